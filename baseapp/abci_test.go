@@ -635,6 +635,49 @@ func TestABCI_CheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+func TestABCI_CheckTx_MempoolEvictionEvents(t *testing.T) {
+	pool := mempool.NewPriorityMempool[int64](mempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:      mempool.NewDefaultTxPriority(),
+		SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+	})
+	suite := NewBaseAppSuite(t, baseapp.SetMempool(pool))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	// both txs have the same signer and sequence, the second one replaces the first one
+	for i, msgCounter := range []int64{1, 2} {
+		tx := newTxCounter(t, suite.txConfig, suite.ac, 0, msgCounter)
+		txBytes, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+
+		r, err := suite.baseApp.CheckTx(&abci.CheckTxRequest{Tx: txBytes, Type: abci.CHECK_TX_TYPE_CHECK})
+		require.NoError(t, err)
+		require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+		require.Equal(t, 1, pool.CountTx())
+
+		var evictions []abci.Event
+		for _, e := range r.Events {
+			if e.Type == mempool.EventTypeEvictTx {
+				evictions = append(evictions, e)
+			}
+		}
+		if i == 0 {
+			require.Empty(t, evictions)
+			continue
+		}
+		require.Len(t, evictions, 1)
+		require.Contains(t, evictions[0].Attributes, abci.EventAttribute{
+			Key:   mempool.AttributeKeyEvictionReason,
+			Value: mempool.EvictionReasonReplaced.String(),
+			Index: true,
+		})
+	}
+}
+
 func TestABCI_FinalizeBlock_DeliverTx(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
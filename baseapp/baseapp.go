@@ -933,11 +933,16 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		anteEvents = events.ToABCIEvents()
 	}
 
+	var mempoolEvents []abci.Event
 	if mode == execModeCheck {
-		err = app.mempool.Insert(ctx, tx)
+		// the events emitted by the mempool, e.g. when it evicts a tx, are returned
+		// with the CheckTx response
+		mempoolCtx := ctx.WithEventManager(sdk.NewEventManager())
+		err = app.mempool.Insert(mempoolCtx, tx)
 		if err != nil {
 			return gInfo, nil, anteEvents, err
 		}
+		mempoolEvents = mempoolCtx.EventManager().ABCIEvents()
 	} else if mode == execModeFinalize {
 		err = app.mempool.Remove(tx)
 		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
@@ -1001,6 +1006,10 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			// append the events in the order of occurrence
 			result.Events = append(anteEvents, result.Events...)
 		}
+
+		if len(mempoolEvents) > 0 {
+			result.Events = append(mempoolEvents, result.Events...)
+		}
 	}
 
 	return gInfo, result, anteEvents, err
@@ -19,6 +19,7 @@ func Cmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra
 		DumpArchiveCmd(),
 		LoadArchiveCmd(),
 		DeleteSnapshotCmd(),
+		DeltaSnapshotCmd(appCreator),
 	)
 	return cmd
 }
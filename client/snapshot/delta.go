@@ -0,0 +1,150 @@
+package snapshot
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// DeltaSnapshotCmd returns the delta snapshots group command
+func DeltaSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delta",
+		Short: "Manage local delta snapshots",
+		Long: `Manage local delta snapshots. A delta snapshot only records the changes between a base
+snapshot height and its own height, restoring it with "snapshots restore <height> <format>"
+restores the full snapshot and all the delta snapshots of its chain.`,
+	}
+	cmd.AddCommand(
+		CreateDeltaSnapshotCmd(appCreator),
+		ListDeltaSnapshotsCmd,
+		VerifyDeltaSnapshotCmd,
+	)
+	return cmd
+}
+
+// CreateDeltaSnapshotCmd returns a command to take a delta snapshot of the application state
+func CreateDeltaSnapshotCmd[T servertypes.Application](appCreator servertypes.AppCreator[T]) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <base-height>",
+		Short: "Export the app state changes since a base snapshot to the snapshot store",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg := client.GetConfigFromCmd(cmd)
+			viper := client.GetViperFromCmd(cmd)
+
+			baseHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64("height")
+			if err != nil {
+				return err
+			}
+
+			home := cfg.RootDir
+			db, err := openDB(home, server.GetAppDBBackend(viper))
+			if err != nil {
+				return err
+			}
+			logger := log.NewLogger(cmd.OutOrStdout())
+			app := appCreator(logger, db, nil, viper)
+
+			if height == 0 {
+				height = app.CommitMultiStore().LastCommitID().Version
+			}
+
+			cmd.Printf("Exporting delta snapshot for height %d on base height %d\n", height, baseHeight)
+
+			sm := app.SnapshotManager()
+			snapshot, err := sm.CreateDelta(baseHeight, uint64(height))
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Delta snapshot created at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64("height", 0, "Height to export, default to latest state height")
+
+	return cmd
+}
+
+// ListDeltaSnapshotsCmd returns the command to list local delta snapshots and their chains
+var ListDeltaSnapshotsCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List local delta snapshots with the heights of their chain",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		viper := client.GetViperFromCmd(cmd)
+		snapshotStore, err := server.GetSnapshotStore(viper)
+		if err != nil {
+			return err
+		}
+		snapshots, err := snapshotStore.List()
+		if err != nil {
+			return fmt.Errorf("failed to list snapshots: %w", err)
+		}
+		for _, snapshot := range snapshots {
+			if !snapshottypes.IsDeltaFormat(snapshot.Format) {
+				continue
+			}
+			base, err := snapshotStore.DeltaBase(snapshot.Height)
+			if err != nil {
+				return err
+			}
+			chain, err := snapshotStore.DeltaChain(snapshot.Height)
+			if err != nil {
+				cmd.Println("height:", snapshot.Height, "base:", base, "chunks:", snapshot.Chunks, "chain: broken:", err)
+				continue
+			}
+			heights := make([]uint64, len(chain))
+			for i, s := range chain {
+				heights[i] = s.Height
+			}
+			cmd.Println("height:", snapshot.Height, "base:", base, "chunks:", snapshot.Chunks, "chain:", heights)
+		}
+
+		return nil
+	},
+}
+
+// VerifyDeltaSnapshotCmd returns the command to verify the chain of a local delta snapshot
+var VerifyDeltaSnapshotCmd = &cobra.Command{
+	Use:   "verify <height>",
+	Short: "Verify the chunks of a local delta snapshot and of all the snapshots of its chain",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		viper := client.GetViperFromCmd(cmd)
+		height, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		snapshotStore, err := server.GetSnapshotStore(viper)
+		if err != nil {
+			return err
+		}
+		chain, err := snapshotStore.DeltaChain(height)
+		if err != nil {
+			return err
+		}
+		for _, snapshot := range chain {
+			if err := snapshotStore.Verify(snapshot.Height, snapshot.Format); err != nil {
+				return fmt.Errorf("snapshot at height %d, format %d is invalid: %w", snapshot.Height, snapshot.Format, err)
+			}
+			cmd.Println("height:", snapshot.Height, "format:", snapshot.Format, "chunks:", snapshot.Chunks, "ok")
+		}
+
+		return nil
+	},
+}
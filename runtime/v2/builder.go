@@ -154,11 +154,13 @@ func (a *AppBuilder[T]) Build(opts ...AppBuilderOption[T]) (*App[T], error) {
 	a.app.db = rs
 
 	appManagerBuilder := appmanager.Builder[T]{
-		STF:                a.app.stf,
-		DB:                 a.app.db,
-		ValidateTxGasLimit: a.app.config.GasConfig.ValidateTxGasLimit,
-		QueryGasLimit:      a.app.config.GasConfig.QueryGasLimit,
-		SimulationGasLimit: a.app.config.GasConfig.SimulationGasLimit,
+		STF:                 a.app.stf,
+		DB:                  a.app.db,
+		ValidateTxGasLimit:  a.app.config.GasConfig.ValidateTxGasLimit,
+		QueryGasLimit:       a.app.config.GasConfig.QueryGasLimit,
+		SimulationGasLimit:  a.app.config.GasConfig.SimulationGasLimit,
+		ParallelTxExecution: a.app.config.ParallelExecution.GetEnabled(),
+		ParallelTxWorkers:   int(a.app.config.ParallelExecution.GetWorkers()),
 		InitGenesis: func(
 			ctx context.Context,
 			src io.Reader,
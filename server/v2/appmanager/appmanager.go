@@ -103,7 +103,17 @@ func (a AppManager[T]) DeliverBlock(
 		return nil, nil, fmt.Errorf("invalid DeliverBlock height wanted %d, got %d", latestVersion+1, block.Height)
 	}
 
-	blockResponse, newState, err := a.stf.DeliverBlock(ctx, block, currentState)
+	var (
+		blockResponse *server.BlockResponse
+		newState      corestore.WriterMap
+	)
+	if a.config.ParallelTxExecution {
+		blockResponse, newState, err = a.stf.(ParallelStateTransitionFunction[T]).DeliverBlockParallel(
+			ctx, block, currentState, a.config.ParallelTxWorkers,
+		)
+	} else {
+		blockResponse, newState, err = a.stf.DeliverBlock(ctx, block, currentState)
+	}
 	if err != nil {
 		return nil, nil, fmt.Errorf("block delivery failed: %w", err)
 	}
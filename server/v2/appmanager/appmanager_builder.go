@@ -1,6 +1,8 @@
 package appmanager
 
 import (
+	"fmt"
+
 	"cosmossdk.io/core/transaction"
 )
 
@@ -15,6 +17,12 @@ type Builder[T transaction.Tx] struct {
 	QueryGasLimit      uint64
 	SimulationGasLimit uint64
 
+	// ParallelTxExecution enables the optimistic parallel execution of the block transactions,
+	// it requires STF to implement ParallelStateTransitionFunction.
+	ParallelTxExecution bool
+	// ParallelTxWorkers is the number of txs executed concurrently when ParallelTxExecution is enabled.
+	ParallelTxWorkers int
+
 	// InitGenesis is a function that initializes the application state from a genesis file.
 	// It takes a context, a source reader for the genesis file, and a transaction handler function.
 	InitGenesis InitGenesis
@@ -26,11 +34,19 @@ type Builder[T transaction.Tx] struct {
 // Build creates a new instance of AppManager with the provided configuration and returns it.
 // It initializes the AppManager with the given database, export state, import state, initGenesis function, and state transition function.
 func (b Builder[T]) Build() (*AppManager[T], error) {
+	if b.ParallelTxExecution {
+		if _, ok := b.STF.(ParallelStateTransitionFunction[T]); !ok {
+			return nil, fmt.Errorf("parallel tx execution is enabled but %T does not support it", b.STF)
+		}
+	}
+
 	return &AppManager[T]{
 		config: Config{
-			ValidateTxGasLimit: b.ValidateTxGasLimit,
-			QueryGasLimit:      b.QueryGasLimit,
-			SimulationGasLimit: b.SimulationGasLimit,
+			ValidateTxGasLimit:  b.ValidateTxGasLimit,
+			QueryGasLimit:       b.QueryGasLimit,
+			SimulationGasLimit:  b.SimulationGasLimit,
+			ParallelTxExecution: b.ParallelTxExecution,
+			ParallelTxWorkers:   b.ParallelTxWorkers,
 		},
 		db:            b.DB,
 		initGenesis:   b.InitGenesis,
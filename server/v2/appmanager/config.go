@@ -6,4 +6,9 @@ type Config struct {
 	ValidateTxGasLimit uint64 `mapstructure:"validate-tx-gas-limit"` // TODO: check how this works on app mempool
 	QueryGasLimit      uint64 `mapstructure:"query-gas-limit"`
 	SimulationGasLimit uint64 `mapstructure:"simulation-gas-limit"`
+
+	// ParallelTxExecution enables the optimistic parallel execution of the block transactions.
+	ParallelTxExecution bool `mapstructure:"parallel-tx-execution"`
+	// ParallelTxWorkers is the number of txs executed concurrently, defaults to GOMAXPROCS if 0.
+	ParallelTxWorkers int `mapstructure:"parallel-tx-workers"`
 }
@@ -41,3 +41,19 @@ type StateTransitionFunction[T transaction.Tx] interface {
 		req transaction.Msg,
 	) (transaction.Msg, error)
 }
+
+// ParallelStateTransitionFunction is a StateTransitionFunction able to execute
+// the transactions of a block concurrently.
+type ParallelStateTransitionFunction[T transaction.Tx] interface {
+	StateTransitionFunction[T]
+
+	// DeliverBlockParallel executes a block of transactions using the provided
+	// number of workers. The results and new state must be identical to the ones
+	// returned by DeliverBlock.
+	DeliverBlockParallel(
+		ctx context.Context,
+		block *server.BlockRequest[T],
+		state store.ReaderMap,
+		workers int,
+	) (blockResult *server.BlockResponse, newState store.WriterMap, err error)
+}
@@ -140,6 +140,21 @@ func (c *Consensus[T]) CheckTx(ctx context.Context, req *abciproto.CheckTxReques
 	if resp.Error != nil {
 		cometResp.Code = 1
 		cometResp.Log = resp.Error.Error()
+
+		// a tx which is no longer valid is removed from the app-side mempool
+		if req.Type == abciproto.CHECK_TX_TYPE_RECHECK {
+			if err := c.mempool.Remove(decodedTx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+				return nil, fmt.Errorf("unable to remove tx: %w", err)
+			}
+		}
+		return cometResp, nil
+	}
+
+	if req.Type != abciproto.CHECK_TX_TYPE_RECHECK {
+		if err := c.mempool.Insert(ctx, decodedTx); err != nil {
+			cometResp.Code = 1
+			cometResp.Log = err.Error()
+		}
 	}
 	return cometResp, nil
 }
@@ -473,7 +488,7 @@ func (c *Consensus[T]) FinalizeBlock(
 
 	// remove txs from the mempool
 	for _, tx := range decodedTxs {
-		if err = c.mempool.Remove(tx); err != nil {
+		if err = c.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
 			return nil, fmt.Errorf("unable to remove tx: %w", err)
 		}
 	}
@@ -623,6 +623,36 @@ func TestConsensus_Query(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, res.Value, []byte(nil))
+
+	// Query store subspace
+	res, err = c.Query(context.Background(), &abciproto.QueryRequest{
+		Path:   "store/cookies/subspace",
+		Data:   []byte("ke"),
+		Height: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("ke"), res.Key)
+	require.Equal(t, marshalKVPairs([]store.KVPair{{Key: []byte("key"), Value: []byte("value")}}), res.Value)
+
+	// a subspace holding more pairs than the limit is rejected
+	err = c.store.GetStateStorage().ApplyChangeset(1, &store.Changeset{
+		Changes: []store.StateChanges{
+			{
+				Actor:        actorName,
+				StateChanges: []store.KVPair{{Key: []byte("other"), Value: []byte("value")}},
+			},
+		},
+	})
+	require.NoError(t, err)
+	c.cfg.AppTomlConfig.MaxSubspaceQueryPairs = 1
+	res, err = c.Query(context.Background(), &abciproto.QueryRequest{
+		Path:   "store/cookies/subspace",
+		Data:   []byte(""),
+		Height: 1,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, uint32(0), res.Code)
+	require.Contains(t, res.Log, "holds more than 1 pairs")
 }
 
 func setUpConsensus(t *testing.T, gasLimit uint64, mempool mempool.Mempool[mock.Tx]) *Consensus[mock.Tx] {
@@ -69,6 +69,10 @@ func (h *DefaultProposalHandler[T]) PrepareHandler() PrepareHandler[T] {
 			return h.txSelector.SelectedTxs(ctx), nil
 		}
 
+		if lanes, ok := h.mempool.(*mempool.LaneMempool[T]); ok {
+			return h.prepareLanes(ctx, app, lanes, uint64(req.MaxTxBytes), maxBlockGas)
+		}
+
 		iterator := h.mempool.Select(ctx, txs)
 		for iterator != nil {
 			memTx := iterator.Tx()
@@ -151,6 +155,10 @@ func (h *DefaultProposalHandler[T]) ProcessHandler() ProcessHandler[T] {
 			}
 		}
 
+		if lanes, ok := h.mempool.(*mempool.LaneMempool[T]); ok {
+			return verifyLanes(ctx, lanes, txs)
+		}
+
 		return nil
 	}
 }
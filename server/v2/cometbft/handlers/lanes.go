@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/cometbft/mempool"
+)
+
+// laneLimit returns the max bytes or gas a lane may use, given its max block space,
+// the block max and what is left by the previous lanes. A block max of 0 means
+// there is no limit.
+func laneLimit(maxBlockSpace, blockMax, left uint64) uint64 {
+	if blockMax == 0 || maxBlockSpace == 0 {
+		return left
+	}
+	return min(blockMax*maxBlockSpace/100, left)
+}
+
+// prepareLanes selects the transactions of the lane mempool for a proposal, lane
+// after lane, each lane being limited to its share of the block space.
+func (h *DefaultProposalHandler[T]) prepareLanes(
+	ctx context.Context,
+	app AppManager[T],
+	mp *mempool.LaneMempool[T],
+	maxTxBytes, maxBlockGas uint64,
+) ([]T, error) {
+	var (
+		selected           []T
+		bytesLeft, gasLeft = maxTxBytes, maxBlockGas
+	)
+	for i, lane := range mp.Lanes() {
+		selector := NewDefaultTxSelector[T]()
+		laneBytes := laneLimit(lane.MaxBlockSpace, maxTxBytes, bytesLeft)
+		laneGas := laneLimit(lane.MaxBlockSpace, maxBlockGas, gasLeft)
+
+		// a lane without any block space left is skipped, as a max gas of 0 means no limit
+		// to the TxSelector.
+		if laneBytes == 0 || (maxBlockGas > 0 && laneGas == 0) {
+			continue
+		}
+
+		for iterator := mp.SelectLane(ctx, i); iterator != nil; iterator = iterator.Next() {
+			memTx := iterator.Tx()
+
+			// NOTE: the transactions of the mempool were validated in CheckTx, but
+			// they may have been invalidated since, see PrepareHandler.
+			if _, err := app.ValidateTx(ctx, memTx); err != nil {
+				if err := mp.Remove(memTx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+					return nil, err
+				}
+				continue
+			}
+
+			if selector.SelectTxForProposal(ctx, laneBytes, laneGas, memTx) {
+				break
+			}
+		}
+
+		txs := selector.SelectedTxs(ctx)
+		size, gas := txsSize(txs)
+		bytesLeft -= size
+		if maxBlockGas > 0 {
+			gasLeft -= gas
+		}
+		selected = append(selected, txs...)
+	}
+
+	return selected, nil
+}
+
+// verifyLanes verifies that the transactions of a proposal are ordered by lane.
+//
+// NOTE: the block space of the lanes is a policy of the proposer and is not
+// verified, as it may differ from one validator to another, e.g. when the lanes
+// are read from the node configuration. The lane of a transaction must however
+// be the same on every validator, so the lanes must be defined by the application
+// in the same order on every node.
+func verifyLanes[T transaction.Tx](ctx context.Context, mp *mempool.LaneMempool[T], txs []T) error {
+	lanes := mp.Lanes()
+	current := 0
+	for _, tx := range txs {
+		laneIdx := mp.LaneOf(ctx, tx)
+		if laneIdx < current {
+			return fmt.Errorf("tx of lane %s is after txs of lane %s", lanes[laneIdx].Name, lanes[current].Name)
+		}
+		current = laneIdx
+	}
+
+	return nil
+}
+
+// txsSize returns the total proto size and gas limit of the given transactions,
+// as accounted by the TxSelector.
+func txsSize[T transaction.Tx](txs []T) (size, gas uint64) {
+	for _, tx := range txs {
+		size += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx.Bytes()}))
+		// the gas limit was already read by the TxSelector.
+		gasLimit, _ := tx.GetGasLimit()
+		gas += gasLimit
+	}
+	return size, gas
+}
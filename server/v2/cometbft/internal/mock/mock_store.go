@@ -115,6 +115,28 @@ func (s *MockStore) Query(storeKey []byte, version uint64, key []byte, prove boo
 	return res, err
 }
 
+func (s *MockStore) QueryRange(storeKey []byte, version uint64, start, end []byte, limit uint64, prove bool) (storev2.RangeQueryResult, error) {
+	itr, err := s.Storage.Iterator(storeKey, version, start, end)
+	if err != nil {
+		return storev2.RangeQueryResult{}, err
+	}
+	defer itr.Close()
+
+	res := storev2.RangeQueryResult{
+		Start:   start,
+		End:     end,
+		Version: version,
+	}
+	for ; itr.Valid(); itr.Next() {
+		if limit > 0 && uint64(len(res.Pairs)) == limit {
+			res.End = itr.Key()
+			break
+		}
+		res.Pairs = append(res.Pairs, corestore.KVPair{Key: itr.Key(), Value: itr.Value()})
+	}
+	return res, nil
+}
+
 func (s *MockStore) LastCommitID() (proof.CommitID, error) {
 	v, err := s.GetStateCommitment().GetLatestVersion()
 	bz := sha256.Sum256([]byte{})
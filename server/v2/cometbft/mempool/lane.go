@@ -0,0 +1,340 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"cosmossdk.io/core/transaction"
+)
+
+var (
+	_ Mempool[transaction.Tx]  = (*LaneMempool[transaction.Tx])(nil)
+	_ Iterator[transaction.Tx] = (*laneIterator[transaction.Tx])(nil)
+
+	ErrTxReplacementRejected = errors.New("tx with the same sender and nonce already in mempool with a higher or equal priority")
+)
+
+// TxInfo defines the metadata of a transaction used to order it in the LaneMempool.
+type TxInfo struct {
+	// Sender is the account the nonce of the transaction belongs to.
+	Sender string
+	// Nonce is the sequence number of the transaction for its sender.
+	Nonce uint64
+	// Priority is the priority of the transaction, e.g. its fee per gas unit.
+	Priority int64
+}
+
+// TxInfoFn returns the metadata of a transaction. It is provided by the application,
+// as the mempool knows nothing about the fees and nonces of the transactions.
+type TxInfoFn[T transaction.Tx] func(ctx context.Context, tx T) (TxInfo, error)
+
+// Lane defines a class of transactions of the LaneMempool which are given a share of
+// the block space, e.g. oracle or IBC relay transactions.
+type Lane[T transaction.Tx] struct {
+	// Name is the unique name of the lane.
+	Name string
+	// MaxBlockSpace is the maximum share of the block, in percent of both the max
+	// block bytes and gas, the transactions of the lane may use. 0 means the lane
+	// may use all the block space left by the previous lanes. It is only applied
+	// by the proposer, the other validators do not verify it.
+	MaxBlockSpace uint64
+	// MaxTxs is the maximum number of transactions of the lane in the mempool, 0
+	// meaning there is no cap.
+	MaxTxs int
+	// Match returns true if the transaction belongs to the lane. A nil Match matches
+	// every transaction, which is only allowed for the last lane.
+	Match func(ctx context.Context, tx T) bool
+}
+
+// LaneMempool is a mempool made of lanes of transactions. The lanes are ordered
+// by decreasing priority: a transaction belongs to the first lane it matches, and
+// the transactions of a lane are selected before the ones of the next lanes.
+//
+// Within a lane, transactions are ordered by priority, while the transactions of a
+// sender are always ordered by nonce: the transaction with the highest priority
+// among the lowest nonce transaction of every sender is selected first. The nonce
+// ordering of a sender is only guaranteed within a lane.
+type LaneMempool[T transaction.Tx] struct {
+	mtx    sync.Mutex
+	maxTxs int
+	txInfo TxInfoFn[T]
+	lanes  []*lane[T]
+	txs    map[[32]byte]*laneTx[T]
+	// seq is the insertion sequence, used to order transactions of equal priority
+	seq uint64
+}
+
+type lane[T transaction.Tx] struct {
+	Lane[T]
+	// senders holds the transactions of every sender, ordered by nonce.
+	senders map[string][]*laneTx[T]
+	count   int
+}
+
+type laneTx[T transaction.Tx] struct {
+	tx   T
+	hash [32]byte
+	info TxInfo
+	lane int
+	seq  uint64
+}
+
+// NewLaneMempool returns a LaneMempool with the given lanes, ordered by decreasing
+// priority. The last lane is the default lane of the transactions not matching any
+// other lane, and must not define Match.
+func NewLaneMempool[T transaction.Tx](cfg Config, txInfo TxInfoFn[T], lanes ...Lane[T]) (*LaneMempool[T], error) {
+	if txInfo == nil {
+		return nil, errors.New("tx info function cannot be nil")
+	}
+	if len(lanes) == 0 {
+		return nil, errors.New("at least one lane is required")
+	}
+
+	var totalBlockSpace uint64
+	names := make(map[string]struct{}, len(lanes))
+	mp := &LaneMempool[T]{
+		maxTxs: cfg.MaxTxs,
+		txInfo: txInfo,
+		txs:    make(map[[32]byte]*laneTx[T]),
+	}
+	for i, l := range lanes {
+		if _, ok := names[l.Name]; ok {
+			return nil, fmt.Errorf("duplicate lane %s", l.Name)
+		}
+		names[l.Name] = struct{}{}
+		if l.MaxBlockSpace > 100 {
+			return nil, fmt.Errorf("lane %s max block space %d%% exceeds 100%%", l.Name, l.MaxBlockSpace)
+		}
+		totalBlockSpace += l.MaxBlockSpace
+		if last := i == len(lanes)-1; last != (l.Match == nil) {
+			return nil, fmt.Errorf("lane %s: only the last lane must match every transaction", l.Name)
+		}
+		mp.lanes = append(mp.lanes, &lane[T]{Lane: l, senders: make(map[string][]*laneTx[T])})
+	}
+	if totalBlockSpace > 100 {
+		return nil, fmt.Errorf("total lanes max block space %d%% exceeds 100%%", totalBlockSpace)
+	}
+
+	return mp, nil
+}
+
+// Lanes returns the lanes of the mempool, ordered by decreasing priority.
+func (mp *LaneMempool[T]) Lanes() []Lane[T] {
+	lanes := make([]Lane[T], len(mp.lanes))
+	for i, l := range mp.lanes {
+		lanes[i] = l.Lane
+	}
+	return lanes
+}
+
+// LaneOf returns the index of the lane the transaction belongs to.
+func (mp *LaneMempool[T]) LaneOf(ctx context.Context, tx T) int {
+	for i, l := range mp.lanes[:len(mp.lanes)-1] {
+		if l.Match(ctx, tx) {
+			return i
+		}
+	}
+	return len(mp.lanes) - 1
+}
+
+// Insert inserts a transaction in its lane. A transaction with the same sender and
+// nonce as a transaction of the mempool replaces it only if its priority is higher.
+func (mp *LaneMempool[T]) Insert(ctx context.Context, tx T) error {
+	if mp.maxTxs < 0 {
+		return nil
+	}
+
+	info, err := mp.txInfo(ctx, tx)
+	if err != nil {
+		return err
+	}
+	laneIdx := mp.LaneOf(ctx, tx)
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	hash := tx.Hash()
+	if _, ok := mp.txs[hash]; ok {
+		return nil
+	}
+
+	l := mp.lanes[laneIdx]
+	queue := l.senders[info.Sender]
+	i := sort.Search(len(queue), func(i int) bool { return queue[i].info.Nonce >= info.Nonce })
+	mp.seq++
+	ltx := &laneTx[T]{tx: tx, hash: hash, info: info, lane: laneIdx, seq: mp.seq}
+
+	if i < len(queue) && queue[i].info.Nonce == info.Nonce {
+		if queue[i].info.Priority >= info.Priority {
+			return ErrTxReplacementRejected
+		}
+		delete(mp.txs, queue[i].hash)
+		queue[i] = ltx
+		mp.txs[hash] = ltx
+		return nil
+	}
+
+	if (mp.maxTxs > 0 && len(mp.txs) >= mp.maxTxs) || (l.MaxTxs > 0 && l.count >= l.MaxTxs) {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	queue = append(queue, nil)
+	copy(queue[i+1:], queue[i:])
+	queue[i] = ltx
+	l.senders[info.Sender] = queue
+	l.count++
+	mp.txs[hash] = ltx
+
+	return nil
+}
+
+// Select returns an iterator over the transactions of all the lanes, lane after
+// lane. The given transactions are ignored.
+func (mp *LaneMempool[T]) Select(_ context.Context, _ []T) Iterator[T] {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return mp.newIterator(0, len(mp.lanes))
+}
+
+// SelectLane returns an iterator over the transactions of the lane at the given
+// index.
+func (mp *LaneMempool[T]) SelectLane(_ context.Context, laneIdx int) Iterator[T] {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return mp.newIterator(laneIdx, laneIdx+1)
+}
+
+// SelectBy calls the callback with the transactions of all the lanes, in the order
+// of Select, until it returns false. The callback may modify the mempool.
+func (mp *LaneMempool[T]) SelectBy(_ context.Context, _ []T, callback func(T) bool) {
+	mp.mtx.Lock()
+	it := mp.newIterator(0, len(mp.lanes))
+	mp.mtx.Unlock()
+
+	for ; it != nil; it = it.Next() {
+		if !callback(it.Tx()) {
+			return
+		}
+	}
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *LaneMempool[T]) CountTx() int {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return len(mp.txs)
+}
+
+// Remove removes a transaction from the mempool.
+func (mp *LaneMempool[T]) Remove(tx T) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	ltx, ok := mp.txs[tx.Hash()]
+	if !ok {
+		return ErrTxNotFound
+	}
+
+	l := mp.lanes[ltx.lane]
+	queue := l.senders[ltx.info.Sender]
+	for i, qtx := range queue {
+		if qtx == ltx {
+			queue = append(queue[:i], queue[i+1:]...)
+			break
+		}
+	}
+	if len(queue) == 0 {
+		delete(l.senders, ltx.info.Sender)
+	} else {
+		l.senders[ltx.info.Sender] = queue
+	}
+	l.count--
+	delete(mp.txs, ltx.hash)
+
+	return nil
+}
+
+// newIterator returns an iterator over the lanes [from, to), or nil if they are
+// empty. The iterator works on a snapshot of the lanes, so that the mempool can be
+// modified while iterating.
+func (mp *LaneMempool[T]) newIterator(from, to int) Iterator[T] {
+	it := &laneIterator[T]{}
+	for _, l := range mp.lanes[from:to] {
+		var heads senderHeap[T]
+		for _, queue := range l.senders {
+			heads = append(heads, append([]*laneTx[T](nil), queue...))
+		}
+		heap.Init(&heads)
+		it.lanes = append(it.lanes, heads)
+	}
+
+	return it.Next()
+}
+
+// laneIterator iterates over the transactions of consecutive lanes.
+type laneIterator[T transaction.Tx] struct {
+	lanes   []senderHeap[T]
+	current *laneTx[T]
+}
+
+// Next implements Iterator.
+func (it *laneIterator[T]) Next() Iterator[T] {
+	for len(it.lanes) > 0 {
+		heads := &it.lanes[0]
+		if heads.Len() == 0 {
+			it.lanes = it.lanes[1:]
+			continue
+		}
+
+		// pop the best transaction, and replace it by the next one of its sender
+		queue := (*heads)[0]
+		it.current = queue[0]
+		if len(queue) > 1 {
+			(*heads)[0] = queue[1:]
+			heap.Fix(heads, 0)
+		} else {
+			heap.Pop(heads)
+		}
+		return it
+	}
+
+	return nil
+}
+
+// Tx implements Iterator.
+func (it *laneIterator[T]) Tx() T {
+	return it.current.tx
+}
+
+// senderHeap is a max heap of the transaction queues of the senders, ordered by the
+// priority of their first transaction, the oldest transaction first on equality.
+type senderHeap[T transaction.Tx] [][]*laneTx[T]
+
+func (h senderHeap[T]) Len() int { return len(h) }
+
+func (h senderHeap[T]) Less(i, j int) bool {
+	a, b := h[i][0], h[j][0]
+	if a.info.Priority != b.info.Priority {
+		return a.info.Priority > b.info.Priority
+	}
+	return a.seq < b.seq
+}
+
+func (h senderHeap[T]) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *senderHeap[T]) Push(x any) { *h = append(*h, x.([]*laneTx[T])) }
+
+func (h *senderHeap[T]) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
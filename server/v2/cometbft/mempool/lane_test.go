@@ -0,0 +1,162 @@
+package mempool
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/transaction"
+)
+
+type testTx struct {
+	sender   string
+	nonce    uint64
+	priority int64
+	lane     string
+}
+
+func (tx testTx) Hash() [32]byte                          { return sha256.Sum256(tx.Bytes()) }
+func (tx testTx) GetMessages() ([]transaction.Msg, error) { return nil, nil }
+func (tx testTx) GetSenders() ([]transaction.Identity, error) {
+	return []transaction.Identity{[]byte(tx.sender)}, nil
+}
+func (tx testTx) GetGasLimit() (uint64, error) { return 0, nil }
+func (tx testTx) Bytes() []byte {
+	return []byte(fmt.Sprintf("%s/%d/%d/%s", tx.sender, tx.nonce, tx.priority, tx.lane))
+}
+
+func testTxInfo(_ context.Context, tx testTx) (TxInfo, error) {
+	return TxInfo{Sender: tx.sender, Nonce: tx.nonce, Priority: tx.priority}, nil
+}
+
+func newTestLaneMempool(t *testing.T, maxTxs int) *LaneMempool[testTx] {
+	t.Helper()
+	mp, err := NewLaneMempool(Config{MaxTxs: maxTxs}, testTxInfo,
+		Lane[testTx]{
+			Name:          "oracle",
+			MaxBlockSpace: 20,
+			MaxTxs:        2,
+			Match:         func(_ context.Context, tx testTx) bool { return tx.lane == "oracle" },
+		},
+		Lane[testTx]{Name: "default"},
+	)
+	require.NoError(t, err)
+	return mp
+}
+
+func selectAll(mp Mempool[testTx]) []testTx {
+	var txs []testTx
+	for it := mp.Select(context.Background(), nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+func TestNewLaneMempool(t *testing.T) {
+	match := func(context.Context, testTx) bool { return true }
+	testCases := []struct {
+		name  string
+		lanes []Lane[testTx]
+	}{
+		{name: "no lanes"},
+		{name: "duplicate lane", lanes: []Lane[testTx]{{Name: "a", Match: match}, {Name: "a"}}},
+		{name: "lane block space above 100%", lanes: []Lane[testTx]{{Name: "a", MaxBlockSpace: 101}}},
+		{name: "total block space above 100%", lanes: []Lane[testTx]{{Name: "a", MaxBlockSpace: 60, Match: match}, {Name: "b", MaxBlockSpace: 60}}},
+		{name: "last lane with match", lanes: []Lane[testTx]{{Name: "a", Match: match}}},
+		{name: "lane without match", lanes: []Lane[testTx]{{Name: "a"}, {Name: "b"}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewLaneMempool(Config{}, testTxInfo, tc.lanes...)
+			require.Error(t, err)
+		})
+	}
+
+	_, err := NewLaneMempool[testTx](Config{}, nil, Lane[testTx]{Name: "default"})
+	require.Error(t, err)
+}
+
+func TestLaneMempoolOrdering(t *testing.T) {
+	ctx := context.Background()
+	mp := newTestLaneMempool(t, 0)
+
+	txs := []testTx{
+		{sender: "alice", nonce: 1, priority: 100},
+		{sender: "alice", nonce: 0, priority: 1},
+		{sender: "bob", nonce: 0, priority: 50},
+		{sender: "carol", nonce: 0, priority: 10},
+		{sender: "relayer", nonce: 0, priority: 0, lane: "oracle"},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+	require.Equal(t, len(txs), mp.CountTx())
+
+	// the oracle lane comes first, then the senders by priority of their lowest
+	// nonce transaction
+	require.Equal(t, []testTx{txs[4], txs[2], txs[3], txs[1], txs[0]}, selectAll(mp))
+
+	var lane []testTx
+	for it := mp.SelectLane(ctx, 0); it != nil; it = it.Next() {
+		lane = append(lane, it.Tx())
+	}
+	require.Equal(t, []testTx{txs[4]}, lane)
+	require.Equal(t, 0, mp.LaneOf(ctx, txs[4]))
+	require.Equal(t, 1, mp.LaneOf(ctx, txs[0]))
+
+	// the mempool can be modified while iterating
+	var selected []testTx
+	mp.SelectBy(ctx, nil, func(tx testTx) bool {
+		selected = append(selected, tx)
+		require.NoError(t, mp.Remove(tx))
+		return true
+	})
+	require.Len(t, selected, len(txs))
+	require.Zero(t, mp.CountTx())
+	require.Nil(t, mp.Select(ctx, nil))
+	require.ErrorIs(t, mp.Remove(txs[0]), ErrTxNotFound)
+}
+
+func TestLaneMempoolReplacement(t *testing.T) {
+	ctx := context.Background()
+	mp := newTestLaneMempool(t, 0)
+
+	tx := testTx{sender: "alice", nonce: 0, priority: 10}
+	require.NoError(t, mp.Insert(ctx, tx))
+	// inserting the same tx is a no-op
+	require.NoError(t, mp.Insert(ctx, tx))
+
+	require.ErrorIs(t, mp.Insert(ctx, testTx{sender: "alice", nonce: 0, priority: 10, lane: "other"}), ErrTxReplacementRejected)
+	require.ErrorIs(t, mp.Insert(ctx, testTx{sender: "alice", nonce: 0, priority: 5}), ErrTxReplacementRejected)
+
+	replacement := testTx{sender: "alice", nonce: 0, priority: 20}
+	require.NoError(t, mp.Insert(ctx, replacement))
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []testTx{replacement}, selectAll(mp))
+	require.ErrorIs(t, mp.Remove(tx), ErrTxNotFound)
+}
+
+func TestLaneMempoolCapacity(t *testing.T) {
+	ctx := context.Background()
+
+	mp := newTestLaneMempool(t, 3)
+	for i := uint64(0); i < 2; i++ {
+		require.NoError(t, mp.Insert(ctx, testTx{sender: "relayer", nonce: i, lane: "oracle"}))
+	}
+	// the oracle lane is full
+	require.ErrorIs(t, mp.Insert(ctx, testTx{sender: "relayer", nonce: 2, lane: "oracle"}), ErrMempoolTxMaxCapacity)
+	require.NoError(t, mp.Insert(ctx, testTx{sender: "alice"}))
+	// the mempool is full
+	require.ErrorIs(t, mp.Insert(ctx, testTx{sender: "bob"}), ErrMempoolTxMaxCapacity)
+	// a replacement does not need any capacity
+	require.NoError(t, mp.Insert(ctx, testTx{sender: "alice", priority: 1}))
+	require.Equal(t, 3, mp.CountTx())
+
+	// a negative max txs disables the mempool
+	mp = newTestLaneMempool(t, -1)
+	require.NoError(t, mp.Insert(ctx, testTx{sender: "alice"}))
+	require.Zero(t, mp.CountTx())
+}
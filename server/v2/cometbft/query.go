@@ -1,12 +1,15 @@
 package cometbft
 
 import (
+	"bytes"
 	"context"
 	"strings"
 
 	abci "github.com/cometbft/cometbft/api/cometbft/abci/v1"
 	crypto "github.com/cometbft/cometbft/api/cometbft/crypto/v1"
+	"google.golang.org/protobuf/encoding/protowire"
 
+	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/server/v2/cometbft/types"
 	cometerrors "cosmossdk.io/server/v2/cometbft/types/errors"
@@ -96,6 +99,12 @@ func (c *Consensus[T]) handleQueryStore(path []string, _ types.Store, req *abci.
 	// "/store/<storeName>" for store queries
 	storeName := path[1]
 	storeNameBz := []byte(storeName) // TODO fastpath?
+
+	// "/store/<storeName>/subspace" for prefix queries
+	if len(path) > 2 && path[2] == "subspace" {
+		return c.handleQuerySubspace(storeNameBz, req)
+	}
+
 	qRes, err := c.store.Query(storeNameBz, uint64(req.Height), req.Data, req.Prove)
 	if err != nil {
 		return nil, err
@@ -109,23 +118,119 @@ func (c *Consensus[T]) handleQueryStore(path []string, _ types.Store, req *abci.
 	}
 
 	if req.Prove {
+		res.ProofOps = &crypto.ProofOps{}
 		for _, proof := range qRes.ProofOps {
 			bz, err := proof.Proof.Marshal()
 			if err != nil {
 				return nil, errorsmod.Wrap(err, "failed to marshal proof")
 			}
 
-			res.ProofOps = &crypto.ProofOps{
-				Ops: []crypto.ProofOp{
-					{
-						Type: proof.Type,
-						Key:  proof.Key,
-						Data: bz,
-					},
+			res.ProofOps.Ops = append(res.ProofOps.Ops, crypto.ProofOp{
+				Type: proof.Type,
+				Key:  proof.Key,
+				Data: bz,
+			})
+		}
+	}
+
+	return res, nil
+}
+
+// handleQuerySubspace returns all the key/value pairs of the store whose keys start
+// with the prefix held in the request data. The pairs are encoded like the ones of the
+// store v1 subspace queries, and the proof, if requested, is made of the range proof
+// of the pairs followed by the proof of the store in the commit info.
+func (c *Consensus[T]) handleQuerySubspace(storeName []byte, req *abci.QueryRequest) (*abci.QueryResponse, error) {
+	prefix := req.Data
+	start, end := prefix, prefixEndBytes(prefix)
+	if len(start) == 0 {
+		// an empty prefix is the whole store
+		start = nil
+	}
+	limit := c.cfg.AppTomlConfig.MaxSubspaceQueryPairs
+	qRes, err := c.store.QueryRange(storeName, uint64(req.Height), start, end, limit, req.Prove)
+	if err != nil {
+		return nil, err
+	}
+	// the query has no pagination, so a subspace cut by the limit is rejected
+	// rather than returned incomplete.
+	if !bytes.Equal(qRes.End, end) {
+		return nil, errorsmod.Wrapf(cometerrors.ErrInvalidRequest, "subspace %X holds more than %d pairs", prefix, limit)
+	}
+
+	res := &abci.QueryResponse{
+		Codespace: cometerrors.RootCodespace,
+		Height:    int64(qRes.Version),
+		Key:       prefix,
+		Value:     marshalKVPairs(qRes.Pairs),
+	}
+
+	if req.Prove {
+		bz, err := qRes.RangeOp.Proof.Marshal()
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to marshal range proof")
+		}
+		res.ProofOps = &crypto.ProofOps{
+			Ops: []crypto.ProofOp{
+				{
+					Type: qRes.RangeOp.Type,
+					Key:  prefix,
+					Data: bz,
 				},
+			},
+		}
+		for _, proof := range qRes.ProofOps {
+			bz, err := proof.Proof.Marshal()
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed to marshal proof")
 			}
+
+			res.ProofOps.Ops = append(res.ProofOps.Ops, crypto.ProofOp{
+				Type: proof.Type,
+				Key:  proof.Key,
+				Data: bz,
+			})
 		}
 	}
 
 	return res, nil
 }
+
+// marshalKVPairs encodes the pairs as the protobuf message
+// cosmos.store.internal.kv.v1beta1.Pairs returned by store v1 subspace queries.
+func marshalKVPairs(pairs []store.KVPair) []byte {
+	var bz []byte
+	for _, pair := range pairs {
+		var pairBz []byte
+		pairBz = protowire.AppendTag(pairBz, 1, protowire.BytesType)
+		pairBz = protowire.AppendBytes(pairBz, pair.Key)
+		pairBz = protowire.AppendTag(pairBz, 2, protowire.BytesType)
+		pairBz = protowire.AppendBytes(pairBz, pair.Value)
+
+		bz = protowire.AppendTag(bz, 1, protowire.BytesType)
+		bz = protowire.AppendBytes(bz, pairBz)
+	}
+	return bz
+}
+
+// prefixEndBytes returns the []byte that would end a range query for all []byte
+// with a certain prefix, or nil if no such []byte exists.
+func prefixEndBytes(prefix []byte) []byte {
+	if len(prefix) == 0 {
+		return nil
+	}
+
+	end := bytes.Clone(prefix)
+	for {
+		if end[len(end)-1] != byte(255) {
+			end[len(end)-1]++
+			break
+		}
+
+		end = end[:len(end)-1]
+		if len(end) == 0 {
+			return nil
+		}
+	}
+	return end
+}
@@ -21,9 +21,12 @@ import (
 	"cosmossdk.io/core/transaction"
 	"cosmossdk.io/log"
 	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/api/telemetry"
 	cometlog "cosmossdk.io/server/v2/cometbft/log"
 	"cosmossdk.io/server/v2/cometbft/mempool"
 	"cosmossdk.io/server/v2/cometbft/types"
+	serverstore "cosmossdk.io/server/v2/store"
+	storemetrics "cosmossdk.io/store/v2/metrics"
 	"cosmossdk.io/store/v2/snapshots"
 
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
@@ -125,7 +128,21 @@ func (s *CometBFTServer[T]) Init(appI serverv2.AppI[T], cfg map[string]any, logg
 	if err != nil {
 		return err
 	}
-	consensus.snapshotManager = snapshots.NewManager(snapshotStore, s.serverOptions.SnapshotOptions(cfg), sc, ss, nil, s.logger)
+	storeCfg := serverstore.DefaultConfig()
+	if len(cfg) > 0 {
+		if err := serverv2.UnmarshalSubConfig(cfg, serverstore.ServerName, &storeCfg); err != nil {
+			return fmt.Errorf("failed to unmarshal store config: %w", err)
+		}
+	}
+
+	snapshotOpts := s.serverOptions.SnapshotOptions(cfg)
+	if storeCfg.Snapshot.RateLimit > 0 {
+		snapshotOpts.RateLimit = storeCfg.Snapshot.RateLimit
+	}
+	consensus.snapshotManager = snapshots.NewManager(snapshotStore, snapshotOpts, sc, ss, nil, s.logger)
+	if storeCfg.Snapshot.Metrics {
+		consensus.snapshotManager.SetMetrics(storemetrics.Metrics{Labels: telemetry.GlobalLabels})
+	}
 
 	s.Consensus = consensus
 
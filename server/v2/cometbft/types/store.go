@@ -28,6 +28,10 @@ type Store interface {
 	// Query is a key/value query directly to the underlying database. This skips the appmanager
 	Query(storeKey []byte, version uint64, key []byte, prove bool) (storev2.QueryResult, error)
 
+	// QueryRange is a query of at most limit key/value pairs of a [start, end) key range
+	// directly to the underlying database, a limit of 0 meaning no limit. This skips the appmanager
+	QueryRange(storeKey []byte, version uint64, start, end []byte, limit uint64, prove bool) (storev2.RangeQueryResult, error)
+
 	// LastCommitID returns a CommitID pertaining to the last commitment.
 	LastCommitID() (proof.CommitID, error)
 
@@ -0,0 +1,485 @@
+package stf
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+)
+
+// DeliverBlockParallel behaves like DeliverBlock, but executes the block transactions
+// optimistically in parallel using the given number of workers. If workers is zero or
+// negative, runtime.GOMAXPROCS(0) workers are used.
+//
+// Every tx is first executed speculatively on its own branch of the state as it was
+// before any tx of the block was run, while recording the keys and ranges it reads.
+// Then, in block order, the read set of each tx is checked against the keys written
+// by the txs preceding it: if they do not overlap the speculative writes are applied,
+// otherwise the tx is executed again on the up-to-date state. The results and the
+// resulting state are therefore identical to the ones produced by DeliverBlock.
+//
+// CONTRACT: the provided state must be safe for concurrent reads.
+func (s STF[T]) DeliverBlockParallel(
+	ctx context.Context,
+	block *server.BlockRequest[T],
+	state store.ReaderMap,
+	workers int,
+) (blockResult *server.BlockResponse, newState store.WriterMap, err error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return s.deliverBlock(ctx, block, state, func(
+		ctx context.Context,
+		state store.WriterMap,
+		txs []T,
+		hi header.Info,
+	) ([]server.TxResult, error) {
+		return s.deliverTxsParallel(ctx, state, txs, hi, workers)
+	})
+}
+
+// txExecution holds the outcome of the execution of a tx on a branch of the state.
+// The validation and the message execution of the tx are tracked separately: the
+// validation of every tx usually writes the same keys, like the balance of the fee
+// collector, so it is often run again on the up-to-date state, while the message
+// execution only needs to be run again if it read stale data.
+type txExecution struct {
+	gasLimit    uint64
+	gasLimitErr error
+
+	validateGas       uint64
+	validationEvents  []event.Event
+	validationErr     error
+	validationChanges []store.StateChanges
+	validationReads   *readSet
+
+	execResp    []transaction.Msg
+	execGas     uint64
+	execEvents  []event.Event
+	execErr     error
+	execChanges []store.StateChanges
+	execReads   *readSet
+
+	// err is an execution failure which is not a tx failure, the tx is then delivered serially.
+	err error
+}
+
+// result returns the tx result, built like deliverTx does.
+func (e *txExecution) result() server.TxResult {
+	if e.gasLimitErr != nil {
+		return server.TxResult{Error: e.gasLimitErr}
+	}
+	if e.validationErr != nil {
+		return server.TxResult{Error: e.validationErr}
+	}
+	return server.TxResult{
+		Events:    append(e.validationEvents, e.execEvents...),
+		GasUsed:   e.execGas + e.validateGas,
+		GasWanted: e.gasLimit,
+		Resp:      e.execResp,
+		Error:     e.execErr,
+	}
+}
+
+// deliverTxsParallel executes the txs concurrently and commits their state changes
+// to the provided state in block order, re-executing the parts of the txs which read
+// keys written by a previous tx of the block.
+func (s STF[T]) deliverTxsParallel(
+	ctx context.Context,
+	state store.WriterMap,
+	txs []T,
+	hi header.Info,
+	workers int,
+) ([]server.TxResult, error) {
+	if len(txs) <= 1 {
+		return s.deliverTxs(ctx, state, txs, hi)
+	}
+
+	// speculatively execute all the txs against the state before the txs.
+	snapshot := newSyncReaderMap(state)
+	executions := make([]*txExecution, len(txs))
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < min(workers, len(txs)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				executions[i] = s.speculateTx(ctx, snapshot, txs[i], hi)
+			}
+		}()
+	}
+	var err error
+	for i := range txs {
+		if err = isCtxCancelled(ctx); err != nil {
+			break
+		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	// validate and commit the executions in block order.
+	txResults := make([]server.TxResult, len(txs))
+	written := newWriteSet()
+	for i, execution := range executions {
+		if err = isCtxCancelled(ctx); err != nil {
+			return nil, err
+		}
+		txResults[i], err = s.commitTx(ctx, state, written, txs[i], hi, execution)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return txResults, nil
+}
+
+// speculateTx executes the tx on a branch of the provided state, tracking the reads
+// of its validation and of its message execution.
+func (s STF[T]) speculateTx(ctx context.Context, state store.ReaderMap, tx T, hi header.Info) *txExecution {
+	e := &txExecution{validationReads: newReadSet(), execReads: newReadSet()}
+	e.gasLimit, e.gasLimitErr = tx.GetGasLimit()
+	if e.gasLimitErr != nil {
+		return e
+	}
+
+	s.runValidation(ctx, trackedReaderMap{state: state, reads: e.validationReads}, tx, e)
+	if e.err != nil || e.validationErr != nil {
+		return e
+	}
+
+	// the message execution sees the state changes of the validation.
+	validatedState := s.branchFn(state)
+	if e.err = validatedState.ApplyStateChanges(e.validationChanges); e.err != nil {
+		return e
+	}
+	s.runExec(ctx, trackedReaderMap{state: validatedState, reads: e.execReads}, tx, hi, e)
+	return e
+}
+
+// commitTx applies the speculative execution of the tx to the provided state, running its
+// validation and its message execution again if they read keys written by the previous txs.
+func (s STF[T]) commitTx(
+	ctx context.Context,
+	state store.WriterMap,
+	written writeSet,
+	tx T,
+	hi header.Info,
+	e *txExecution,
+) (server.TxResult, error) {
+	if e.err != nil {
+		return s.deliverTxSerially(ctx, state, written, tx, hi)
+	}
+	if e.gasLimitErr != nil {
+		return e.result(), nil
+	}
+
+	validation := e
+	if e.validationReads.conflictsWith(written) {
+		validation = &txExecution{gasLimit: e.gasLimit}
+		s.runValidation(ctx, state, tx, validation)
+		if validation.err != nil {
+			return s.deliverTxSerially(ctx, state, written, tx, hi)
+		}
+	}
+	if validation.validationErr != nil {
+		return validation.result(), s.applyTxChanges(state, written, validation.validationChanges)
+	}
+
+	// the message execution has seen stale data if it read a key written by the previous txs,
+	// or written differently by the validation run again.
+	rerunExec := e.validationErr != nil || e.execReads.conflictsWith(written)
+	if !rerunExec && validation != e {
+		changed := newWriteSet()
+		changed.addDiff(e.validationChanges, validation.validationChanges)
+		rerunExec = validation.validateGas != e.validateGas || e.execReads.conflictsWith(changed)
+	}
+	if !rerunExec {
+		validation.execResp, validation.execGas, validation.execEvents, validation.execErr, validation.execChanges =
+			e.execResp, e.execGas, e.execEvents, e.execErr, e.execChanges
+	} else {
+		validatedState := s.branchFn(state)
+		if err := validatedState.ApplyStateChanges(validation.validationChanges); err != nil {
+			return server.TxResult{}, err
+		}
+		s.runExec(ctx, validatedState, tx, hi, validation)
+		if validation.err != nil {
+			return s.deliverTxSerially(ctx, state, written, tx, hi)
+		}
+	}
+
+	if err := s.applyTxChanges(state, written, validation.validationChanges); err != nil {
+		return server.TxResult{}, err
+	}
+	return validation.result(), s.applyTxChanges(state, written, validation.execChanges)
+}
+
+// runValidation validates the tx on a branch of the provided state, recording the outcome in e.
+func (s STF[T]) runValidation(ctx context.Context, state store.ReaderMap, tx T, e *txExecution) {
+	defer recoverExecution(e)
+	validationState := s.branchFn(state)
+	e.validateGas, e.validationEvents, e.validationErr = s.validateTx(ctx, validationState, e.gasLimit, tx, transaction.ExecModeFinalize)
+	if e.validationErr != nil {
+		return
+	}
+	e.validationChanges, e.err = validationState.GetStateChanges()
+}
+
+// runExec executes the tx messages on a branch of the provided state, recording the outcome in e.
+func (s STF[T]) runExec(ctx context.Context, state store.ReaderMap, tx T, hi header.Info, e *txExecution) {
+	defer recoverExecution(e)
+	execState := s.branchFn(state)
+	e.execResp, e.execGas, e.execEvents, e.execErr = s.execTx(ctx, execState, e.gasLimit-e.validateGas, tx, transaction.ExecModeFinalize, hi)
+	e.execChanges, e.err = execState.GetStateChanges()
+}
+
+// recoverExecution turns a panic during the execution of a tx into an execution failure,
+// the tx is then delivered serially which handles the panic like DeliverBlock does.
+func recoverExecution(e *txExecution) {
+	if r := recover(); r != nil {
+		e.err = fmt.Errorf("panic during transaction execution: %s", r)
+	}
+}
+
+// deliverTxSerially delivers the tx on the up-to-date state, like DeliverBlock does.
+func (s STF[T]) deliverTxSerially(
+	ctx context.Context,
+	state store.WriterMap,
+	written writeSet,
+	tx T,
+	hi header.Info,
+) (server.TxResult, error) {
+	txState := s.branchFn(state)
+	result := s.deliverTx(ctx, txState, tx, transaction.ExecModeFinalize, hi)
+	changes, err := txState.GetStateChanges()
+	if err != nil {
+		return server.TxResult{}, err
+	}
+	return result, s.applyTxChanges(state, written, changes)
+}
+
+// applyTxChanges applies the state changes of a tx to the provided state and records them
+// in the write set.
+func (s STF[T]) applyTxChanges(state store.WriterMap, written writeSet, changes []store.StateChanges) error {
+	if err := state.ApplyStateChanges(changes); err != nil {
+		return err
+	}
+	written.add(changes)
+	return nil
+}
+
+// keyRange is a [start, end) range of keys, a nil bound means unbounded.
+type keyRange struct {
+	start, end []byte
+}
+
+func (r keyRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) &&
+		(r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// readSet records the keys and key ranges read by a tx, grouped by actor.
+// It is not safe for concurrent use, as it belongs to a single tx execution.
+type readSet struct {
+	keys   map[string]map[string]struct{}
+	ranges map[string][]keyRange
+}
+
+func newReadSet() *readSet {
+	return &readSet{
+		keys:   make(map[string]map[string]struct{}),
+		ranges: make(map[string][]keyRange),
+	}
+}
+
+func (r *readSet) addKey(actor, key []byte) {
+	keys, ok := r.keys[string(actor)]
+	if !ok {
+		keys = make(map[string]struct{})
+		r.keys[string(actor)] = keys
+	}
+	keys[string(key)] = struct{}{}
+}
+
+func (r *readSet) addRange(actor, start, end []byte) {
+	r.ranges[string(actor)] = append(r.ranges[string(actor)], keyRange{
+		start: bytes.Clone(start),
+		end:   bytes.Clone(end),
+	})
+}
+
+// conflictsWith reports whether any of the read keys or ranges contains a key of the write set.
+func (r *readSet) conflictsWith(w writeSet) bool {
+	for actor, keys := range r.keys {
+		writtenKeys := w[actor]
+		for key := range keys {
+			if _, ok := writtenKeys[key]; ok {
+				return true
+			}
+		}
+	}
+	for actor, ranges := range r.ranges {
+		for key := range w[actor] {
+			for _, rng := range ranges {
+				if rng.contains([]byte(key)) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// writeSet records the keys written by the committed txs, grouped by actor.
+type writeSet map[string]map[string]struct{}
+
+func newWriteSet() writeSet {
+	return make(writeSet)
+}
+
+func (w writeSet) add(changes []store.StateChanges) {
+	for _, sc := range changes {
+		keys, ok := w[string(sc.Actor)]
+		if !ok {
+			keys = make(map[string]struct{}, len(sc.StateChanges))
+			w[string(sc.Actor)] = keys
+		}
+		for _, kv := range sc.StateChanges {
+			keys[string(kv.Key)] = struct{}{}
+		}
+	}
+}
+
+// addDiff records the keys whose state changes differ between a and b.
+func (w writeSet) addDiff(a, b []store.StateChanges) {
+	values := func(changes []store.StateChanges) map[string]store.KVPair {
+		kvs := make(map[string]store.KVPair)
+		for _, sc := range changes {
+			for _, kv := range sc.StateChanges {
+				kvs[string(sc.Actor)+"/"+string(kv.Key)] = kv
+			}
+		}
+		return kvs
+	}
+	aValues, bValues := values(a), values(b)
+	for _, changes := range [][]store.StateChanges{a, b} {
+		for _, sc := range changes {
+			for _, kv := range sc.StateChanges {
+				id := string(sc.Actor) + "/" + string(kv.Key)
+				aKV, inA := aValues[id]
+				bKV, inB := bValues[id]
+				if inA && inB && aKV.Remove == bKV.Remove && bytes.Equal(aKV.Value, bKV.Value) {
+					continue
+				}
+				keys, ok := w[string(sc.Actor)]
+				if !ok {
+					keys = make(map[string]struct{})
+					w[string(sc.Actor)] = keys
+				}
+				keys[string(kv.Key)] = struct{}{}
+			}
+		}
+	}
+}
+
+// trackedReaderMap is a store.ReaderMap which records every read in a readSet.
+type trackedReaderMap struct {
+	state store.ReaderMap
+	reads *readSet
+}
+
+func (t trackedReaderMap) GetReader(actor []byte) (store.Reader, error) {
+	reader, err := t.state.GetReader(actor)
+	if err != nil {
+		return nil, err
+	}
+	return trackedReader{actor: bytes.Clone(actor), reader: reader, reads: t.reads}, nil
+}
+
+type trackedReader struct {
+	actor  []byte
+	reader store.Reader
+	reads  *readSet
+}
+
+func (t trackedReader) Has(key []byte) (bool, error) {
+	t.reads.addKey(t.actor, key)
+	return t.reader.Has(key)
+}
+
+func (t trackedReader) Get(key []byte) ([]byte, error) {
+	t.reads.addKey(t.actor, key)
+	return t.reader.Get(key)
+}
+
+func (t trackedReader) Iterator(start, end []byte) (store.Iterator, error) {
+	t.reads.addRange(t.actor, start, end)
+	return t.reader.Iterator(start, end)
+}
+
+func (t trackedReader) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	t.reads.addRange(t.actor, start, end)
+	return t.reader.ReverseIterator(start, end)
+}
+
+// syncReaderMap makes a store.WriterMap, which is not safe for concurrent use,
+// safe for concurrent reads as long as nobody writes to it.
+// Getting a reader and creating iterators can mutate the internal state of the
+// branch, hence they are serialized, while point reads are allowed concurrently.
+type syncReaderMap struct {
+	mu    *sync.RWMutex
+	state store.WriterMap
+}
+
+func newSyncReaderMap(state store.WriterMap) syncReaderMap {
+	return syncReaderMap{mu: new(sync.RWMutex), state: state}
+}
+
+func (s syncReaderMap) GetReader(actor []byte) (store.Reader, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	reader, err := s.state.GetReader(actor)
+	if err != nil {
+		return nil, err
+	}
+	return syncReader{mu: s.mu, reader: reader}, nil
+}
+
+type syncReader struct {
+	mu     *sync.RWMutex
+	reader store.Reader
+}
+
+func (s syncReader) Has(key []byte) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.reader.Has(key)
+}
+
+func (s syncReader) Get(key []byte) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.reader.Get(key)
+}
+
+func (s syncReader) Iterator(start, end []byte) (store.Iterator, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reader.Iterator(start, end)
+}
+
+func (s syncReader) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reader.ReverseIterator(start, end)
+}
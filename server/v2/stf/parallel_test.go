@@ -0,0 +1,273 @@
+package stf
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	appmodulev2 "cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/server"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/server/v2/stf/branch"
+	"cosmossdk.io/server/v2/stf/gas"
+	"cosmossdk.io/server/v2/stf/mock"
+)
+
+var (
+	bankActor       = []byte("bank")
+	feeCollectorKey = []byte("fee_collector")
+)
+
+// newBankSTF returns an STF whose only message moves one token from the tx sender
+// to the account named in the message, and which keeps a per sender tx counter and
+// a counter of the fees collected from all the txs. The returned counter is incremented
+// on every message execution.
+func newBankSTF(t *testing.T) (*STF[mock.Tx], *atomic.Int64) {
+	t.Helper()
+	execs := &atomic.Int64{}
+	s := &STF[mock.Tx]{
+		doPreBlock:        func(ctx context.Context, txs []mock.Tx) error { return nil },
+		doBeginBlock:      func(ctx context.Context) error { return nil },
+		doEndBlock:        func(ctx context.Context) error { return nil },
+		doValidatorUpdate: func(ctx context.Context) ([]appmodulev2.ValidatorUpdate, error) { return nil, nil },
+		doTxValidation: func(ctx context.Context, tx mock.Tx) error {
+			state, err := ctx.(*executionContext).state.GetWriter(bankActor)
+			if err != nil {
+				return err
+			}
+			key := append([]byte("seq/"), tx.Sender...)
+			seq, err := getUint(state, key)
+			if err != nil {
+				return err
+			}
+			if err := setUint(state, key, seq+1); err != nil {
+				return err
+			}
+			fees, err := getUint(state, feeCollectorKey)
+			if err != nil {
+				return err
+			}
+			return setUint(state, feeCollectorKey, fees+1)
+		},
+		postTxExec:          func(ctx context.Context, tx mock.Tx, success bool) error { return nil },
+		branchFn:            branch.DefaultNewWriterMap,
+		makeGasMeter:        gas.DefaultGasMeter,
+		makeGasMeteredState: gas.DefaultWrapWithGasMeter,
+	}
+	addMsgHandlerToSTF(t, s, func(ctx context.Context, msg *gogotypes.StringValue) (*gogotypes.StringValue, error) {
+		execs.Add(1)
+		exCtx := ctx.(*executionContext)
+		state, err := exCtx.state.GetWriter(bankActor)
+		if err != nil {
+			return nil, err
+		}
+		from := append([]byte("balance/"), exCtx.sender...)
+		to := append([]byte("balance/"), msg.Value...)
+		fromBalance, err := getUint(state, from)
+		if err != nil {
+			return nil, err
+		}
+		if fromBalance == 0 {
+			return nil, errors.New("insufficient funds")
+		}
+		if err := setUint(state, from, fromBalance-1); err != nil {
+			return nil, err
+		}
+		toBalance, err := getUint(state, to)
+		if err != nil {
+			return nil, err
+		}
+		return &gogotypes.StringValue{}, setUint(state, to, toBalance+1)
+	})
+	return s, execs
+}
+
+func getUint(state store.Reader, key []byte) (uint64, error) {
+	bz, err := state.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func setUint(state store.Writer, key []byte, v uint64) error {
+	return state.Set(key, binary.BigEndian.AppendUint64(nil, v))
+}
+
+// stateRoot computes a deterministic hash of the changes held by the provided state.
+func stateRoot(t *testing.T, state store.WriterMap) []byte {
+	t.Helper()
+	changes, err := state.GetStateChanges()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return bytes.Compare(changes[i].Actor, changes[j].Actor) < 0
+	})
+	h := sha256.New()
+	for _, sc := range changes {
+		h.Write(sc.Actor)
+		for _, kv := range sc.StateChanges {
+			h.Write(kv.Key)
+			h.Write(kv.Value)
+			h.Write([]byte(fmt.Sprintf("%t", kv.Remove)))
+		}
+	}
+	return h.Sum(nil)
+}
+
+func bankTx(from, to string) mock.Tx {
+	return mock.Tx{
+		Sender:   []byte(from),
+		Msg:      &gogotypes.StringValue{Value: to},
+		GasLimit: 100_000,
+	}
+}
+
+// deliverBothWays delivers the txs both serially and in parallel, checks that the outcomes
+// are identical and returns the parallel block result.
+func deliverBothWays(t *testing.T, s *STF[mock.Tx], state store.ReaderMap, txs []mock.Tx, workers int) *server.BlockResponse {
+	t.Helper()
+	sum := sha256.Sum256([]byte("test-hash"))
+	block := &server.BlockRequest[mock.Tx]{
+		Height:  uint64(1),
+		Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+		AppHash: sum[:],
+		Hash:    sum[:],
+		Txs:     txs,
+	}
+
+	serialResult, serialState, err := s.DeliverBlock(context.Background(), block, state)
+	if err != nil {
+		t.Fatalf("DeliverBlock error: %v", err)
+	}
+	parallelResult, parallelState, err := s.DeliverBlockParallel(context.Background(), block, state, workers)
+	if err != nil {
+		t.Fatalf("DeliverBlockParallel error: %v", err)
+	}
+
+	if !reflect.DeepEqual(serialResult, parallelResult) {
+		t.Errorf("block results differ:\nserial:   %v\nparallel: %v", serialResult, parallelResult)
+	}
+	if serialRoot, parallelRoot := stateRoot(t, serialState), stateRoot(t, parallelState); !bytes.Equal(serialRoot, parallelRoot) {
+		t.Errorf("state roots differ: serial %X, parallel %X", serialRoot, parallelRoot)
+	}
+	return parallelResult
+}
+
+func TestDeliverBlockParallel(t *testing.T) {
+	s, execs := newBankSTF(t)
+
+	genesis := mock.DB()
+	fund := func(state store.WriterMap, accounts ...string) {
+		bank, err := state.GetWriter(bankActor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, acc := range accounts {
+			if err := setUint(bank, []byte("balance/"+acc), 1); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	t.Run("independent txs", func(t *testing.T) {
+		state := branch.DefaultNewWriterMap(genesis)
+		fund(state, "a", "b", "c", "d")
+		// the gas consumed by the validation must not change for the message execution to be kept,
+		// the fee collector value length is the same for all the txs if it already holds fees.
+		bank, err := state.GetWriter(bankActor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := setUint(bank, feeCollectorKey, 1); err != nil {
+			t.Fatal(err)
+		}
+		execs.Store(0)
+		deliverBothWays(t, s, state, []mock.Tx{
+			bankTx("a", "e"),
+			bankTx("b", "f"),
+			bankTx("c", "g"),
+			bankTx("d", "h"),
+		}, 4)
+		// the fees collected by every tx do not cause the messages to be executed again.
+		if n := execs.Load(); n != 8 {
+			t.Errorf("expected 4 serial and 4 parallel message executions, got %d", n)
+		}
+	})
+
+	t.Run("dependent txs are re-executed", func(t *testing.T) {
+		state := branch.DefaultNewWriterMap(genesis)
+		fund(state, "a")
+		// b and c can only pay once they received the funds from the previous tx.
+		txs := []mock.Tx{
+			bankTx("a", "b"),
+			bankTx("b", "c"),
+			bankTx("c", "d"),
+		}
+		result := deliverBothWays(t, s, state, txs, 3)
+		for i, txResult := range result.TxResults {
+			if txResult.Error != nil {
+				t.Errorf("tx %d failed: %v", i, txResult.Error)
+			}
+		}
+	})
+
+	t.Run("failing txs", func(t *testing.T) {
+		state := branch.DefaultNewWriterMap(genesis)
+		fund(state, "a")
+		txs := []mock.Tx{
+			bankTx("a", "b"),
+			bankTx("a", "c"), // insufficient funds
+			{Sender: []byte("e"), Msg: &gogotypes.StringValue{Value: "f"}, GasLimit: 0}, // out of gas
+		}
+		deliverBothWays(t, s, state, txs, 2)
+	})
+}
+
+// TestDeliverBlockParallelDeterminism delivers random blocks with a mix of conflicting and
+// independent txs, and checks that serial and parallel execution produce identical results.
+func TestDeliverBlockParallelDeterminism(t *testing.T) {
+	s, _ := newBankSTF(t)
+	seed := time.Now().UnixNano()
+	t.Logf("seed: %d", seed)
+	r := rand.New(rand.NewSource(seed))
+
+	accounts := make([]string, 50)
+	for i := range accounts {
+		accounts[i] = fmt.Sprintf("acc%d", i)
+	}
+
+	for round := 0; round < 20; round++ {
+		state := branch.DefaultNewWriterMap(mock.DB())
+		bank, err := state.GetWriter(bankActor)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, acc := range accounts {
+			if err := setUint(bank, []byte("balance/"+acc), uint64(r.Intn(3))); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		txs := make([]mock.Tx, 200)
+		for i := range txs {
+			txs[i] = bankTx(accounts[r.Intn(len(accounts))], accounts[r.Intn(len(accounts))])
+		}
+
+		t.Run(fmt.Sprintf("round %d", round), func(t *testing.T) {
+			deliverBothWays(t, s, state, txs, 1+r.Intn(8))
+		})
+	}
+}
@@ -82,6 +82,15 @@ func NewSTF[T transaction.Tx](
 	}, nil
 }
 
+// deliverTxsFn executes the transactions of a block on the provided state,
+// returning the results in block order.
+type deliverTxsFn[T transaction.Tx] func(
+	ctx context.Context,
+	state store.WriterMap,
+	txs []T,
+	hi header.Info,
+) ([]server.TxResult, error)
+
 // DeliverBlock is our state transition function.
 // It takes a read only view of the state to apply the block to,
 // executes the block and returns the block results and the new state.
@@ -89,6 +98,17 @@ func (s STF[T]) DeliverBlock(
 	ctx context.Context,
 	block *server.BlockRequest[T],
 	state store.ReaderMap,
+) (blockResult *server.BlockResponse, newState store.WriterMap, err error) {
+	return s.deliverBlock(ctx, block, state, s.deliverTxs)
+}
+
+// deliverBlock runs the block lifecycle, delegating the execution of the
+// block transactions to the provided deliverTxs function.
+func (s STF[T]) deliverBlock(
+	ctx context.Context,
+	block *server.BlockRequest[T],
+	state store.ReaderMap,
+	deliverTxs deliverTxsFn[T],
 ) (blockResult *server.BlockResponse, newState store.WriterMap, err error) {
 	// creates a new branchFn state, from the readonly view of the state
 	// that can be written to.
@@ -140,14 +160,10 @@ func (s STF[T]) DeliverBlock(
 	}
 
 	// execute txs
-	txResults := make([]server.TxResult, len(block.Txs))
 	// TODO: skip first tx if vote extensions are enabled (marko)
-	for i, txBytes := range block.Txs {
-		// check if we need to return early or continue delivering txs
-		if err = isCtxCancelled(ctx); err != nil {
-			return nil, nil, err
-		}
-		txResults[i] = s.deliverTx(exCtx, newState, txBytes, transaction.ExecModeFinalize, hi)
+	txResults, err := deliverTxs(exCtx, newState, block.Txs, hi)
+	if err != nil {
+		return nil, nil, err
 	}
 	// reset events
 	exCtx.events = make([]event.Event, 0)
@@ -166,6 +182,24 @@ func (s STF[T]) DeliverBlock(
 	}, newState, nil
 }
 
+// deliverTxs executes the provided txs one after the other on the provided state.
+func (s STF[T]) deliverTxs(
+	ctx context.Context,
+	state store.WriterMap,
+	txs []T,
+	hi header.Info,
+) ([]server.TxResult, error) {
+	txResults := make([]server.TxResult, len(txs))
+	for i, tx := range txs {
+		// check if we need to return early or continue delivering txs
+		if err := isCtxCancelled(ctx); err != nil {
+			return nil, err
+		}
+		txResults[i] = s.deliverTx(ctx, state, tx, transaction.ExecModeFinalize, hi)
+	}
+	return txResults, nil
+}
+
 // deliverTx executes a TX and returns the result.
 func (s STF[T]) deliverTx(
 	ctx context.Context,
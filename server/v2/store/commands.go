@@ -13,6 +13,7 @@ import (
 	serverv2 "cosmossdk.io/server/v2"
 	storev2 "cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/migration"
 	"cosmossdk.io/store/v2/root"
 )
 
@@ -79,6 +80,75 @@ Supported app-db-backend types include 'goleveldb', 'rocksdb', 'pebbledb'.`,
 	return cmd
 }
 
+// MigrateCmd returns the command to manage the migration of the state from store/v1 to store/v2.
+func (s *StoreComponent[T]) MigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage the migration of the application state from store/v1 to store/v2",
+	}
+	cmd.AddCommand(s.MigrateStatusCmd())
+
+	return cmd
+}
+
+// MigrateStatusCmd returns the command to print the progress of the store/v1 to store/v2 migration.
+func (s *StoreComponent[T]) MigrateStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Print the checkpointed progress of the store/v1 to store/v2 migration",
+		Long: `Print the checkpointed progress of the store/v1 to store/v2 migration.
+
+For every store key, it prints whether its commitment tree is imported and whether
+all its keys are written to the state storage, alongside the number of keys and bytes
+written and the last written key. The node must be stopped, as the application
+database is opened by the command.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vp := serverv2.GetViperFromCmd(cmd)
+			dbType := db.DBType(vp.GetString(FlagAppDBBackend))
+			if cmd.Flags().Changed(FlagAppDBBackend) {
+				dbStr, err := cmd.Flags().GetString(FlagAppDBBackend)
+				if err != nil {
+					return err
+				}
+				dbType = db.DBType(dbStr)
+			}
+
+			appDB, err := db.NewDB(dbType, "application", filepath.Join(vp.GetString(serverv2.FlagHome), "data"), nil)
+			if err != nil {
+				return err
+			}
+			defer appDB.Close()
+
+			status, err := migration.LoadStatus(appDB)
+			if err != nil {
+				return err
+			}
+			if status.Height == 0 {
+				cmd.Println("no migration in progress")
+				return nil
+			}
+
+			cmd.Println("migration height:", status.Height)
+			if status.MigratedVersion == 0 {
+				cmd.Println("migrated version: none, the state is being migrated")
+			} else {
+				cmd.Println("migrated version:", status.MigratedVersion)
+			}
+			for _, st := range status.Stores {
+				cmd.Printf("store: %s commitment-done: %t storage-done: %t keys: %d bytes: %d last-key: %X\n",
+					st.StoreKey, st.CommitmentDone, st.StorageDone, st.Keys, st.Bytes, st.LastKey)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application and snapshots databases")
+
+	return cmd
+}
+
 func createRootStore(cmd *cobra.Command, v *viper.Viper, logger log.Logger) (storev2.RootStore, uint64, error) {
 	tempViper := v
 	rootDir := v.GetString(serverv2.FlagHome)
@@ -8,10 +8,27 @@ func DefaultConfig() *Config {
 	return &Config{
 		AppDBBackend: "goleveldb",
 		Options:      root.DefaultStoreOptions(),
+		Snapshot:     DefaultSnapshotConfig(),
 	}
 }
 
 type Config struct {
-	AppDBBackend string       `mapstructure:"app-db-backend" toml:"app-db-backend" comment:"The type of database for application and snapshots databases."`
-	Options      root.Options `mapstructure:"options" toml:"options"`
+	AppDBBackend string         `mapstructure:"app-db-backend" toml:"app-db-backend" comment:"The type of database for application and snapshots databases."`
+	Options      root.Options   `mapstructure:"options" toml:"options"`
+	Snapshot     SnapshotConfig `mapstructure:"snapshot" toml:"snapshot"`
+}
+
+// DefaultSnapshotConfig returns the default snapshot config, the rate is not
+// limited and metrics are emitted.
+func DefaultSnapshotConfig() SnapshotConfig {
+	return SnapshotConfig{
+		RateLimit: 0,
+		Metrics:   true,
+	}
+}
+
+// SnapshotConfig defines the options of the state sync snapshots created by the node.
+type SnapshotConfig struct {
+	RateLimit uint64 `mapstructure:"rate-limit" toml:"rate-limit" comment:"Maximum number of bytes per second written to the snapshot chunks while creating a snapshot. 0 disables the limit."`
+	Metrics   bool   `mapstructure:"metrics" toml:"metrics" comment:"Emit the duration and the number of bytes written of the snapshots created by the node."`
 }
@@ -58,6 +58,7 @@ func (s *StoreComponent[T]) CLICommands() serverv2.CLIConfig {
 	return serverv2.CLIConfig{
 		Commands: []*cobra.Command{
 			s.PrunesCmd(),
+			s.MigrateCmd(),
 			s.ExportSnapshotCmd(),
 			s.DeleteSnapshotCmd(),
 			s.ListSnapshotsCmd(),
@@ -17,7 +17,9 @@ import (
 
 	"cosmossdk.io/log"
 	serverv2 "cosmossdk.io/server/v2"
+	"cosmossdk.io/server/v2/api/telemetry"
 	storev2 "cosmossdk.io/store/v2"
+	storemetrics "cosmossdk.io/store/v2/metrics"
 	"cosmossdk.io/store/v2/snapshots"
 	"cosmossdk.io/store/v2/snapshots/types"
 )
@@ -371,7 +373,20 @@ func createSnapshotsManager(cmd *cobra.Command, v *viper.Viper, logger log.Logge
 		}
 	}
 
-	sm := snapshots.NewManager(snapshotStore, snapshots.NewSnapshotOptions(interval, uint32(keepRecent)), store.GetStateCommitment().(snapshots.CommitSnapshotter), store.GetStateStorage().(snapshots.StorageSnapshotter), nil, logger)
+	snapshotCfg := DefaultSnapshotConfig()
+	if v.Sub("store.snapshot") != nil {
+		if err := v.Sub("store.snapshot").Unmarshal(&snapshotCfg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal snapshot config: %w", err)
+		}
+	}
+
+	snapshotOpts := snapshots.NewSnapshotOptions(interval, uint32(keepRecent))
+	snapshotOpts.RateLimit = snapshotCfg.RateLimit
+
+	sm := snapshots.NewManager(snapshotStore, snapshotOpts, store.GetStateCommitment().(snapshots.CommitSnapshotter), store.GetStateStorage().(snapshots.StorageSnapshotter), nil, logger)
+	if snapshotCfg.Metrics {
+		sm.SetMetrics(storemetrics.Metrics{Labels: telemetry.GlobalLabels})
+	}
 	return sm, nil
 }
 
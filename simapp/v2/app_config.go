@@ -87,6 +87,7 @@ var (
 		{Account: minttypes.ModuleName, Permissions: []string{authtypes.Minter}},
 		{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 		{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+		{Account: stakingtypes.ModuleName, Permissions: []string{authtypes.Minter}},
 		{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: nft.ModuleName},
 	}
@@ -183,6 +184,11 @@ var (
 						QueryGasLimit:      100_000,
 						SimulationGasLimit: 100_000,
 					},
+					// Set Enabled to true to execute the block transactions optimistically in parallel.
+					ParallelExecution: &runtimev2.ParallelExecutionConfig{
+						Enabled: false,
+						Workers: 0, // defaults to GOMAXPROCS
+					},
 				}),
 			},
 			{
@@ -232,6 +232,61 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreSnapshotRestoreDelta(t *testing.T) {
+	source := newMultiStoreWithMixedMountsAndBasicData(coretesting.NewMemDB())
+	target := newMultiStoreWithMixedMounts(coretesting.NewMemDB())
+	require.EqualValues(t, 3, source.LastCommitID().Version)
+
+	transfer := func(write func(*snapshots.StreamWriter) error, read func(*snapshots.StreamReader) error) {
+		chunks := make(chan io.ReadCloser, 100)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			defer streamWriter.Close()
+			require.NoError(t, write(streamWriter))
+		}()
+		streamReader, err := snapshots.NewStreamReader(chunks)
+		require.NoError(t, err)
+		require.NoError(t, read(streamReader))
+	}
+
+	// restore the base snapshot at height 1.
+	transfer(func(w *snapshots.StreamWriter) error {
+		return source.Snapshot(1, w)
+	}, func(r *snapshots.StreamReader) error {
+		_, err := target.Restore(1, snapshottypes.CurrentFormat, r)
+		return err
+	})
+	require.EqualValues(t, 1, target.LastCommitID().Version)
+
+	// a delta whose base does not match the target store is rejected.
+	transfer(func(w *snapshots.StreamWriter) error {
+		return source.SnapshotDelta(2, 3, w)
+	}, func(r *snapshots.StreamReader) error {
+		_, err := target.RestoreDelta(2, 3, r)
+		require.Error(t, err)
+		for err == nil || !errors.Is(err, io.EOF) {
+			err = r.ReadMsg(&snapshottypes.SnapshotItem{})
+		}
+		return nil
+	})
+
+	transfer(func(w *snapshots.StreamWriter) error {
+		return source.SnapshotDelta(1, 3, w)
+	}, func(r *snapshots.StreamReader) error {
+		_, err := target.RestoreDelta(1, 3, r)
+		return err
+	})
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for _, key := range source.StoreKeysByName() {
+		sourceStore := source.GetStoreByName(key.Name()).(types.CommitKVStore)
+		targetStore := target.GetStoreByName(key.Name()).(types.CommitKVStore)
+		if sourceStore.GetStoreType() == types.StoreTypeIAVL {
+			assertStoresEqual(t, sourceStore, targetStore, "store %q not equal", key.Name())
+		}
+	}
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Helper()
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
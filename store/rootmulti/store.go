@@ -1014,6 +1014,171 @@ loop:
 	return snapshotItem, rs.LoadLatestVersion()
 }
 
+// SnapshotDelta implements snapshottypes.DeltaSnapshotter. The changes committed after
+// baseHeight are written height by height, and for every height store by store in name
+// order: a SnapshotStoreItem followed by a SnapshotIAVLItem for every changed key. The
+// base height must not have been pruned.
+func (rs *Store) SnapshotDelta(baseHeight, height uint64, protoWriter protoio.Writer) error {
+	if baseHeight >= height {
+		return errorsmod.Wrapf(types.ErrLogic, "delta base height %v must be lower than height %v", baseHeight, height)
+	}
+	if height > uint64(GetLatestVersion(rs.db)) {
+		return errorsmod.Wrapf(types.ErrLogic, "cannot snapshot future height %v", height)
+	}
+
+	type namedStore struct {
+		*iavl.Store
+		name string
+	}
+	stores := []namedStore{}
+	for _, key := range keysFromStoreKeyMap(rs.stores) {
+		switch store := rs.GetCommitKVStore(key).(type) {
+		case *iavl.Store:
+			if !store.VersionExists(int64(baseHeight)) {
+				return errorsmod.Wrapf(types.ErrLogic, "store %q has no version at delta base height %v", key.Name(), baseHeight)
+			}
+			stores = append(stores, namedStore{name: key.Name(), Store: store})
+		case *transient.Store, *mem.Store:
+			// Non-persisted stores shouldn't be snapshotted
+			continue
+		default:
+			return errorsmod.Wrapf(types.ErrLogic,
+				"don't know how to snapshot store %q of type %T", key.Name(), store)
+		}
+	}
+
+	for h := int64(baseHeight) + 1; h <= int64(height); h++ {
+		for _, store := range stores {
+			err := store.TraverseStateChanges(h, h, func(version int64, changeSet *iavltree.ChangeSet) error {
+				if len(changeSet.Pairs) == 0 {
+					return nil
+				}
+				err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+					Item: &snapshottypes.SnapshotItem_Store{
+						Store: &snapshottypes.SnapshotStoreItem{
+							Name: store.name,
+						},
+					},
+				})
+				if err != nil {
+					return err
+				}
+				for _, pair := range changeSet.Pairs {
+					item := &snapshottypes.SnapshotIAVLItem{
+						Key:     pair.Key,
+						Value:   pair.Value,
+						Version: version,
+					}
+					if pair.Delete {
+						item.Value = nil
+						item.Height = snapshottypes.DeltaRemoveHeight
+					}
+					if err := protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+						Item: &snapshottypes.SnapshotItem_IAVL{
+							IAVL: item,
+						},
+					}); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				rs.logger.Error("delta snapshot failed", "store", store.name, "height", h, "err", err)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// RestoreDelta implements snapshottypes.DeltaSnapshotter. The store must be at the base
+// height, the changes of every height are applied in order and committed, so that the
+// resulting stores are identical to the ones of the snapshotted node.
+func (rs *Store) RestoreDelta(
+	baseHeight, height uint64, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	if latest := rs.LastCommitID().Version; latest != int64(baseHeight) {
+		return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic,
+			"latest height %v does not match the delta base height %v", latest, baseHeight)
+	}
+
+	var (
+		store         *iavl.Store
+		snapshotItem  snapshottypes.SnapshotItem
+		currentHeight = int64(baseHeight) + 1
+	)
+	// commitUpTo commits the pending changes at the current height, followed by the
+	// heights without any change up to the given one.
+	commitUpTo := func(target int64) error {
+		for ; currentHeight <= target; currentHeight++ {
+			if commitID := rs.Commit(); commitID.Version != currentHeight {
+				return errorsmod.Wrapf(types.ErrLogic, "committed height %v, expected %v", commitID.Version, currentHeight)
+			}
+		}
+		return nil
+	}
+
+loop:
+	for {
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, errorsmod.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			var ok bool
+			store, ok = rs.GetStoreByName(item.Store.Name).(*iavl.Store)
+			if !ok || store == nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "cannot apply changes to non-IAVL store %q", item.Store.Name)
+			}
+
+		case *snapshottypes.SnapshotItem_IAVL:
+			if store == nil {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrap(types.ErrLogic, "received change item before store item")
+			}
+			change := item.IAVL
+			if change.Version < currentHeight || change.Version > int64(height) {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "unexpected change height %v", change.Version)
+			}
+			if change.Height != 0 && change.Height != snapshottypes.DeltaRemoveHeight {
+				return snapshottypes.SnapshotItem{}, errorsmod.Wrapf(types.ErrLogic, "invalid change node height %v", change.Height)
+			}
+			// all the changes of the previous heights have been applied.
+			if err := commitUpTo(change.Version - 1); err != nil {
+				return snapshottypes.SnapshotItem{}, err
+			}
+
+			// Protobuf does not differentiate between []byte{} and nil, see Restore.
+			if change.Key == nil {
+				change.Key = []byte{}
+			}
+			if change.Height == snapshottypes.DeltaRemoveHeight {
+				store.Delete(change.Key)
+			} else {
+				if change.Value == nil {
+					change.Value = []byte{}
+				}
+				store.Set(change.Key, change.Value)
+			}
+
+		default:
+			break loop
+		}
+	}
+
+	if err := commitUpTo(int64(height)); err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	return snapshotItem, nil
+}
+
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
 	var db corestore.KVStoreWithBatch
 
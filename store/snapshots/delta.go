@@ -0,0 +1,249 @@
+package snapshots
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/snapshots/types"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// CreateDelta creates a delta snapshot at the given height, which only contains the
+// changes committed after the given base height, and returns its metadata. A full or
+// delta snapshot must exist at the base height.
+func (m *Manager) CreateDelta(baseHeight, height uint64) (*types.Snapshot, error) {
+	if m == nil {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "no snapshot store configured")
+	}
+	if baseHeight >= height {
+		return nil, errorsmod.Wrapf(storetypes.ErrLogic,
+			"delta base height %v must be lower than the snapshot height %v", baseHeight, height)
+	}
+	deltaSnapshotter, ok := m.multistore.(types.DeltaSnapshotter)
+	if !ok {
+		return nil, errorsmod.Wrap(storetypes.ErrLogic, "the multistore does not support delta snapshots")
+	}
+
+	err := m.begin(opSnapshot)
+	if err != nil {
+		return nil, err
+	}
+	defer m.end()
+
+	base, err := m.store.getChainable(baseHeight)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDeltaChain, "no snapshot exists at base height %v", baseHeight)
+	}
+
+	ch := make(chan io.ReadCloser)
+	go m.createDeltaSnapshot(deltaSnapshotter, baseHeight, height, ch)
+
+	return m.store.Save(height, types.DeltaFormat, ch)
+}
+
+// createDeltaSnapshot writes the delta header followed by the multistore changes, and
+// the extension payloads at the snapshot height to the channel.
+func (m *Manager) createDeltaSnapshot(deltaSnapshotter types.DeltaSnapshotter, baseHeight, height uint64, ch chan<- io.ReadCloser) {
+	streamWriter := NewStreamWriter(ch)
+	if streamWriter == nil {
+		return
+	}
+	defer func() {
+		if err := streamWriter.Close(); err != nil {
+			streamWriter.CloseWithError(err)
+		}
+	}()
+
+	if err := types.WriteDeltaHeader(streamWriter, baseHeight); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	if err := deltaSnapshotter.SnapshotDelta(baseHeight, height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+	if err := m.writeExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+}
+
+// DeltaChain returns the snapshots which must be restored in order to restore the
+// delta snapshot at the given height: a full snapshot, followed by the delta
+// snapshots up to the given height in height order.
+func (m *Manager) DeltaChain(height uint64) ([]*types.Snapshot, error) {
+	return m.store.DeltaChain(height)
+}
+
+// VerifyDeltaChain checks that the delta snapshot at the given height chains to a
+// full snapshot, and that the chunks of every snapshot of the chain match their hashes.
+func (m *Manager) VerifyDeltaChain(height uint64) ([]*types.Snapshot, error) {
+	return m.store.deltaChain(height, true)
+}
+
+// restoreLocalDeltaChain restores the full snapshot and the delta snapshots leading
+// to the delta snapshot at the given height, in order.
+func (m *Manager) restoreLocalDeltaChain(height uint64) error {
+	chain, err := m.store.DeltaChain(height)
+	if err != nil {
+		return err
+	}
+	for _, link := range chain {
+		snapshot, ch, err := m.store.Load(link.Height, link.Format)
+		if err != nil {
+			return err
+		}
+		if snapshot == nil {
+			return errorsmod.Wrapf(types.ErrInvalidDeltaChain, "snapshot at height %v format %v disappeared", link.Height, link.Format)
+		}
+		if err := m.doRestoreSnapshot(*snapshot, ch); err != nil {
+			return errorsmod.Wrapf(err, "failed to restore snapshot at height %v format %v", snapshot.Height, snapshot.Format)
+		}
+	}
+	return nil
+}
+
+// restoreDelta reads the delta header from the stream, and applies the multistore
+// changes on top of the state at the base height.
+func (m *Manager) restoreDelta(height uint64, streamReader *StreamReader) (types.SnapshotItem, error) {
+	deltaSnapshotter, ok := m.multistore.(types.DeltaSnapshotter)
+	if !ok {
+		return types.SnapshotItem{}, errorsmod.Wrap(storetypes.ErrLogic, "the multistore does not support delta snapshots")
+	}
+	baseHeight, err := types.ReadDeltaHeader(streamReader)
+	if err != nil {
+		return types.SnapshotItem{}, err
+	}
+	return deltaSnapshotter.RestoreDelta(baseHeight, height, streamReader)
+}
+
+// DeltaBase returns the base height of the delta snapshot at the given height.
+func (s *Store) DeltaBase(height uint64) (uint64, error) {
+	snapshot, chunks, err := s.Load(height, types.DeltaFormat)
+	if err != nil {
+		return 0, err
+	}
+	if snapshot == nil {
+		return 0, errorsmod.Wrapf(types.ErrInvalidDeltaChain, "no delta snapshot exists at height %v", height)
+	}
+	streamReader, err := NewStreamReader(chunks)
+	if err != nil {
+		DrainChunks(chunks)
+		return 0, err
+	}
+	defer streamReader.Close()
+
+	return types.ReadDeltaHeader(streamReader)
+}
+
+// DeltaChain returns the full snapshot and the delta snapshots, in height order, which
+// lead to the delta snapshot at the given height. When both a full and a delta snapshot
+// exist at a base height, the full snapshot ends the chain.
+func (s *Store) DeltaChain(height uint64) ([]*types.Snapshot, error) {
+	return s.deltaChain(height, false)
+}
+
+// deltaChain builds the chain leading to the delta snapshot at the given height. If
+// verify is true, the chunks of every snapshot are verified before being read.
+func (s *Store) deltaChain(height uint64, verify bool) ([]*types.Snapshot, error) {
+	snapshot, err := s.Get(height, types.DeltaFormat)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDeltaChain, "no delta snapshot exists at height %v", height)
+	}
+
+	chain := []*types.Snapshot{snapshot}
+	for {
+		if verify {
+			if err := s.Verify(snapshot.Height, snapshot.Format); err != nil {
+				return nil, err
+			}
+		}
+		if !types.IsDeltaFormat(snapshot.Format) {
+			break
+		}
+		baseHeight, err := s.DeltaBase(snapshot.Height)
+		if err != nil {
+			return nil, err
+		}
+		if baseHeight >= snapshot.Height {
+			return nil, errorsmod.Wrapf(types.ErrInvalidDeltaChain,
+				"delta snapshot at height %v has base height %v", snapshot.Height, baseHeight)
+		}
+		snapshot, err = s.getChainable(baseHeight)
+		if err != nil {
+			return nil, err
+		}
+		if snapshot == nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidDeltaChain, "no snapshot exists at base height %v", baseHeight)
+		}
+		chain = append(chain, snapshot)
+	}
+
+	// the chain was built from the top, restore order starts from the full snapshot.
+	for i, j := 0, len(chain)-1; i < j; i, j = i+1, j-1 {
+		chain[i], chain[j] = chain[j], chain[i]
+	}
+	return chain, nil
+}
+
+// getChainable returns the snapshot a delta snapshot can be chained on at the given
+// height, preferring a full snapshot over a delta one. It returns nil if none exists.
+func (s *Store) getChainable(height uint64) (*types.Snapshot, error) {
+	snapshot, err := s.Get(height, types.CurrentFormat)
+	if snapshot != nil || err != nil {
+		return snapshot, err
+	}
+	return s.Get(height, types.DeltaFormat)
+}
+
+// Verify checks that the chunks of the snapshot match the chunk hashes and the
+// snapshot hash recorded in its metadata.
+func (s *Store) Verify(height uint64, format uint32) error {
+	snapshot, err := s.Get(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return errorsmod.Wrapf(storetypes.ErrLogic, "snapshot doesn't exist, height: %d, format: %d", height, format)
+	}
+	if uint32(len(snapshot.Metadata.ChunkHashes)) != snapshot.Chunks {
+		return errorsmod.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk hashes, but %v chunks",
+			len(snapshot.Metadata.ChunkHashes), snapshot.Chunks)
+	}
+
+	snapshotHasher := sha256.New()
+	chunkHasher := sha256.New()
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		if err := func() error {
+			chunk, err := s.loadChunkFile(height, format, i)
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to load chunk %d", i)
+			}
+			defer chunk.Close()
+
+			chunkHasher.Reset()
+			if _, err := io.Copy(io.MultiWriter(chunkHasher, snapshotHasher), chunk); err != nil {
+				return errorsmod.Wrapf(err, "failed to read chunk %d", i)
+			}
+			if hash := chunkHasher.Sum(nil); !bytes.Equal(hash, snapshot.Metadata.ChunkHashes[i]) {
+				return errorsmod.Wrapf(types.ErrChunkHashMismatch, "chunk %d: expected %x, got %x",
+					i, snapshot.Metadata.ChunkHashes[i], hash)
+			}
+			return nil
+		}(); err != nil {
+			return err
+		}
+	}
+	if hash := snapshotHasher.Sum(nil); !bytes.Equal(hash, snapshot.Hash) {
+		return errorsmod.Wrapf(types.ErrChunkHashMismatch, "snapshot: expected %x, got %x", snapshot.Hash, hash)
+	}
+	return nil
+}
@@ -204,6 +204,15 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		streamWriter.CloseWithError(err)
 		return
 	}
+	if err := m.writeExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+}
+
+// writeExtensions writes the metadata and payloads of every extension at the given
+// height, in name order.
+func (m *Manager) writeExtensions(height uint64, streamWriter *StreamWriter) error {
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
@@ -216,22 +225,32 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 		payloadWriter := func(payload []byte) error {
 			return types.WriteExtensionPayload(streamWriter, payload)
 		}
 		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 	}
+	return nil
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
+// Delta snapshots are omitted, as they cannot be restored by a state syncing node.
 func (m *Manager) List() ([]*types.Snapshot, error) {
-	return m.store.List()
+	snapshots, err := m.store.List()
+	if err != nil {
+		return nil, err
+	}
+	full := snapshots[:0]
+	for _, snapshot := range snapshots {
+		if !types.IsDeltaFormat(snapshot.Format) {
+			full = append(full, snapshot)
+		}
+	}
+	return full, nil
 }
 
 // LoadChunk loads a chunk into a byte slice, mirroring ABCI LoadChunk. It can be called
@@ -362,7 +381,11 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return payload.Payload, nil
 	}
 
-	nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	if types.IsDeltaFormat(snapshot.Format) {
+		nextItem, err = m.restoreDelta(snapshot.Height, streamReader)
+	} else {
+		nextItem, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	}
 	if err != nil {
 		return errorsmod.Wrap(err, "multistore restore")
 	}
@@ -459,7 +482,8 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
-// RestoreLocalSnapshot restores app state from a local snapshot.
+// RestoreLocalSnapshot restores app state from a local snapshot. A delta snapshot is
+// restored along with the full and delta snapshots it is chained on.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	snapshot, ch, err := m.store.Load(height, format)
 	if err != nil {
@@ -475,10 +499,16 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 
 	err = m.beginLocked(opRestore)
 	if err != nil {
+		DrainChunks(ch)
 		return err
 	}
 	defer m.endLocked()
 
+	if types.IsDeltaFormat(format) {
+		// the delta snapshot is restored with the snapshots it is chained on.
+		DrainChunks(ch)
+		return m.restoreLocalDeltaChain(height)
+	}
 	return m.doRestoreSnapshot(*snapshot, ch)
 }
 
@@ -4,3 +4,13 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// DeltaFormat is the format used for delta snapshots, which only contain the changes
+// committed after the height of a base snapshot. It never matches CurrentFormat, so
+// delta snapshots are not restored by state sync peers, which expect full snapshots.
+const DeltaFormat uint32 = CurrentFormat | 1<<31
+
+// IsDeltaFormat returns true if the given format is the format of delta snapshots.
+func IsDeltaFormat(format uint32) bool {
+	return format == DeltaFormat
+}
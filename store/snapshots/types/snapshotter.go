@@ -26,6 +26,20 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// DeltaSnapshotter is a Snapshotter which can also create and restore delta snapshots,
+// which only contain the changes committed after a base height.
+type DeltaSnapshotter interface {
+	Snapshotter
+
+	// SnapshotDelta writes the changes committed after baseHeight up to height into
+	// the protobuf writer.
+	SnapshotDelta(baseHeight, height uint64, protoWriter protoio.Writer) error
+
+	// RestoreDelta applies the changes from the protobuf message stream on top of the
+	// state at baseHeight, committing every height up to the given one.
+	RestoreDelta(baseHeight, height uint64, protoReader protoio.Reader) (SnapshotItem, error)
+}
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
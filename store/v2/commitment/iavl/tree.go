@@ -10,6 +10,7 @@ import (
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/proof"
 )
 
 var (
@@ -68,6 +69,10 @@ func (t *IavlTree) Commit() ([]byte, uint64, error) {
 
 // GetProof returns a proof for the given key and version.
 func (t *IavlTree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof, error) {
+	// the immutable tree of a pruned version is empty, which would prove any key absent
+	if !t.tree.VersionExists(int64(version)) {
+		return nil, fmt.Errorf("version %d does not exist", version)
+	}
 	immutableTree, err := t.tree.GetImmutable(int64(version))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get immutable tree at version %d: %w", version, err)
@@ -76,6 +81,89 @@ func (t *IavlTree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof,
 	return immutableTree.GetProof(key)
 }
 
+// GetRangeProof returns a proof of the content of the [start, end) key range at the
+// given version, see proof.RangeProof. If limit is not 0 and the range holds more
+// pairs, the end of the proven range is the key following the first limit pairs.
+func (t *IavlTree) GetRangeProof(version uint64, start, end []byte, limit uint64) (*proof.RangeProof, error) {
+	immutableTree, err := t.tree.GetImmutable(int64(version))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get immutable tree at version %d: %w", version, err)
+	}
+
+	existenceProof := func(key []byte) (*ics23.ExistenceProof, error) {
+		p, err := immutableTree.GetProof(key)
+		if err != nil {
+			return nil, err
+		}
+		exist := p.GetExist()
+		if exist == nil {
+			return nil, fmt.Errorf("no existence proof for key %X", key)
+		}
+		return exist, nil
+	}
+	// firstKey returns the first key of the iteration, or nil if it is empty.
+	firstKey := func(start, end []byte, ascending bool) ([]byte, error) {
+		itr, err := immutableTree.Iterator(start, end, ascending)
+		if err != nil {
+			return nil, err
+		}
+		defer itr.Close()
+		if !itr.Valid() {
+			return nil, itr.Error()
+		}
+		return itr.Key(), nil
+	}
+
+	rangeProof := &proof.RangeProof{Start: start, End: end}
+	if start != nil {
+		left, err := firstKey(nil, start, false)
+		if err != nil {
+			return nil, err
+		}
+		if left != nil {
+			if rangeProof.Left, err = existenceProof(left); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	itr, err := immutableTree.Iterator(start, end, true)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		entry, err := existenceProof(itr.Key())
+		if err != nil {
+			return nil, err
+		}
+		if limit > 0 && uint64(len(rangeProof.Entries)) == limit {
+			// the range is cut before this key, which is the right boundary
+			rangeProof.End = entry.Key
+			rangeProof.Right = entry
+			return rangeProof, nil
+		}
+		rangeProof.Entries = append(rangeProof.Entries, *entry)
+	}
+	if err := itr.Error(); err != nil {
+		return nil, err
+	}
+
+	if end != nil {
+		right, err := firstKey(end, nil, true)
+		if err != nil {
+			return nil, err
+		}
+		if right != nil {
+			if rangeProof.Right, err = existenceProof(right); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return rangeProof, nil
+}
+
 func (t *IavlTree) Get(version uint64, key []byte) ([]byte, error) {
 	immutableTree, err := t.tree.GetImmutable(int64(version))
 	if err != nil {
@@ -139,6 +227,22 @@ func (t *IavlTree) Import(version uint64) (commitment.Importer, error) {
 	}, nil
 }
 
+// TraverseStateChanges calls fn with the changes committed at every version from
+// startVersion to endVersion (inclusive). The version preceding startVersion must
+// exist, as the changes are computed against it.
+func (t *IavlTree) TraverseStateChanges(startVersion, endVersion uint64, fn func(version uint64, changes []corestore.KVPair) error) error {
+	if startVersion > 1 && !t.tree.VersionExists(int64(startVersion-1)) {
+		return fmt.Errorf("version %d does not exist", startVersion-1)
+	}
+	return t.tree.TraverseStateChanges(int64(startVersion), int64(endVersion), func(version int64, changeSet *iavl.ChangeSet) error {
+		changes := make([]corestore.KVPair, len(changeSet.Pairs))
+		for i, pair := range changeSet.Pairs {
+			changes[i] = corestore.KVPair{Key: pair.Key, Value: pair.Value, Remove: pair.Delete}
+		}
+		return fn(uint64(version), changes)
+	})
+}
+
 // Close closes the iavl tree.
 func (t *IavlTree) Close() error {
 	return t.tree.Close()
@@ -1,9 +1,11 @@
 package iavl
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
+	ics23 "github.com/cosmos/ics23/go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -12,6 +14,7 @@ import (
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/store/v2/commitment"
 	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/proof"
 )
 
 func TestCommitterSuite(t *testing.T) {
@@ -133,3 +136,122 @@ func TestIavlTree(t *testing.T) {
 	// close the db
 	require.NoError(t, tree.Close())
 }
+
+func TestIavlTree_RangeProof(t *testing.T) {
+	tree := generateTree()
+	for i := 0; i < 20; i++ {
+		require.NoError(t, tree.Set([]byte(fmt.Sprintf("key%02d", i)), []byte(fmt.Sprintf("value%02d", i))))
+	}
+	require.NoError(t, tree.Remove([]byte("key13")))
+	hash, version, err := tree.Commit()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		start, end []byte
+		keys       []string
+	}{
+		{"whole tree", nil, nil, nil},
+		{"prefix", []byte("key1"), []byte("key2"), []string{"key10", "key11", "key12", "key14", "key15", "key16", "key17", "key18", "key19"}},
+		{"middle range", []byte("key05"), []byte("key08"), []string{"key05", "key06", "key07"}},
+		{"removed key", []byte("key13"), []byte("key14"), []string{}},
+		{"empty range", []byte("key055"), []byte("key06"), []string{}},
+		{"before all keys", nil, []byte("key"), []string{}},
+		{"after all keys", []byte("key99"), nil, []string{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rangeProof, err := tree.GetRangeProof(version, tc.start, tc.end, 0)
+			require.NoError(t, err)
+			require.NoError(t, rangeProof.Verify(ics23.IavlSpec, hash))
+
+			keys, values := rangeProof.Pairs()
+			if tc.keys == nil {
+				require.Len(t, keys, 19)
+			} else {
+				require.Len(t, keys, len(tc.keys))
+				for i, key := range tc.keys {
+					require.Equal(t, key, string(keys[i]))
+					require.Equal(t, "value"+key[3:], string(values[i]))
+				}
+			}
+
+			// the proof survives the encoding
+			bz, err := rangeProof.Marshal()
+			require.NoError(t, err)
+			decoded := &proof.RangeProof{}
+			require.NoError(t, decoded.Unmarshal(bz))
+			require.NoError(t, decoded.Verify(ics23.IavlSpec, hash))
+
+			// omitting a key of the range or a boundary is detected
+			if len(keys) > 0 {
+				tampered := *rangeProof
+				tampered.Entries = append([]ics23.ExistenceProof{}, rangeProof.Entries[1:]...)
+				require.Error(t, tampered.Verify(ics23.IavlSpec, hash))
+			}
+			if len(keys) > 2 {
+				tampered := *rangeProof
+				tampered.Entries = append([]ics23.ExistenceProof{rangeProof.Entries[0]}, rangeProof.Entries[2:]...)
+				require.Error(t, tampered.Verify(ics23.IavlSpec, hash))
+			}
+			if rangeProof.Left != nil {
+				tampered := *rangeProof
+				tampered.Left = nil
+				require.Error(t, tampered.Verify(ics23.IavlSpec, hash))
+			}
+			if rangeProof.Right != nil {
+				tampered := *rangeProof
+				tampered.Right = nil
+				require.Error(t, tampered.Verify(ics23.IavlSpec, hash))
+			}
+			require.Error(t, rangeProof.Verify(ics23.IavlSpec, []byte("invalid root")))
+		})
+	}
+}
+
+func TestIavlTree_RangeProofLimit(t *testing.T) {
+	tree := generateTree()
+	for i := 0; i < 20; i++ {
+		require.NoError(t, tree.Set([]byte(fmt.Sprintf("key%02d", i)), []byte(fmt.Sprintf("value%02d", i))))
+	}
+	hash, version, err := tree.Commit()
+	require.NoError(t, err)
+
+	// the range is paginated by starting each page at the end of the previous one
+	var (
+		start = []byte("key05")
+		keys  []string
+	)
+	for {
+		rangeProof, err := tree.GetRangeProof(version, start, []byte("key15"), 4)
+		require.NoError(t, err)
+		require.NoError(t, rangeProof.Verify(ics23.IavlSpec, hash))
+		pageKeys, _ := rangeProof.Pairs()
+		require.LessOrEqual(t, len(pageKeys), 4)
+		for _, key := range pageKeys {
+			keys = append(keys, string(key))
+		}
+		if string(rangeProof.End) == "key15" {
+			break
+		}
+		start = rangeProof.End
+	}
+	require.Equal(t, []string{"key05", "key06", "key07", "key08", "key09", "key10", "key11", "key12", "key13", "key14"}, keys)
+}
+
+func TestIavlTree_RangeProofEmptyTree(t *testing.T) {
+	tree := generateTree()
+	hash, version, err := tree.Commit()
+	require.NoError(t, err)
+
+	rangeProof, err := tree.GetRangeProof(version, []byte("key1"), []byte("key2"), 0)
+	require.NoError(t, err)
+	keys, _ := rangeProof.Pairs()
+	require.Empty(t, keys)
+
+	root, err := rangeProof.Calculate()
+	require.NoError(t, err)
+	require.Equal(t, hash, root)
+	require.NoError(t, rangeProof.Verify(ics23.IavlSpec, hash))
+	require.Error(t, rangeProof.Verify(ics23.IavlSpec, []byte("invalid root")))
+}
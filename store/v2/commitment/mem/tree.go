@@ -1,10 +1,15 @@
 package mem
 
 import (
+	"errors"
+	"fmt"
+
 	ics23 "github.com/cosmos/ics23/go"
 
+	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/proof"
 )
 
 var _ commitment.Tree = (*Tree)(nil)
@@ -46,6 +51,10 @@ func (t *Tree) GetProof(version uint64, key []byte) (*ics23.CommitmentProof, err
 	return nil, nil
 }
 
+func (t *Tree) GetRangeProof(version uint64, start, end []byte, limit uint64) (*proof.RangeProof, error) {
+	return nil, nil
+}
+
 func (t *Tree) Get(version uint64, key []byte) ([]byte, error) {
 	return t.MemDB.Get(key)
 }
@@ -62,6 +71,12 @@ func (t *Tree) Import(version uint64) (commitment.Importer, error) {
 	return nil, nil
 }
 
+// TraverseStateChanges is not supported, the in-memory tree does not keep the
+// changes committed at past versions.
+func (t *Tree) TraverseStateChanges(startVersion, endVersion uint64, fn func(version uint64, changes []corestore.KVPair) error) error {
+	return fmt.Errorf("traversing the state changes of an in-memory tree: %w", errors.ErrUnsupported)
+}
+
 func New() *Tree {
 	return &Tree{MemDB: db.NewMemDB()}
 }
@@ -2,8 +2,11 @@ package commitment
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
+	ics23 "github.com/cosmos/ics23/go"
+
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2/internal/encoding"
 	"cosmossdk.io/store/v2/proof"
@@ -13,6 +16,8 @@ const (
 	commitInfoKeyFmt      = "c/%d" // c/<version>
 	latestVersionKey      = "c/latest"
 	removedStoreKeyPrefix = "c/removed/" // c/removed/<version>/<store-name>
+	writtenKeyPrefix      = "c/written/" // c/written/<version>/<store-name-len><store-name><key>
+	historicalProofPrefix = "c/proof/"   // c/proof/<version>/<store-name-len><store-name><key>
 )
 
 // MetadataStore is a store for metadata related to the commitment store.
@@ -178,3 +183,144 @@ func (m *MetadataStore) deleteCommitInfo(version uint64) error {
 	cInfoKey := []byte(fmt.Sprintf(commitInfoKeyFmt, version))
 	return m.kv.Delete(cInfoKey)
 }
+
+func buildVersionedKey(prefix string, version uint64, storeKey, key []byte) []byte {
+	var buf bytes.Buffer
+	versionPrefix := encoding.BuildPrefixWithVersion(prefix, version)
+	buf.Grow(len(versionPrefix) + encoding.EncodeBytesSize(storeKey) + len(key))
+	buf.Write(versionPrefix)
+	_ = encoding.EncodeBytes(&buf, storeKey) // writing to a bytes.Buffer never fails
+	buf.Write(key)
+	return buf.Bytes()
+}
+
+func parseVersionedKey(prefix string, bz []byte) (version uint64, storeKey, key []byte, err error) {
+	versionPrefixLen := len(encoding.BuildPrefixWithVersion(prefix, 0))
+	if len(bz) < versionPrefixLen {
+		return 0, nil, nil, fmt.Errorf("invalid key %X with prefix %s", bz, prefix)
+	}
+	version = binary.BigEndian.Uint64(bz[len(prefix):])
+	storeKey, n, err := encoding.DecodeBytes(bz[versionPrefixLen:])
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return version, storeKey, bz[versionPrefixLen+n:], nil
+}
+
+// flushWrittenKeys persists the keys written at the given version per store key,
+// so that their proofs can be built before the version is pruned.
+func (m *MetadataStore) flushWrittenKeys(version uint64, writtenKeys map[string]map[string]struct{}) (err error) {
+	batch := m.kv.NewBatch()
+	defer func() {
+		cErr := batch.Close()
+		if err == nil {
+			err = cErr
+		}
+	}()
+
+	for storeKey, keys := range writtenKeys {
+		for key := range keys {
+			if err := batch.Set(buildVersionedKey(writtenKeyPrefix, version, []byte(storeKey), []byte(key)), []byte{}); err != nil {
+				return err
+			}
+		}
+	}
+	return batch.Write()
+}
+
+// iterateWrittenKeys calls fn with the keys written at the versions of the
+// [fromVersion, toVersion] range, in version order.
+func (m *MetadataStore) iterateWrittenKeys(fromVersion, toVersion uint64, fn func(version uint64, storeKey, key []byte) error) (err error) {
+	start := encoding.BuildPrefixWithVersion(writtenKeyPrefix, fromVersion)
+	end := encoding.BuildPrefixWithVersion(writtenKeyPrefix, toVersion+1)
+	iter, err := m.kv.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if ierr := iter.Close(); ierr != nil && err == nil {
+			err = ierr
+		}
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		version, storeKey, key, err := parseVersionedKey(writtenKeyPrefix, iter.Key())
+		if err != nil {
+			return err
+		}
+		if err := fn(version, storeKey, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetHistoricalProof returns the retained proof of the given key in the tree of
+// the given store at the given version, or nil if no proof was retained.
+func (m *MetadataStore) GetHistoricalProof(version uint64, storeKey, key []byte) (*ics23.CommitmentProof, error) {
+	value, err := m.kv.Get(buildVersionedKey(historicalProofPrefix, version, storeKey, key))
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, nil
+	}
+
+	p := &ics23.CommitmentProof{}
+	if err := p.Unmarshal(value); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// flushHistoricalProofs persists the given tree proofs of the given version.
+func (m *MetadataStore) flushHistoricalProofs(version uint64, proofs []historicalProof) (err error) {
+	batch := m.kv.NewBatch()
+	defer func() {
+		cErr := batch.Close()
+		if err == nil {
+			err = cErr
+		}
+	}()
+
+	for _, p := range proofs {
+		value, err := p.proof.Marshal()
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(buildVersionedKey(historicalProofPrefix, version, p.storeKey, p.key), value); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// deleteVersionRange deletes the entries of the given prefix of the versions in
+// the [fromVersion, toVersion] range.
+func (m *MetadataStore) deleteVersionRange(prefix string, fromVersion, toVersion uint64) (err error) {
+	start := encoding.BuildPrefixWithVersion(prefix, fromVersion)
+	end := encoding.BuildPrefixWithVersion(prefix, toVersion+1)
+	iter, err := m.kv.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if ierr := iter.Close(); ierr != nil && err == nil {
+			err = ierr
+		}
+	}()
+
+	batch := m.kv.NewBatch()
+	defer func() {
+		if berr := batch.Close(); berr != nil && err == nil {
+			err = berr
+		}
+	}()
+	for ; iter.Valid(); iter.Next() {
+		if err := batch.Delete(iter.Key()); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
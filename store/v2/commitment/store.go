@@ -7,8 +7,10 @@ import (
 	"maps"
 	"math"
 	"slices"
+	"sync"
 
 	protoio "github.com/cosmos/gogoproto/io"
+	ics23 "github.com/cosmos/ics23/go"
 
 	corelog "cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
@@ -21,10 +23,11 @@ import (
 )
 
 var (
-	_ store.Committer             = (*CommitStore)(nil)
-	_ store.UpgradeableStore      = (*CommitStore)(nil)
-	_ snapshots.CommitSnapshotter = (*CommitStore)(nil)
-	_ store.PausablePruner        = (*CommitStore)(nil)
+	_ store.Committer                  = (*CommitStore)(nil)
+	_ store.UpgradeableStore           = (*CommitStore)(nil)
+	_ snapshots.CommitSnapshotter      = (*CommitStore)(nil)
+	_ snapshots.CommitDeltaSnapshotter = (*CommitStore)(nil)
+	_ store.PausablePruner             = (*CommitStore)(nil)
 )
 
 // MountTreeFn is a function that mounts a tree given a store key.
@@ -43,18 +46,42 @@ type CommitStore struct {
 	// oldTrees is a map of store keys to old trees that have been deleted or renamed.
 	// It is used to get the proof for the old store keys.
 	oldTrees map[string]Tree
+
+	// proofRetention is the number of recent versions for which the proofs of the
+	// keys written at that version are retained once the trees are pruned.
+	proofRetention uint64
+	// writtenKeys holds the keys written since the last commit per store key,
+	// it is only tracked when proofs are retained.
+	writtenKeys map[string]map[string]struct{}
+
+	// snapshotVersions counts the snapshots in progress per version. The versions
+	// being snapshotted are not pruned until their snapshots are done, so snapshots
+	// can be created from them in the background while new versions are committed.
+	snapshotMtx      sync.Mutex
+	snapshotVersions map[uint64]int
 }
 
 // NewCommitStore creates a new CommitStore instance.
 func NewCommitStore(trees, oldTrees map[string]Tree, db corestore.KVStoreWithBatch, logger corelog.Logger) (*CommitStore, error) {
 	return &CommitStore{
-		logger:     logger,
-		multiTrees: trees,
-		oldTrees:   oldTrees,
-		metadata:   NewMetadataStore(db),
+		logger:           logger,
+		multiTrees:       trees,
+		oldTrees:         oldTrees,
+		metadata:         NewMetadataStore(db),
+		snapshotVersions: make(map[uint64]int),
 	}, nil
 }
 
+// SetProofRetention sets the number of recent versions for which the proofs of
+// the keys written at that version are retained, independently of the pruning
+// options. Before a version is pruned from the trees, the proofs of its written
+// keys are persisted alongside its commit info, and GetProof serves them once
+// the trees no longer hold the version. A retention of 0 disables it.
+func (c *CommitStore) SetProofRetention(versions uint64) {
+	c.proofRetention = versions
+	c.writtenKeys = make(map[string]map[string]struct{})
+}
+
 func (c *CommitStore) WriteChangeset(cs *corestore.Changeset) error {
 	for _, pairs := range cs.Changes {
 		key := conv.UnsafeBytesToStr(pairs.Actor)
@@ -63,6 +90,9 @@ func (c *CommitStore) WriteChangeset(cs *corestore.Changeset) error {
 		if !ok {
 			return fmt.Errorf("store key %s not found in multiTrees", key)
 		}
+		if c.proofRetention > 0 && !internal.IsMemoryStoreKey(key) {
+			c.trackWrittenKeys(key, pairs.StateChanges)
+		}
 		for _, kv := range pairs.StateChanges {
 			if kv.Remove {
 				if err := tree.Remove(kv.Key); err != nil {
@@ -168,6 +198,14 @@ func (c *CommitStore) loadVersion(targetVersion uint64, storeKeys []string) erro
 		if err := c.metadata.setLatestVersion(targetVersion); err != nil {
 			return err
 		}
+		if c.proofRetention > 0 {
+			if err := c.metadata.deleteVersionRange(writtenKeyPrefix, targetVersion+1, latestVersion); err != nil {
+				return err
+			}
+			if err := c.metadata.deleteVersionRange(historicalProofPrefix, targetVersion+1, latestVersion); err != nil {
+				return err
+			}
+		}
 	}
 
 	for _, storeKey := range storeKeys {
@@ -232,9 +270,27 @@ func (c *CommitStore) Commit(version uint64) (*proof.CommitInfo, error) {
 		return nil, err
 	}
 
+	if c.proofRetention > 0 {
+		if err := c.metadata.flushWrittenKeys(version, c.writtenKeys); err != nil {
+			return nil, err
+		}
+		c.writtenKeys = make(map[string]map[string]struct{})
+	}
+
 	return cInfo, nil
 }
 
+func (c *CommitStore) trackWrittenKeys(storeKey string, pairs []corestore.KVPair) {
+	keys, ok := c.writtenKeys[storeKey]
+	if !ok {
+		keys = make(map[string]struct{}, len(pairs))
+		c.writtenKeys[storeKey] = keys
+	}
+	for _, kv := range pairs {
+		keys[string(kv.Key)] = struct{}{}
+	}
+}
+
 func (c *CommitStore) SetInitialVersion(version uint64) error {
 	for _, tree := range c.multiTrees {
 		if err := tree.SetInitialVersion(version); err != nil {
@@ -257,7 +313,12 @@ func (c *CommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]p
 
 	iProof, err := tree.GetProof(version, key)
 	if err != nil {
-		return nil, err
+		// the version may have been pruned from the tree, with the proof retained
+		retained, rErr := c.getRetainedProof(storeKey, version, key)
+		if rErr != nil || retained == nil {
+			return nil, err
+		}
+		iProof = retained
 	}
 	cInfo, err := c.metadata.GetCommitInfo(version)
 	if err != nil {
@@ -275,6 +336,41 @@ func (c *CommitStore) GetProof(storeKey []byte, version uint64, key []byte) ([]p
 	return []proof.CommitmentOp{commitOp, *storeCommitmentOp}, nil
 }
 
+// GetRangeProof returns the proof of the content of the [start, end) key range of
+// the given store at the given version, alongside the proof of the store hash in the
+// commit info of the version. If limit is not 0, at most limit pairs are proven.
+func (c *CommitStore) GetRangeProof(storeKey []byte, version uint64, start, end []byte, limit uint64) (proof.RangeOp, proof.CommitmentOp, error) {
+	rawStoreKey := conv.UnsafeBytesToStr(storeKey)
+	tree, ok := c.multiTrees[rawStoreKey]
+	if !ok {
+		tree, ok = c.oldTrees[rawStoreKey]
+		if !ok {
+			return proof.RangeOp{}, proof.CommitmentOp{}, fmt.Errorf("store %s not found", rawStoreKey)
+		}
+	}
+
+	rangeProof, err := tree.GetRangeProof(version, start, end, limit)
+	if err != nil {
+		return proof.RangeOp{}, proof.CommitmentOp{}, err
+	}
+	if rangeProof == nil {
+		return proof.RangeOp{}, proof.CommitmentOp{}, fmt.Errorf("store %s does not support range proofs", rawStoreKey)
+	}
+	cInfo, err := c.metadata.GetCommitInfo(version)
+	if err != nil {
+		return proof.RangeOp{}, proof.CommitmentOp{}, err
+	}
+	if cInfo == nil {
+		return proof.RangeOp{}, proof.CommitmentOp{}, fmt.Errorf("commit info not found for version %d", version)
+	}
+	_, storeCommitmentOp, err := cInfo.GetStoreProof(storeKey)
+	if err != nil {
+		return proof.RangeOp{}, proof.CommitmentOp{}, err
+	}
+
+	return proof.NewIAVLRangeOp(rangeProof), *storeCommitmentOp, nil
+}
+
 func (c *CommitStore) Get(storeKey []byte, version uint64, key []byte) ([]byte, error) {
 	tree, ok := c.multiTrees[conv.UnsafeBytesToStr(storeKey)]
 	if !ok {
@@ -290,9 +386,26 @@ func (c *CommitStore) Get(storeKey []byte, version uint64, key []byte) ([]byte,
 }
 
 // Prune implements store.Pruner.
+//
+// NOTE: The versions which are being snapshotted are not pruned, they will be
+// pruned by a subsequent call once their snapshots are done.
 func (c *CommitStore) Prune(version uint64) error {
+	version = c.prunableVersion(version)
+	if version == 0 {
+		return nil
+	}
+
+	// the commit info of the versions whose proofs are retained is kept
+	cInfoVersion := version
+	if c.proofRetention > 0 {
+		var err error
+		if cInfoVersion, err = c.retainProofs(version); err != nil {
+			return err
+		}
+	}
+
 	// prune the metadata
-	for v := version; v > 0; v-- {
+	for v := cInfoVersion; v > 0; v-- {
 		if err := c.metadata.deleteCommitInfo(v); err != nil {
 			return err
 		}
@@ -322,6 +435,101 @@ func (c *CommitStore) pruneRemovedStoreKeys(version uint64) error {
 	return c.metadata.deleteRemovedStoreKeys(version, clearKVStore)
 }
 
+// historicalProof is the proof of a key in the tree of a store at a version
+// which is retained once the version is pruned from the tree.
+type historicalProof struct {
+	storeKey, key []byte
+	proof         *ics23.CommitmentProof
+}
+
+// retainProofs persists the proofs of the keys written at the versions up to the
+// given one which are about to be pruned from the trees and are within the proof
+// retention, and deletes the written keys and the retained proofs of the older
+// versions. It returns the highest version whose commit info is no longer needed.
+func (c *CommitStore) retainProofs(version uint64) (uint64, error) {
+	latestVersion, err := c.metadata.GetLatestVersion()
+	if err != nil {
+		return 0, err
+	}
+	retainFrom := uint64(1)
+	if latestVersion > c.proofRetention {
+		retainFrom = latestVersion - c.proofRetention + 1
+	}
+
+	if retainFrom <= version {
+		proofs := make(map[uint64][]historicalProof)
+		err := c.metadata.iterateWrittenKeys(retainFrom, version, func(v uint64, storeKey, key []byte) error {
+			tree, ok := c.multiTrees[conv.UnsafeBytesToStr(storeKey)]
+			if !ok {
+				return nil
+			}
+			iProof, err := tree.GetProof(v, key)
+			if err != nil {
+				return fmt.Errorf("failed to get proof of key %X in store %s at version %d: %w", key, storeKey, v, err)
+			}
+			proofs[v] = append(proofs[v], historicalProof{storeKey: storeKey, key: key, proof: iProof})
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+		for v, vProofs := range proofs {
+			if err := c.metadata.flushHistoricalProofs(v, vProofs); err != nil {
+				return 0, fmt.Errorf("failed to flush proofs for version %d: %w", v, err)
+			}
+		}
+	}
+
+	if err := c.metadata.deleteVersionRange(writtenKeyPrefix, 0, version); err != nil {
+		return 0, err
+	}
+	if retainFrom > 1 {
+		if err := c.metadata.deleteVersionRange(historicalProofPrefix, 0, retainFrom-1); err != nil {
+			return 0, err
+		}
+	}
+
+	return min(version, retainFrom-1), nil
+}
+
+func (c *CommitStore) getRetainedProof(storeKey []byte, version uint64, key []byte) (*ics23.CommitmentProof, error) {
+	if c.proofRetention == 0 {
+		return nil, nil
+	}
+	return c.metadata.GetHistoricalProof(version, storeKey, key)
+}
+
+// prunableVersion returns the highest version up to the given one which can be
+// pruned without affecting the snapshots in progress.
+func (c *CommitStore) prunableVersion(version uint64) uint64 {
+	c.snapshotMtx.Lock()
+	defer c.snapshotMtx.Unlock()
+
+	for v := range c.snapshotVersions {
+		if v <= version {
+			version = v - 1
+		}
+	}
+	return version
+}
+
+// retainSnapshotVersion prevents the given version from being pruned until
+// releaseSnapshotVersion is called.
+func (c *CommitStore) retainSnapshotVersion(version uint64) {
+	c.snapshotMtx.Lock()
+	defer c.snapshotMtx.Unlock()
+	c.snapshotVersions[version]++
+}
+
+func (c *CommitStore) releaseSnapshotVersion(version uint64) {
+	c.snapshotMtx.Lock()
+	defer c.snapshotMtx.Unlock()
+	c.snapshotVersions[version]--
+	if c.snapshotVersions[version] <= 0 {
+		delete(c.snapshotVersions, version)
+	}
+}
+
 // PausePruning implements store.PausablePruner.
 func (c *CommitStore) PausePruning(pause bool) {
 	for _, tree := range c.multiTrees {
@@ -345,7 +553,13 @@ func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error
 		return fmt.Errorf("the snapshot version %d is greater than the latest version %d", version, latestVersion)
 	}
 
-	for storeKey, tree := range c.multiTrees {
+	// the snapshot is created from the immutable view of the trees at the given
+	// version, which is retained from pruning while it is being exported.
+	c.retainSnapshotVersion(version)
+	defer c.releaseSnapshotVersion(version)
+
+	for _, storeKey := range slices.Sorted(maps.Keys(c.multiTrees)) {
+		tree := c.multiTrees[storeKey]
 		// TODO: check the parallelism of this loop
 		if err := func() error {
 			exporter, err := tree.Export(version)
@@ -484,6 +698,182 @@ loop:
 	return snapshotItem, c.LoadVersion(version)
 }
 
+// SnapshotDelta implements snapshots.CommitDeltaSnapshotter. The changes are written
+// version by version, and for every version store by store in lexical order. A store
+// item is followed by the changes committed to the store at that version.
+func (c *CommitStore) SnapshotDelta(baseVersion, version uint64, protoWriter protoio.Writer) error {
+	if baseVersion >= version {
+		return fmt.Errorf("the base version %d must be lower than the snapshot version %d", baseVersion, version)
+	}
+
+	latestVersion, err := c.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if version > latestVersion {
+		return fmt.Errorf("the snapshot version %d is greater than the latest version %d", version, latestVersion)
+	}
+
+	// the changes are computed from the trees at the base version and later ones,
+	// which are all retained from pruning while the delta is being exported.
+	c.retainSnapshotVersion(baseVersion)
+	defer c.releaseSnapshotVersion(baseVersion)
+
+	storeKeys := make([]string, 0, len(c.multiTrees))
+	for storeKey := range c.multiTrees {
+		if !internal.IsMemoryStoreKey(storeKey) {
+			storeKeys = append(storeKeys, storeKey)
+		}
+	}
+	slices.Sort(storeKeys)
+
+	for v := baseVersion + 1; v <= version; v++ {
+		for _, storeKey := range storeKeys {
+			err := c.multiTrees[storeKey].TraverseStateChanges(v, v, func(v uint64, changes []corestore.KVPair) error {
+				if len(changes) == 0 {
+					return nil
+				}
+				err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+					Item: &snapshotstypes.SnapshotItem_Store{
+						Store: &snapshotstypes.SnapshotStoreItem{
+							Name: storeKey,
+						},
+					},
+				})
+				if err != nil {
+					return fmt.Errorf("failed to write store name: %w", err)
+				}
+				for _, kv := range changes {
+					item := &snapshotstypes.SnapshotIAVLItem{
+						Key:     kv.Key,
+						Value:   kv.Value,
+						Version: int64(v),
+					}
+					if kv.Remove {
+						item.Value = nil
+						item.Height = snapshotstypes.DeltaRemoveHeight
+					}
+					if err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+						Item: &snapshotstypes.SnapshotItem_IAVL{
+							IAVL: item,
+						},
+					}); err != nil {
+						return fmt.Errorf("failed to write change: %w", err)
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("failed to traverse the changes of store %s at version %d: %w", storeKey, v, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RestoreDelta implements snapshots.CommitDeltaSnapshotter. The commit store must be
+// at the base version, the changes of every version are written and committed in
+// order, so the restored trees are identical to the ones of the snapshotted node.
+func (c *CommitStore) RestoreDelta(
+	baseVersion, version uint64,
+	protoReader protoio.Reader,
+	chStorage chan<- *corestore.StateChanges,
+) (snapshotstypes.SnapshotItem, error) {
+	latestVersion, err := c.GetLatestVersion()
+	if err != nil {
+		return snapshotstypes.SnapshotItem{}, err
+	}
+	if latestVersion != baseVersion {
+		return snapshotstypes.SnapshotItem{}, fmt.Errorf("the latest version %d does not match the delta base version %d", latestVersion, baseVersion)
+	}
+
+	var (
+		snapshotItem   snapshotstypes.SnapshotItem
+		storeKey       []byte
+		changeset      = corestore.NewChangeset()
+		currentVersion = baseVersion + 1
+	)
+	// commitUpTo commits the pending changes at the current version, followed by the
+	// versions without any change up to the given one.
+	commitUpTo := func(target uint64) error {
+		for ; currentVersion <= target; currentVersion++ {
+			if err := c.WriteChangeset(changeset); err != nil {
+				return fmt.Errorf("failed to write changeset for version %d: %w", currentVersion, err)
+			}
+			if _, err := c.Commit(currentVersion); err != nil {
+				return fmt.Errorf("failed to commit version %d: %w", currentVersion, err)
+			}
+			changeset = corestore.NewChangeset()
+		}
+		return nil
+	}
+
+loop:
+	for {
+		snapshotItem = snapshotstypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return snapshotstypes.SnapshotItem{}, fmt.Errorf("invalid protobuf message: %w", err)
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshotstypes.SnapshotItem_Store:
+			if _, ok := c.multiTrees[item.Store.Name]; !ok {
+				return snapshotstypes.SnapshotItem{}, fmt.Errorf("store %s not found", item.Store.Name)
+			}
+			storeKey = []byte(item.Store.Name)
+
+		case *snapshotstypes.SnapshotItem_IAVL:
+			if storeKey == nil {
+				return snapshotstypes.SnapshotItem{}, errors.New("received change item before store item")
+			}
+			node := item.IAVL
+			if node.Version <= 0 || uint64(node.Version) < currentVersion || uint64(node.Version) > version {
+				return snapshotstypes.SnapshotItem{}, fmt.Errorf("unexpected change version %d", node.Version)
+			}
+			if node.Height != 0 && node.Height != snapshotstypes.DeltaRemoveHeight {
+				return snapshotstypes.SnapshotItem{}, fmt.Errorf("invalid change height %d", node.Height)
+			}
+			// all the changes of the previous versions have been read.
+			if err := commitUpTo(uint64(node.Version) - 1); err != nil {
+				return snapshotstypes.SnapshotItem{}, err
+			}
+
+			// Protobuf does not differentiate between []byte{} and nil, see Restore.
+			if node.Key == nil {
+				node.Key = []byte{}
+			}
+			remove := node.Height == snapshotstypes.DeltaRemoveHeight
+			if !remove && node.Value == nil {
+				node.Value = []byte{}
+			}
+			changeset.Add(storeKey, node.Key, node.Value, remove)
+			chStorage <- &corestore.StateChanges{
+				Actor: storeKey,
+				StateChanges: []corestore.KVPair{
+					{
+						Key:    node.Key,
+						Value:  node.Value,
+						Remove: remove,
+					},
+				},
+			}
+
+		default:
+			break loop
+		}
+	}
+
+	if err := commitUpTo(version); err != nil {
+		return snapshotstypes.SnapshotItem{}, err
+	}
+
+	return snapshotItem, nil
+}
+
 func (c *CommitStore) GetCommitInfo(version uint64) (*proof.CommitInfo, error) {
 	return c.metadata.GetCommitInfo(version)
 }
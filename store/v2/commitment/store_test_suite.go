@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
+	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/stretchr/testify/suite"
 
 	corelog "cosmossdk.io/core/log"
@@ -13,6 +15,7 @@ import (
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/store/v2"
 	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/proof"
 	"cosmossdk.io/store/v2/snapshots"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
@@ -125,6 +128,111 @@ func (s *CommitStoreTestSuite) TestStore_Snapshotter() {
 	}
 }
 
+func (s *CommitStoreTestSuite) TestStore_DeltaSnapshotter() {
+	storeKeys := []string{storeKey1, storeKey2}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+
+	baseVersion, latestVersion := uint64(5), uint64(10)
+	for i := uint64(1); i <= latestVersion; i++ {
+		// the changes are written in key order, as the root store does.
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			// update and remove some of the keys written at the previous version
+			if i > 1 {
+				cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%02d-0", i-1)), []byte("updated"), false)
+				cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%02d-1", i-1)), nil, true)
+			}
+			for j := 0; j < 5; j++ {
+				cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%02d-%d", i, j)), []byte(fmt.Sprintf("value-%02d-%d", i, j)), false)
+			}
+		}
+		// leave a version without any change
+		if i == 7 {
+			cs = corestore.NewChangeset()
+		}
+		s.Require().NoError(commitStore.WriteChangeset(cs))
+		_, err = commitStore.Commit(i)
+		s.Require().NoError(err)
+	}
+
+	// transfer streams the snapshot written by the snapshot function to the restore function.
+	transfer := func(snapshot func(protoWriter protoio.Writer) error, restore func(protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges) error) map[string]string {
+		chunks := make(chan io.ReadCloser, 100)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			s.Require().NotNil(streamWriter)
+			defer streamWriter.Close()
+			s.Require().NoError(snapshot(streamWriter))
+		}()
+
+		streamReader, err := snapshots.NewStreamReader(chunks)
+		s.Require().NoError(err)
+		chStorage := make(chan *corestore.StateChanges, 100)
+		leaves := make(map[string]string)
+		done := make(chan struct{})
+		go func() {
+			for kv := range chStorage {
+				for _, pair := range kv.StateChanges {
+					key := fmt.Sprintf("%s_%s", kv.Actor, pair.Key)
+					if pair.Remove {
+						leaves[key] = "removed"
+					} else {
+						leaves[key] = string(pair.Value)
+					}
+				}
+			}
+			close(done)
+		}()
+		s.Require().NoError(restore(streamReader, chStorage))
+		close(chStorage)
+		<-done
+		return leaves
+	}
+
+	targetStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+	transfer(func(protoWriter protoio.Writer) error {
+		return commitStore.Snapshot(baseVersion, protoWriter)
+	}, func(protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges) error {
+		_, err := targetStore.Restore(baseVersion, snapshotstypes.CurrentFormat, protoReader, chStorage)
+		return err
+	})
+
+	// the delta must be restored on top of its base version
+	_, err = commitStore.RestoreDelta(baseVersion, latestVersion, nil, nil)
+	s.Require().Error(err)
+	s.Require().Error(commitStore.SnapshotDelta(latestVersion, latestVersion, nil))
+
+	changes := transfer(func(protoWriter protoio.Writer) error {
+		return commitStore.SnapshotDelta(baseVersion, latestVersion, protoWriter)
+	}, func(protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges) error {
+		_, err := targetStore.RestoreDelta(baseVersion, latestVersion, protoReader, chStorage)
+		return err
+	})
+	for _, storeKey := range storeKeys {
+		s.Require().Equal(fmt.Sprintf("value-%02d-2", latestVersion), changes[fmt.Sprintf("%s_key-%02d-2", storeKey, latestVersion)])
+		s.Require().Equal("updated", changes[fmt.Sprintf("%s_key-%02d-0", storeKey, latestVersion-1)])
+		s.Require().Equal("removed", changes[fmt.Sprintf("%s_key-%02d-1", storeKey, latestVersion-1)])
+		s.Require().NotContains(changes, fmt.Sprintf("%s_key-%02d-0", storeKey, baseVersion-1))
+	}
+
+	// every version of the restored store matches the original one
+	for v := baseVersion + 1; v <= latestVersion; v++ {
+		expected, err := commitStore.GetCommitInfo(v)
+		s.Require().NoError(err)
+		actual, err := targetStore.GetCommitInfo(v)
+		s.Require().NoError(err)
+		for _, storeKey := range storeKeys {
+			s.Require().Equal(expected.GetStoreCommitID([]byte(storeKey)), actual.GetStoreCommitID([]byte(storeKey)), "version %d store %s", v, storeKey)
+		}
+		s.Require().Equal(expected.Hash(), actual.Hash())
+	}
+	latest, err := targetStore.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(latestVersion, latest)
+}
+
 func (s *CommitStoreTestSuite) TestStore_LoadVersion() {
 	storeKeys := []string{storeKey1, storeKey2}
 	mdb := dbm.NewMemDB()
@@ -221,6 +329,48 @@ func (s *CommitStoreTestSuite) TestStore_Pruning() {
 	}
 }
 
+func (s *CommitStoreTestSuite) TestStore_PruningDuringSnapshot() {
+	storeKeys := []string{storeKey1, storeKey2}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+
+	latestVersion := uint64(10)
+	for i := uint64(1); i <= latestVersion; i++ {
+		kvPairs := make(map[string]corestore.KVPairs)
+		for _, storeKey := range storeKeys {
+			kvPairs[storeKey] = corestore.KVPairs{{Key: []byte(fmt.Sprintf("key-%d", i)), Value: []byte(fmt.Sprintf("value-%d", i))}}
+		}
+		s.Require().NoError(commitStore.WriteChangeset(corestore.NewChangesetWithPairs(kvPairs)))
+		_, err = commitStore.Commit(i)
+		s.Require().NoError(err)
+	}
+
+	// the version being snapshotted and the following ones should not be pruned
+	snapshotVersion := uint64(5)
+	commitStore.retainSnapshotVersion(snapshotVersion)
+	s.Require().NoError(commitStore.Prune(8))
+	for i := uint64(1); i <= latestVersion; i++ {
+		commitInfo, _ := commitStore.GetCommitInfo(i)
+		if i < snapshotVersion {
+			s.Require().Nil(commitInfo)
+		} else {
+			s.Require().NotNil(commitInfo)
+		}
+	}
+
+	// once the snapshot is done, the version can be pruned
+	commitStore.releaseSnapshotVersion(snapshotVersion)
+	s.Require().NoError(commitStore.Prune(8))
+	for i := uint64(1); i <= latestVersion; i++ {
+		commitInfo, _ := commitStore.GetCommitInfo(i)
+		if i <= 8 {
+			s.Require().Nil(commitInfo)
+		} else {
+			s.Require().NotNil(commitInfo)
+		}
+	}
+}
+
 func (s *CommitStoreTestSuite) TestStore_GetProof() {
 	storeKeys := []string{storeKey1, storeKey2}
 	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
@@ -264,6 +414,86 @@ func (s *CommitStoreTestSuite) TestStore_GetProof() {
 	s.Require().Nil(commit)
 }
 
+func (s *CommitStoreTestSuite) TestStore_ProofRetention() {
+	storeKeys := []string{storeKey1, storeKey2}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
+	s.Require().NoError(err)
+	proofRetention := uint64(5)
+	commitStore.SetProofRetention(proofRetention)
+
+	toVersion := uint64(10)
+	keyCount := 5
+	commitInfos := make(map[uint64]*proof.CommitInfo)
+
+	// commit some changes, deleting a key written in the previous version
+	for version := uint64(1); version <= toVersion; version++ {
+		cs := corestore.NewChangeset()
+		for _, storeKey := range storeKeys {
+			for i := 0; i < keyCount; i++ {
+				cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d-%d", version, i)), []byte(fmt.Sprintf("value-%d-%d", version, i)), false)
+			}
+			if version > 1 {
+				cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d-%d", version-1, 0)), nil, true)
+			}
+		}
+		s.Require().NoError(commitStore.WriteChangeset(cs))
+		commitInfos[version], err = commitStore.Commit(version)
+		s.Require().NoError(err)
+	}
+
+	// prune the trees in two steps, the proofs of the keys written at the
+	// pruned versions within the retention are retained
+	s.Require().NoError(commitStore.Prune(3))
+	s.Require().NoError(commitStore.Prune(toVersion - 1))
+
+	// the trees may be pruned in the background, a key which is not written at a
+	// pruned version cannot be proven once its version is gone
+	for _, storeKey := range storeKeys {
+		s.Require().Eventually(func() bool {
+			_, err := commitStore.GetProof([]byte(storeKey), toVersion-proofRetention+1, []byte("key-1-1"))
+			return err != nil
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	verify := func(version uint64, ops []proof.CommitmentOp, value []byte) {
+		s.Require().Len(ops, 2)
+		var leaf [][]byte
+		if value != nil {
+			leaf = [][]byte{value}
+		}
+		root, err := ops[0].Run(leaf)
+		s.Require().NoError(err)
+		root, err = ops[1].Run(root)
+		s.Require().NoError(err)
+		s.Require().Equal(commitInfos[version].Hash(), root[0])
+	}
+
+	for version := uint64(1); version <= toVersion; version++ {
+		for _, storeKey := range storeKeys {
+			key := []byte(fmt.Sprintf("key-%d-%d", version, 1))
+			ops, err := commitStore.GetProof([]byte(storeKey), version, key)
+			if version <= toVersion-proofRetention {
+				// the version is pruned and its proofs are not retained
+				s.Require().Error(err)
+				continue
+			}
+			s.Require().NoError(err)
+			verify(version, ops, []byte(fmt.Sprintf("value-%d-%d", version, 1)))
+
+			// the key deleted at this version is proven absent
+			deletedKey := []byte(fmt.Sprintf("key-%d-%d", version-1, 0))
+			ops, err = commitStore.GetProof([]byte(storeKey), version, deletedKey)
+			s.Require().NoError(err)
+			verify(version, ops, nil)
+		}
+	}
+
+	// the commit info of the versions out of the retention is pruned
+	cInfo, err := commitStore.metadata.GetCommitInfo(toVersion - proofRetention)
+	s.Require().NoError(err)
+	s.Require().Nil(cInfo)
+}
+
 func (s *CommitStoreTestSuite) TestStore_Get() {
 	storeKeys := []string{storeKey1, storeKey2}
 	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, nil, coretesting.NewNopLogger())
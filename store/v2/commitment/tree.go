@@ -6,6 +6,8 @@ import (
 
 	ics23 "github.com/cosmos/ics23/go"
 
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/proof"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 )
 
@@ -29,6 +31,12 @@ type Tree interface {
 	SetInitialVersion(version uint64) error
 	GetProof(version uint64, key []byte) (*ics23.CommitmentProof, error)
 
+	// GetRangeProof returns the proof of the complete content of the [start, end)
+	// key range at the given version. If limit is not 0 and the range holds more
+	// pairs, the proven range is cut after the first limit pairs, its end being the
+	// key following them.
+	GetRangeProof(version uint64, start, end []byte, limit uint64) (*proof.RangeProof, error)
+
 	// Get attempts to retrieve a value from the tree for a given version.
 	//
 	// NOTE: This method only exists to support migration from IAVL v0/v1 to v2.
@@ -39,6 +47,10 @@ type Tree interface {
 	Export(version uint64) (Exporter, error)
 	Import(version uint64) (Importer, error)
 
+	// TraverseStateChanges calls fn with the changes committed at every version
+	// from startVersion to endVersion (inclusive), in version order.
+	TraverseStateChanges(startVersion, endVersion uint64, fn func(version uint64, changes []corestore.KVPair) error) error
+
 	io.Closer
 }
 
@@ -53,6 +53,11 @@ type Committer interface {
 	// GetProof returns the proof of existence or non-existence for the given key.
 	GetProof(storeKey []byte, version uint64, key []byte) ([]proof.CommitmentOp, error)
 
+	// GetRangeProof returns the proof of the content of the [start, end) key range
+	// of a store, alongside the proof of the store in the commit info. If limit is
+	// not 0, at most limit pairs are proven, see commitment.Tree.
+	GetRangeProof(storeKey []byte, version uint64, start, end []byte, limit uint64) (proof.RangeOp, proof.CommitmentOp, error)
+
 	// Get returns the value for the given key at the given version.
 	//
 	// NOTE: This method only exists to support migration from IAVL v0/v1 to v2.
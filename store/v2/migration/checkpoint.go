@@ -0,0 +1,234 @@
+package migration
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	"github.com/cosmos/gogoproto/proto"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/internal/encoding"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+const (
+	migrateHeightKey          = "m/height"  // height of the state being migrated
+	migrateVersionKey         = "m/version" // version the migration caught up to
+	migrateCheckpointKeyStart = "m/cp_"     // m/cp_<storeKey>
+	migrateCheckpointKeyEnd   = "m/cp`"
+)
+
+// Status is the persisted progress of a migration. It is read by the `store migrate
+// status` command and used to resume an interrupted migration.
+type Status struct {
+	// Height is the height of the state being migrated, 0 if no migration started.
+	Height uint64
+	// MigratedVersion is the latest version fully migrated to the new store, it is
+	// 0 until the whole state at Height is migrated.
+	MigratedVersion uint64
+	// Stores is the progress of every store key, in lexical order.
+	Stores []StoreStatus
+}
+
+// StoreStatus is the checkpoint of a store key of the migrated state.
+type StoreStatus struct {
+	StoreKey string
+	// CommitmentDone is true once the commitment tree of the store is imported.
+	CommitmentDone bool
+	// StorageDone is true once all the keys of the store are written to the state
+	// storage.
+	StorageDone bool
+	// LastKey is the cursor of the state storage migration: the last key written.
+	LastKey []byte
+	// Keys and Bytes are the number of keys and bytes written to the state storage.
+	Keys  uint64
+	Bytes uint64
+}
+
+// LoadStatus reads the progress of the migration persisted in the given db.
+func LoadStatus(db corestore.KVStore) (*Status, error) {
+	height, err := getUint64(db, migrateHeightKey)
+	if err != nil {
+		return nil, err
+	}
+	version, err := getUint64(db, migrateVersionKey)
+	if err != nil {
+		return nil, err
+	}
+	status := &Status{Height: height, MigratedVersion: version}
+
+	itr, err := db.Iterator([]byte(migrateCheckpointKeyStart), []byte(migrateCheckpointKeyEnd))
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		storeKey := string(itr.Key()[len(migrateCheckpointKeyStart):])
+		st, err := unmarshalStoreStatus(storeKey, itr.Value())
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode checkpoint of store %s: %w", storeKey, err)
+		}
+		status.Stores = append(status.Stores, *st)
+	}
+	if err := itr.Error(); err != nil {
+		return nil, err
+	}
+	sort.Slice(status.Stores, func(i, j int) bool { return status.Stores[i].StoreKey < status.Stores[j].StoreKey })
+
+	return status, nil
+}
+
+func getUint64(db corestore.KVStore, key string) (uint64, error) {
+	bz, err := db.Get([]byte(key))
+	if err != nil {
+		return 0, fmt.Errorf("failed to get %s from db: %w", key, err)
+	}
+	if bz == nil {
+		return 0, nil
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("invalid %s value length %d", key, len(bz))
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func setUint64(db corestore.KVStore, key string, value uint64) error {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, value)
+	if err := db.Set([]byte(key), buf); err != nil {
+		return fmt.Errorf("failed to set %s in db: %w", key, err)
+	}
+	return nil
+}
+
+func (s *StoreStatus) marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	flags := uint64(0)
+	if s.CommitmentDone {
+		flags |= 1
+	}
+	if s.StorageDone {
+		flags |= 2
+	}
+	for _, u := range []uint64{flags, s.Keys, s.Bytes} {
+		if err := encoding.EncodeUvarint(&buf, u); err != nil {
+			return nil, err
+		}
+	}
+	if err := encoding.EncodeBytes(&buf, s.LastKey); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func unmarshalStoreStatus(storeKey string, bz []byte) (*StoreStatus, error) {
+	var fields [3]uint64
+	for i := range fields {
+		u, n, err := encoding.DecodeUvarint(bz)
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = u
+		bz = bz[n:]
+	}
+	lastKey, n, err := encoding.DecodeBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(bz) {
+		return nil, errors.New("unexpected trailing bytes")
+	}
+
+	return &StoreStatus{
+		StoreKey:       storeKey,
+		CommitmentDone: fields[0]&1 != 0,
+		StorageDone:    fields[0]&2 != 0,
+		LastKey:        bytes.Clone(lastKey),
+		Keys:           fields[1],
+		Bytes:          fields[2],
+	}, nil
+}
+
+// checkpointReader wraps the migration stream read by the commitment restore. It hides
+// the stores whose tree is already imported, forwarding their leaves to the state
+// storage directly, and marks the imported trees in the checkpoints.
+type checkpointReader struct {
+	m         *Manager
+	reader    protoio.Reader
+	chStorage chan<- *corestore.StateChanges
+
+	skip      bool
+	storeKey  []byte
+	lastStore string
+	committed string
+}
+
+var _ protoio.Reader = (*checkpointReader)(nil)
+
+// ReadMsg implements protoio.Reader.
+func (r *checkpointReader) ReadMsg(msg proto.Message) error {
+	// the restore commits the importer of the previous store once it receives a new
+	// store item, before reading the next one.
+	if r.committed != "" {
+		if err := r.m.markCommitmentDone(r.committed); err != nil {
+			return err
+		}
+		r.committed = ""
+	}
+
+	for {
+		if err := r.reader.ReadMsg(msg); err != nil {
+			return err
+		}
+		snapshotItem, ok := msg.(*snapshotstypes.SnapshotItem)
+		if !ok {
+			return fmt.Errorf("unexpected message type: %T", msg)
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshotstypes.SnapshotItem_Store:
+			r.storeKey = []byte(item.Store.Name)
+			r.skip = r.m.storeStatus(item.Store.Name).CommitmentDone
+			if r.skip {
+				continue
+			}
+			r.committed, r.lastStore = r.lastStore, item.Store.Name
+			return nil
+
+		case *snapshotstypes.SnapshotItem_IAVL:
+			if !r.skip {
+				return nil
+			}
+			if item.IAVL.Height == 0 {
+				key, value := item.IAVL.Key, item.IAVL.Value
+				if key == nil {
+					key = []byte{}
+				}
+				if value == nil {
+					value = []byte{}
+				}
+				r.chStorage <- &corestore.StateChanges{
+					Actor:        r.storeKey,
+					StateChanges: []corestore.KVPair{{Key: key, Value: value}},
+				}
+			}
+
+		default:
+			return nil
+		}
+	}
+}
+
+// finish marks the tree of the last store as imported, it must be called once the
+// restore returned successfully.
+func (r *checkpointReader) finish() error {
+	if r.lastStore == "" {
+		return nil
+	}
+	return r.m.markCommitmentDone(r.lastStore)
+}
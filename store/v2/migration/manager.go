@@ -1,6 +1,7 @@
 package migration
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -14,6 +15,7 @@ import (
 	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/internal/encoding"
+	"cosmossdk.io/store/v2/metrics"
 	"cosmossdk.io/store/v2/snapshots"
 	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
 	"cosmossdk.io/store/v2/storage"
@@ -24,6 +26,9 @@ const (
 	defaultChannelBufferSize = 1024
 	// defaultStorageBufferSize is the default buffer size for the storage snapshotter.
 	defaultStorageBufferSize = 1024
+	// defaultStorageBatchSize is the size of the state storage writes, a checkpoint is
+	// persisted after every write.
+	defaultStorageBatchSize = 100000
 
 	migrateChangesetKeyFmt = "m/cs_%x" // m/cs_<version>
 )
@@ -46,6 +51,12 @@ type Manager struct {
 	mtx             sync.Mutex // mutex for migratedVersion
 	migratedVersion uint64
 
+	cpMtx       sync.Mutex // mutex for checkpoints
+	checkpoints map[string]*StoreStatus
+
+	// telemetry reflects a telemetry agent responsible for emitting metrics (if any)
+	telemetry metrics.StoreMetrics
+
 	chChangeset <-chan *VersionedChangeset
 	chDone      <-chan struct{}
 }
@@ -60,14 +71,24 @@ func NewManager(db corestore.KVStoreWithBatch, sm *snapshots.Manager, ss *storag
 		stateStorage:     ss,
 		stateCommitment:  sc,
 		db:               db,
+		checkpoints:      make(map[string]*StoreStatus),
 	}
 }
 
+// SetMetrics sets the telemetry handler on the Manager.
+func (m *Manager) SetMetrics(telemetry metrics.StoreMetrics) {
+	m.telemetry = telemetry
+}
+
 // Start starts the whole migration process.
 // It migrates the whole state at the given version to the new store/v2 (both SC and SS).
 // It also catches up the Changesets which are committed while the migration is in progress.
 // `chChangeset` is the channel to receive the committed Changesets from the RootStore.
 // `chDone` is the channel to receive the done signal from the RootStore.
+//
+// If a previous migration was interrupted, it is resumed from its checkpoints instead:
+// the state is migrated at the height of the interrupted migration, and the Changesets
+// committed since then are caught up from the db.
 // NOTE: It should be called by the RootStore, running in the background.
 func (m *Manager) Start(version uint64, chChangeset <-chan *VersionedChangeset, chDone <-chan struct{}) error {
 	m.chChangeset = chChangeset
@@ -79,8 +100,25 @@ func (m *Manager) Start(version uint64, chChangeset <-chan *VersionedChangeset,
 		}
 	}()
 
-	if err := m.Migrate(version); err != nil {
-		return fmt.Errorf("failed to migrate state: %w", err)
+	status, err := LoadStatus(m.db)
+	if err != nil {
+		return fmt.Errorf("failed to load migration status: %w", err)
+	}
+	switch {
+	case status.Height == 0 || status.Height >= version:
+		if err := m.Migrate(version); err != nil {
+			return fmt.Errorf("failed to migrate state: %w", err)
+		}
+	case status.MigratedVersion < status.Height:
+		m.logger.Info("resuming migration", "height", status.Height)
+		if err := m.Migrate(status.Height); err != nil {
+			return fmt.Errorf("failed to migrate state: %w", err)
+		}
+	default:
+		m.logger.Info("resuming migration catch up", "version", status.MigratedVersion)
+		m.mtx.Lock()
+		m.migratedVersion = status.MigratedVersion
+		m.mtx.Unlock()
 	}
 
 	return m.Sync()
@@ -92,7 +130,29 @@ func (m *Manager) GetStateCommitment() *commitment.CommitStore {
 }
 
 // Migrate migrates the whole state at the given height to the new store/v2.
+//
+// The progress is checkpointed per store key, so that a migration of the same height
+// interrupted midway skips the trees already imported and the keys already written
+// to the state storage.
 func (m *Manager) Migrate(height uint64) error {
+	if m.telemetry != nil {
+		defer m.telemetry.MeasureSince(time.Now(), "migration", "migrate")
+	}
+
+	resuming, err := m.loadCheckpoints(height)
+	if err != nil {
+		return err
+	}
+	if !resuming {
+		latestVersion, err := m.stateStorage.GetLatestVersion()
+		if err != nil {
+			return fmt.Errorf("failed to get latest version: %w", err)
+		}
+		if height <= latestVersion {
+			return fmt.Errorf("the migration height %d is not greater than latest version %d", height, latestVersion)
+		}
+	}
+
 	// create the migration stream and snapshot,
 	// which acts as protoio.Reader and snapshots.WriteCloser.
 	ms := NewMigrationStream(defaultChannelBufferSize)
@@ -106,12 +166,16 @@ func (m *Manager) Migrate(height uint64) error {
 
 	eg := new(errgroup.Group)
 	eg.Go(func() error {
-		return m.stateStorage.Restore(height, chStorage)
+		return m.restoreStorage(height, chStorage)
 	})
 	eg.Go(func() error {
 		defer close(chStorage)
 		if m.stateCommitment != nil {
-			if _, err := m.stateCommitment.Restore(height, 0, ms, chStorage); err != nil {
+			reader := &checkpointReader{m: m, reader: ms, chStorage: chStorage}
+			if _, err := m.stateCommitment.Restore(height, 0, reader, chStorage); err != nil {
+				return err
+			}
+			if err := reader.finish(); err != nil {
 				return err
 			}
 		} else { // there is no commitment migration, just consume the stream to restore the state storage
@@ -161,8 +225,168 @@ func (m *Manager) Migrate(height uint64) error {
 		return err
 	}
 
+	return m.setMigratedVersion(height)
+}
+
+// restoreStorage writes the state received from the channel to the state storage in
+// batches, and persists the checkpoint of the written store after every batch. The
+// keys already written by an interrupted migration are skipped.
+func (m *Manager) restoreStorage(height uint64, chStorage <-chan *corestore.StateChanges) error {
+	var (
+		cs       = corestore.NewChangeset()
+		size     int
+		storeKey string
+		status   StoreStatus
+		keys     uint64
+		lastKey  []byte
+	)
+	flush := func(done bool) error {
+		if size > 0 {
+			if err := m.stateStorage.ApplyChangeset(height, cs); err != nil {
+				return fmt.Errorf("failed to write changeset to storage: %w", err)
+			}
+		}
+		err := m.updateCheckpoint(storeKey, func(st *StoreStatus) {
+			if keys > 0 {
+				st.Keys += keys
+				st.Bytes += uint64(size)
+				st.LastKey = lastKey
+			}
+			st.StorageDone = done
+		})
+		if err != nil {
+			return err
+		}
+		if m.telemetry != nil && keys > 0 {
+			m.telemetry.IncrCounter(float32(keys), "migration", "keys_migrated")
+			m.telemetry.IncrCounter(float32(size), "migration", "bytes_migrated")
+		}
+
+		cs, size, keys = corestore.NewChangeset(), 0, 0
+		return nil
+	}
+
+	for changes := range chStorage {
+		if string(changes.Actor) != storeKey {
+			// the stores are streamed one after the other.
+			if storeKey != "" {
+				if err := flush(true); err != nil {
+					return err
+				}
+			}
+			storeKey = string(changes.Actor)
+			status = m.storeStatus(storeKey)
+		}
+		if status.StorageDone {
+			continue
+		}
+
+		for _, kv := range changes.StateChanges {
+			// the keys of a store are streamed in order, so the cursor is the last key written.
+			if status.Keys > 0 && bytes.Compare(kv.Key, status.LastKey) <= 0 {
+				continue
+			}
+			cs.Add(changes.Actor, kv.Key, kv.Value, kv.Remove)
+			size += len(kv.Key) + len(kv.Value)
+			keys++
+			lastKey = kv.Key
+			if size > defaultStorageBatchSize {
+				if err := flush(false); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if storeKey != "" {
+		return flush(true)
+	}
+	return nil
+}
+
+// loadCheckpoints loads the checkpoints of the migration of the given height, and
+// returns true if the migration of this height was already started. The checkpoints
+// of another height are discarded.
+func (m *Manager) loadCheckpoints(height uint64) (bool, error) {
+	status, err := LoadStatus(m.db)
+	if err != nil {
+		return false, fmt.Errorf("failed to load migration status: %w", err)
+	}
+
+	m.cpMtx.Lock()
+	defer m.cpMtx.Unlock()
+
+	m.checkpoints = make(map[string]*StoreStatus)
+	if status.Height == height {
+		for i := range status.Stores {
+			m.checkpoints[status.Stores[i].StoreKey] = &status.Stores[i]
+		}
+		return true, nil
+	}
+
+	if status.Height != 0 {
+		m.logger.Info("discarding migration checkpoints", "height", status.Height)
+	}
+	for _, st := range status.Stores {
+		if err := m.db.Delete([]byte(migrateCheckpointKeyStart + st.StoreKey)); err != nil {
+			return false, fmt.Errorf("failed to delete checkpoint from db: %w", err)
+		}
+	}
+	if err := m.db.Delete([]byte(migrateVersionKey)); err != nil {
+		return false, fmt.Errorf("failed to delete migrated version from db: %w", err)
+	}
+	return false, setUint64(m.db, migrateHeightKey, height)
+}
+
+// storeStatus returns a copy of the checkpoint of the given store.
+func (m *Manager) storeStatus(storeKey string) StoreStatus {
+	m.cpMtx.Lock()
+	defer m.cpMtx.Unlock()
+
+	if st, ok := m.checkpoints[storeKey]; ok {
+		return *st
+	}
+	return StoreStatus{StoreKey: storeKey}
+}
+
+// markCommitmentDone persists that the tree of the given store is imported.
+func (m *Manager) markCommitmentDone(storeKey string) error {
+	return m.updateCheckpoint(storeKey, func(st *StoreStatus) {
+		st.CommitmentDone = true
+	})
+}
+
+// updateCheckpoint applies the given update to the checkpoint of a store and persists
+// it. The commitment and the storage progress are updated from different goroutines.
+func (m *Manager) updateCheckpoint(storeKey string, update func(st *StoreStatus)) error {
+	m.cpMtx.Lock()
+	defer m.cpMtx.Unlock()
+
+	st := StoreStatus{StoreKey: storeKey}
+	if prev, ok := m.checkpoints[storeKey]; ok {
+		st = *prev
+	}
+	update(&st)
+
+	bz, err := st.marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal checkpoint: %w", err)
+	}
+	if err := m.db.Set([]byte(migrateCheckpointKeyStart+storeKey), bz); err != nil {
+		return fmt.Errorf("failed to write checkpoint to db: %w", err)
+	}
+	m.checkpoints[storeKey] = &st
+	return nil
+}
+
+// setMigratedVersion sets and persists the migrated version.
+func (m *Manager) setMigratedVersion(version uint64) error {
+	if err := setUint64(m.db, migrateVersionKey, version); err != nil {
+		return err
+	}
+
 	m.mtx.Lock()
-	m.migratedVersion = height
+	m.migratedVersion = version
 	m.mtx.Unlock()
 
 	return nil
@@ -257,10 +481,9 @@ func (m *Manager) Sync() error {
 			if err := m.stateStorage.ApplyChangeset(version, cs); err != nil {
 				return fmt.Errorf("failed to write changeset to storage: %w", err)
 			}
-
-			m.mtx.Lock()
-			m.migratedVersion = version
-			m.mtx.Unlock()
+			if err := m.setMigratedVersion(version); err != nil {
+				return err
+			}
 
 			version += 1
 		}
@@ -158,8 +158,9 @@ func TestStartMigrateState(t *testing.T) {
 			// check if migrate process complete
 			go func() {
 				for {
+					// the catch up may already have synced the next version
 					migrateVersion := m.GetMigratedVersion()
-					if migrateVersion == toVersion-1 {
+					if migrateVersion >= toVersion-1 {
 						break
 					}
 				}
@@ -218,3 +219,85 @@ func TestStartMigrateState(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateStateResume(t *testing.T) {
+	for _, noCommitStore := range []bool{false, true} {
+		t.Run(fmt.Sprintf("Migrate noCommitStore=%v", noCommitStore), func(t *testing.T) {
+			m, orgCommitStore := setupMigrationManager(t, noCommitStore)
+
+			// apply changeset
+			toVersion := uint64(10)
+			keyCount := 10
+			for version := uint64(1); version <= toVersion; version++ {
+				cs := corestore.NewChangeset()
+				for _, storeKey := range storeKeys {
+					for i := 0; i < keyCount; i++ {
+						cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key-%d-%d", version, i)), []byte(fmt.Sprintf("value-%d-%d", version, i)), false)
+					}
+				}
+				require.NoError(t, orgCommitStore.WriteChangeset(cs))
+				_, err := orgCommitStore.Commit(version)
+				require.NoError(t, err)
+			}
+
+			// simulate a migration interrupted after writing the keys of store1 up to "key-3"
+			height := toVersion - 1
+			require.NoError(t, setUint64(m.db, migrateHeightKey, height))
+			require.NoError(t, m.updateCheckpoint("store1", func(st *StoreStatus) {
+				st.LastKey = []byte("key-3")
+				st.Keys = 1
+			}))
+
+			require.NoError(t, m.Migrate(height))
+			require.Equal(t, height, m.GetMigratedVersion())
+
+			// the keys before the cursor are not written again
+			for version := uint64(1); version < toVersion; version++ {
+				for i := 0; i < keyCount; i++ {
+					key := []byte(fmt.Sprintf("key-%d-%d", version, i))
+					val, err := m.stateStorage.Get([]byte("store1"), height, key)
+					require.NoError(t, err)
+					if version < 3 {
+						require.Nil(t, val)
+					} else {
+						require.Equal(t, []byte(fmt.Sprintf("value-%d-%d", version, i)), val)
+					}
+					val, err = m.stateStorage.Get([]byte("store2"), height, key)
+					require.NoError(t, err)
+					require.Equal(t, []byte(fmt.Sprintf("value-%d-%d", version, i)), val)
+				}
+			}
+
+			status, err := LoadStatus(m.db)
+			require.NoError(t, err)
+			require.Equal(t, height, status.Height)
+			require.Equal(t, height, status.MigratedVersion)
+			require.Len(t, status.Stores, len(storeKeys))
+			require.Equal(t, "store1", status.Stores[0].StoreKey)
+			require.Equal(t, uint64(1+7*keyCount), status.Stores[0].Keys)
+			require.Equal(t, "store2", status.Stores[1].StoreKey)
+			require.Equal(t, uint64(9*keyCount), status.Stores[1].Keys)
+			for _, st := range status.Stores {
+				require.True(t, st.StorageDone)
+				require.Equal(t, !noCommitStore, st.CommitmentDone)
+			}
+
+			// migrating the same height again skips the imported trees and the written keys
+			snapshotsStore, err := snapshots.NewStore(t.TempDir())
+			require.NoError(t, err)
+			snapshotsManager := snapshots.NewManager(snapshotsStore, snapshots.NewSnapshotOptions(1500, 2), orgCommitStore, nil, nil, coretesting.NewNopLogger())
+			m2 := NewManager(m.db, snapshotsManager, m.stateStorage, m.stateCommitment, coretesting.NewNopLogger())
+			require.NoError(t, m2.Migrate(height))
+
+			status2, err := LoadStatus(m.db)
+			require.NoError(t, err)
+			require.Equal(t, status, status2)
+
+			if m.stateCommitment != nil {
+				val, err := m.stateCommitment.Get([]byte("store1"), height, []byte("key-1-0"))
+				require.NoError(t, err)
+				require.Equal(t, []byte("value-1-0"), val)
+			}
+		})
+	}
+}
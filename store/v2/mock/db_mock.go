@@ -129,6 +129,22 @@ func (mr *MockStateCommitterMockRecorder) GetProof(storeKey, version, key any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProof", reflect.TypeOf((*MockStateCommitter)(nil).GetProof), storeKey, version, key)
 }
 
+// GetRangeProof mocks base method.
+func (m *MockStateCommitter) GetRangeProof(storeKey []byte, version uint64, start, end []byte, limit uint64) (proof.RangeOp, proof.CommitmentOp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRangeProof", storeKey, version, start, end, limit)
+	ret0, _ := ret[0].(proof.RangeOp)
+	ret1, _ := ret[1].(proof.CommitmentOp)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRangeProof indicates an expected call of GetRangeProof.
+func (mr *MockStateCommitterMockRecorder) GetRangeProof(storeKey, version, start, end, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRangeProof", reflect.TypeOf((*MockStateCommitter)(nil).GetRangeProof), storeKey, version, start, end, limit)
+}
+
 // LoadVersion mocks base method.
 func (m *MockStateCommitter) LoadVersion(targetVersion uint64) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,155 @@
+package proof
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	ics23 "github.com/cosmos/ics23/go"
+
+	errors "cosmossdk.io/errors/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+)
+
+// ProofOpIAVLRange is the proof operation type of the range proofs of IAVL trees.
+const ProofOpIAVLRange = "ics23:iavl-range"
+
+// emptyTreeRoot is the root of an empty IAVL tree, the hash of no data.
+var emptyTreeRoot = sha256.Sum256(nil)
+
+// Pairs returns the key/value pairs proven by the proof, in key order.
+func (p *RangeProof) Pairs() (keys, values [][]byte) {
+	keys = make([][]byte, len(p.Entries))
+	values = make([][]byte, len(p.Entries))
+	for i, entry := range p.Entries {
+		keys[i], values[i] = entry.Key, entry.Value
+	}
+	return keys, values
+}
+
+// proofs returns all the existence proofs of the range proof, in key order.
+func (p *RangeProof) proofs() []*ics23.ExistenceProof {
+	proofs := make([]*ics23.ExistenceProof, 0, len(p.Entries)+2)
+	if p.Left != nil {
+		proofs = append(proofs, p.Left)
+	}
+	for i := range p.Entries {
+		proofs = append(proofs, &p.Entries[i])
+	}
+	if p.Right != nil {
+		proofs = append(proofs, p.Right)
+	}
+	return proofs
+}
+
+// Calculate returns the root of the tree the proof was generated from, it does not
+// verify the proof.
+func (p *RangeProof) Calculate() ([]byte, error) {
+	proofs := p.proofs()
+	if len(proofs) == 0 {
+		return emptyTreeRoot[:], nil
+	}
+	return proofs[0].Calculate()
+}
+
+// Verify checks that the proof is a valid proof of the whole [Start, End) range of the
+// tree with the given root.
+func (p *RangeProof) Verify(spec *ics23.ProofSpec, root []byte) error {
+	if p.Start != nil && p.End != nil && bytes.Compare(p.Start, p.End) > 0 {
+		return errors.Wrap(storeerrors.ErrInvalidProof, "range start is after range end")
+	}
+	if spec.PrehashKeyBeforeComparison {
+		return errors.Wrap(storeerrors.ErrInvalidProof, "range proofs do not support prehashed keys")
+	}
+
+	// the boundaries must be outside of the range, and the entries inside of it.
+	if p.Left != nil && (p.Start == nil || bytes.Compare(p.Left.Key, p.Start) >= 0) {
+		return errors.Wrapf(storeerrors.ErrInvalidProof, "left boundary key %X is not lower than the range start", p.Left.Key)
+	}
+	if p.Right != nil && (p.End == nil || bytes.Compare(p.Right.Key, p.End) < 0) {
+		return errors.Wrapf(storeerrors.ErrInvalidProof, "right boundary key %X is lower than the range end", p.Right.Key)
+	}
+	for _, entry := range p.Entries {
+		if (p.Start != nil && bytes.Compare(entry.Key, p.Start) < 0) || (p.End != nil && bytes.Compare(entry.Key, p.End) >= 0) {
+			return errors.Wrapf(storeerrors.ErrInvalidProof, "key %X is outside of the range", entry.Key)
+		}
+	}
+
+	proofs := p.proofs()
+	if len(proofs) == 0 {
+		if !bytes.Equal(root, emptyTreeRoot[:]) {
+			return errors.Wrap(storeerrors.ErrInvalidProof, "range proof of an empty tree does not match the root")
+		}
+		return nil
+	}
+	for i, proof := range proofs {
+		if err := proof.Verify(spec, root, proof.Key, proof.Value); err != nil {
+			return errors.Wrapf(storeerrors.ErrInvalidProof, "invalid proof of key %X: %v", proof.Key, err)
+		}
+		if i > 0 {
+			// a key can't be proven twice, and no key may be between two neighbors.
+			if bytes.Compare(proofs[i-1].Key, proof.Key) >= 0 {
+				return errors.Wrapf(storeerrors.ErrInvalidProof, "keys %X and %X are not in order", proofs[i-1].Key, proof.Key)
+			}
+			if !ics23.IsLeftNeighbor(spec.InnerSpec, proofs[i-1].Path, proof.Path) {
+				return errors.Wrapf(storeerrors.ErrInvalidProof, "keys %X and %X are not neighbors", proofs[i-1].Key, proof.Key)
+			}
+		}
+	}
+	if p.Left == nil && !ics23.IsLeftMost(spec.InnerSpec, proofs[0].Path) {
+		return errors.Wrapf(storeerrors.ErrInvalidProof, "key %X is not the leftmost key", proofs[0].Key)
+	}
+	if last := proofs[len(proofs)-1]; p.Right == nil && !ics23.IsRightMost(spec.InnerSpec, last.Path) {
+		return errors.Wrapf(storeerrors.ErrInvalidProof, "key %X is not the rightmost key", last.Key)
+	}
+
+	return nil
+}
+
+// RangeOp is the counterpart of CommitmentOp for range proofs, it proves the content
+// of a key range of a tree and returns the root of the tree.
+type RangeOp struct {
+	Type  string
+	Spec  *ics23.ProofSpec
+	Proof *RangeProof
+}
+
+func NewIAVLRangeOp(proof *RangeProof) RangeOp {
+	return RangeOp{
+		Type:  ProofOpIAVLRange,
+		Spec:  ics23.IavlSpec,
+		Proof: proof,
+	}
+}
+
+// GetKey returns the start of the proven range.
+func (op RangeOp) GetKey() []byte {
+	return op.Proof.Start
+}
+
+// Run takes in the key/value pairs of the range, flattened as key1, value1, key2,
+// value2, ..., and verifies that they are exactly the content of the range. It returns
+// the root of the tree wrapped in [][]byte if the proof op succeeds, so that it can
+// be chained with the CommitmentOp of the store.
+func (op RangeOp) Run(args [][]byte) ([][]byte, error) {
+	if len(args)%2 != 0 {
+		return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "args must be key/value pairs, got %d args", len(args))
+	}
+	if len(args)/2 != len(op.Proof.Entries) {
+		return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "proof has %d entries, got %d pairs", len(op.Proof.Entries), len(args)/2)
+	}
+	for i, entry := range op.Proof.Entries {
+		if !bytes.Equal(entry.Key, args[2*i]) || !bytes.Equal(entry.Value, args[2*i+1]) {
+			return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "pair %d does not match the proof", i)
+		}
+	}
+
+	root, err := op.Proof.Calculate()
+	if err != nil {
+		return nil, errors.Wrapf(storeerrors.ErrInvalidProof, "could not calculate root for proof: %v", err)
+	}
+	if err := op.Proof.Verify(op.Spec, root); err != nil {
+		return nil, err
+	}
+
+	return [][]byte{root}, nil
+}
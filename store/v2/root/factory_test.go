@@ -1,6 +1,7 @@
 package root
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -21,6 +22,14 @@ func TestFactory(t *testing.T) {
 	f, err := CreateRootStore(&fop)
 	require.NoError(t, err)
 	require.NotNil(t, f)
+	require.Nil(t, f.(*Store).wal)
+
+	fop.RootDir = t.TempDir()
+	fop.Options.WALEnabled = true
+	f, err = CreateRootStore(&fop)
+	require.NoError(t, err)
+	require.NotNil(t, f.(*Store).wal)
+	require.DirExists(t, filepath.Join(fop.RootDir, "data", "wal"))
 
 	fop.Options.SCType = SCTypeIavlV2
 	f, err = CreateRootStore(&fop)
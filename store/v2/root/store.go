@@ -52,6 +52,9 @@ type Store struct {
 	// pruningManager reflects the pruning manager used to prune state of the SS and SC backends
 	pruningManager *pruning.Manager
 
+	// wal reflects the write-ahead log of the changeset being committed (if any)
+	wal *WAL
+
 	// Migration related fields
 	// migrationManager reflects the migration manager used to migrate state from v1 to v2
 	migrationManager *migration.Manager
@@ -75,6 +78,9 @@ func New(
 	mm *migration.Manager,
 	m metrics.StoreMetrics,
 ) (store.RootStore, error) {
+	if mm != nil && m != nil {
+		mm.SetMetrics(m)
+	}
 	return &Store{
 		logger:           logger,
 		initialVersion:   1,
@@ -87,6 +93,12 @@ func New(
 	}, nil
 }
 
+// SetWAL sets the write-ahead log the changesets are written to before being
+// committed, and replayed from when loading the latest version.
+func (s *Store) SetWAL(wal *WAL) {
+	s.wal = wal
+}
+
 // Close closes the store and resets all internal fields. Note, Close() is NOT
 // idempotent and should only be called once.
 func (s *Store) Close() (err error) {
@@ -217,12 +229,68 @@ func (s *Store) Query(storeKey []byte, version uint64, key []byte, prove bool) (
 	return result, nil
 }
 
+func (s *Store) QueryRange(storeKey []byte, version uint64, start, end []byte, limit uint64, prove bool) (store.RangeQueryResult, error) {
+	if s.telemetry != nil {
+		now := time.Now()
+		defer s.telemetry.MeasureSince(now, "root_store", "query_range")
+	}
+
+	result := store.RangeQueryResult{
+		Start:   start,
+		End:     end,
+		Version: version,
+	}
+
+	// the pairs are read from the SC backend when a proof is requested, so that
+	// they match the proof, or while migrating as the SS backend is incomplete.
+	if prove || s.isMigrating {
+		rangeOp, storeOp, err := s.stateCommitment.GetRangeProof(storeKey, version, start, end, limit)
+		if err != nil {
+			return store.RangeQueryResult{}, fmt.Errorf("failed to get SC store range proof: %w", err)
+		}
+		result.End = rangeOp.Proof.End
+		keys, values := rangeOp.Proof.Pairs()
+		for i := range keys {
+			result.Pairs = append(result.Pairs, corestore.KVPair{Key: keys[i], Value: values[i]})
+		}
+		if prove {
+			result.RangeOp = &rangeOp
+			result.ProofOps = []proof.CommitmentOp{storeOp}
+		}
+		return result, nil
+	}
+
+	itr, err := s.stateStorage.Iterator(storeKey, version, start, end)
+	if err != nil {
+		return store.RangeQueryResult{}, fmt.Errorf("failed to query SS store: %w", err)
+	}
+	defer itr.Close()
+	for ; itr.Valid(); itr.Next() {
+		if limit > 0 && uint64(len(result.Pairs)) == limit {
+			result.End = bytes.Clone(itr.Key())
+			break
+		}
+		result.Pairs = append(result.Pairs, corestore.KVPair{Key: itr.Key(), Value: itr.Value()})
+	}
+	if err := itr.Error(); err != nil {
+		return store.RangeQueryResult{}, fmt.Errorf("failed to query SS store: %w", err)
+	}
+
+	return result, nil
+}
+
 func (s *Store) LoadLatestVersion() error {
 	if s.telemetry != nil {
 		now := time.Now()
 		defer s.telemetry.MeasureSince(now, "root_store", "load_latest_version")
 	}
 
+	if s.wal != nil {
+		if err := s.replayWAL(); err != nil {
+			return fmt.Errorf("failed to replay WAL: %w", err)
+		}
+	}
+
 	lv, err := s.GetLatestVersion()
 	if err != nil {
 		return err
@@ -366,6 +434,14 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 		s.logger.Debug("commit header and version mismatch", "header_height", s.commitHeader.Height, "version", version)
 	}
 
+	// the changeset is durably logged before any backend is committed, so that the
+	// backends can be brought back in sync if the process dies in between.
+	if s.wal != nil {
+		if err := s.wal.Write(version, cs); err != nil {
+			return nil, fmt.Errorf("failed to write changeset to WAL: %w", err)
+		}
+	}
+
 	// signal to the pruning manager that a new version is about to be committed
 	// this may be required if the SS and SC backends implementation have the
 	// background pruning process which must be paused during the commit
@@ -401,6 +477,12 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 		return nil, err
 	}
 
+	if s.wal != nil {
+		if err := s.wal.Clear(); err != nil {
+			return nil, err
+		}
+	}
+
 	// signal to the pruning manager that the commit is done
 	if err := s.pruningManager.SignalCommit(false, version); err != nil {
 		s.logger.Error("failed to signal commit done to pruning manager", "err", err)
@@ -413,6 +495,56 @@ func (s *Store) Commit(cs *corestore.Changeset) ([]byte, error) {
 	return s.lastCommitInfo.Hash(), nil
 }
 
+// replayWAL replays the changeset left in the WAL by an interrupted commit to
+// the SS and SC backends which did not commit it, and clears the WAL.
+func (s *Store) replayWAL() error {
+	version, cs, err := s.wal.Read()
+	if err != nil || cs == nil {
+		return err
+	}
+
+	scVersion, err := s.stateCommitment.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	switch {
+	case scVersion == version:
+	case scVersion+1 == version || scVersion == 0: // the first version may be the initial version
+		s.logger.Info("replaying WAL changeset to SC", "version", version)
+		if err := s.stateCommitment.LoadVersion(scVersion); err != nil {
+			return fmt.Errorf("failed to load SC version %d: %w", scVersion, err)
+		}
+		if err := s.stateCommitment.WriteChangeset(cs); err != nil {
+			return fmt.Errorf("failed to write batch to SC store: %w", err)
+		}
+		if _, err := s.stateCommitment.Commit(version); err != nil {
+			return fmt.Errorf("failed to commit SC store: %w", err)
+		}
+	default:
+		return fmt.Errorf("the WAL version %d does not follow the SC version %d", version, scVersion)
+	}
+
+	// the SS backend is not committed to while migrating
+	if !s.isMigrating {
+		ssVersion, err := s.stateStorage.GetLatestVersion()
+		if err != nil {
+			return err
+		}
+		switch {
+		case ssVersion == version:
+		case ssVersion+1 == version || ssVersion == 0: // the first version may be the initial version
+			s.logger.Info("replaying WAL changeset to SS", "version", version)
+			if err := s.stateStorage.ApplyChangeset(version, cs); err != nil {
+				return fmt.Errorf("failed to commit SS: %w", err)
+			}
+		default:
+			return fmt.Errorf("the WAL version %d does not follow the SS version %d", version, ssVersion)
+		}
+	}
+
+	return s.wal.Clear()
+}
+
 // startMigration starts a migration process to migrate the RootStore/v1 to the
 // SS and SC backends of store/v2 and initializes the channels.
 // It runs in a separate goroutine and replaces the current RootStore with the
@@ -231,6 +231,66 @@ func (s *RootStoreTestSuite) TestQueryProof() {
 	s.Require().Equal(expRoots[0], cInfo.Hash())
 }
 
+func (s *RootStoreTestSuite) TestQueryRange() {
+	cs := corestore.NewChangeset()
+	cs.Add(testStoreKeyBytes, []byte("balance/a"), []byte("1"), false)
+	cs.Add(testStoreKeyBytes, []byte("balance/b"), []byte("2"), false)
+	cs.Add(testStoreKeyBytes, []byte("balance/c"), []byte("3"), false)
+	cs.Add(testStoreKeyBytes, []byte("supply"), []byte("6"), false)
+	cs.Add(testStoreKeyBytes, []byte("account"), []byte("x"), false)
+	cs.Add(testStoreKey2Bytes, []byte("balance/d"), []byte("4"), false)
+	_, err := s.rootStore.Commit(cs)
+	s.Require().NoError(err)
+
+	expected := []corestore.KVPair{
+		{Key: []byte("balance/a"), Value: []byte("1")},
+		{Key: []byte("balance/b"), Value: []byte("2")},
+		{Key: []byte("balance/c"), Value: []byte("3")},
+	}
+
+	// without proof, the pairs are read from SS
+	result, err := s.rootStore.QueryRange(testStoreKeyBytes, 1, []byte("balance/"), []byte("balance0"), 0, false)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, result.Pairs)
+	s.Require().Nil(result.RangeOp)
+
+	result, err = s.rootStore.QueryRange(testStoreKeyBytes, 1, []byte("balance/"), []byte("balance0"), 0, true)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, result.Pairs)
+	s.Require().NotNil(result.RangeOp)
+	s.Require().Len(result.ProofOps, 1)
+
+	// the pairs are proven against the store hash, which is proven against the commit hash
+	cInfo, err := s.rootStore.GetStateCommitment().GetCommitInfo(1)
+	s.Require().NoError(err)
+	storeHash := cInfo.GetStoreCommitID(testStoreKeyBytes).Hash
+	args := [][]byte{}
+	for _, pair := range expected {
+		args = append(args, pair.Key, pair.Value)
+	}
+	treeRoots, err := result.RangeOp.Run(args)
+	s.Require().NoError(err)
+	s.Require().Equal(storeHash, treeRoots[0])
+	expRoots, err := result.ProofOps[0].Run([][]byte{storeHash})
+	s.Require().NoError(err)
+	s.Require().Equal(cInfo.Hash(), expRoots[0])
+
+	// omitting a pair fails the verification
+	_, err = result.RangeOp.Run(args[2:])
+	s.Require().Error(err)
+
+	// with a limit, the range is cut before the key following the returned pairs
+	for _, prove := range []bool{false, true} {
+		result, err = s.rootStore.QueryRange(testStoreKeyBytes, 1, []byte("balance/"), []byte("balance0"), 2, prove)
+		s.Require().NoError(err)
+		s.Require().Equal(expected[:2], result.Pairs)
+		s.Require().Equal([]byte("balance/c"), result.End)
+	}
+	treeRoots, err = result.RangeOp.Run(args[:4])
+	s.Require().NoError(err)
+	s.Require().Equal(storeHash, treeRoots[0])
+}
+
 func (s *RootStoreTestSuite) TestLoadVersion() {
 	// write and commit a few changesets
 	for v := 1; v <= 5; v++ {
@@ -0,0 +1,136 @@
+package root
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2/internal/encoding"
+)
+
+const (
+	walFileName = "changeset.wal"
+	// walHeaderSize is the size of the version and the checksum preceding the
+	// encoded changeset in the WAL file.
+	walHeaderSize = 12
+)
+
+// WAL is a write-ahead log of the changeset being committed by the root Store.
+// The changeset is fsynced to the WAL before it is committed to the SS and SC
+// backends, and removed once both are committed. If the process dies in between,
+// the changeset is replayed to the backend(s) lagging behind when the latest
+// version is loaded.
+//
+// The WAL holds at most one changeset, written atomically by renaming a fsynced
+// temporary file, as a single version is committed at a time.
+type WAL struct {
+	dir string
+}
+
+// NewWAL returns a WAL stored in the given directory, which is created if it
+// does not exist.
+func NewWAL(dir string) (*WAL, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create WAL directory: %w", err)
+	}
+
+	return &WAL{dir: dir}, nil
+}
+
+func (w *WAL) path() string {
+	return filepath.Join(w.dir, walFileName)
+}
+
+// Write durably writes the changeset of the given version to the WAL, replacing
+// the previous one if any.
+func (w *WAL) Write(version uint64, cs *corestore.Changeset) error {
+	csBytes, err := encoding.MarshalChangeset(cs)
+	if err != nil {
+		return fmt.Errorf("failed to marshal changeset: %w", err)
+	}
+	bz := make([]byte, walHeaderSize, walHeaderSize+len(csBytes))
+	binary.BigEndian.PutUint64(bz, version)
+	binary.BigEndian.PutUint32(bz[8:], crc32.ChecksumIEEE(csBytes))
+	bz = append(bz, csBytes...)
+
+	tmpPath := w.path() + ".tmp"
+	f, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to create WAL file: %w", err)
+	}
+	if _, err := f.Write(bz); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write WAL file: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to sync WAL file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close WAL file: %w", err)
+	}
+	if err := os.Rename(tmpPath, w.path()); err != nil {
+		return fmt.Errorf("failed to rename WAL file: %w", err)
+	}
+
+	return w.syncDir()
+}
+
+// Read returns the changeset in the WAL and its version, or a nil changeset if
+// the WAL is empty.
+func (w *WAL) Read() (uint64, *corestore.Changeset, error) {
+	bz, err := os.ReadFile(w.path())
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil, nil
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read WAL file: %w", err)
+	}
+	if len(bz) < walHeaderSize {
+		return 0, nil, fmt.Errorf("WAL file is too short: %d bytes", len(bz))
+	}
+
+	version := binary.BigEndian.Uint64(bz)
+	checksum := binary.BigEndian.Uint32(bz[8:])
+	csBytes := bz[walHeaderSize:]
+	if crc32.ChecksumIEEE(csBytes) != checksum {
+		return 0, nil, fmt.Errorf("WAL changeset of version %d is corrupted", version)
+	}
+
+	cs := corestore.NewChangeset()
+	if err := encoding.UnmarshalChangeset(cs, csBytes); err != nil {
+		return 0, nil, fmt.Errorf("failed to unmarshal changeset: %w", err)
+	}
+
+	return version, cs, nil
+}
+
+// Clear removes the changeset from the WAL. It does not need to be durable, as
+// replaying a changeset committed to both backends is a no-op.
+func (w *WAL) Clear() error {
+	if err := os.Remove(w.path()); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove WAL file: %w", err)
+	}
+
+	return nil
+}
+
+// syncDir fsyncs the WAL directory so that the rename of the WAL file is durable.
+func (w *WAL) syncDir() error {
+	d, err := os.Open(w.dir)
+	if err != nil {
+		return fmt.Errorf("failed to open WAL directory: %w", err)
+	}
+	defer d.Close()
+
+	if err := d.Sync(); err != nil {
+		return fmt.Errorf("failed to sync WAL directory: %w", err)
+	}
+
+	return nil
+}
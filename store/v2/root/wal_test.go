@@ -0,0 +1,185 @@
+package root
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	corestore "cosmossdk.io/core/store"
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	"cosmossdk.io/store/v2/proof"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+var errCrash = errors.New("crash")
+
+// crashingSS simulates a process dying before the SS backend commits.
+type crashingSS struct {
+	store.VersionedDatabase
+}
+
+func (crashingSS) ApplyChangeset(uint64, *corestore.Changeset) error {
+	return errCrash
+}
+
+// crashingSC simulates a process dying before the SC backend commits.
+type crashingSC struct {
+	store.Committer
+}
+
+func (crashingSC) Commit(uint64) (*proof.CommitInfo, error) {
+	return nil, errCrash
+}
+
+func TestWAL(t *testing.T) {
+	wal, err := NewWAL(filepath.Join(t.TempDir(), "wal"))
+	require.NoError(t, err)
+
+	version, cs, err := wal.Read()
+	require.NoError(t, err)
+	require.Nil(t, cs)
+	require.Zero(t, version)
+
+	expected := corestore.NewChangeset()
+	expected.Add([]byte("store"), []byte("key"), []byte("value"), false)
+	expected.Add([]byte("store"), []byte("removed"), nil, true)
+	require.NoError(t, wal.Write(7, expected))
+
+	version, cs, err = wal.Read()
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), version)
+	require.Equal(t, expected.Changes, cs.Changes)
+
+	require.NoError(t, wal.Clear())
+	_, cs, err = wal.Read()
+	require.NoError(t, err)
+	require.Nil(t, cs)
+	require.NoError(t, wal.Clear())
+
+	// a corrupted changeset is detected
+	require.NoError(t, wal.Write(8, expected))
+	bz, err := os.ReadFile(wal.path())
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 0xff
+	require.NoError(t, os.WriteFile(wal.path(), bz, 0o600))
+	_, _, err = wal.Read()
+	require.Error(t, err)
+}
+
+func TestCommitRecoveryFromWAL(t *testing.T) {
+	testCases := []struct {
+		name             string
+		crashSS, crashSC bool
+		crashBeforeClear bool
+	}{
+		{name: "crash before committing", crashSS: true, crashSC: true},
+		{name: "crash after committing SC", crashSS: true},
+		{name: "crash after committing SS", crashSC: true},
+		{name: "crash before clearing the WAL", crashBeforeClear: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			noopLog := coretesting.NewNopLogger()
+			rootDir := t.TempDir()
+
+			sqliteDB, err := sqlite.New(rootDir)
+			require.NoError(t, err)
+			ss := storage.NewStorageStore(sqliteDB, noopLog)
+			scDB := dbm.NewMemDB()
+			newSC := func() *commitment.CommitStore {
+				multiTrees := make(map[string]commitment.Tree)
+				for _, storeKey := range testStoreKeys {
+					multiTrees[storeKey] = iavl.NewIavlTree(dbm.NewPrefixDB(scDB, []byte(storeKey)), noopLog, iavl.DefaultConfig())
+				}
+				sc, err := commitment.NewCommitStore(multiTrees, nil, scDB, noopLog)
+				require.NoError(t, err)
+				return sc
+			}
+			newRootStore := func(ss store.VersionedDatabase, sc store.Committer) *Store {
+				wal, err := NewWAL(filepath.Join(rootDir, "wal"))
+				require.NoError(t, err)
+				rs, err := New(noopLog, ss, sc, pruning.NewManager(nil, nil, nil, nil), nil, nil)
+				require.NoError(t, err)
+				rs.(*Store).SetWAL(wal)
+				require.NoError(t, rs.LoadLatestVersion())
+				return rs.(*Store)
+			}
+			changeset := func(version uint64) *corestore.Changeset {
+				cs := corestore.NewChangeset()
+				for _, storeKey := range testStoreKeys {
+					for i := 0; i < 10; i++ {
+						cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("val%03d_%d", i, version)), false)
+					}
+					cs.Add([]byte(storeKey), []byte(fmt.Sprintf("key%03d", 10+version)), nil, true)
+				}
+				return cs
+			}
+
+			// commit a few versions without any crash
+			rs := newRootStore(ss, newSC())
+			for version := uint64(1); version <= 3; version++ {
+				_, err := rs.Commit(changeset(version))
+				require.NoError(t, err)
+			}
+
+			// commit the next version, the process dying at the given stage
+			var crashSS store.VersionedDatabase = ss
+			if tc.crashSS {
+				crashSS = crashingSS{ss}
+			}
+			var crashSC store.Committer = newSC()
+			if tc.crashSC {
+				crashSC = crashingSC{crashSC}
+			}
+			rs = newRootStore(crashSS, crashSC)
+			_, err = rs.Commit(changeset(4))
+			if tc.crashBeforeClear {
+				require.NoError(t, err)
+				require.NoError(t, rs.wal.Write(4, changeset(4)))
+			} else {
+				require.ErrorIs(t, err, errCrash)
+			}
+
+			// restart, the WAL brings both backends to the version being committed
+			sc := newSC()
+			rs = newRootStore(ss, sc)
+			version, err := rs.GetLatestVersion()
+			require.NoError(t, err)
+			require.Equal(t, uint64(4), version)
+			ssVersion, err := ss.GetLatestVersion()
+			require.NoError(t, err)
+			require.Equal(t, uint64(4), ssVersion)
+			_, cs, err := rs.wal.Read()
+			require.NoError(t, err)
+			require.Nil(t, cs)
+
+			for _, storeKey := range testStoreKeys {
+				for i := 0; i < 10; i++ {
+					key := []byte(fmt.Sprintf("key%03d", i))
+					expected := []byte(fmt.Sprintf("val%03d_4", i))
+					val, err := ss.Get([]byte(storeKey), 4, key)
+					require.NoError(t, err)
+					require.Equal(t, expected, val)
+					val, err = sc.Get([]byte(storeKey), 4, key)
+					require.NoError(t, err)
+					require.Equal(t, expected, val)
+				}
+			}
+
+			// the backends are in sync, so the next version commits with the expected hash
+			_, err = rs.Commit(changeset(5))
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package snapshots_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/store/v2/snapshots"
+	"cosmossdk.io/store/v2/snapshots/types"
+)
+
+func TestManager_DeltaChain(t *testing.T) {
+	store, err := snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	commitSnapshotter := &mockCommitSnapshotter{items: [][]byte{{1, 2, 3}}}
+	manager := snapshots.NewManager(store, opts, commitSnapshotter, &mockStorageSnapshotter{}, nil, coretesting.NewNopLogger())
+
+	// a delta snapshot needs a snapshot at its base height
+	_, err = manager.CreateDelta(5, 10)
+	require.ErrorIs(t, err, types.ErrInvalidDeltaChain)
+	_, err = manager.CreateDelta(10, 10)
+	require.Error(t, err)
+
+	_, err = manager.Create(5)
+	require.NoError(t, err)
+	commitSnapshotter.items = [][]byte{{4, 5, 6}}
+	delta, err := manager.CreateDelta(5, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), delta.Height)
+	require.Equal(t, types.DeltaFormat, delta.Format)
+	commitSnapshotter.items = [][]byte{{7, 8, 9}}
+	_, err = manager.CreateDelta(10, 15)
+	require.NoError(t, err)
+
+	// delta snapshots are not offered to state syncing nodes
+	list, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, uint64(5), list[0].Height)
+
+	base, err := store.DeltaBase(15)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), base)
+
+	chain, err := manager.VerifyDeltaChain(15)
+	require.NoError(t, err)
+	require.Len(t, chain, 3)
+	require.Equal(t, uint64(5), chain[0].Height)
+	require.Equal(t, types.CurrentFormat, chain[0].Format)
+	require.Equal(t, uint64(10), chain[1].Height)
+	require.Equal(t, types.DeltaFormat, chain[1].Format)
+	require.Equal(t, uint64(15), chain[2].Height)
+	require.Equal(t, types.DeltaFormat, chain[2].Format)
+
+	// restoring the last delta restores the whole chain
+	target := &mockCommitSnapshotter{}
+	targetManager := snapshots.NewManager(store, opts, target, &mockStorageSnapshotter{items: map[string][]byte{}}, nil, coretesting.NewNopLogger())
+	require.NoError(t, targetManager.RestoreLocalSnapshot(15, types.DeltaFormat))
+	require.Equal(t, [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, target.items)
+
+	// a missing base breaks the chain
+	require.NoError(t, store.Delete(5, types.CurrentFormat))
+	_, err = manager.DeltaChain(15)
+	require.ErrorIs(t, err, types.ErrInvalidDeltaChain)
+
+	// a corrupted chunk breaks the chain verification
+	require.NoError(t, os.WriteFile(store.PathChunk(10, types.DeltaFormat, 0), []byte{0}, 0o600))
+	_, err = manager.VerifyDeltaChain(15)
+	require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+}
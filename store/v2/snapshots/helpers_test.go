@@ -161,6 +161,32 @@ func (m *mockCommitSnapshotter) Snapshot(height uint64, protoWriter protoio.Writ
 	return nil
 }
 
+func (m *mockCommitSnapshotter) SnapshotDelta(baseHeight, height uint64, protoWriter protoio.Writer) error {
+	return m.Snapshot(height, protoWriter)
+}
+
+func (m *mockCommitSnapshotter) RestoreDelta(
+	baseHeight, height uint64, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges,
+) (snapshotstypes.SnapshotItem, error) {
+	var item snapshotstypes.SnapshotItem
+	for {
+		item.Reset()
+		err := protoReader.ReadMsg(&item)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return snapshotstypes.SnapshotItem{}, fmt.Errorf("invalid protobuf message: %w", err)
+		}
+		payload := item.GetExtensionPayload()
+		if payload == nil {
+			break
+		}
+		m.items = append(m.items, payload.Payload)
+	}
+
+	return item, nil
+}
+
 func (m *mockCommitSnapshotter) SnapshotFormat() uint32 {
 	return snapshotstypes.CurrentFormat
 }
@@ -10,11 +10,13 @@ import (
 	"os"
 	"sort"
 	"sync"
+	"time"
 
 	corelog "cosmossdk.io/core/log"
 	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors/v2"
 	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/metrics"
 	"cosmossdk.io/store/v2/snapshots/types"
 )
 
@@ -42,6 +44,8 @@ type Manager struct {
 	storageSnapshotter StorageSnapshotter
 
 	logger corelog.Logger
+	// telemetry reflects a telemetry agent responsible for emitting metrics (if any)
+	telemetry metrics.StoreMetrics
 
 	mtx               sync.Mutex
 	operation         operation
@@ -90,6 +94,11 @@ func NewManager(store *Store, opts SnapshotOptions, commitSnapshotter CommitSnap
 	}
 }
 
+// SetMetrics sets the telemetry handler on the Manager.
+func (m *Manager) SetMetrics(telemetry metrics.StoreMetrics) {
+	m.telemetry = telemetry
+}
+
 // RegisterExtensions register extension snapshotters to manager
 func (m *Manager) RegisterExtensions(extensions ...ExtensionSnapshotter) error {
 	if m.extensions == nil {
@@ -185,23 +194,37 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	start := time.Now()
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
 	go m.createSnapshot(height, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	snapshot, err := m.store.Save(height, types.CurrentFormat, ch)
+	if err != nil {
+		return nil, err
+	}
+	if m.telemetry != nil {
+		m.telemetry.MeasureSince(start, "snapshot", "create")
+	}
+
+	return snapshot, nil
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
 // the produced chunks are written to the channel.
 func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
-	streamWriter := NewStreamWriter(ch)
+	streamWriter := NewRateLimitedStreamWriter(ch, m.opts.RateLimit)
 	if streamWriter == nil {
 		return
 	}
 	defer func() {
 		if err := streamWriter.Close(); err != nil {
 			streamWriter.CloseWithError(err)
+			return
+		}
+		if m.telemetry != nil {
+			m.telemetry.IncrCounter(float32(streamWriter.Written()), "snapshot", "bytes_written")
 		}
 	}()
 
@@ -209,6 +232,15 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		streamWriter.CloseWithError(err)
 		return
 	}
+	if err := m.writeExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+}
+
+// writeExtensions writes the metadata and payloads of every extension at the given
+// height, in name order.
+func (m *Manager) writeExtensions(height uint64, streamWriter *StreamWriter) error {
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
@@ -221,17 +253,16 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 		payloadWriter := func(payload []byte) error {
 			return types.WriteExtensionPayload(streamWriter, payload)
 		}
 		if err := extension.SnapshotExtension(height, payloadWriter); err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
 	}
+	return nil
 }
 
 // CreateMigration creates a migration snapshot and writes it to the given writer.
@@ -266,8 +297,19 @@ func (m *Manager) EndMigration(commitSnapshotter CommitSnapshotter) {
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
+// Delta snapshots are omitted, as they cannot be restored by a state syncing node.
 func (m *Manager) List() ([]*types.Snapshot, error) {
-	return m.store.List()
+	snapshots, err := m.store.List()
+	if err != nil {
+		return nil, err
+	}
+	full := snapshots[:0]
+	for _, snapshot := range snapshots {
+		if !types.IsDeltaFormat(snapshot.Format) {
+			full = append(full, snapshot)
+		}
+	}
+	return full, nil
 }
 
 // LoadChunk loads a chunk into a byte slice, mirroring ABCI LoadChunk. It can be called
@@ -410,7 +452,11 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		}
 	}()
 
-	nextItem, err = m.commitSnapshotter.Restore(snapshot.Height, snapshot.Format, streamReader, chStorage)
+	if types.IsDeltaFormat(snapshot.Format) {
+		nextItem, err = m.restoreDelta(snapshot.Height, streamReader, chStorage)
+	} else {
+		nextItem, err = m.commitSnapshotter.Restore(snapshot.Height, snapshot.Format, streamReader, chStorage)
+	}
 	if err != nil {
 		return errorsmod.Wrap(err, "multistore restore")
 	}
@@ -514,7 +560,8 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	return false, nil
 }
 
-// RestoreLocalSnapshot restores app state from a local snapshot.
+// RestoreLocalSnapshot restores app state from a local snapshot. A delta snapshot is
+// restored along with the full and delta snapshots it is chained on.
 func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 	snapshot, ch, err := m.store.Load(height, format)
 	if err != nil {
@@ -530,10 +577,16 @@ func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
 
 	err = m.beginLocked(opRestore)
 	if err != nil {
+		DrainChunks(ch)
 		return err
 	}
 	defer m.endLocked()
 
+	if types.IsDeltaFormat(format) {
+		// the delta snapshot is restored with the snapshots it is chained on.
+		DrainChunks(ch)
+		return m.restoreLocalDeltaChain(height)
+	}
 	return m.doRestoreSnapshot(*snapshot, ch)
 }
 
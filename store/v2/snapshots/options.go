@@ -8,6 +8,10 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// RateLimit defines the maximum number of bytes per second written to the
+	// snapshot chunks while creating a snapshot. If 0, the rate is not limited.
+	RateLimit uint64
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
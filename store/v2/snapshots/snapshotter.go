@@ -17,6 +17,17 @@ type CommitSnapshotter interface {
 	Restore(version uint64, format uint32, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges) (types.SnapshotItem, error)
 }
 
+// CommitDeltaSnapshotter defines an API for creating and restoring delta snapshots
+// of the commitment state, which only contain the changes committed after a base version.
+type CommitDeltaSnapshotter interface {
+	// SnapshotDelta writes the changes committed after baseVersion up to version.
+	SnapshotDelta(baseVersion, version uint64, protoWriter protoio.Writer) error
+
+	// RestoreDelta applies the changes from the snapshot reader on top of the
+	// commitment state at baseVersion, committing every version up to version.
+	RestoreDelta(baseVersion, version uint64, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges) (types.SnapshotItem, error)
+}
+
 // StorageSnapshotter defines an API for restoring snapshots of the storage state.
 type StorageSnapshotter interface {
 	// Restore restores the storage state from the given channel.
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"compress/zlib"
 	"io"
+	"time"
 
 	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/cosmos/gogoproto/proto"
@@ -27,19 +28,28 @@ type WriteCloser interface {
 }
 
 // StreamWriter set up a stream pipeline to serialize snapshot nodes:
-// Exported Items -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
+// Exported Items -> delimited Protobuf -> zlib -> throttle -> buffer -> chunkWriter -> chan io.ReadCloser
 type StreamWriter struct {
 	chunkWriter *ChunkWriter
 	bufWriter   *bufio.Writer
+	throttle    *throttledWriter
 	zWriter     *zlib.Writer
 	protoWriter protoio.WriteCloser
 }
 
 // NewStreamWriter set up a stream pipeline to serialize snapshot DB records.
 func NewStreamWriter(ch chan<- io.ReadCloser) *StreamWriter {
+	return NewRateLimitedStreamWriter(ch, 0)
+}
+
+// NewRateLimitedStreamWriter set up a stream pipeline to serialize snapshot DB records,
+// which writes at most bytesPerSecond compressed bytes per second. If bytesPerSecond
+// is 0, the rate is not limited.
+func NewRateLimitedStreamWriter(ch chan<- io.ReadCloser, bytesPerSecond uint64) *StreamWriter {
 	chunkWriter := NewChunkWriter(ch, snapshotChunkSize)
 	bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
-	zWriter, err := zlib.NewWriterLevel(bufWriter, snapshotCompressionLevel)
+	throttle := newThrottledWriter(bufWriter, bytesPerSecond)
+	zWriter, err := zlib.NewWriterLevel(throttle, snapshotCompressionLevel)
 	if err != nil {
 		chunkWriter.CloseWithError(errors.Wrap(err, "zlib failure"))
 		return nil
@@ -48,11 +58,17 @@ func NewStreamWriter(ch chan<- io.ReadCloser) *StreamWriter {
 	return &StreamWriter{
 		chunkWriter: chunkWriter,
 		bufWriter:   bufWriter,
+		throttle:    throttle,
 		zWriter:     zWriter,
 		protoWriter: protoWriter,
 	}
 }
 
+// Written returns the number of compressed bytes written so far.
+func (sw *StreamWriter) Written() uint64 {
+	return sw.throttle.written
+}
+
 // WriteMsg implements protoio.Write interface
 func (sw *StreamWriter) WriteMsg(msg proto.Message) error {
 	return sw.protoWriter.WriteMsg(msg)
@@ -76,6 +92,36 @@ func (sw *StreamWriter) CloseWithError(err error) {
 	sw.chunkWriter.CloseWithError(err)
 }
 
+// throttledWriter is an io.Writer which counts the bytes written to the underlying
+// writer, and sleeps as needed to not exceed the given rate.
+type throttledWriter struct {
+	w              io.Writer
+	bytesPerSecond uint64
+	start          time.Time
+	written        uint64
+}
+
+func newThrottledWriter(w io.Writer, bytesPerSecond uint64) *throttledWriter {
+	return &throttledWriter{
+		w:              w,
+		bytesPerSecond: bytesPerSecond,
+		start:          time.Now(),
+	}
+}
+
+// Write implements io.Writer interface
+func (t *throttledWriter) Write(p []byte) (int, error) {
+	n, err := t.w.Write(p)
+	t.written += uint64(n)
+	if t.bytesPerSecond > 0 {
+		expected := time.Duration(float64(t.written) / float64(t.bytesPerSecond) * float64(time.Second))
+		if elapsed := time.Since(t.start); expected > elapsed {
+			time.Sleep(expected - elapsed)
+		}
+	}
+	return n, err
+}
+
 // StreamReader set up a restore stream pipeline
 // chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
 type StreamReader struct {
@@ -0,0 +1,47 @@
+package snapshots_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/v2/snapshots"
+	snapshotstypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+func TestStreamWriter_RateLimit(t *testing.T) {
+	write := func(bytesPerSecond uint64) (uint64, time.Duration) {
+		ch := make(chan io.ReadCloser, 100)
+		start := time.Now()
+		streamWriter := snapshots.NewRateLimitedStreamWriter(ch, bytesPerSecond)
+		require.NotNil(t, streamWriter)
+		go func() {
+			defer streamWriter.Close()
+			for i := 0; i < 10; i++ {
+				// random-like payloads are barely compressible
+				value := make([]byte, 1024)
+				for j := range value {
+					value[j] = byte((i*31 + j*j*7) % 251)
+				}
+				require.NoError(t, streamWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+					Item: &snapshotstypes.SnapshotItem_IAVL{
+						IAVL: &snapshotstypes.SnapshotIAVLItem{Key: []byte{byte(i)}, Value: value},
+					},
+				}))
+			}
+		}()
+		readChunks(ch)
+		return streamWriter.Written(), time.Since(start)
+	}
+
+	written, _ := write(0)
+	require.NotZero(t, written)
+
+	// write at a rate which takes roughly half a second
+	bytesPerSecond := written * 2
+	limitedWritten, elapsed := write(bytesPerSecond)
+	require.Equal(t, written, limitedWritten)
+	require.GreaterOrEqual(t, elapsed, 400*time.Millisecond)
+}
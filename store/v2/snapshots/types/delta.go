@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	protoio "github.com/cosmos/gogoproto/io"
+)
+
+const (
+	// DeltaHeaderName is the name of the first item of a delta snapshot stream, which
+	// is followed by a payload holding the big endian encoded base height.
+	DeltaHeaderName = "delta"
+
+	// DeltaRemoveHeight is the height of the IAVL items of a delta snapshot which remove
+	// a key. The items which set a key have a height of 0, and their version is the
+	// height at which the change was committed.
+	DeltaRemoveHeight int32 = -1
+)
+
+// WriteDeltaHeader writes the header of a delta snapshot, recording the base height
+// the delta applies on.
+func WriteDeltaHeader(protoWriter protoio.Writer, baseHeight uint64) error {
+	err := protoWriter.WriteMsg(&SnapshotItem{
+		Item: &SnapshotItem_Extension{
+			Extension: &SnapshotExtensionMeta{
+				Name:   DeltaHeaderName,
+				Format: DeltaFormat,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return WriteExtensionPayload(protoWriter, Uint64ToBigEndian(baseHeight))
+}
+
+// ReadDeltaHeader reads the header of a delta snapshot and returns its base height.
+func ReadDeltaHeader(protoReader protoio.Reader) (uint64, error) {
+	var item SnapshotItem
+	if err := protoReader.ReadMsg(&item); err != nil {
+		return 0, fmt.Errorf("failed to read delta snapshot header: %w", err)
+	}
+	header := item.GetExtension()
+	if header == nil || header.Name != DeltaHeaderName || header.Format != DeltaFormat {
+		return 0, fmt.Errorf("%w: missing delta snapshot header", ErrInvalidMetadata)
+	}
+
+	item.Reset()
+	if err := protoReader.ReadMsg(&item); err != nil {
+		return 0, fmt.Errorf("failed to read delta snapshot base height: %w", err)
+	}
+	payload := item.GetExtensionPayload()
+	if payload == nil || len(payload.Payload) != 8 {
+		return 0, fmt.Errorf("%w: invalid delta snapshot base height", ErrInvalidMetadata)
+	}
+	return BigEndianToUint64(payload.Payload), nil
+}
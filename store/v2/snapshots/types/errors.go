@@ -16,4 +16,7 @@ var (
 
 	// ErrInvalidSnapshotVersion is returned when the snapshot version is invalid
 	ErrInvalidSnapshotVersion = errors.New("invalid snapshot version")
+
+	// ErrInvalidDeltaChain is returned when a delta snapshot does not chain to a full snapshot.
+	ErrInvalidDeltaChain = errors.New("invalid delta snapshot chain")
 )
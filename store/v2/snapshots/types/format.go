@@ -4,3 +4,14 @@ package types
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
 const CurrentFormat uint32 = 3
+
+// DeltaFormat is the format used for delta snapshots, which only contain the changes
+// committed after the height of a base snapshot. Delta snapshots are versioned apart
+// from full snapshots, this must never match a full snapshot format so that delta
+// snapshots are not restored by state sync peers, which expect full snapshots.
+const DeltaFormat uint32 = 1000
+
+// IsDeltaFormat returns true if the given format is the format of delta snapshots.
+func IsDeltaFormat(format uint32) bool {
+	return format == DeltaFormat
+}
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	"cosmossdk.io/store/v2"
+)
+
+var _ store.Batch = (*Batch)(nil)
+
+type batchAction int
+
+const (
+	batchActionSet batchAction = 0
+	batchActionDel batchAction = 1
+)
+
+type batchOp struct {
+	action     batchAction
+	storeKey   []byte
+	key, value []byte
+}
+
+// Batch buffers the writes of a version and applies them, alongside the latest
+// version, in a single SQL transaction on Write.
+type Batch struct {
+	db      *sql.DB
+	ops     []batchOp
+	size    int
+	version uint64
+}
+
+func NewBatch(db *sql.DB, version uint64) (*Batch, error) {
+	return &Batch{
+		db:      db,
+		ops:     make([]batchOp, 0),
+		version: version,
+	}, nil
+}
+
+func (b *Batch) Size() int {
+	return b.size
+}
+
+func (b *Batch) Reset() error {
+	b.ops = make([]batchOp, 0)
+	b.size = 0
+	return nil
+}
+
+func (b *Batch) Set(storeKey, key, value []byte) error {
+	// the driver sends a nil slice as NULL, which the value column rejects
+	if value == nil {
+		value = []byte{}
+	}
+	b.size += len(key) + len(value)
+	b.ops = append(b.ops, batchOp{action: batchActionSet, storeKey: storeKey, key: key, value: value})
+	return nil
+}
+
+func (b *Batch) Delete(storeKey, key []byte) error {
+	b.size += len(key)
+	b.ops = append(b.ops, batchOp{action: batchActionDel, storeKey: storeKey, key: key})
+	return nil
+}
+
+func (b *Batch) Write() (err error) {
+	tx, err := b.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to create SQL transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err = setReservedHeight(tx, keyLatestHeight, b.version); err != nil {
+		return err
+	}
+
+	upsert, err := tx.Prepare(upsertStmt)
+	if err != nil {
+		return fmt.Errorf("failed to prepare SQL statement: %w", err)
+	}
+	defer upsert.Close()
+	del, err := tx.Prepare(delStmt)
+	if err != nil {
+		return fmt.Errorf("failed to prepare SQL statement: %w", err)
+	}
+	defer del.Close()
+
+	for _, op := range b.ops {
+		switch op.action {
+		case batchActionSet:
+			if _, err = upsert.Exec(op.storeKey, op.key, op.value, b.version); err != nil {
+				return fmt.Errorf("failed to exec SQL statement: %w", err)
+			}
+
+		case batchActionDel:
+			if _, err = del.Exec(b.version, op.storeKey, op.key, b.version); err != nil {
+				return fmt.Errorf("failed to exec SQL statement: %w", err)
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to write SQL transaction: %w", err)
+	}
+
+	return nil
+}
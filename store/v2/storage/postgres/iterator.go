@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	corestore "cosmossdk.io/core/store"
+)
+
+var _ corestore.Iterator = (*iterator)(nil)
+
+type iterator struct {
+	rows       *sql.Rows
+	key, val   []byte
+	start, end []byte
+	valid      bool
+	err        error
+}
+
+func newIterator(db *Database, storeKey []byte, targetVersion uint64, start, end []byte, reverse bool) (*iterator, error) {
+	if targetVersion < db.earliestVersion {
+		return &iterator{
+			start: start,
+			end:   end,
+			valid: false,
+		}, nil
+	}
+
+	var (
+		keyClause = []string{"store_key = $1", "version <= $2"}
+		queryArgs = []any{storeKey, targetVersion}
+	)
+	if len(start) > 0 {
+		queryArgs = append(queryArgs, start)
+		keyClause = append(keyClause, fmt.Sprintf("key >= $%d", len(queryArgs)))
+	}
+	if len(end) > 0 {
+		queryArgs = append(queryArgs, end)
+		keyClause = append(keyClause, fmt.Sprintf("key < $%d", len(queryArgs)))
+	}
+	queryArgs = append(queryArgs, targetVersion)
+
+	orderBy := "ASC"
+	if reverse {
+		orderBy = "DESC"
+	}
+
+	// Note, this is not susceptible to SQL injection because placeholders are used
+	// for parts of the query outside the store's direct control.
+	rows, err := db.storage.Query(fmt.Sprintf(`
+	SELECT x.key, x.value
+	FROM (
+		SELECT key, value, version, tombstone,
+			row_number() OVER (PARTITION BY key ORDER BY version DESC) AS _rn
+			FROM state_storage WHERE %s
+		) x
+	WHERE x._rn = 1 AND (x.tombstone = 0 OR x.tombstone > $%d) ORDER BY x.key %s;
+	`, strings.Join(keyClause, " AND "), len(queryArgs), orderBy), queryArgs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	itr := &iterator{
+		rows:  rows,
+		start: start,
+		end:   end,
+		valid: rows.Next(),
+	}
+	if !itr.valid {
+		// the range is empty, any failure of the query is reported by rows.Err()
+		return itr, nil
+	}
+
+	// read the first row
+	itr.parseRow()
+	return itr, nil
+}
+
+func (itr *iterator) Close() (err error) {
+	if itr.rows != nil {
+		err = itr.rows.Close()
+	}
+
+	itr.valid = false
+	itr.rows = nil
+
+	return err
+}
+
+// Domain returns the domain of the iterator. The caller must not modify the
+// return values.
+func (itr *iterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+func (itr *iterator) Key() []byte {
+	itr.assertIsValid()
+	return slices.Clone(itr.key)
+}
+
+func (itr *iterator) Value() []byte {
+	itr.assertIsValid()
+	return slices.Clone(itr.val)
+}
+
+func (itr *iterator) Valid() bool {
+	if !itr.valid || itr.rows.Err() != nil {
+		itr.valid = false
+		return itr.valid
+	}
+
+	// if key is at the end or past it, consider it invalid
+	if end := itr.end; end != nil {
+		if bytes.Compare(end, itr.Key()) <= 0 {
+			itr.valid = false
+			return itr.valid
+		}
+	}
+
+	return true
+}
+
+func (itr *iterator) Next() {
+	if itr.rows.Next() {
+		itr.parseRow()
+		return
+	}
+
+	itr.valid = false
+}
+
+func (itr *iterator) Error() error {
+	if itr.rows != nil {
+		if err := itr.rows.Err(); err != nil {
+			return err
+		}
+	}
+
+	return itr.err
+}
+
+func (itr *iterator) parseRow() {
+	var (
+		key   []byte
+		value []byte
+	)
+	if err := itr.rows.Scan(&key, &value); err != nil {
+		itr.err = fmt.Errorf("failed to scan row: %w", err)
+		itr.valid = false
+		return
+	}
+
+	itr.key = key
+	itr.val = value
+}
+
+func (itr *iterator) assertIsValid() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+}
@@ -86,7 +86,7 @@ func newIterator(db *Database, storeKey []byte, targetVersion uint64, start, end
 		valid:     rows.Next(),
 	}
 	if !itr.valid {
-		itr.err = fmt.Errorf("iterator invalid: %w", sql.ErrNoRows)
+		// the range is empty, any failure of the query is reported by rows.Err()
 		return itr, nil
 	}
 
@@ -116,7 +116,11 @@ func (ss *StorageStore) Restore(version uint64, chStorage <-chan *corestore.Stat
 
 	for kvPair := range chStorage {
 		for _, kv := range kvPair.StateChanges {
-			if err := b.Set(kvPair.Actor, kv.Key, kv.Value); err != nil {
+			if kv.Remove {
+				if err := b.Delete(kvPair.Actor, kv.Key); err != nil {
+					return err
+				}
+			} else if err := b.Set(kvPair.Actor, kv.Key, kv.Value); err != nil {
 				return err
 			}
 			if b.Size() > defaultBatchBufferSize {
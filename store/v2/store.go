@@ -31,6 +31,14 @@ type RootStore interface {
 	// and key tuple. Queries should be routed to the underlying SS engine.
 	Query(storeKey []byte, version uint64, key []byte, prove bool) (QueryResult, error)
 
+	// QueryRange performs a query on the RootStore of all the key/value pairs of
+	// the [start, end) key range of a store at the given version (height). If limit
+	// is not 0 and the range holds more pairs, only the first limit pairs are returned
+	// and the End of the result is set to the key following them, so that it can be
+	// used as the start of the next query. If prove is true, a proof of the
+	// completeness of the pairs of the [Start, End) range of the result is returned.
+	QueryRange(storeKey []byte, version uint64, start, end []byte, limit uint64, prove bool) (RangeQueryResult, error)
+
 	// LoadVersion loads the RootStore to the given version.
 	LoadVersion(version uint64) error
 
@@ -106,3 +114,15 @@ type QueryResult struct {
 	Version  uint64
 	ProofOps []proof.CommitmentOp
 }
+
+// RangeQueryResult defines the response type to performing a range query on a
+// RootStore. When a proof is requested, RangeOp proves the pairs against the root
+// of the store, and ProofOps prove the root of the store against the commit hash.
+type RangeQueryResult struct {
+	Start    []byte
+	End      []byte
+	Pairs    []corestore.KVPair
+	Version  uint64
+	RangeOp  *proof.RangeOp
+	ProofOps []proof.CommitmentOp
+}
@@ -10,14 +10,17 @@ import (
 
 	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	sdkmath "cosmossdk.io/math"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
 	"cosmossdk.io/x/bank/testutil"
 	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/tx/signing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/testutil/integration"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -166,3 +169,47 @@ func TestAsyncExec(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateAccount(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t, nil)
+
+	newAccount := func(sequence uint64) (sdk.AccountI, *codectypes.Any) {
+		pubKey := secp256k1.GenPrivKey().PubKey()
+		acc := f.authKeeper.NewAccountWithAddress(f.ctx, sdk.AccAddress(pubKey.Address()))
+		assert.NilError(t, acc.SetSequence(sequence))
+		f.authKeeper.SetAccount(f.ctx, acc)
+
+		pkAny, err := codectypes.NewAnyWithValue(pubKey)
+		assert.NilError(t, err)
+		return acc, pkAny
+	}
+
+	migrateMsg := func(acc sdk.AccountI, pkAny *codectypes.Any) *authtypes.MsgMigrateAccount {
+		initMsg, err := codectypes.NewAnyWithValue(&basev1.MsgInit{PubKey: pkAny})
+		assert.NilError(t, err)
+		return &authtypes.MsgMigrateAccount{
+			Signer:         f.mustAddr(acc.GetAddress()),
+			AccountType:    "base",
+			AccountInitMsg: initMsg,
+		}
+	}
+
+	// x/auth sets the init sequence of the base account to the sequence of the migrated account
+	acc, pkAny := newAccount(3)
+	_, err := f.app.RunMsg(migrateMsg(acc, pkAny), integration.WithAutomaticFinalizeBlock(), integration.WithAutomaticCommit())
+	assert.NilError(t, err)
+
+	assert.Assert(t, f.authKeeper.GetAccount(f.ctx, acc.GetAddress()) == nil)
+	assert.Assert(t, f.accountsKeeper.IsAccountsModuleAccount(f.ctx, acc.GetAddress()))
+
+	// the migrated account is still returned by x/auth
+	info, err := authkeeper.NewQueryServer(f.authKeeper).AccountInfo(f.ctx, &authtypes.QueryAccountInfoRequest{Address: f.mustAddr(acc.GetAddress())})
+	assert.NilError(t, err)
+	assert.Equal(t, acc.GetAccountNumber(), info.Info.AccountNumber)
+	assert.Equal(t, uint64(3), info.Info.Sequence)
+
+	// the account can only be migrated once
+	_, err = f.app.RunMsg(migrateMsg(acc, pkAny), integration.WithAutomaticFinalizeBlock(), integration.WithAutomaticCommit())
+	assert.ErrorContains(t, err, "account not found")
+}
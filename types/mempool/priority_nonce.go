@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"math/big"
+	"strconv"
 	"sync"
 
 	"github.com/huandu/skiplist"
@@ -29,9 +31,16 @@ type (
 
 		// TxReplacement is a callback to be called when duplicated transaction nonce
 		// detected during mempool insert. An application can define a transaction
-		// replacement rule based on tx priority or certain transaction fields.
+		// replacement rule based on tx priority or certain transaction fields, e.g.
+		// the replace-by-fee rule of NewReplaceByFeeTxReplacement.
 		TxReplacement func(op, np C, oTx, nTx sdk.Tx) bool
 
+		// OnEvict is a callback to be called when a tx is evicted from the mempool,
+		// either replaced by a tx with the same sender and nonce, or dropped to make
+		// room for a higher priority tx. An EventTypeEvictTx event is emitted for
+		// each evicted tx regardless of this callback.
+		OnEvict func(tx sdk.Tx, reason EvictionReason)
+
 		// MaxTx sets the maximum number of transactions allowed in the mempool with
 		// the semantics:
 		// - if MaxTx == 0, there is no cap on the number of transactions in the mempool
@@ -41,6 +50,11 @@ type (
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
 
+		// EvictionPolicy defines what happens when a tx is inserted while the mempool
+		// holds MaxTx transactions. Replacing a tx with the same sender and nonce is
+		// always allowed, as it does not grow the mempool.
+		EvictionPolicy EvictionPolicy
+
 		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
 		SignerExtractor SignerExtractionAdapter
 	}
@@ -103,6 +117,70 @@ type (
 	}
 )
 
+// EvictionPolicy defines the policy of the PriorityNonceMempool when it is full.
+type EvictionPolicy uint8
+
+const (
+	// EvictionPolicyRejectNew rejects the inserted tx with ErrMempoolTxMaxCapacity.
+	EvictionPolicyRejectNew EvictionPolicy = iota
+	// EvictionPolicyDropLowestPriority evicts the lowest priority tx of the mempool
+	// if the inserted tx has a higher priority, and rejects the inserted tx otherwise.
+	// The txs of the evicted tx sender with a higher nonce are kept, and are
+	// expected to be removed once they fail to be re-checked.
+	EvictionPolicyDropLowestPriority
+)
+
+// EventTypeEvictTx is the type of the event emitted on the sdk.Context given to
+// Insert when a tx is evicted from the PriorityNonceMempool. Its attributes are
+// the sender and sequence of the evicted tx, and the EvictionReason.
+const (
+	EventTypeEvictTx           = "evict_tx"
+	AttributeKeyEvictionReason = "reason"
+)
+
+// EvictionReason defines why a tx was evicted from the PriorityNonceMempool.
+type EvictionReason uint8
+
+const (
+	// EvictionReasonReplaced means the tx was replaced by a tx with the same sender
+	// and nonce.
+	EvictionReasonReplaced EvictionReason = iota
+	// EvictionReasonCapacity means the tx was dropped as the mempool was full.
+	EvictionReasonCapacity
+)
+
+// String implements fmt.Stringer.
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictionReasonReplaced:
+		return "replaced"
+	case EvictionReasonCapacity:
+		return "capacity"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(r))
+	}
+}
+
+// NewReplaceByFeeTxReplacement returns a TxReplacement rule for int64 priorities,
+// e.g. the fee per gas unit of NewDefaultTxPriority, accepting a tx replacing a tx
+// with the same sender and nonce only if its priority is higher by at least
+// minBumpPercent percent.
+func NewReplaceByFeeTxReplacement(minBumpPercent uint64) func(op, np int64, oTx, nTx sdk.Tx) bool {
+	bump := new(big.Int).SetUint64(minBumpPercent)
+	return func(op, np int64, _, _ sdk.Tx) bool {
+		if np <= op {
+			return false
+		}
+
+		// np*100 >= op*100 + |op|*minBumpPercent, computed with big ints to not overflow
+		oldPriority := big.NewInt(op)
+		minPriority := new(big.Int).Mul(oldPriority, big.NewInt(100))
+		minPriority.Add(minPriority, new(big.Int).Mul(new(big.Int).Abs(oldPriority), bump))
+		newPriority := new(big.Int).Mul(big.NewInt(np), big.NewInt(100))
+		return newPriority.Cmp(minPriority) >= 0
+	}
+}
+
 // NewDefaultTxPriority returns a TxPriority comparator using ctx.Priority as
 // the defining transaction priority.
 func NewDefaultTxPriority() TxPriority[int64] {
@@ -202,12 +280,13 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 //
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
 // changing the total order of the mempool.
+//
+// If the mempool is full, the tx is rejected or the lowest priority tx is evicted
+// depending on the EvictionPolicy.
 func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
-	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
-		return ErrMempoolTxMaxCapacity
-	} else if mp.cfg.MaxTx < 0 {
+	if mp.cfg.MaxTx < 0 {
 		return nil
 	}
 
@@ -234,6 +313,14 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	}
 
 	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
+	sk := txMeta[C]{nonce: nonce, sender: sender}
+
+	// a tx replacing a tx with the same sender and nonce does not need any room
+	if _, txExists := mp.scores[sk]; !txExists && mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
+		if err := mp.evictLowestPriority(ctx, priority); err != nil {
+			return err
+		}
+	}
 
 	senderIndex, ok := mp.senderIndices[sender]
 	if !ok {
@@ -252,17 +339,21 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	//
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
-	sk := txMeta[C]{nonce: nonce, sender: sender}
 	if oldScore, txExists := mp.scores[sk]; txExists {
-		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
+		oldTx := senderIndex.Get(key).Value.(sdk.Tx)
+		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, oldTx, tx) {
 			return fmt.Errorf(
 				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
 				oldScore.priority,
 				priority,
-				senderIndex.Get(key).Value.(sdk.Tx),
+				oldTx,
 				tx,
 			)
 		}
+		if mp.cfg.OnEvict != nil {
+			mp.cfg.OnEvict(oldTx, EvictionReasonReplaced)
+		}
+		emitEvictionEvent(ctx, sender, nonce, EvictionReasonReplaced)
 
 		mp.priorityIndex.Remove(txMeta[C]{
 			nonce:    nonce,
@@ -285,6 +376,56 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	return nil
 }
 
+// evictLowestPriority makes room for a tx with the given priority in a full
+// mempool according to the EvictionPolicy, returning ErrMempoolTxMaxCapacity if
+// the tx must be rejected.
+func (mp *PriorityNonceMempool[C]) evictLowestPriority(ctx context.Context, priority C) error {
+	if mp.cfg.EvictionPolicy != EvictionPolicyDropLowestPriority {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	lowest := mp.priorityIndex.Back()
+	if lowest == nil {
+		return ErrMempoolTxMaxCapacity
+	}
+	key := lowest.Key().(txMeta[C])
+	if mp.cfg.TxPriority.Compare(priority, key.priority) <= 0 {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	evicted := lowest.Value.(sdk.Tx)
+	mp.priorityIndex.Remove(key)
+	mp.senderIndices[key.sender].Remove(key)
+	delete(mp.scores, txMeta[C]{nonce: key.nonce, sender: key.sender})
+	mp.priorityCounts[key.priority]--
+
+	if mp.cfg.OnEvict != nil {
+		mp.cfg.OnEvict(evicted, EvictionReasonCapacity)
+	}
+	emitEvictionEvent(ctx, key.sender, key.nonce, EvictionReasonCapacity)
+
+	return nil
+}
+
+// emitEvictionEvent emits an EventTypeEvictTx event on the event manager of ctx,
+// if it is an sdk.Context. Other contexts carry no event manager.
+func emitEvictionEvent(ctx context.Context, sender string, nonce uint64, reason EvictionReason) {
+	sdkCtx, ok := ctx.(sdk.Context)
+	if !ok {
+		sdkCtx, ok = ctx.Value(sdk.SdkContextKey).(sdk.Context)
+	}
+	if !ok || sdkCtx.EventManager() == nil {
+		return
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeEvictTx,
+		sdk.NewAttribute(sdk.AttributeKeySender, sender),
+		sdk.NewAttribute(sdk.AttributeKeyAccountSequence, strconv.FormatUint(nonce, 10)),
+		sdk.NewAttribute(AttributeKeyEvictionReason, reason.String()),
+	))
+}
+
 func (i *PriorityNonceIterator[C]) iteratePriority() Iterator {
 	// beginning of priority iteration
 	if i.priorityNode == nil {
@@ -975,3 +975,119 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	iter := mp.Select(ctx, nil)
 	require.Equal(t, txs[3], iter.Tx())
 }
+
+func TestNextSenderTx_ReplaceByFee(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa := accounts[0].Address
+
+	var evicted []sdk.Tx
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:    mempool.NewDefaultTxPriority(),
+			TxReplacement: mempool.NewReplaceByFeeTxReplacement(10),
+			OnEvict: func(tx sdk.Tx, reason mempool.EvictionReason) {
+				require.Equal(t, mempool.EvictionReasonReplaced, reason)
+				evicted = append(evicted, tx)
+			},
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+
+	txs := []testTx{
+		{id: 0, priority: 100, nonce: 1, address: sa},
+		{id: 1, priority: 100, nonce: 1, address: sa}, // same priority
+		{id: 2, priority: 109, nonce: 1, address: sa}, // bump below 10%
+		{id: 3, priority: 110, nonce: 1, address: sa}, // bump of 10%
+	}
+	for i, tx := range txs {
+		err := mp.Insert(ctx.WithPriority(tx.priority), tx)
+		if i == 0 || i == 3 {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+		require.Equal(t, 1, mp.CountTx())
+	}
+	require.Equal(t, []sdk.Tx{txs[0]}, evicted)
+	require.Equal(t, txs[3], mp.Select(ctx, nil).Tx())
+
+	rbf := mempool.NewReplaceByFeeTxReplacement(10)
+	require.True(t, rbf(-100, -90, nil, nil))
+	require.False(t, rbf(-100, -91, nil, nil))
+	require.True(t, rbf(0, 1, nil, nil))
+	require.False(t, rbf(math.MaxInt64, math.MaxInt64, nil, nil))
+	require.True(t, rbf(math.MaxInt64/2, math.MaxInt64, nil, nil))
+}
+
+func TestNextSenderTx_EvictionPolicy(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+	sc := accounts[2].Address
+
+	evicted := map[mempool.EvictionReason][]sdk.Tx{}
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority: mempool.NewDefaultTxPriority(),
+			OnEvict: func(tx sdk.Tx, reason mempool.EvictionReason) {
+				evicted[reason] = append(evicted[reason], tx)
+			},
+			MaxTx:           2,
+			EvictionPolicy:  mempool.EvictionPolicyDropLowestPriority,
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+
+	txs := []testTx{
+		{id: 0, priority: 20, nonce: 1, address: sa},
+		{id: 1, priority: 10, nonce: 1, address: sb},
+		{id: 2, priority: 10, nonce: 1, address: sc}, // not higher than the lowest priority, rejected
+		{id: 3, priority: 30, nonce: 1, address: sc}, // evicts the tx of sb
+		{id: 4, priority: 25, nonce: 1, address: sa}, // replaces the tx of sa without evicting any tx
+	}
+	// insert returns the events emitted by the mempool while inserting the tx
+	insert := func(tx testTx) (sdk.Events, error) {
+		txCtx := ctx.WithPriority(tx.priority).WithEventManager(sdk.NewEventManager())
+		err := mp.Insert(txCtx, tx)
+		return txCtx.EventManager().Events(), err
+	}
+	evictEvent := func(tx testTx, reason mempool.EvictionReason) sdk.Event {
+		return sdk.NewEvent(
+			mempool.EventTypeEvictTx,
+			sdk.NewAttribute(sdk.AttributeKeySender, tx.address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAccountSequence, fmt.Sprintf("%d", tx.nonce)),
+			sdk.NewAttribute(mempool.AttributeKeyEvictionReason, reason.String()),
+		)
+	}
+
+	for _, tx := range txs[:2] {
+		events, err := insert(tx)
+		require.NoError(t, err)
+		require.Empty(t, events)
+	}
+	events, err := insert(txs[2])
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+	require.Empty(t, events)
+	require.Empty(t, evicted)
+
+	events, err = insert(txs[3])
+	require.NoError(t, err)
+	require.Equal(t, sdk.Events{evictEvent(txs[1], mempool.EvictionReasonCapacity)}, events)
+	require.Equal(t, map[mempool.EvictionReason][]sdk.Tx{mempool.EvictionReasonCapacity: {txs[1]}}, evicted)
+	require.ErrorIs(t, mp.Remove(txs[1]), mempool.ErrTxNotFound)
+
+	events, err = insert(txs[4])
+	require.NoError(t, err)
+	require.Equal(t, sdk.Events{evictEvent(txs[0], mempool.EvictionReasonReplaced)}, events)
+	require.Equal(t, map[mempool.EvictionReason][]sdk.Tx{
+		mempool.EvictionReasonCapacity: {txs[1]},
+		mempool.EvictionReasonReplaced: {txs[0]},
+	}, evicted)
+	require.Equal(t, 2, mp.CountTx())
+
+	iter := mp.Select(ctx, nil)
+	require.Equal(t, txs[3], iter.Tx())
+	require.Equal(t, txs[4], iter.Next().Tx())
+}
@@ -70,6 +70,11 @@ type Account struct {
 }
 
 func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if msg.InitSequence != 0 {
+		if err := a.Sequence.Set(ctx, msg.InitSequence); err != nil {
+			return nil, err
+		}
+	}
 	return &v1.MsgInitResponse{}, a.savePubKey(ctx, msg.PubKey)
 }
 
@@ -87,7 +92,28 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
 	}
 
-	pubKey, signerData, err := a.computeSignerData(ctx)
+	pubKey, err := a.loadPubKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load pubkey: %w", err)
+	}
+
+	signBytes, err := a.GetSignBytes(ctx, msg, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	signature := msg.Tx.Signatures[msg.SignerIndex]
+	if !pubKey.VerifySignature(signBytes, signature) {
+		return nil, errors.New("signature verification failed")
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+}
+
+// GetSignBytes returns the bytes the signer of the authenticated tx must have signed with
+// the provided pubkey. It increases the account sequence and checks the tx one matches it.
+func (a Account) GetSignBytes(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate, pubKey PubKey) ([]byte, error) {
+	signerData, err := a.computeSignerData(ctx, pubKey)
 	if err != nil {
 		return nil, fmt.Errorf("unable to compute signer data: %w", err)
 	}
@@ -107,18 +133,12 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
 	}
 
-	signature := msg.Tx.Signatures[msg.SignerIndex]
-
 	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get sign bytes: %w", err)
 	}
 
-	if !pubKey.VerifySignature(signBytes, signature) {
-		return nil, errors.New("signature verification failed")
-	}
-
-	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+	return signBytes, nil
 }
 
 func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
@@ -130,34 +150,29 @@ func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
 }
 
 // computeSignerData will populate signer data and also increase the sequence.
-func (a Account) computeSignerData(ctx context.Context) (PubKey, signing.SignerData, error) {
+func (a Account) computeSignerData(ctx context.Context, pk PubKey) (signing.SignerData, error) {
 	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
 	if err != nil {
-		return nil, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 	chainID := a.hs.HeaderInfo(ctx).ChainID
 
 	wantSequence, err := a.Sequence.Next(ctx)
 	if err != nil {
-		return nil, signing.SignerData{}, err
-	}
-
-	pk, err := a.loadPubKey(ctx)
-	if err != nil {
-		return nil, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 
 	pkAny, err := codectypes.NewAnyWithValue(pk)
 	if err != nil {
-		return nil, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 
 	accNum, err := a.getNumber(ctx, addrStr)
 	if err != nil {
-		return nil, signing.SignerData{}, err
+		return signing.SignerData{}, err
 	}
 
-	return pk, signing.SignerData{
+	return signing.SignerData{
 		Address:       addrStr,
 		ChainID:       chainID,
 		AccountNumber: accNum,
@@ -226,28 +241,42 @@ func (a Account) loadPubKey(ctx context.Context) (PubKey, error) {
 	return pubKey, nil
 }
 
-func (a Account) savePubKey(ctx context.Context, anyPk *codectypes.Any) error {
+// DecodePubKey decodes and validates a pubkey of one of the types supported by the account.
+func (a Account) DecodePubKey(anyPk *codectypes.Any) (PubKey, error) {
 	// check if known
 	name := nameFromTypeURL(anyPk.TypeUrl)
 	impl, exists := a.supportedPubKeys[name]
 	if !exists {
-		return fmt.Errorf("unknown pubkey type %s", name)
+		return nil, fmt.Errorf("unknown pubkey type %s", name)
 	}
 	pk, err := impl.decode(anyPk.Value)
 	if err != nil {
-		return fmt.Errorf("unable to decode pubkey: %w", err)
+		return nil, fmt.Errorf("unable to decode pubkey: %w", err)
 	}
 	err = impl.validate(pk)
 	if err != nil {
-		return fmt.Errorf("unable to validate pubkey: %w", err)
+		return nil, fmt.Errorf("unable to validate pubkey: %w", err)
+	}
+	return pk, nil
+}
+
+// SetPubKey replaces the pubkey of the account, it is used by the accounts embedding a base
+// account to swap its pubkey through other flows than MsgSwapPubKey.
+func (a Account) SetPubKey(ctx context.Context, anyPk *codectypes.Any) error {
+	return a.savePubKey(ctx, anyPk)
+}
+
+func (a Account) savePubKey(ctx context.Context, anyPk *codectypes.Any) error {
+	if _, err := a.DecodePubKey(anyPk); err != nil {
+		return err
 	}
 
 	// save into state
-	err = a.PubKey.Set(ctx, anyPk.Value)
+	err := a.PubKey.Set(ctx, anyPk.Value)
 	if err != nil {
 		return fmt.Errorf("unable to save pubkey: %w", err)
 	}
-	return a.PubKeyType.Set(ctx, name)
+	return a.PubKeyType.Set(ctx, nameFromTypeURL(anyPk.TypeUrl))
 }
 
 func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
@@ -79,6 +79,20 @@ func TestInit(t *testing.T) {
 	}
 }
 
+func TestInitSequence(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	baseAcc := setupBaseAccount(t, ss)
+	_, err := baseAcc.Init(ctx, &v1.MsgInit{
+		PubKey:       toAnyPb(t, secp256k1.GenPrivKey().PubKey()),
+		InitSequence: 5,
+	})
+	require.NoError(t, err)
+
+	resp, err := baseAcc.QuerySequence(ctx, &v1.QuerySequence{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), resp.Sequence)
+}
+
 func TestSwapKey(t *testing.T) {
 	ctx, ss := newMockContext(t)
 	baseAcc := setupBaseAccount(t, ss)
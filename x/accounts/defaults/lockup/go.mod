@@ -156,6 +156,7 @@ replace (
 	cosmossdk.io/x/bank => ../../../bank
 	cosmossdk.io/x/consensus => ../../../consensus
 	cosmossdk.io/x/distribution => ../../../distribution
+	cosmossdk.io/x/epochs => ../../../epochs
 	cosmossdk.io/x/gov => ../../../gov
 	cosmossdk.io/x/mint => ../../../mint
 	cosmossdk.io/x/protocolpool => ../../../protocolpool
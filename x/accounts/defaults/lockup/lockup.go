@@ -36,6 +36,7 @@ var (
 	LockingPeriodsPrefix   = collections.NewPrefix(5)
 	OwnerPrefix            = collections.NewPrefix(6)
 	WithdrawedCoinsPrefix  = collections.NewPrefix(7)
+	SequencePrefix         = collections.NewPrefix(8)
 )
 
 var (
@@ -58,6 +59,7 @@ func newBaseLockup(d accountstd.Dependencies) *BaseLockup {
 		addressCodec:     d.AddressCodec,
 		headerService:    d.Environment.HeaderService,
 		EndTime:          collections.NewItem(d.SchemaBuilder, EndTimePrefix, "end_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
+		Sequence:         collections.NewItem(d.SchemaBuilder, SequencePrefix, "sequence", collections.Uint64Value),
 	}
 
 	return BaseLockup
@@ -74,6 +76,8 @@ type BaseLockup struct {
 	headerService    header.Service
 	// lockup end time.
 	EndTime collections.Item[time.Time]
+	// Sequence is the sequence of the legacy vesting account migrated into the account.
+	Sequence collections.Item[uint64]
 }
 
 func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupAccount) (
@@ -88,45 +92,97 @@ func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupA
 		return nil, err
 	}
 
-	funds := accountstd.Funds(ctx)
+	originalLocking, err := getOriginalLocking(ctx, msg.LegacyVestingState)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bva.initLocking(ctx, originalLocking, msg.LegacyVestingState)
+	if err != nil {
+		return nil, err
+	}
+
+	err = bva.EndTime.Set(ctx, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockuptypes.MsgInitLockupAccountResponse{}, nil
+}
+
+// getOriginalLocking returns the coins locked by the account, which are the funds sent at initialization,
+// or the original vesting amount of the legacy vesting account migrated into the account.
+func getOriginalLocking(ctx context.Context, legacyState *lockuptypes.LegacyVestingState) (sdk.Coins, error) {
+	if legacyState == nil {
+		return accountstd.Funds(ctx), nil
+	}
 
-	sortedAmt := funds.Sort()
+	// x/auth migrates a legacy account by initializing the account on its own address.
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("legacy vesting state can only be set when migrating a legacy account")
+	}
+
+	return legacyState.OriginalLocking, nil
+}
+
+// initLocking sets the original locking of the account and the initial values of its delegation
+// tracking, which are carried over from the legacy vesting state if any.
+func (bva *BaseLockup) initLocking(ctx context.Context, originalLocking sdk.Coins, legacyState *lockuptypes.LegacyVestingState) error {
+	sortedAmt := originalLocking.Sort()
 	for _, coin := range sortedAmt {
-		err = bva.OriginalLocking.Set(ctx, coin.Denom, coin.Amount)
+		err := bva.OriginalLocking.Set(ctx, coin.Denom, coin.Amount)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		// Set initial value for all locked token
 		err = bva.WithdrawedCoins.Set(ctx, coin.Denom, math.ZeroInt())
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
 	bondDenom, err := getStakingDenom(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Set initial value for all locked token
 	err = bva.DelegatedFree.Set(ctx, bondDenom, math.ZeroInt())
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// Set initial value for all locked token
 	err = bva.DelegatedLocking.Set(ctx, bondDenom, math.ZeroInt())
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	err = bva.EndTime.Set(ctx, msg.EndTime)
+	if legacyState == nil {
+		return nil
+	}
+
+	err = bva.Sequence.Set(ctx, legacyState.Sequence)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return &lockuptypes.MsgInitLockupAccountResponse{}, nil
+	for _, coin := range legacyState.DelegatedFree {
+		err = bva.DelegatedFree.Set(ctx, coin.Denom, coin.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, coin := range legacyState.DelegatedLocking {
+		err = bva.DelegatedLocking.Set(ctx, coin.Denom, coin.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (bva *BaseLockup) Delegate(
@@ -663,12 +719,19 @@ func (bva BaseLockup) QueryLockupAccountBaseInfo(ctx context.Context, _ *lockupt
 	}
 	delegatedFree := sdk.NewCoins(sdk.NewCoin(bondDenom, delegatedFreeAmt))
 
+	// only accounts migrated from a legacy vesting account have a sequence.
+	sequence, err := bva.Sequence.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
 	return &lockuptypes.QueryLockupAccountInfoResponse{
 		Owner:            ownerAddress,
 		OriginalLocking:  originalLocking,
 		DelegatedLocking: delegatedLocking,
 		DelegatedFree:    delegatedFree,
 		EndTime:          &endTime,
+		Sequence:         sequence,
 	}, nil
 }
 
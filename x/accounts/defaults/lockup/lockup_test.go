@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -62,6 +63,46 @@ func TestInitLockupAccount(t *testing.T) {
 	}
 }
 
+func TestInitLockupAccountWithLegacyVestingState(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	deps := makeMockDependencies(ss)
+
+	baseLockup := newBaseLockup(deps)
+	msg := &lockuptypes.MsgInitLockupAccount{
+		Owner:   "owner",
+		EndTime: time.Now().Add(time.Minute),
+		LegacyVestingState: &lockuptypes.LegacyVestingState{
+			OriginalLocking:  sdk.NewCoins(sdk.NewCoin("test", math.NewInt(20))),
+			DelegatedFree:    sdk.NewCoins(sdk.NewCoin("test", math.NewInt(2))),
+			DelegatedLocking: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))),
+			Sequence:         4,
+		},
+	}
+
+	// only a legacy account migrating into the lockup account can set its legacy vesting state
+	_, err := baseLockup.Init(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = baseLockup.Init(accountstd.SetSender(ctx, []byte("lockup_account")), msg)
+	require.NoError(t, err)
+
+	originalLocking, err := baseLockup.OriginalLocking.Get(ctx, "test")
+	require.NoError(t, err)
+	require.True(t, originalLocking.Equal(math.NewInt(20)))
+
+	delFree, err := baseLockup.DelegatedFree.Get(ctx, "test")
+	require.NoError(t, err)
+	require.True(t, delFree.Equal(math.NewInt(2)))
+
+	delLocking, err := baseLockup.DelegatedLocking.Get(ctx, "test")
+	require.NoError(t, err)
+	require.True(t, delLocking.Equal(math.NewInt(5)))
+
+	sequence, err := baseLockup.Sequence.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), sequence)
+}
+
 func TestTrackingDelegation(t *testing.T) {
 	ctx, ss := newMockContext(t)
 
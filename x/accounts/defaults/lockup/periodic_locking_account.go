@@ -46,7 +46,8 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 
 	hs := pva.headerService.HeaderInfo(ctx)
 
-	if msg.StartTime.Before(hs.Time) {
+	// a migrated legacy vesting account may have started already
+	if msg.LegacyVestingState == nil && msg.StartTime.Before(hs.Time) {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap("start time %s should be after block time")
 	}
 
@@ -70,38 +71,15 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 		}
 	}
 
-	funds := accountstd.Funds(ctx)
-	if !funds.Equal(totalCoins) {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("invalid funding amount, should be equal to total coins lockup")
-	}
-
-	sortedAmt := totalCoins.Sort()
-	for _, coin := range sortedAmt {
-		err := pva.OriginalLocking.Set(ctx, coin.Denom, coin.Amount)
-		if err != nil {
-			return nil, err
-		}
-
-		// Set initial value for all withdrawed token
-		err = pva.WithdrawedCoins.Set(ctx, coin.Denom, math.ZeroInt())
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	bondDenom, err := getStakingDenom(ctx)
+	originalLocking, err := getOriginalLocking(ctx, msg.LegacyVestingState)
 	if err != nil {
 		return nil, err
 	}
-
-	// Set initial value for all locked token
-	err = pva.DelegatedFree.Set(ctx, bondDenom, math.ZeroInt())
-	if err != nil {
-		return nil, err
+	if !originalLocking.Equal(totalCoins) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("invalid funding amount, should be equal to total coins lockup")
 	}
 
-	// Set initial value for all locked token
-	err = pva.DelegatedLocking.Set(ctx, bondDenom, math.ZeroInt())
+	err = pva.initLocking(ctx, totalCoins, msg.LegacyVestingState)
 	if err != nil {
 		return nil, err
 	}
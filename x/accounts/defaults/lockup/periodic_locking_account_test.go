@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
 	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -277,3 +278,56 @@ func TestPeriodicAccountGetLockCoinInfo(t *testing.T) {
 	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(10)))
 	require.True(t, locked.AmountOf("test").Equal(math.ZeroInt()))
 }
+
+func TestPeriodicAccountInitWithLegacyVestingState(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	now := time.Now()
+	sdkCtx := sdk.NewContext(nil, true, log.NewNopLogger()).WithContext(ctx).WithHeaderInfo(header.Info{
+		Time: now,
+	})
+	selfCtx := sdkCtx.WithContext(accountstd.SetSender(ctx, []byte("lockup_account")))
+
+	acc, err := NewPeriodicLockingAccount(makeMockDependencies(ss))
+	require.NoError(t, err)
+
+	// a migrated legacy vesting account may have started already
+	msg := &lockuptypes.MsgInitPeriodicLockingAccount{
+		Owner:     "owner",
+		StartTime: now.Add(-time.Minute),
+		LockingPeriods: []lockuptypes.Period{
+			{
+				Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(5))),
+				Length: time.Minute,
+			},
+			{
+				Amount: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(3))),
+				Length: time.Minute,
+			},
+		},
+		LegacyVestingState: &lockuptypes.LegacyVestingState{
+			OriginalLocking:  sdk.NewCoins(sdk.NewCoin("test", math.NewInt(7))),
+			DelegatedLocking: sdk.NewCoins(sdk.NewCoin("test", math.NewInt(3))),
+			Sequence:         2,
+		},
+	}
+
+	_, err = acc.Init(selfCtx, msg)
+	require.ErrorContains(t, err, "invalid funding amount, should be equal to total coins lockup")
+
+	msg.LegacyVestingState.OriginalLocking = sdk.NewCoins(sdk.NewCoin("test", math.NewInt(8)))
+	_, err = acc.Init(selfCtx, msg)
+	require.NoError(t, err)
+
+	unlocked, locked, err := acc.GetLockCoinsInfo(sdkCtx, now)
+	require.NoError(t, err)
+	require.True(t, unlocked.AmountOf("test").Equal(math.NewInt(5)))
+	require.True(t, locked.AmountOf("test").Equal(math.NewInt(3)))
+
+	delLocking, err := acc.DelegatedLocking.Get(ctx, "test")
+	require.NoError(t, err)
+	require.True(t, delLocking.Equal(math.NewInt(3)))
+
+	info, err := acc.QueryLockupAccountInfo(sdkCtx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), info.Sequence)
+}
@@ -84,8 +84,83 @@ func (m *Period) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// LegacyVestingState defines the locking state of a legacy x/auth vesting account
+// migrated into a lockup account.
+type LegacyVestingState struct {
+	// original_locking is the original vesting amount of the vesting account.
+	OriginalLocking github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=original_locking,json=originalLocking,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"original_locking"`
+	// delegated_free is the delegated vested amount of the vesting account.
+	DelegatedFree github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=delegated_free,json=delegatedFree,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegated_free"`
+	// delegated_locking is the delegated vesting amount of the vesting account.
+	DelegatedLocking github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=delegated_locking,json=delegatedLocking,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegated_locking"`
+	// sequence is the sequence of the vesting account, it is kept by the lockup account.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *LegacyVestingState) Reset()         { *m = LegacyVestingState{} }
+func (m *LegacyVestingState) String() string { return proto.CompactTextString(m) }
+func (*LegacyVestingState) ProtoMessage()    {}
+func (*LegacyVestingState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79b466256e1a079c, []int{1}
+}
+func (m *LegacyVestingState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LegacyVestingState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LegacyVestingState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LegacyVestingState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LegacyVestingState.Merge(m, src)
+}
+func (m *LegacyVestingState) XXX_Size() int {
+	return m.Size()
+}
+func (m *LegacyVestingState) XXX_DiscardUnknown() {
+	xxx_messageInfo_LegacyVestingState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LegacyVestingState proto.InternalMessageInfo
+
+func (m *LegacyVestingState) GetOriginalLocking() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OriginalLocking
+	}
+	return nil
+}
+
+func (m *LegacyVestingState) GetDelegatedFree() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegatedFree
+	}
+	return nil
+}
+
+func (m *LegacyVestingState) GetDelegatedLocking() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegatedLocking
+	}
+	return nil
+}
+
+func (m *LegacyVestingState) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Period)(nil), "cosmos.accounts.defaults.lockup.Period")
+	proto.RegisterType((*LegacyVestingState)(nil), "cosmos.accounts.defaults.lockup.LegacyVestingState")
 }
 
 func init() {
@@ -93,30 +168,85 @@ func init() {
 }
 
 var fileDescriptor_79b466256e1a079c = []byte{
-	// 326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x63, 0x90, 0x32, 0x04, 0x18, 0xa8, 0x18, 0x4a, 0x07, 0xa7, 0x62, 0xaa, 0x2a, 0x6a,
-	0xab, 0x70, 0x01, 0x54, 0x10, 0xac, 0x88, 0x91, 0x05, 0x39, 0x8e, 0xeb, 0x5a, 0x4d, 0xf2, 0xaa,
-	0xda, 0x41, 0xf4, 0x16, 0x8c, 0x88, 0x13, 0x20, 0xa6, 0x5e, 0x02, 0xa9, 0x63, 0x47, 0x26, 0x8a,
-	0x9a, 0xa1, 0xd7, 0x40, 0xb1, 0x9d, 0x91, 0xc5, 0xef, 0x59, 0xfe, 0xbf, 0xf7, 0xbf, 0x5f, 0x8e,
-	0xce, 0x39, 0xe8, 0x1c, 0x34, 0x65, 0x9c, 0x43, 0x59, 0x18, 0x4d, 0x53, 0x31, 0x66, 0x65, 0x66,
-	0x34, 0xcd, 0x80, 0x4f, 0xcb, 0x99, 0x2f, 0x64, 0x36, 0x07, 0x03, 0xad, 0xd8, 0xa9, 0x49, 0xa3,
-	0x26, 0x8d, 0x9a, 0x38, 0x59, 0xe7, 0x98, 0xe5, 0xaa, 0x00, 0x6a, 0x4f, 0xc7, 0x74, 0xb0, 0x77,
-	0x48, 0x98, 0x16, 0xf4, 0x79, 0x98, 0x08, 0xc3, 0x86, 0x94, 0x83, 0x2a, 0xfc, 0xfb, 0x89, 0x04,
-	0x09, 0xb6, 0xa5, 0x75, 0xd7, 0x50, 0x12, 0x40, 0x66, 0x82, 0xda, 0x5b, 0x52, 0x8e, 0x69, 0x5a,
-	0xce, 0x99, 0x51, 0xe0, 0xa9, 0xb3, 0x2f, 0x14, 0x85, 0xf7, 0x62, 0xae, 0x20, 0x6d, 0x5d, 0x45,
-	0x61, 0x26, 0x0a, 0x69, 0x26, 0x6d, 0xd4, 0x45, 0xbd, 0x83, 0x8b, 0x53, 0xe2, 0x58, 0xd2, 0xb0,
-	0xe4, 0xc6, 0xb3, 0xa3, 0xa3, 0xd5, 0x4f, 0x1c, 0xbc, 0x6d, 0x62, 0xf4, 0xb1, 0x5b, 0xf6, 0xd1,
-	0x83, 0xe7, 0x5a, 0x8b, 0x28, 0x64, 0x79, 0x1d, 0xa8, 0xbd, 0xd7, 0xdd, 0xb7, 0x13, 0x7c, 0xce,
-	0x7a, 0x67, 0xe2, 0x77, 0x26, 0xd7, 0xa0, 0x8a, 0xd1, 0x6d, 0x3d, 0xe1, 0x73, 0x13, 0xf7, 0xa4,
-	0x32, 0x93, 0x32, 0x21, 0x1c, 0x72, 0xea, 0x03, 0xba, 0x32, 0xd0, 0xe9, 0x94, 0x9a, 0xc5, 0x4c,
-	0x68, 0x0b, 0xe8, 0xf7, 0xdd, 0xb2, 0x7f, 0x98, 0x09, 0xc9, 0xf8, 0xe2, 0xa9, 0x4e, 0xad, 0xbd,
-	0xb5, 0x33, 0x1c, 0xdd, 0xad, 0xb6, 0x18, 0xad, 0xb7, 0x18, 0xfd, 0x6e, 0x31, 0x7a, 0xad, 0x70,
-	0xb0, 0xae, 0x70, 0xf0, 0x5d, 0xe1, 0xe0, 0x71, 0xe0, 0xe6, 0xe9, 0x74, 0x4a, 0x14, 0xd0, 0x97,
-	0xff, 0x7f, 0xc8, 0x9a, 0x25, 0xa1, 0x4d, 0x7b, 0xf9, 0x17, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xa5,
-	0x33, 0xd0, 0xd1, 0x01, 0x00, 0x00,
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x31, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0x63, 0x12, 0x45, 0xc8, 0x50, 0x68, 0x4f, 0x0c, 0x21, 0xc3, 0x5d, 0xd4, 0x29, 0xaa,
+	0xa8, 0xad, 0xc2, 0xc6, 0x84, 0x02, 0x2a, 0x4b, 0x07, 0x54, 0x24, 0x06, 0x96, 0xc8, 0x77, 0xf7,
+	0xe2, 0x5a, 0xb9, 0xf8, 0x85, 0xb3, 0x0f, 0x91, 0x6f, 0xc0, 0x80, 0x10, 0x23, 0x62, 0x62, 0x44,
+	0x4c, 0xfd, 0x12, 0x48, 0x1d, 0x3b, 0x32, 0x51, 0x48, 0x86, 0xf0, 0x31, 0xd0, 0xd9, 0xbe, 0x30,
+	0x75, 0xcd, 0x72, 0xb6, 0xe5, 0xff, 0xff, 0xfd, 0xfe, 0xef, 0xe9, 0x4c, 0x1f, 0x64, 0x68, 0x66,
+	0x68, 0xb8, 0xc8, 0x32, 0xac, 0xb4, 0x35, 0x3c, 0x87, 0x89, 0xa8, 0x0a, 0x6b, 0x78, 0x81, 0xd9,
+	0xb4, 0x9a, 0x87, 0x85, 0xcd, 0x4b, 0xb4, 0x18, 0x25, 0x5e, 0xcd, 0x1a, 0x35, 0x6b, 0xd4, 0xcc,
+	0xcb, 0xfa, 0x7b, 0x62, 0xa6, 0x34, 0x72, 0xf7, 0xf5, 0x9e, 0x7e, 0x1c, 0x08, 0xa9, 0x30, 0xc0,
+	0xdf, 0x1e, 0xa5, 0x60, 0xc5, 0x11, 0xcf, 0x50, 0xe9, 0x70, 0x7f, 0x4f, 0xa2, 0x44, 0xb7, 0xe5,
+	0xf5, 0xae, 0x71, 0x49, 0x44, 0x59, 0x00, 0x77, 0xa7, 0xb4, 0x9a, 0xf0, 0xbc, 0x2a, 0x85, 0x55,
+	0x18, 0x5c, 0xfb, 0x3f, 0x08, 0xed, 0xbe, 0x80, 0x52, 0x61, 0x1e, 0x3d, 0xa1, 0xdd, 0x02, 0xb4,
+	0xb4, 0x67, 0x3d, 0x32, 0x20, 0xc3, 0x5b, 0x0f, 0xef, 0x33, 0xef, 0x65, 0x8d, 0x97, 0x3d, 0x0b,
+	0xde, 0xd1, 0xce, 0xc5, 0xaf, 0xa4, 0xf5, 0xf9, 0x2a, 0x21, 0xdf, 0xd6, 0xe7, 0x07, 0xe4, 0x34,
+	0xf8, 0xa2, 0x05, 0xed, 0x8a, 0x59, 0xdd, 0x50, 0xef, 0xc6, 0xa0, 0xed, 0x2a, 0x84, 0x3e, 0xeb,
+	0xcc, 0x2c, 0x64, 0x66, 0x4f, 0x51, 0xe9, 0xd1, 0x71, 0x5d, 0xe1, 0xfb, 0x55, 0x32, 0x94, 0xca,
+	0x9e, 0x55, 0x29, 0xcb, 0x70, 0xc6, 0x43, 0x83, 0x7e, 0x39, 0x34, 0xf9, 0x94, 0xdb, 0xc5, 0x1c,
+	0x8c, 0x33, 0x98, 0x2f, 0xeb, 0xf3, 0x83, 0xdb, 0x05, 0x48, 0x91, 0x2d, 0xc6, 0x75, 0xd7, 0x26,
+	0xa0, 0x3d, 0x70, 0xff, 0x4f, 0x9b, 0x46, 0x27, 0xee, 0xf6, 0x15, 0x18, 0xab, 0xb4, 0x7c, 0x69,
+	0x85, 0x85, 0xe8, 0x03, 0xa1, 0xbb, 0x58, 0x2a, 0xa9, 0xb4, 0x28, 0xc6, 0xf5, 0x6c, 0x95, 0x96,
+	0x3d, 0xb2, 0xad, 0x70, 0x77, 0x1b, 0xf4, 0x89, 0x27, 0x47, 0xef, 0x09, 0xbd, 0x93, 0x43, 0x2d,
+	0xb4, 0x90, 0x8f, 0x27, 0x25, 0xc0, 0xf6, 0x26, 0xb5, 0xb3, 0x01, 0x1f, 0x97, 0x00, 0xd1, 0x47,
+	0x42, 0xf7, 0xfe, 0x47, 0x69, 0x46, 0xd3, 0xde, 0x56, 0x9a, 0xdd, 0x0d, 0xbb, 0x99, 0x4d, 0x9f,
+	0xde, 0x34, 0xf0, 0xa6, 0x02, 0x9d, 0x41, 0xaf, 0x33, 0x20, 0xc3, 0xce, 0xe9, 0xe6, 0xfc, 0xb8,
+	0xf3, 0xf7, 0x6b, 0x42, 0x46, 0xcf, 0x2f, 0x96, 0x31, 0xb9, 0x5c, 0xc6, 0xe4, 0xf7, 0x32, 0x26,
+	0x9f, 0x56, 0x71, 0xeb, 0x72, 0x15, 0xb7, 0x7e, 0xae, 0xe2, 0xd6, 0xeb, 0x43, 0xcf, 0x36, 0xf9,
+	0x94, 0x29, 0xe4, 0xef, 0xae, 0x7f, 0x85, 0x2e, 0x58, 0xda, 0x75, 0x7f, 0xf4, 0xa3, 0x7f, 0x03,
+	0x00, 0xc8, 0xc0, 0xd3, 0x9d, 0xb5, 0x03, 0x00, 0x00,
 }
 
+func (this *LegacyVestingState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LegacyVestingState)
+	if !ok {
+		that2, ok := that.(LegacyVestingState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.OriginalLocking) != len(that1.OriginalLocking) {
+		return false
+	}
+	for i := range this.OriginalLocking {
+		if !this.OriginalLocking[i].Equal(&that1.OriginalLocking[i]) {
+			return false
+		}
+	}
+	if len(this.DelegatedFree) != len(that1.DelegatedFree) {
+		return false
+	}
+	for i := range this.DelegatedFree {
+		if !this.DelegatedFree[i].Equal(&that1.DelegatedFree[i]) {
+			return false
+		}
+	}
+	if len(this.DelegatedLocking) != len(that1.DelegatedLocking) {
+		return false
+	}
+	for i := range this.DelegatedLocking {
+		if !this.DelegatedLocking[i].Equal(&that1.DelegatedLocking[i]) {
+			return false
+		}
+	}
+	if this.Sequence != that1.Sequence {
+		return false
+	}
+	return true
+}
 func (m *Period) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -162,6 +292,76 @@ func (m *Period) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LegacyVestingState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LegacyVestingState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LegacyVestingState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintLockup(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DelegatedLocking) > 0 {
+		for iNdEx := len(m.DelegatedLocking) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedLocking[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DelegatedFree) > 0 {
+		for iNdEx := len(m.DelegatedFree) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedFree[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OriginalLocking) > 0 {
+		for iNdEx := len(m.OriginalLocking) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OriginalLocking[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLockup(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLockup(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLockup(v)
 	base := offset
@@ -190,6 +390,36 @@ func (m *Period) Size() (n int) {
 	return n
 }
 
+func (m *LegacyVestingState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OriginalLocking) > 0 {
+		for _, e := range m.OriginalLocking {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	if len(m.DelegatedFree) > 0 {
+		for _, e := range m.DelegatedFree {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	if len(m.DelegatedLocking) > 0 {
+		for _, e := range m.DelegatedLocking {
+			l = e.Size()
+			n += 1 + l + sovLockup(uint64(l))
+		}
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovLockup(uint64(m.Sequence))
+	}
+	return n
+}
+
 func sovLockup(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -313,6 +543,177 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LegacyVestingState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLockup
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacyVestingState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacyVestingState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalLocking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalLocking = append(m.OriginalLocking, types.Coin{})
+			if err := m.OriginalLocking[len(m.OriginalLocking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedFree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedFree = append(m.DelegatedFree, types.Coin{})
+			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedLocking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLockup
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedLocking = append(m.DelegatedLocking, types.Coin{})
+			if err := m.DelegatedLocking[len(m.DelegatedLocking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLockup
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLockup(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLockup
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLockup(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
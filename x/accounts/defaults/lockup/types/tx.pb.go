@@ -42,6 +42,9 @@ type MsgInitLockupAccount struct {
 	EndTime time.Time `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
 	// start of lockup
 	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// legacy_vesting_state is set by x/auth when migrating a legacy vesting account, the original
+	// locking is then taken from it instead of the funds sent at initialization.
+	LegacyVestingState *LegacyVestingState `protobuf:"bytes,4,opt,name=legacy_vesting_state,json=legacyVestingState,proto3" json:"legacy_vesting_state,omitempty"`
 }
 
 func (m *MsgInitLockupAccount) Reset()         { *m = MsgInitLockupAccount{} }
@@ -98,6 +101,13 @@ func (m *MsgInitLockupAccount) GetStartTime() time.Time {
 	return time.Time{}
 }
 
+func (m *MsgInitLockupAccount) GetLegacyVestingState() *LegacyVestingState {
+	if m != nil {
+		return m.LegacyVestingState
+	}
+	return nil
+}
+
 // MsgInitLockupAccountResponse defines the Msg/InitLockupAccount response type.
 type MsgInitLockupAccountResponse struct {
 }
@@ -143,6 +153,9 @@ type MsgInitPeriodicLockingAccount struct {
 	// start of lockup
 	StartTime      time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
 	LockingPeriods []Period  `protobuf:"bytes,3,rep,name=locking_periods,json=lockingPeriods,proto3" json:"locking_periods"`
+	// legacy_vesting_state is set by x/auth when migrating a legacy vesting account, the original
+	// locking is then taken from it instead of the funds sent at initialization.
+	LegacyVestingState *LegacyVestingState `protobuf:"bytes,4,opt,name=legacy_vesting_state,json=legacyVestingState,proto3" json:"legacy_vesting_state,omitempty"`
 }
 
 func (m *MsgInitPeriodicLockingAccount) Reset()         { *m = MsgInitPeriodicLockingAccount{} }
@@ -199,6 +212,13 @@ func (m *MsgInitPeriodicLockingAccount) GetLockingPeriods() []Period {
 	return nil
 }
 
+func (m *MsgInitPeriodicLockingAccount) GetLegacyVestingState() *LegacyVestingState {
+	if m != nil {
+		return m.LegacyVestingState
+	}
+	return nil
+}
+
 // MsgInitPeriodicLockingAccountResponse defines the Msg/InitPeriodicLockingAccount
 // response type.
 type MsgInitPeriodicLockingAccountResponse struct {
@@ -555,58 +575,61 @@ func init() {
 }
 
 var fileDescriptor_e5f39108a4d67f92 = []byte{
-	// 816 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0xda, 0xaa, 0xc1, 0x43, 0x81, 0xb2, 0x58, 0xc5, 0x58, 0x65, 0x97, 0x5a, 0x42, 0x58,
-	0x56, 0xbd, 0x5b, 0x68, 0xa5, 0x56, 0x56, 0x2f, 0xb8, 0xf4, 0x97, 0x54, 0x57, 0xc8, 0xf4, 0x87,
-	0xd4, 0x1c, 0xac, 0xf1, 0xee, 0x30, 0xac, 0xf0, 0xce, 0x58, 0x3b, 0x63, 0x1b, 0xdf, 0xa2, 0x28,
-	0x87, 0x88, 0x13, 0xe7, 0x9c, 0x38, 0x45, 0x11, 0x27, 0x47, 0xe2, 0x8f, 0xe0, 0x88, 0x38, 0x71,
-	0x0a, 0x91, 0x89, 0x64, 0xfe, 0x8c, 0x68, 0x77, 0x66, 0x89, 0x0d, 0x04, 0x1c, 0x1f, 0x12, 0x29,
-	0x17, 0xef, 0xcc, 0xbc, 0xef, 0xbd, 0xf7, 0xbd, 0x6f, 0xde, 0xdb, 0x35, 0xc8, 0x5a, 0x94, 0xb9,
-	0x94, 0x99, 0xd0, 0xb2, 0x68, 0x83, 0x70, 0x66, 0xda, 0x68, 0x0b, 0x36, 0x6a, 0x9c, 0x99, 0x35,
-	0x6a, 0xed, 0x34, 0xea, 0x26, 0xdf, 0x35, 0xea, 0x1e, 0xe5, 0x54, 0xd5, 0x05, 0xd2, 0x08, 0x91,
-	0x46, 0x88, 0x34, 0x04, 0x32, 0x3d, 0x03, 0x5d, 0x87, 0x50, 0x33, 0xf8, 0x15, 0x3e, 0x69, 0x4d,
-	0x46, 0xaf, 0x42, 0x86, 0xcc, 0xe6, 0x4a, 0x15, 0x71, 0xb8, 0x62, 0x5a, 0xd4, 0x21, 0xd2, 0xfe,
-	0xcd, 0x7d, 0xd9, 0xc5, 0x43, 0xa2, 0xe7, 0x24, 0xda, 0x65, 0xd8, 0x6c, 0xae, 0xf8, 0x0f, 0x69,
-	0x98, 0x17, 0x86, 0x4a, 0xb0, 0x33, 0x25, 0x4f, 0x61, 0x4a, 0x62, 0x8a, 0xa9, 0x38, 0xf7, 0x57,
-	0xa1, 0x03, 0xa6, 0x14, 0xd7, 0x90, 0x19, 0xec, 0xaa, 0x8d, 0x2d, 0x13, 0x92, 0xb6, 0x34, 0xe9,
-	0xd7, 0x4d, 0xdc, 0x71, 0x11, 0xe3, 0xd0, 0x95, 0x2c, 0x32, 0x0f, 0xa3, 0x20, 0x59, 0x62, 0xf8,
-	0x0f, 0xe2, 0xf0, 0x3f, 0x03, 0x76, 0x6b, 0x82, 0xbc, 0x6a, 0x80, 0xcf, 0x68, 0x8b, 0x20, 0x2f,
-	0xa5, 0x2c, 0x2a, 0xd9, 0x44, 0x31, 0x75, 0x7a, 0x94, 0x4f, 0x4a, 0x2e, 0x6b, 0xb6, 0xed, 0x21,
-	0xc6, 0x36, 0xb9, 0xe7, 0x10, 0x5c, 0x16, 0x30, 0x75, 0x1d, 0x8c, 0x23, 0x62, 0x57, 0xfc, 0xf8,
-	0xa9, 0xe8, 0xa2, 0x92, 0x9d, 0x58, 0x4d, 0x1b, 0x22, 0xb9, 0x11, 0x26, 0x37, 0xfe, 0x0e, 0x93,
-	0x17, 0x27, 0x8f, 0x5f, 0xea, 0x91, 0xfd, 0x73, 0x5d, 0x79, 0xde, 0xeb, 0xe4, 0x94, 0xf2, 0x18,
-	0x22, 0xb6, 0x6f, 0x54, 0x7f, 0x07, 0x80, 0x71, 0xe8, 0x71, 0x11, 0x27, 0xf6, 0xbe, 0x71, 0x12,
-	0x81, 0xb3, 0x6f, 0x2e, 0x64, 0x2f, 0x0f, 0x74, 0x65, 0xaf, 0xd7, 0xc9, 0xc9, 0x9b, 0xce, 0x33,
-	0x7b, 0xc7, 0xbc, 0xad, 0xd2, 0x8c, 0x06, 0xbe, 0xba, 0xed, 0xbc, 0x8c, 0x58, 0x9d, 0x12, 0x86,
-	0x32, 0xcf, 0xa2, 0x60, 0x41, 0x02, 0x36, 0x90, 0xe7, 0x50, 0xdb, 0xb1, 0x7c, 0xa0, 0x43, 0xf0,
-	0xa8, 0x5a, 0x0d, 0x56, 0x19, 0x1d, 0xbd, 0x4a, 0xf5, 0x01, 0x98, 0xae, 0x09, 0x2e, 0x95, 0x7a,
-	0xc0, 0x8d, 0xa5, 0x62, 0x8b, 0xb1, 0xec, 0xc4, 0xea, 0xb2, 0x71, 0x4f, 0x83, 0x1b, 0xa2, 0x96,
-	0x62, 0xc2, 0x8f, 0x2d, 0xe2, 0x4e, 0xc9, 0x50, 0xc2, 0xc2, 0x0a, 0xc6, 0xe5, 0x81, 0x1e, 0xf1,
-	0x25, 0x5c, 0xba, 0x29, 0xa1, 0xc0, 0x0c, 0x0a, 0xb9, 0x0c, 0x96, 0xee, 0xd4, 0xe9, 0x4a, 0xd1,
-	0xae, 0x02, 0x26, 0x4a, 0x0c, 0xaf, 0xa3, 0x1a, 0xc2, 0x90, 0x23, 0xf5, 0x5b, 0x10, 0x67, 0x88,
-	0xd8, 0x43, 0x08, 0x28, 0x71, 0xea, 0x5f, 0x60, 0xa6, 0x09, 0x6b, 0x8e, 0x0d, 0x39, 0xf5, 0x2a,
-	0x50, 0x40, 0x02, 0x21, 0x13, 0xc5, 0xaf, 0x4f, 0x8f, 0xf2, 0x0b, 0xd2, 0xf9, 0xdf, 0x10, 0x33,
-	0x18, 0xe5, 0x8b, 0xe6, 0xb5, 0x73, 0xf5, 0x27, 0x10, 0x87, 0xae, 0xcf, 0x51, 0xf6, 0xdc, 0x7c,
-	0x28, 0x9f, 0x3f, 0xeb, 0x86, 0x9c, 0x75, 0xe3, 0x67, 0xea, 0x90, 0x7e, 0xc1, 0xa4, 0x4f, 0x61,
-	0xf6, 0xc9, 0x81, 0x1e, 0xf1, 0xc5, 0x7a, 0xd4, 0xeb, 0xe4, 0x24, 0xc5, 0xcc, 0x6b, 0x05, 0x4c,
-	0x96, 0x18, 0xfe, 0x87, 0xd8, 0x9f, 0x74, 0x99, 0x87, 0x0a, 0x98, 0x29, 0x31, 0xfc, 0x9f, 0xc3,
-	0xb7, 0x6d, 0x0f, 0xb6, 0xca, 0xa8, 0x05, 0x3d, 0xfb, 0xe3, 0x97, 0x7a, 0x3b, 0xd9, 0xc7, 0x51,
-	0x30, 0x56, 0x62, 0x78, 0x13, 0x91, 0x51, 0x28, 0xfe, 0x00, 0x00, 0xa7, 0xd7, 0xb8, 0xbd, 0xdb,
-	0x2b, 0xc1, 0x69, 0x28, 0x7b, 0xbb, 0x4f, 0xf6, 0xd8, 0xdd, 0xb2, 0xff, 0xea, 0xcb, 0x7e, 0x78,
-	0xae, 0x67, 0xb1, 0xc3, 0xb7, 0x1b, 0x55, 0xc3, 0xa2, 0xae, 0xfc, 0x04, 0x98, 0x7d, 0x43, 0xc8,
-	0xdb, 0x75, 0xc4, 0x02, 0x07, 0xf6, 0xb4, 0xd7, 0xc9, 0x7d, 0xee, 0x37, 0x98, 0xd5, 0xae, 0xf8,
-	0xdf, 0x22, 0x36, 0xc4, 0x9d, 0x6d, 0x80, 0x74, 0x89, 0xe1, 0x5f, 0x76, 0x91, 0xd5, 0xe0, 0xa8,
-	0x84, 0x18, 0x83, 0x18, 0xb1, 0x70, 0x3a, 0xd5, 0x55, 0x90, 0xf0, 0xe4, 0x9a, 0xa5, 0x94, 0x80,
-	0x70, 0xf2, 0xc6, 0xcb, 0x69, 0x8d, 0xb4, 0xcb, 0x6f, 0x61, 0x99, 0x17, 0x62, 0xa2, 0xc3, 0x2e,
-	0x50, 0x7f, 0x04, 0xa0, 0x25, 0xd7, 0x43, 0x08, 0xdc, 0x87, 0x1d, 0x5d, 0xe4, 0x2f, 0x41, 0xdc,
-	0x46, 0x84, 0xba, 0xe2, 0x0d, 0x98, 0x28, 0xcb, 0x5d, 0x61, 0xae, 0x5f, 0x81, 0xbe, 0x4c, 0x99,
-	0x33, 0x05, 0xcc, 0x0e, 0x74, 0xae, 0xac, 0xff, 0x7b, 0x30, 0xee, 0x21, 0x0b, 0x39, 0xcd, 0x21,
-	0x98, 0x5f, 0x21, 0xd5, 0x3d, 0x05, 0x4c, 0x0b, 0xcd, 0x2b, 0xf2, 0xcc, 0x4e, 0x45, 0x3f, 0xd4,
-	0x6d, 0x4f, 0x89, 0xcc, 0x65, 0x99, 0xb8, 0xf8, 0xdb, 0x71, 0x57, 0x53, 0x4e, 0xba, 0x9a, 0xf2,
-	0xaa, 0xab, 0x29, 0xfb, 0x17, 0x5a, 0xe4, 0xe4, 0x42, 0x8b, 0x9c, 0x5d, 0x68, 0x91, 0xff, 0xf3,
-	0x22, 0x2e, 0xb3, 0x77, 0x0c, 0x87, 0x9a, 0xbb, 0x77, 0xfc, 0x53, 0xf2, 0x93, 0x56, 0xe3, 0xc1,
-	0x85, 0x7f, 0xf7, 0x26, 0x00, 0x00, 0xff, 0xff, 0x4e, 0xb6, 0xac, 0x3d, 0x59, 0x09, 0x00, 0x00,
+	// 849 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x13, 0xc8, 0x6e, 0xa6, 0xec, 0x2e, 0xf5, 0x46, 0x6c, 0x36, 0x62, 0xed, 0x12, 0x69,
+	0xb5, 0x51, 0x44, 0x6c, 0xda, 0x45, 0x02, 0x55, 0x5c, 0x1a, 0x96, 0x5f, 0x52, 0x83, 0x2a, 0x17,
+	0x8a, 0x04, 0x87, 0x68, 0x62, 0x4f, 0xa7, 0x56, 0xe3, 0x99, 0xc8, 0x6f, 0x92, 0x34, 0x57, 0xc4,
+	0x01, 0x55, 0x42, 0xea, 0x99, 0x53, 0x8f, 0xa8, 0xa7, 0x20, 0xf5, 0x8f, 0xe8, 0xb1, 0xea, 0xa9,
+	0x27, 0x8a, 0x52, 0xa4, 0xf4, 0xcf, 0x40, 0xf6, 0x8c, 0x4b, 0xd2, 0x96, 0x36, 0x14, 0xa9, 0x48,
+	0x5c, 0x62, 0xcf, 0xbc, 0xef, 0xbd, 0xf7, 0xcd, 0xf7, 0xcd, 0x8c, 0x83, 0xca, 0x2e, 0x87, 0x80,
+	0x83, 0x8d, 0x5d, 0x97, 0x77, 0x98, 0x00, 0xdb, 0x23, 0xeb, 0xb8, 0xd3, 0x12, 0x60, 0xb7, 0xb8,
+	0xbb, 0xd9, 0x69, 0xdb, 0x62, 0xcb, 0x6a, 0x87, 0x5c, 0x70, 0xdd, 0x94, 0x48, 0x2b, 0x41, 0x5a,
+	0x09, 0xd2, 0x92, 0xc8, 0xe2, 0x2c, 0x0e, 0x7c, 0xc6, 0xed, 0xf8, 0x57, 0xe6, 0x14, 0x0d, 0x55,
+	0xbd, 0x89, 0x81, 0xd8, 0xdd, 0xf9, 0x26, 0x11, 0x78, 0xde, 0x76, 0xb9, 0xcf, 0x54, 0xfc, 0xdd,
+	0x9b, 0xba, 0xcb, 0x87, 0x42, 0x3f, 0x51, 0xe8, 0x00, 0xa8, 0xdd, 0x9d, 0x8f, 0x1e, 0x2a, 0xf0,
+	0x54, 0x06, 0x1a, 0xf1, 0xc8, 0x56, 0x3c, 0x65, 0x28, 0x4f, 0x39, 0xe5, 0x72, 0x3e, 0x7a, 0x4b,
+	0x12, 0x28, 0xe7, 0xb4, 0x45, 0xec, 0x78, 0xd4, 0xec, 0xac, 0xdb, 0x98, 0xf5, 0x55, 0xc8, 0xbc,
+	0x18, 0x12, 0x7e, 0x40, 0x40, 0xe0, 0x40, 0xb1, 0x28, 0x8d, 0xd2, 0x28, 0x5f, 0x07, 0xfa, 0x05,
+	0xf3, 0xc5, 0x72, 0xcc, 0x6e, 0x49, 0x92, 0xd7, 0x2d, 0xf4, 0x3a, 0xef, 0x31, 0x12, 0x16, 0xb4,
+	0x39, 0xad, 0x9c, 0xab, 0x15, 0x8e, 0xf6, 0xab, 0x79, 0xc5, 0x65, 0xc9, 0xf3, 0x42, 0x02, 0xb0,
+	0x2a, 0x42, 0x9f, 0x51, 0x47, 0xc2, 0xf4, 0x57, 0xe8, 0x3e, 0x61, 0x5e, 0x23, 0xaa, 0x5f, 0x48,
+	0xcf, 0x69, 0xe5, 0x99, 0x85, 0xa2, 0x25, 0x9b, 0x5b, 0x49, 0x73, 0xeb, 0xab, 0xa4, 0x79, 0xed,
+	0xc1, 0xc1, 0x6f, 0x66, 0x6a, 0xe7, 0xc4, 0xd4, 0x7e, 0x19, 0x0d, 0x2a, 0x9a, 0x73, 0x8f, 0x30,
+	0x2f, 0x0a, 0xea, 0x9f, 0x23, 0x04, 0x02, 0x87, 0x42, 0xd6, 0xc9, 0xfc, 0xd3, 0x3a, 0xb9, 0x38,
+	0x39, 0xae, 0x44, 0x50, 0xbe, 0x45, 0x28, 0x76, 0xfb, 0x8d, 0x2e, 0x01, 0xe1, 0x33, 0xda, 0x00,
+	0x81, 0x05, 0x29, 0xbc, 0x16, 0xd7, 0x7c, 0x69, 0xdd, 0xe0, 0xbf, 0xb5, 0x1c, 0x27, 0xaf, 0xc9,
+	0xdc, 0xd5, 0x28, 0xd5, 0xd1, 0x5b, 0x97, 0xe6, 0x16, 0xcb, 0x67, 0xbb, 0xa6, 0xb6, 0x3d, 0x1a,
+	0x54, 0xd4, 0x86, 0xaa, 0x82, 0xb7, 0x69, 0x5f, 0x25, 0x68, 0xc9, 0x40, 0x6f, 0x5f, 0x35, 0xef,
+	0x10, 0x68, 0x73, 0x06, 0xa4, 0xf4, 0x53, 0x06, 0x3d, 0x53, 0x80, 0x15, 0x12, 0xfa, 0xdc, 0xf3,
+	0xdd, 0x08, 0xe8, 0x33, 0x7a, 0x5b, 0x4b, 0x26, 0xc5, 0x4c, 0xff, 0x0b, 0x31, 0xbf, 0x43, 0x8f,
+	0x5a, 0x92, 0x4b, 0xa3, 0x1d, 0x73, 0x83, 0x42, 0x66, 0x2e, 0x53, 0x9e, 0x59, 0x78, 0x71, 0xa3,
+	0x8e, 0x72, 0x2d, 0xb5, 0x5c, 0x54, 0x5b, 0xd6, 0x7d, 0xa8, 0x4a, 0xc9, 0x08, 0xdc, 0x95, 0x53,
+	0xd6, 0xd9, 0xae, 0x99, 0x8a, 0x9c, 0x7a, 0x7e, 0xd9, 0x29, 0x49, 0x65, 0xd2, 0xaf, 0x17, 0xe8,
+	0xf9, 0xb5, 0x76, 0x9c, 0x1b, 0x37, 0xd4, 0xd0, 0x4c, 0x1d, 0xe8, 0x2b, 0x12, 0x35, 0x15, 0x44,
+	0x7f, 0x0f, 0x65, 0x81, 0x30, 0x6f, 0x0a, 0x9f, 0x14, 0x4e, 0xff, 0x12, 0xcd, 0x76, 0x71, 0xcb,
+	0xf7, 0xb0, 0xe0, 0x61, 0x03, 0x4b, 0x48, 0xec, 0x57, 0xae, 0xf6, 0xce, 0xd1, 0x7e, 0xf5, 0x99,
+	0x4a, 0x5e, 0x4b, 0x30, 0x93, 0x55, 0xde, 0xec, 0x5e, 0x98, 0xd7, 0x3f, 0x42, 0x59, 0x1c, 0x44,
+	0x1c, 0xd5, 0x09, 0x7a, 0x9a, 0x68, 0x18, 0xdd, 0x5c, 0x96, 0xba, 0xb9, 0xac, 0x8f, 0xb9, 0xcf,
+	0xc6, 0x7d, 0x51, 0x39, 0x8b, 0x8f, 0x7f, 0xdc, 0x35, 0x53, 0x91, 0x58, 0xdf, 0x8f, 0x06, 0x15,
+	0x45, 0xb1, 0xf4, 0x87, 0x86, 0x1e, 0xd4, 0x81, 0x7e, 0xcd, 0xbc, 0xff, 0xf5, 0x32, 0xf7, 0x34,
+	0x34, 0x5b, 0x07, 0xfa, 0x8d, 0x2f, 0x36, 0xbc, 0x10, 0xf7, 0x1c, 0xd2, 0xc3, 0xa1, 0xf7, 0xdf,
+	0x2f, 0xf5, 0x6a, 0xb2, 0x3f, 0xa4, 0xd1, 0xbd, 0x3a, 0xd0, 0x55, 0xc2, 0x6e, 0x43, 0xf1, 0x03,
+	0x84, 0x04, 0xbf, 0xc0, 0xed, 0xef, 0xb3, 0x72, 0x82, 0x27, 0xb2, 0xf7, 0xc7, 0x64, 0xcf, 0x5c,
+	0x2f, 0xfb, 0xa7, 0x91, 0xec, 0x7b, 0x27, 0x66, 0x99, 0xfa, 0x62, 0xa3, 0xd3, 0xb4, 0x5c, 0x1e,
+	0xa8, 0x0f, 0x9a, 0x3d, 0x76, 0x08, 0x45, 0xbf, 0x4d, 0x20, 0x4e, 0x80, 0x9f, 0x47, 0x83, 0xca,
+	0x1b, 0xea, 0x36, 0x88, 0xbe, 0xac, 0x30, 0x85, 0x67, 0x2b, 0xa8, 0x58, 0x07, 0xfa, 0xc9, 0x16,
+	0x71, 0x3b, 0x82, 0xd4, 0x09, 0x00, 0xa6, 0x04, 0x92, 0xd3, 0xa9, 0x2f, 0xa0, 0x5c, 0xa8, 0xde,
+	0xa1, 0xa0, 0xc5, 0x84, 0xf3, 0x97, 0xee, 0xc0, 0x25, 0xd6, 0x77, 0xfe, 0x82, 0x95, 0x7e, 0x95,
+	0x27, 0x3a, 0xd9, 0x05, 0xfa, 0x87, 0x08, 0xf5, 0xd4, 0xfb, 0x14, 0x02, 0x8f, 0x61, 0x6f, 0x2f,
+	0xf2, 0x5b, 0x28, 0xeb, 0x11, 0xc6, 0x03, 0x79, 0xd1, 0xe6, 0x1c, 0x35, 0x5a, 0x7c, 0x32, 0xae,
+	0xc0, 0x58, 0xa7, 0xd2, 0xb1, 0x86, 0x1e, 0x4f, 0xec, 0x5c, 0xb5, 0xfe, 0xf7, 0xd1, 0xfd, 0x90,
+	0xb8, 0xc4, 0xef, 0x4e, 0xc1, 0xfc, 0x1c, 0xa9, 0x6f, 0x6b, 0xe8, 0x91, 0xd4, 0xbc, 0xa1, 0xe6,
+	0xbc, 0x42, 0xfa, 0xae, 0xdc, 0x7e, 0x28, 0x3b, 0x3b, 0xaa, 0x71, 0xed, 0xb3, 0x83, 0xa1, 0xa1,
+	0x1d, 0x0e, 0x0d, 0xed, 0xf7, 0xa1, 0xa1, 0xed, 0x9c, 0x1a, 0xa9, 0xc3, 0x53, 0x23, 0x75, 0x7c,
+	0x6a, 0xa4, 0xbe, 0xad, 0xca, 0xba, 0xe0, 0x6d, 0x5a, 0x3e, 0xb7, 0xb7, 0xae, 0xf9, 0xdf, 0x17,
+	0x35, 0x6d, 0x66, 0x63, 0xc3, 0x5f, 0xfe, 0x39, 0x00, 0x59, 0x6a, 0xa3, 0x90, 0x27, 0x0a, 0x00,
+	0x00,
 }
 
 func (this *MsgInitLockupAccount) Equal(that interface{}) bool {
@@ -637,6 +660,9 @@ func (this *MsgInitLockupAccount) Equal(that interface{}) bool {
 	if !this.StartTime.Equal(that1.StartTime) {
 		return false
 	}
+	if !this.LegacyVestingState.Equal(that1.LegacyVestingState) {
+		return false
+	}
 	return true
 }
 func (m *MsgInitLockupAccount) Marshal() (dAtA []byte, err error) {
@@ -659,21 +685,33 @@ func (m *MsgInitLockupAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	if m.LegacyVestingState != nil {
+		{
+			size, err := m.LegacyVestingState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1a
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintTx(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
@@ -728,6 +766,18 @@ func (m *MsgInitPeriodicLockingAccount) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.LegacyVestingState != nil {
+		{
+			size, err := m.LegacyVestingState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.LockingPeriods) > 0 {
 		for iNdEx := len(m.LockingPeriods) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -742,12 +792,12 @@ func (m *MsgInitPeriodicLockingAccount) MarshalToSizedBuffer(dAtA []byte) (int,
 			dAtA[i] = 0x1a
 		}
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -1117,6 +1167,10 @@ func (m *MsgInitLockupAccount) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
 	n += 1 + l + sovTx(uint64(l))
+	if m.LegacyVestingState != nil {
+		l = m.LegacyVestingState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1147,6 +1201,10 @@ func (m *MsgInitPeriodicLockingAccount) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.LegacyVestingState != nil {
+		l = m.LegacyVestingState.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1427,6 +1485,42 @@ func (m *MsgInitLockupAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyVestingState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LegacyVestingState == nil {
+				m.LegacyVestingState = &LegacyVestingState{}
+			}
+			if err := m.LegacyVestingState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1626,6 +1720,42 @@ func (m *MsgInitPeriodicLockingAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyVestingState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LegacyVestingState == nil {
+				m.LegacyVestingState = &LegacyVestingState{}
+			}
+			if err := m.LegacyVestingState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
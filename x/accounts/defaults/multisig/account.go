@@ -24,6 +24,7 @@ var (
 	ConfigPrefix    = collections.NewPrefix(2)
 	ProposalsPrefix = collections.NewPrefix(3)
 	VotesPrefix     = collections.NewPrefix(4)
+	VetoesPrefix    = collections.NewPrefix(5)
 )
 
 // Compile-time type assertions
@@ -42,6 +43,7 @@ type Account struct {
 
 	Proposals collections.Map[uint64, v1.Proposal]
 	Votes     collections.Map[collections.Pair[uint64, []byte], int32] // key: proposalID + voter address
+	Vetoes    collections.KeySet[collections.Pair[uint64, []byte]]     // key: proposalID + member address
 }
 
 // NewAccount returns a new multisig account creator function.
@@ -52,6 +54,7 @@ func NewAccount(deps accountstd.Dependencies) (*Account, error) {
 		Config:        collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
 		Proposals:     collections.NewMap(deps.SchemaBuilder, ProposalsPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](deps.LegacyStateCodec)),
 		Votes:         collections.NewMap(deps.SchemaBuilder, VotesPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.Int32Value),
+		Vetoes:        collections.NewKeySet(deps.SchemaBuilder, VetoesPrefix, "vetoes", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
 		addrCodec:     deps.AddressCodec,
 		headerService: deps.Environment.HeaderService,
 		eventService:  deps.Environment.EventService,
@@ -203,6 +206,11 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 		proposal.VotingPeriodEnd = a.headerService.HeaderInfo(ctx).Time.Add(time.Second * time.Duration(config.VotingPeriod)).Unix()
 	}
 
+	// set the expiry, counted from the end of the voting period
+	if config.ExpiryPeriod != 0 {
+		proposal.ExpiresAt = proposal.VotingPeriodEnd + config.ExpiryPeriod
+	}
+
 	if err = a.Proposals.Set(ctx, seq, proposal); err != nil {
 		return nil, err
 	}
@@ -222,7 +230,7 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 	return &v1.MsgCreateProposalResponse{ProposalId: seq}, nil
 }
 
-// deleteProposalAndVotes deletes a proposal and its votes, pruning the state.
+// deleteProposalAndVotes deletes a proposal, its votes and vetoes, pruning the state.
 func (a Account) deleteProposalAndVotes(ctx context.Context, proposalID uint64) error {
 	// delete the proposal
 	if err := a.Proposals.Remove(ctx, proposalID); err != nil {
@@ -231,24 +239,44 @@ func (a Account) deleteProposalAndVotes(ctx context.Context, proposalID uint64)
 
 	// delete the votes
 	rng := collections.NewPrefixedPairRange[uint64, []byte](proposalID)
-	return a.Votes.Clear(ctx, rng)
+	if err := a.Votes.Clear(ctx, rng); err != nil {
+		return err
+	}
+
+	// delete the vetoes
+	return a.Vetoes.Clear(ctx, rng)
 }
 
 // ExecuteProposal tallies the votes for a proposal and executes it if it passes. If early execution is enabled, it will
 // ignore the voting period and tally the votes without deleting them if the proposal has not passed.
+// If the config has an execution delay, a passing proposal is queued instead, and executed by a later call once
+// the delay has ended. Proposals executed after their expiry are marked as expired instead.
 func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal) (*v1.MsgExecuteProposalResponse, error) {
 	prop, err := a.Proposals.Get(ctx, msg.ProposalId)
 	if err != nil {
 		return nil, err
 	}
 
+	if prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD && prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_QUEUED {
+		return nil, fmt.Errorf("proposal has already been finalized with status %s", prop.Status)
+	}
+
+	now := a.headerService.HeaderInfo(ctx).Time.Unix()
+	if prop.ExpiresAt != 0 && now > prop.ExpiresAt {
+		return &v1.MsgExecuteProposalResponse{}, a.expireProposal(ctx, msg.ProposalId, prop)
+	}
+
+	if prop.Status == v1.ProposalStatus_PROPOSAL_STATUS_QUEUED {
+		return a.executeQueuedProposal(ctx, msg.ProposalId, prop)
+	}
+
 	config, err := a.Config.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// check if voting period is still active and early execution is disabled
-	votingPeriodEnded := a.headerService.HeaderInfo(ctx).Time.Unix() > prop.VotingPeriodEnd
+	votingPeriodEnded := now > prop.VotingPeriodEnd
 	if !votingPeriodEnded && !config.EarlyExecution {
 		return nil, errors.New("voting period has not ended yet, and early execution is not enabled")
 	}
@@ -296,6 +324,14 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 	} else if yesVotes < uint64(config.Threshold) {
 		rejectErr = errors.New("threshold not reached")
 		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_REJECTED
+	} else if config.ExecutionDelay > 0 {
+		// we have quorum and threshold, queue the proposal until the execution delay ends
+		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_QUEUED
+		prop.ExecutableAt = now + config.ExecutionDelay
+		prop.ExpiresAt = 0
+		if config.ExpiryPeriod != 0 {
+			prop.ExpiresAt = prop.ExecutableAt + config.ExpiryPeriod
+		}
 	} else {
 		// we have quorum and threshold, execute the proposal
 		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
@@ -303,7 +339,7 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 	}
 
 	// if early execution is enabled, we return early if the proposal has NOT passed
-	if config.EarlyExecution && prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_PASSED {
+	if config.EarlyExecution && prop.Status == v1.ProposalStatus_PROPOSAL_STATUS_REJECTED {
 		return nil, errors.New("early execution attempted and proposal has not passed")
 	}
 
@@ -377,6 +413,7 @@ func (a *Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, a.Vote)
 	accountstd.RegisterExecuteHandler(builder, a.CreateProposal)
 	accountstd.RegisterExecuteHandler(builder, a.ExecuteProposal)
+	accountstd.RegisterExecuteHandler(builder, a.VetoProposal)
 	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
 }
 
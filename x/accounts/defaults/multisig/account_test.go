@@ -157,6 +157,73 @@ func TestInit(t *testing.T) {
 			},
 			"quorum must be less than or equal to the total weight",
 		},
+		{
+			"veto quorum without execution delay",
+			&v1.MsgInit{
+				Config: &v1.Config{
+					Threshold:    666,
+					Quorum:       400,
+					VotingPeriod: 60,
+					VetoQuorum:   400,
+				},
+				Members: []*v1.Member{
+					{
+						Address: "addr1",
+						Weight:  500,
+					},
+					{
+						Address: "addr2",
+						Weight:  1000,
+					},
+				},
+			},
+			"veto quorum requires an execution delay",
+		},
+		{
+			"veto quorum greater than total weight",
+			&v1.MsgInit{
+				Config: &v1.Config{
+					Threshold:      666,
+					Quorum:         400,
+					VotingPeriod:   60,
+					ExecutionDelay: 60,
+					VetoQuorum:     2000,
+				},
+				Members: []*v1.Member{
+					{
+						Address: "addr1",
+						Weight:  500,
+					},
+					{
+						Address: "addr2",
+						Weight:  1000,
+					},
+				},
+			},
+			"veto quorum must be less than or equal to the total weight",
+		},
+		{
+			"negative execution delay",
+			&v1.MsgInit{
+				Config: &v1.Config{
+					Threshold:      666,
+					Quorum:         400,
+					VotingPeriod:   60,
+					ExecutionDelay: -1,
+				},
+				Members: []*v1.Member{
+					{
+						Address: "addr1",
+						Weight:  500,
+					},
+					{
+						Address: "addr2",
+						Weight:  1000,
+					},
+				},
+			},
+			"execution delay, veto quorum and expiry period must not be negative",
+		},
 	}
 
 	for _, tc := range testcases {
@@ -710,3 +777,187 @@ func TestWeightOverflow(t *testing.T) {
 	_, err = acc.Init(ctx, startAcc)
 	require.ErrorContains(t, err, "overflow")
 }
+
+// setupTimelock returns a multisig account with an execution delay, a veto quorum and an expiry period,
+// and a counter of the proposal messages it executed.
+func setupTimelock(t *testing.T, currentTime *time.Time) (context.Context, *Account, *int) {
+	t.Helper()
+	startAcc := &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:      2000,
+			Quorum:         2000,
+			VotingPeriod:   60,
+			ExecutionDelay: 100,
+			VetoQuorum:     2000,
+			ExpiryPeriod:   50,
+		},
+		Members: []*v1.Member{
+			{
+				Address: "addr1",
+				Weight:  1000,
+			},
+			{
+				Address: "addr2",
+				Weight:  1000,
+			},
+			{
+				Address: "addr3",
+				Weight:  1000,
+			},
+			{
+				Address: "addr4",
+				Weight:  1000,
+			},
+		},
+	}
+
+	executed := 0
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte("multisig_acc"), []byte("addr1"), TestFunds, func(ctx context.Context, sender []byte, msg transaction.Msg) (transaction.Msg, error) {
+			executed++
+			return &v1.MsgUpdateConfigResponse{}, nil
+		}, func(ctx context.Context, req transaction.Msg) (transaction.Msg, error) {
+			return nil, nil
+		},
+	)
+
+	acc := setup(t, ctx, ss, func() time.Time {
+		return *currentTime
+	})
+	_, err := acc.Init(ctx, startAcc)
+	require.NoError(t, err)
+
+	return ctx, acc, &executed
+}
+
+// createPassingProposal creates a proposal and votes yes on it with addr1 and addr2.
+func createPassingProposal(t *testing.T, ctx context.Context, acc *Account) uint64 {
+	t.Helper()
+	anymsg, err := accountstd.PackAny(&v1.MsgUpdateConfig{})
+	require.NoError(t, err)
+
+	createRes, err := acc.CreateProposal(ctx, &v1.MsgCreateProposal{
+		Proposal: &v1.Proposal{
+			Title:    "test",
+			Summary:  "test",
+			Messages: []*types.Any{anymsg},
+		},
+	})
+	require.NoError(t, err)
+
+	for _, voter := range []string{"addr1", "addr2"} {
+		_, err = acc.Vote(accountstd.SetSender(ctx, []byte(voter)), &v1.MsgVote{
+			ProposalId: createRes.ProposalId,
+			Vote:       v1.VoteOption_VOTE_OPTION_YES,
+		})
+		require.NoError(t, err)
+	}
+
+	return createRes.ProposalId
+}
+
+func TestProposalTimelock(t *testing.T) {
+	currentTime := time.Unix(1000, 0)
+	ctx, acc, executed := setupTimelock(t, &currentTime)
+	propId := createPassingProposal(t, ctx, acc)
+
+	// the passing proposal is queued
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err := acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, 0, *executed)
+
+	prop, err := acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_QUEUED, prop.Proposal.Status)
+	require.Equal(t, int64(1161), prop.Proposal.ExecutableAt)
+	require.Equal(t, int64(1211), prop.Proposal.ExpiresAt)
+
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "execution delay has not ended yet")
+
+	// a veto below the veto quorum does not cancel the proposal
+	_, err = acc.VetoProposal(accountstd.SetSender(ctx, []byte("addr3")), &v1.MsgVetoProposal{ProposalId: propId})
+	require.NoError(t, err)
+
+	currentTime = time.Unix(1161, 0)
+	_, err = acc.VetoProposal(accountstd.SetSender(ctx, []byte("addr4")), &v1.MsgVetoProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "execution delay has ended")
+
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, 1, *executed)
+
+	prop, err = acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_PASSED, prop.Proposal.Status)
+
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "proposal has already been finalized")
+	require.Equal(t, 1, *executed)
+}
+
+func TestProposalVeto(t *testing.T) {
+	currentTime := time.Unix(1000, 0)
+	ctx, acc, executed := setupTimelock(t, &currentTime)
+	propId := createPassingProposal(t, ctx, acc)
+
+	// proposals can only be vetoed once queued
+	_, err := acc.VetoProposal(accountstd.SetSender(ctx, []byte("addr3")), &v1.MsgVetoProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "proposal is not queued for execution")
+
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.NoError(t, err)
+
+	_, err = acc.VetoProposal(accountstd.SetSender(ctx, []byte("not_a_member")), &v1.MsgVetoProposal{ProposalId: propId})
+	require.Error(t, err)
+
+	_, err = acc.VetoProposal(accountstd.SetSender(ctx, []byte("addr3")), &v1.MsgVetoProposal{ProposalId: propId})
+	require.NoError(t, err)
+
+	_, err = acc.VetoProposal(accountstd.SetSender(ctx, []byte("addr3")), &v1.MsgVetoProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "member has already vetoed the proposal")
+
+	// the veto quorum is reached
+	_, err = acc.VetoProposal(accountstd.SetSender(ctx, []byte("addr4")), &v1.MsgVetoProposal{ProposalId: propId})
+	require.NoError(t, err)
+
+	prop, err := acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_VETOED, prop.Proposal.Status)
+
+	currentTime = currentTime.Add(100 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "proposal has already been finalized")
+	require.Equal(t, 0, *executed)
+}
+
+func TestProposalExpiry(t *testing.T) {
+	currentTime := time.Unix(1000, 0)
+	ctx, acc, executed := setupTimelock(t, &currentTime)
+
+	// a queued proposal expires after its execution delay and the expiry period
+	queuedId := createPassingProposal(t, ctx, acc)
+	// a proposal which is not tallied expires after its voting period and the expiry period
+	untalliedId := createPassingProposal(t, ctx, acc)
+
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err := acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: queuedId})
+	require.NoError(t, err)
+
+	currentTime = time.Unix(1111, 0)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: untalliedId})
+	require.NoError(t, err)
+
+	currentTime = time.Unix(1212, 0)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: queuedId})
+	require.NoError(t, err)
+
+	for _, propId := range []uint64{queuedId, untalliedId} {
+		prop, err := acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: propId})
+		require.NoError(t, err)
+		require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_EXPIRED, prop.Proposal.Status)
+	}
+	require.Equal(t, 0, *executed)
+}
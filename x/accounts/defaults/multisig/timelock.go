@@ -0,0 +1,161 @@
+package multisig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+)
+
+// VetoProposal vetoes a queued proposal during its execution delay. The sender must be a member of the multisig,
+// once the vetoes reach the veto quorum the proposal is cancelled.
+func (a Account) VetoProposal(ctx context.Context, msg *v1.MsgVetoProposal) (*v1.MsgVetoProposalResponse, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.VetoQuorum == 0 {
+		return nil, errors.New("vetoes are not enabled per config")
+	}
+
+	sender := accountstd.Sender(ctx)
+
+	// check if the sender is a member
+	_, err = a.Members.Get(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	prop, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_QUEUED {
+		return nil, errors.New("proposal is not queued for execution")
+	}
+
+	if a.headerService.HeaderInfo(ctx).Time.Unix() >= prop.ExecutableAt {
+		return nil, errors.New("execution delay has ended")
+	}
+
+	vetoKey := collections.Join(msg.ProposalId, sender)
+	vetoed, err := a.Vetoes.Has(ctx, vetoKey)
+	if err != nil {
+		return nil, err
+	}
+	if vetoed {
+		return nil, errors.New("member has already vetoed the proposal")
+	}
+
+	if err = a.Vetoes.Set(ctx, vetoKey); err != nil {
+		return nil, err
+	}
+
+	addr, err := a.addrCodec.BytesToString(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("veto",
+		event.NewAttribute("proposal_id", fmt.Sprint(msg.ProposalId)),
+		event.NewAttribute("member", addr),
+	); err != nil {
+		return nil, err
+	}
+
+	// tally the vetoes
+	rng := collections.NewPrefixedPairRange[uint64, []byte](msg.ProposalId)
+	vetoWeight := uint64(0)
+	err = a.Vetoes.Walk(ctx, rng, func(key collections.Pair[uint64, []byte]) (stop bool, err error) {
+		weight, err := a.Members.Get(ctx, key.K2())
+		if errors.Is(err, collections.ErrNotFound) {
+			// edge case: if a member has been removed after vetoing, we should ignore their veto
+			return false, nil
+		} else if err != nil {
+			return true, err
+		}
+
+		vetoWeight, err = safeAdd(vetoWeight, weight)
+		return err != nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if vetoWeight < uint64(config.VetoQuorum) {
+		return &v1.MsgVetoProposalResponse{}, nil
+	}
+
+	// the veto quorum is reached, cancel the proposal
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_VETOED
+	if err = a.deleteProposalAndVotes(ctx, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("proposal_vetoed",
+		event.NewAttribute("proposal_id", fmt.Sprint(msg.ProposalId)),
+		event.NewAttribute("veto_weight", fmt.Sprint(vetoWeight)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVetoProposalResponse{}, a.Proposals.Set(ctx, msg.ProposalId, prop)
+}
+
+// executeQueuedProposal executes a queued proposal once its execution delay has ended.
+func (a Account) executeQueuedProposal(ctx context.Context, proposalID uint64, prop v1.Proposal) (*v1.MsgExecuteProposalResponse, error) {
+	if a.headerService.HeaderInfo(ctx).Time.Unix() < prop.ExecutableAt {
+		return nil, errors.New("execution delay has not ended yet")
+	}
+
+	var (
+		err     error
+		execErr error
+	)
+
+	resp := &v1.MsgExecuteProposalResponse{}
+
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
+	resp.Responses, execErr = accountstd.ExecModuleAnys(ctx, prop.Messages) // do not return this error, just emit the event
+
+	if err = a.deleteProposalAndVotes(ctx, proposalID); err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("proposal_executed",
+		event.NewAttribute("proposal_id", fmt.Sprint(proposalID)),
+		event.NewAttribute("status", prop.Status.String()),
+		event.NewAttribute("exec_err", fmt.Sprint(execErr)),
+	); err != nil {
+		return nil, err
+	}
+
+	if err = a.Proposals.Set(ctx, proposalID, prop); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// expireProposal marks a proposal which was not executed before its expiry as expired, pruning its votes and vetoes.
+func (a Account) expireProposal(ctx context.Context, proposalID uint64, prop v1.Proposal) error {
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_EXPIRED
+	if err := a.deleteProposalAndVotes(ctx, proposalID); err != nil {
+		return err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("proposal_expired",
+		event.NewAttribute("proposal_id", fmt.Sprint(proposalID)),
+		event.NewAttribute("status", prop.Status.String()),
+	); err != nil {
+		return err
+	}
+
+	return a.Proposals.Set(ctx, proposalID, prop)
+}
@@ -84,5 +84,20 @@ func validateConfig(cfg v1.Config, totalWeight uint64) error {
 		return errors.New("quorum must be less than or equal to the total weight")
 	}
 
+	// check for negative values
+	if cfg.ExecutionDelay < 0 || cfg.VetoQuorum < 0 || cfg.ExpiryPeriod < 0 {
+		return errors.New("execution delay, veto quorum and expiry period must not be negative")
+	}
+
+	// vetoes can only be cast during the execution delay
+	if cfg.VetoQuorum > 0 && cfg.ExecutionDelay == 0 {
+		return errors.New("veto quorum requires an execution delay")
+	}
+
+	// veto quorum must be less than or equal to the total weight
+	if totalWeight < uint64(cfg.VetoQuorum) {
+		return errors.New("veto quorum must be less than or equal to the total weight")
+	}
+
 	return nil
 }
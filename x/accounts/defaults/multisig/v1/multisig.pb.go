@@ -36,6 +36,15 @@ const (
 	ProposalStatus_PROPOSAL_STATUS_PASSED ProposalStatus = 2
 	// PROPOSAL_STATUS_REJECTED defines the proposal status when the proposal was rejected.
 	ProposalStatus_PROPOSAL_STATUS_REJECTED ProposalStatus = 3
+	// PROPOSAL_STATUS_QUEUED defines the proposal status when the proposal passed and waits for
+	// its execution delay to end.
+	ProposalStatus_PROPOSAL_STATUS_QUEUED ProposalStatus = 4
+	// PROPOSAL_STATUS_VETOED defines the proposal status when the proposal was vetoed during its
+	// execution delay.
+	ProposalStatus_PROPOSAL_STATUS_VETOED ProposalStatus = 5
+	// PROPOSAL_STATUS_EXPIRED defines the proposal status when the proposal was not executed
+	// before its expiry.
+	ProposalStatus_PROPOSAL_STATUS_EXPIRED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -43,6 +52,9 @@ var ProposalStatus_name = map[int32]string{
 	1: "PROPOSAL_STATUS_VOTING_PERIOD",
 	2: "PROPOSAL_STATUS_PASSED",
 	3: "PROPOSAL_STATUS_REJECTED",
+	4: "PROPOSAL_STATUS_QUEUED",
+	5: "PROPOSAL_STATUS_VETOED",
+	6: "PROPOSAL_STATUS_EXPIRED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -50,6 +62,9 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_VOTING_PERIOD": 1,
 	"PROPOSAL_STATUS_PASSED":        2,
 	"PROPOSAL_STATUS_REJECTED":      3,
+	"PROPOSAL_STATUS_QUEUED":        4,
+	"PROPOSAL_STATUS_VETOED":        5,
+	"PROPOSAL_STATUS_EXPIRED":       6,
 }
 
 func (x ProposalStatus) String() string {
@@ -456,6 +471,88 @@ func (m *MsgExecuteProposalResponse) GetResponses() []*any.Any {
 	return nil
 }
 
+// MsgVetoProposal is used by a member to veto a proposal during its execution delay.
+type MsgVetoProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgVetoProposal) Reset()         { *m = MsgVetoProposal{} }
+func (m *MsgVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposal) ProtoMessage()    {}
+func (*MsgVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{8}
+}
+func (m *MsgVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposal.Merge(m, src)
+}
+func (m *MsgVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposal proto.InternalMessageInfo
+
+func (m *MsgVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgVetoProposalResponse is the response returned after vetoing a proposal.
+type MsgVetoProposalResponse struct {
+}
+
+func (m *MsgVetoProposalResponse) Reset()         { *m = MsgVetoProposalResponse{} }
+func (m *MsgVetoProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposalResponse) ProtoMessage()    {}
+func (*MsgVetoProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{9}
+}
+func (m *MsgVetoProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposalResponse.Merge(m, src)
+}
+func (m *MsgVetoProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposalResponse proto.InternalMessageInfo
+
 // MsgUpdateConfig is used to change the config or members.
 type MsgUpdateConfig struct {
 	// only the members that are changing are required, if their weight is 0, they are removed.
@@ -468,7 +565,7 @@ func (m *MsgUpdateConfig) Reset()         { *m = MsgUpdateConfig{} }
 func (m *MsgUpdateConfig) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateConfig) ProtoMessage()    {}
 func (*MsgUpdateConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{8}
+	return fileDescriptor_e6da8796717704d7, []int{10}
 }
 func (m *MsgUpdateConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +616,7 @@ func (m *MsgUpdateConfigResponse) Reset()         { *m = MsgUpdateConfigResponse
 func (m *MsgUpdateConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateConfigResponse) ProtoMessage()    {}
 func (*MsgUpdateConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{9}
+	return fileDescriptor_e6da8796717704d7, []int{11}
 }
 func (m *MsgUpdateConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,7 +655,7 @@ func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{10}
+	return fileDescriptor_e6da8796717704d7, []int{12}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,13 +708,23 @@ type Config struct {
 	Revote bool `protobuf:"varint,4,opt,name=revote,proto3" json:"revote,omitempty"`
 	// early_execution defines if the multisig can be executed before the voting period ends.
 	EarlyExecution bool `protobuf:"varint,5,opt,name=early_execution,json=earlyExecution,proto3" json:"early_execution,omitempty"`
+	// execution_delay is the duration in seconds between a proposal passing and its execution,
+	// if zero passed proposals are executed right away.
+	ExecutionDelay int64 `protobuf:"varint,6,opt,name=execution_delay,json=executionDelay,proto3" json:"execution_delay,omitempty"`
+	// veto_quorum is the weight of vetoes cancelling a proposal during its execution delay,
+	// if zero proposals cannot be vetoed.
+	VetoQuorum int64 `protobuf:"varint,7,opt,name=veto_quorum,json=vetoQuorum,proto3" json:"veto_quorum,omitempty"`
+	// expiry_period is the duration in seconds after the end of the voting period, or of the
+	// execution delay of a passed proposal, after which an unexecuted proposal expires.
+	// If zero proposals do not expire.
+	ExpiryPeriod int64 `protobuf:"varint,8,opt,name=expiry_period,json=expiryPeriod,proto3" json:"expiry_period,omitempty"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
 func (m *Config) String() string { return proto.CompactTextString(m) }
 func (*Config) ProtoMessage()    {}
 func (*Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{11}
+	return fileDescriptor_e6da8796717704d7, []int{13}
 }
 func (m *Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -681,6 +788,27 @@ func (m *Config) GetEarlyExecution() bool {
 	return false
 }
 
+func (m *Config) GetExecutionDelay() int64 {
+	if m != nil {
+		return m.ExecutionDelay
+	}
+	return 0
+}
+
+func (m *Config) GetVetoQuorum() int64 {
+	if m != nil {
+		return m.VetoQuorum
+	}
+	return 0
+}
+
+func (m *Config) GetExpiryPeriod() int64 {
+	if m != nil {
+		return m.ExpiryPeriod
+	}
+	return 0
+}
+
 // Proposal defines the structure of a proposal.
 type Proposal struct {
 	Title    string     `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -689,13 +817,19 @@ type Proposal struct {
 	// voting_period_end will be set by the account when the proposal is created.
 	VotingPeriodEnd int64          `protobuf:"varint,4,opt,name=voting_period_end,json=votingPeriodEnd,proto3" json:"voting_period_end,omitempty"`
 	Status          ProposalStatus `protobuf:"varint,5,opt,name=status,proto3,enum=cosmos.accounts.defaults.multisig.v1.ProposalStatus" json:"status,omitempty"`
+	// executable_at will be set by the account when the proposal passes, if the config has an
+	// execution delay.
+	ExecutableAt int64 `protobuf:"varint,6,opt,name=executable_at,json=executableAt,proto3" json:"executable_at,omitempty"`
+	// expires_at will be set by the account when the proposal is created or passes, if the config
+	// has an expiry period.
+	ExpiresAt int64 `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{12}
+	return fileDescriptor_e6da8796717704d7, []int{14}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -759,6 +893,20 @@ func (m *Proposal) GetStatus() ProposalStatus {
 	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
 }
 
+func (m *Proposal) GetExecutableAt() int64 {
+	if m != nil {
+		return m.ExecutableAt
+	}
+	return 0
+}
+
+func (m *Proposal) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 // QuerySequence is the request for the account sequence.
 type QuerySequence struct {
 }
@@ -767,7 +915,7 @@ func (m *QuerySequence) Reset()         { *m = QuerySequence{} }
 func (m *QuerySequence) String() string { return proto.CompactTextString(m) }
 func (*QuerySequence) ProtoMessage()    {}
 func (*QuerySequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{13}
+	return fileDescriptor_e6da8796717704d7, []int{15}
 }
 func (m *QuerySequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,7 +954,7 @@ func (m *QuerySequenceResponse) Reset()         { *m = QuerySequenceResponse{} }
 func (m *QuerySequenceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySequenceResponse) ProtoMessage()    {}
 func (*QuerySequenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{14}
+	return fileDescriptor_e6da8796717704d7, []int{16}
 }
 func (m *QuerySequenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -850,7 +998,7 @@ func (m *QueryConfig) Reset()         { *m = QueryConfig{} }
 func (m *QueryConfig) String() string { return proto.CompactTextString(m) }
 func (*QueryConfig) ProtoMessage()    {}
 func (*QueryConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{15}
+	return fileDescriptor_e6da8796717704d7, []int{17}
 }
 func (m *QueryConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -889,7 +1037,7 @@ func (m *QueryConfigResponse) Reset()         { *m = QueryConfigResponse{} }
 func (m *QueryConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConfigResponse) ProtoMessage()    {}
 func (*QueryConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{16}
+	return fileDescriptor_e6da8796717704d7, []int{18}
 }
 func (m *QueryConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -941,7 +1089,7 @@ func (m *QueryProposal) Reset()         { *m = QueryProposal{} }
 func (m *QueryProposal) String() string { return proto.CompactTextString(m) }
 func (*QueryProposal) ProtoMessage()    {}
 func (*QueryProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{17}
+	return fileDescriptor_e6da8796717704d7, []int{19}
 }
 func (m *QueryProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -986,7 +1134,7 @@ func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
 func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalResponse) ProtoMessage()    {}
 func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{18}
+	return fileDescriptor_e6da8796717704d7, []int{20}
 }
 func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1033,6 +1181,8 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgVoteResponse")
 	proto.RegisterType((*MsgExecuteProposal)(nil), "cosmos.accounts.defaults.multisig.v1.MsgExecuteProposal")
 	proto.RegisterType((*MsgExecuteProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgExecuteProposalResponse")
+	proto.RegisterType((*MsgVetoProposal)(nil), "cosmos.accounts.defaults.multisig.v1.MsgVetoProposal")
+	proto.RegisterType((*MsgVetoProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse")
 	proto.RegisterType((*MsgUpdateConfig)(nil), "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig")
 	proto.RegisterType((*MsgUpdateConfigResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse")
 	proto.RegisterType((*Member)(nil), "cosmos.accounts.defaults.multisig.v1.Member")
@@ -1051,61 +1201,68 @@ func init() {
 }
 
 var fileDescriptor_e6da8796717704d7 = []byte{
-	// 859 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x9b, 0x6e, 0x9a, 0xbe, 0xd2, 0x24, 0x9d, 0x96, 0xad, 0x9b, 0x5d, 0xb2, 0xc5, 0x20,
-	0xb1, 0xaa, 0x16, 0xa7, 0x9b, 0xc2, 0x8d, 0x4b, 0xda, 0xb8, 0x28, 0xab, 0x4d, 0x6c, 0xc6, 0x69,
-	0x25, 0xb8, 0x58, 0x6e, 0x3c, 0x75, 0xad, 0x8d, 0x3d, 0x5e, 0xcf, 0xb8, 0x6c, 0xfe, 0x04, 0xe2,
-	0xc6, 0x0f, 0x80, 0x23, 0x47, 0x7e, 0x04, 0xc7, 0x15, 0x27, 0x8e, 0xa8, 0x95, 0xf8, 0x1d, 0xa8,
-	0xe3, 0xb1, 0x9b, 0xa6, 0xd2, 0x6e, 0x10, 0x3d, 0x70, 0xf3, 0x7b, 0xf3, 0xbe, 0xef, 0x7d, 0xf3,
-	0xcd, 0x9b, 0x91, 0x61, 0x7f, 0x44, 0x59, 0x48, 0x59, 0xcb, 0x1d, 0x8d, 0x68, 0x1a, 0x71, 0xd6,
-	0xf2, 0xc8, 0x99, 0x9b, 0x8e, 0x39, 0x6b, 0x85, 0xe9, 0x98, 0x07, 0x2c, 0xf0, 0x5b, 0x17, 0xcf,
-	0x8b, 0x6f, 0x3d, 0x4e, 0x28, 0xa7, 0xe8, 0xd3, 0x0c, 0xa4, 0xe7, 0x20, 0x3d, 0x07, 0xe9, 0x45,
-	0xe1, 0xc5, 0xf3, 0xc6, 0xb6, 0x4f, 0xa9, 0x3f, 0x26, 0x2d, 0x81, 0x39, 0x4d, 0xcf, 0x5a, 0x6e,
-	0x34, 0xc9, 0x08, 0x1a, 0xdb, 0x19, 0x81, 0x23, 0xa2, 0x96, 0x64, 0x13, 0x81, 0xf6, 0x93, 0x02,
-	0xcb, 0x7d, 0xe6, 0xf7, 0xa2, 0x80, 0xa3, 0x23, 0x58, 0x0e, 0x49, 0x78, 0x4a, 0x12, 0xa6, 0x2a,
-	0x3b, 0xa5, 0xa7, 0xab, 0xed, 0x67, 0xfa, 0x3c, 0x9d, 0xf5, 0xbe, 0x00, 0xe1, 0x1c, 0x8c, 0xba,
-	0x50, 0x1e, 0xd1, 0xe8, 0x2c, 0xf0, 0xd5, 0xc5, 0x1d, 0x65, 0x7e, 0x9a, 0x43, 0x81, 0xc1, 0x12,
-	0xab, 0xad, 0x43, 0x4d, 0x0a, 0xc3, 0x84, 0xc5, 0x34, 0x62, 0x44, 0x73, 0x60, 0xbd, 0xcf, 0xfc,
-	0xc3, 0x84, 0xb8, 0x9c, 0x58, 0x09, 0x8d, 0x29, 0x73, 0xc7, 0xe8, 0x05, 0x54, 0x62, 0xf9, 0xad,
-	0x2a, 0xa2, 0x9f, 0x3e, 0x5f, 0xbf, 0x9c, 0x01, 0x17, 0x78, 0xed, 0x2b, 0xd8, 0xbe, 0xd3, 0x20,
-	0xef, 0x8e, 0x9e, 0xc0, 0x6a, 0x5e, 0xe8, 0x04, 0x9e, 0xe8, 0xb5, 0x84, 0x21, 0x4f, 0xf5, 0x3c,
-	0x2d, 0x16, 0x56, 0x9e, 0x50, 0xfe, 0xfe, 0x5a, 0xd4, 0x85, 0xa5, 0x0b, 0xca, 0x89, 0x70, 0xa8,
-	0xda, 0xde, 0x9b, 0x4f, 0xf1, 0x35, 0xb5, 0x19, 0xf3, 0x80, 0x46, 0x58, 0xa0, 0xa5, 0x47, 0xd7,
-	0xe9, 0xc2, 0xa3, 0x2f, 0x01, 0xf5, 0x99, 0x6f, 0xbc, 0x21, 0xa3, 0x74, 0xca, 0xa4, 0xf7, 0x6a,
-	0xb7, 0xa0, 0x71, 0x17, 0x56, 0x6c, 0xbd, 0x0d, 0x2b, 0x89, 0xfc, 0xce, 0x67, 0x63, 0x53, 0xcf,
-	0xe6, 0x4d, 0xcf, 0xe7, 0x4d, 0xef, 0x44, 0x13, 0x7c, 0x53, 0xa6, 0xfd, 0xaa, 0x08, 0x71, 0xc7,
-	0xb1, 0xe7, 0x72, 0x92, 0x9d, 0x2d, 0xb2, 0xa1, 0x9a, 0x8a, 0xd8, 0xf9, 0x2f, 0x83, 0xb6, 0x96,
-	0x71, 0xf4, 0xef, 0x75, 0xdc, 0xb6, 0x61, 0x6b, 0x46, 0x6d, 0x61, 0xe9, 0x10, 0xca, 0x59, 0x2f,
-	0xd4, 0x86, 0x65, 0xd7, 0xf3, 0x12, 0xc2, 0x98, 0xb0, 0x70, 0xe5, 0x40, 0xfd, 0xe3, 0xb7, 0xcf,
-	0x37, 0x65, 0xbb, 0x4e, 0xb6, 0x62, 0xf3, 0x24, 0x88, 0x7c, 0x9c, 0x17, 0xa2, 0x87, 0x50, 0xfe,
-	0x9e, 0x04, 0xfe, 0x39, 0x17, 0xf2, 0x96, 0xb0, 0x8c, 0xb4, 0x5f, 0x14, 0x28, 0x4b, 0x5b, 0x1e,
-	0xc3, 0x0a, 0x3f, 0x4f, 0x08, 0x3b, 0xa7, 0xe3, 0xec, 0x6c, 0x4a, 0xf8, 0x26, 0x71, 0x4d, 0xf0,
-	0x3a, 0xa5, 0x49, 0x1a, 0x0a, 0x82, 0x12, 0x96, 0x11, 0xfa, 0x04, 0xd6, 0x2e, 0x28, 0x0f, 0x22,
-	0xdf, 0x89, 0x49, 0x12, 0x50, 0x4f, 0x2d, 0x89, 0xe5, 0x0f, 0xb2, 0xa4, 0x25, 0x72, 0xd7, 0xe0,
-	0x84, 0x88, 0x49, 0x5b, 0xda, 0x51, 0x9e, 0x56, 0xb0, 0x8c, 0xd0, 0x67, 0x50, 0x23, 0x6e, 0x32,
-	0x9e, 0x38, 0x44, 0x1c, 0x79, 0x40, 0x23, 0xf5, 0x81, 0x28, 0xa8, 0x8a, 0xb4, 0x91, 0x67, 0xb5,
-	0xbf, 0x15, 0xa8, 0x14, 0x63, 0xb4, 0x09, 0x0f, 0x78, 0xc0, 0xc7, 0x24, 0xdb, 0x3d, 0xce, 0x02,
-	0xa4, 0xc2, 0x32, 0x4b, 0xc3, 0xd0, 0x4d, 0x26, 0x42, 0xe1, 0x0a, 0xce, 0x43, 0xb4, 0x07, 0x95,
-	0x90, 0x30, 0xe6, 0xfa, 0x84, 0xa9, 0xa5, 0x77, 0x8c, 0x4d, 0x51, 0x85, 0x76, 0x61, 0xfd, 0xd6,
-	0xa6, 0x1c, 0x12, 0x79, 0x42, 0x7a, 0x09, 0xd7, 0xa6, 0x37, 0x66, 0x44, 0x1e, 0x7a, 0x09, 0x65,
-	0xc6, 0x5d, 0x9e, 0x32, 0x21, 0xbd, 0xda, 0xfe, 0xe2, 0xdf, 0xdd, 0x7b, 0x5b, 0x60, 0xb1, 0xe4,
-	0xd0, 0x6a, 0xb0, 0xf6, 0x4d, 0x4a, 0x92, 0x89, 0x4d, 0x5e, 0xa7, 0x24, 0x1a, 0x11, 0x6d, 0x1f,
-	0x3e, 0xbc, 0x95, 0x28, 0x6e, 0x43, 0x03, 0x2a, 0x4c, 0xe6, 0xe4, 0x4d, 0x2a, 0x62, 0x6d, 0x0d,
-	0x56, 0x05, 0x28, 0x3b, 0x59, 0xed, 0x67, 0x05, 0x36, 0xa6, 0xe2, 0x82, 0xe2, 0xff, 0xf5, 0xd4,
-	0xee, 0xc9, 0xad, 0xcf, 0xff, 0x5c, 0x8c, 0xa4, 0x37, 0x77, 0x5e, 0x8a, 0x7b, 0x7c, 0x8d, 0x77,
-	0x7f, 0x50, 0xa0, 0x7a, 0xfb, 0xb0, 0xd0, 0x13, 0x78, 0x64, 0x61, 0xd3, 0x32, 0xed, 0xce, 0x4b,
-	0xc7, 0x1e, 0x76, 0x86, 0xc7, 0xb6, 0x73, 0x3c, 0xb0, 0x2d, 0xe3, 0xb0, 0x77, 0xd4, 0x33, 0xba,
-	0xf5, 0x05, 0xf4, 0x31, 0x7c, 0x34, 0x5b, 0x70, 0x62, 0x0e, 0x7b, 0x83, 0xaf, 0x1d, 0xcb, 0xc0,
-	0x3d, 0xb3, 0x5b, 0x57, 0x50, 0x03, 0x1e, 0xce, 0x96, 0x58, 0x1d, 0xdb, 0x36, 0xba, 0xf5, 0x45,
-	0xf4, 0x18, 0xd4, 0xd9, 0x35, 0x6c, 0xbc, 0x30, 0x0e, 0x87, 0x46, 0xb7, 0x5e, 0xda, 0x7d, 0x05,
-	0x70, 0xf3, 0x04, 0xa3, 0x47, 0xb0, 0x75, 0x62, 0x0e, 0x0d, 0xc7, 0xb4, 0x86, 0x3d, 0x73, 0x30,
-	0xa3, 0x63, 0x03, 0x6a, 0xd3, 0x8b, 0xdf, 0x1a, 0x76, 0x5d, 0x41, 0x5b, 0xb0, 0x31, 0x9d, 0xec,
-	0x1c, 0xd8, 0xc3, 0x4e, 0x6f, 0x50, 0x5f, 0x44, 0x08, 0xaa, 0xd3, 0x0b, 0x03, 0xb3, 0x5e, 0x3a,
-	0x38, 0xfa, 0xfd, 0xb2, 0xa9, 0xbc, 0xbd, 0x6c, 0x2a, 0x7f, 0x5d, 0x36, 0x95, 0x1f, 0xaf, 0x9a,
-	0x0b, 0x6f, 0xaf, 0x9a, 0x0b, 0x7f, 0x5e, 0x35, 0x17, 0xbe, 0x7b, 0x96, 0x19, 0xca, 0xbc, 0x57,
-	0x7a, 0x40, 0x5b, 0x6f, 0xde, 0xfd, 0x33, 0x71, 0x5a, 0x16, 0x37, 0x6d, 0xff, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x6b, 0x50, 0x07, 0xb0, 0x7b, 0x08, 0x00, 0x00,
+	// 973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x53, 0xdb, 0x46,
+	0x18, 0x46, 0x18, 0x8c, 0x79, 0x09, 0xc6, 0x2c, 0x24, 0x08, 0x92, 0x38, 0x54, 0xed, 0x4c, 0x33,
+	0x4c, 0x2a, 0x13, 0xd3, 0xde, 0x7a, 0x31, 0x48, 0x74, 0x9c, 0x09, 0x48, 0xac, 0x0c, 0xd3, 0xf6,
+	0xa2, 0x11, 0xd6, 0x22, 0x34, 0xb1, 0xb5, 0x8e, 0x76, 0xe5, 0xe2, 0x7f, 0xd1, 0x99, 0x1e, 0xfa,
+	0x03, 0x7a, 0xeb, 0xf4, 0xd8, 0x1f, 0xd1, 0x63, 0xa6, 0xa7, 0x1e, 0x3b, 0xf0, 0x47, 0x3a, 0xda,
+	0x95, 0x84, 0x71, 0x32, 0x89, 0x3b, 0xcd, 0xa1, 0x37, 0xbd, 0xcf, 0x3e, 0xcf, 0xfb, 0xf1, 0xe8,
+	0xf5, 0xca, 0xb0, 0xd7, 0xa5, 0xac, 0x4f, 0x59, 0xc3, 0xeb, 0x76, 0x69, 0x12, 0x71, 0xd6, 0xf0,
+	0xc9, 0x85, 0x97, 0xf4, 0x38, 0x6b, 0xf4, 0x93, 0x1e, 0x0f, 0x59, 0x18, 0x34, 0x86, 0xcf, 0x8b,
+	0x67, 0x7d, 0x10, 0x53, 0x4e, 0xd1, 0x67, 0x52, 0xa4, 0xe7, 0x22, 0x3d, 0x17, 0xe9, 0x05, 0x71,
+	0xf8, 0x7c, 0x6b, 0x33, 0xa0, 0x34, 0xe8, 0x91, 0x86, 0xd0, 0x9c, 0x27, 0x17, 0x0d, 0x2f, 0x1a,
+	0xc9, 0x04, 0x5b, 0x9b, 0x32, 0x81, 0x2b, 0xa2, 0x46, 0x96, 0x4d, 0x04, 0xda, 0xcf, 0x0a, 0x2c,
+	0x1c, 0xb1, 0xa0, 0x1d, 0x85, 0x1c, 0x1d, 0xc2, 0x42, 0x9f, 0xf4, 0xcf, 0x49, 0xcc, 0x54, 0x65,
+	0xbb, 0xf4, 0x74, 0xa9, 0xf9, 0x4c, 0x9f, 0xa6, 0xb2, 0x7e, 0x24, 0x44, 0x38, 0x17, 0x23, 0x03,
+	0xca, 0x5d, 0x1a, 0x5d, 0x84, 0x81, 0x3a, 0xbb, 0xad, 0x4c, 0x9f, 0xe6, 0x40, 0x68, 0x70, 0xa6,
+	0xd5, 0x56, 0x61, 0x25, 0x6b, 0x0c, 0x13, 0x36, 0xa0, 0x11, 0x23, 0x9a, 0x0b, 0xab, 0x47, 0x2c,
+	0x38, 0x88, 0x89, 0xc7, 0x89, 0x1d, 0xd3, 0x01, 0x65, 0x5e, 0x0f, 0xbd, 0x80, 0xca, 0x20, 0x7b,
+	0x56, 0x15, 0x51, 0x4f, 0x9f, 0xae, 0x5e, 0x9e, 0x01, 0x17, 0x7a, 0xed, 0x6b, 0xd8, 0x7c, 0xab,
+	0x40, 0x5e, 0x1d, 0x3d, 0x81, 0xa5, 0x9c, 0xe8, 0x86, 0xbe, 0xa8, 0x35, 0x87, 0x21, 0x87, 0xda,
+	0xbe, 0x36, 0x10, 0x56, 0x9e, 0x51, 0xfe, 0x61, 0x2e, 0x32, 0x60, 0x6e, 0x48, 0x39, 0x11, 0x0e,
+	0x55, 0x9b, 0xbb, 0xd3, 0x75, 0x9c, 0xa6, 0xb6, 0x06, 0x3c, 0xa4, 0x11, 0x16, 0xea, 0xcc, 0xa3,
+	0x14, 0x2e, 0x3c, 0xfa, 0x0a, 0xd0, 0x11, 0x0b, 0xcc, 0x2b, 0xd2, 0x4d, 0xc6, 0x4c, 0xfa, 0x60,
+	0xef, 0x36, 0x6c, 0xbd, 0x2d, 0x2b, 0x46, 0x6f, 0xc2, 0x62, 0x9c, 0x3d, 0xe7, 0xbb, 0xb1, 0xae,
+	0xcb, 0x7d, 0xd3, 0xf3, 0x7d, 0xd3, 0x5b, 0xd1, 0x08, 0xdf, 0xd2, 0xb4, 0xa6, 0xec, 0x8d, 0x70,
+	0x3a, 0x7d, 0x17, 0x9b, 0xb0, 0x31, 0xa1, 0x29, 0xe6, 0xfa, 0x4d, 0x11, 0xf9, 0x4e, 0x07, 0xbe,
+	0xc7, 0x89, 0x5c, 0x15, 0xe4, 0x40, 0x35, 0x11, 0xb1, 0xfb, 0x5f, 0xf6, 0x76, 0x59, 0xe6, 0x38,
+	0xfa, 0xa8, 0xdb, 0x2b, 0x27, 0x19, 0xef, 0xb6, 0x98, 0xa4, 0x03, 0x65, 0x59, 0x0b, 0x35, 0x61,
+	0xc1, 0xf3, 0xfd, 0x98, 0x30, 0x26, 0xbc, 0x58, 0xdc, 0x57, 0xff, 0xfc, 0xfd, 0x8b, 0xf5, 0xac,
+	0x5c, 0x4b, 0x9e, 0x38, 0x3c, 0x0e, 0xa3, 0x00, 0xe7, 0x44, 0xf4, 0x00, 0xca, 0x3f, 0x90, 0x30,
+	0xb8, 0xe4, 0xa2, 0xbd, 0x39, 0x9c, 0x45, 0xda, 0x4f, 0xb3, 0x50, 0xce, 0x6c, 0x79, 0x04, 0x8b,
+	0xfc, 0x32, 0x26, 0xec, 0x92, 0xf6, 0xa4, 0xc9, 0x25, 0x7c, 0x0b, 0xa4, 0x09, 0x5e, 0x27, 0x34,
+	0x4e, 0xfa, 0x22, 0x41, 0x09, 0x67, 0x11, 0xfa, 0x14, 0x96, 0x87, 0x94, 0x87, 0x51, 0xe0, 0x0e,
+	0x48, 0x1c, 0x52, 0x5f, 0x2d, 0x89, 0xe3, 0x7b, 0x12, 0xb4, 0x05, 0x96, 0x8a, 0x63, 0x22, 0x16,
+	0x77, 0x6e, 0x5b, 0x79, 0x5a, 0xc1, 0x59, 0x84, 0x3e, 0x87, 0x15, 0xe2, 0xc5, 0xbd, 0x91, 0x4b,
+	0xc4, 0x06, 0x85, 0x34, 0x52, 0xe7, 0x05, 0xa1, 0x2a, 0x60, 0x33, 0x47, 0x05, 0x31, 0x0f, 0x5c,
+	0x9f, 0xf4, 0xbc, 0x91, 0x5a, 0x16, 0x75, 0xaa, 0x05, 0x6c, 0xa4, 0x68, 0xba, 0x2b, 0x43, 0xc2,
+	0xa9, 0x9b, 0xf5, 0xba, 0x20, 0x48, 0x90, 0x42, 0x27, 0x45, 0xbf, 0xe4, 0x6a, 0x10, 0xc6, 0xa3,
+	0xbc, 0xdf, 0x8a, 0xec, 0x57, 0x82, 0xb2, 0x5f, 0xed, 0xd7, 0x59, 0xa8, 0x14, 0xeb, 0xb7, 0x0e,
+	0xf3, 0x3c, 0xe4, 0x3d, 0x22, 0xcd, 0xc6, 0x32, 0x40, 0x2a, 0x2c, 0xb0, 0xa4, 0xdf, 0xf7, 0xe2,
+	0x91, 0x30, 0x64, 0x11, 0xe7, 0x21, 0xda, 0x85, 0x4a, 0x9f, 0x30, 0xe6, 0x05, 0x84, 0xa9, 0xa5,
+	0xf7, 0x2c, 0x7d, 0xc1, 0x42, 0x3b, 0xb0, 0x7a, 0xc7, 0x43, 0x97, 0x44, 0xbe, 0x70, 0xaa, 0x84,
+	0x57, 0xc6, 0x7d, 0x34, 0x23, 0x1f, 0xbd, 0x84, 0x32, 0xe3, 0x1e, 0x4f, 0x98, 0x70, 0xaa, 0xda,
+	0xfc, 0xf2, 0xdf, 0xdd, 0x5a, 0x8e, 0xd0, 0xe2, 0x2c, 0x87, 0x74, 0x23, 0x35, 0xd0, 0x3b, 0xef,
+	0x11, 0xd7, 0xe3, 0x99, 0xab, 0xf7, 0x6e, 0xc1, 0x16, 0x47, 0x8f, 0x01, 0x84, 0x3b, 0x84, 0xa5,
+	0x0c, 0x69, 0xe9, 0x62, 0x86, 0xb4, 0xb8, 0xb6, 0x02, 0xcb, 0x27, 0x09, 0x89, 0x47, 0x0e, 0x79,
+	0x9d, 0x90, 0xa8, 0x4b, 0xb4, 0x3d, 0xb8, 0x7f, 0x07, 0x28, 0xee, 0x83, 0x2d, 0xa8, 0xb0, 0x0c,
+	0xcb, 0x7e, 0xc5, 0x45, 0xac, 0x2d, 0xc3, 0x92, 0x10, 0xc9, 0x65, 0xd4, 0x7e, 0x51, 0x60, 0x6d,
+	0x2c, 0x2e, 0x52, 0xfc, 0xbf, 0x3e, 0x36, 0xbb, 0xd9, 0xe8, 0xd3, 0x5f, 0x55, 0x5d, 0xb8, 0x7f,
+	0x47, 0x51, 0x0c, 0xf6, 0x11, 0xbf, 0x47, 0x3b, 0x37, 0x0a, 0x54, 0xef, 0xbe, 0x70, 0xf4, 0x04,
+	0x1e, 0xda, 0xd8, 0xb2, 0x2d, 0xa7, 0xf5, 0xd2, 0x75, 0x3a, 0xad, 0xce, 0xa9, 0xe3, 0x9e, 0x1e,
+	0x3b, 0xb6, 0x79, 0xd0, 0x3e, 0x6c, 0x9b, 0x46, 0x6d, 0x06, 0x7d, 0x02, 0x8f, 0x27, 0x09, 0x67,
+	0x56, 0xa7, 0x7d, 0xfc, 0x8d, 0x6b, 0x9b, 0xb8, 0x6d, 0x19, 0x35, 0x05, 0x6d, 0xc1, 0x83, 0x49,
+	0x8a, 0xdd, 0x72, 0x1c, 0xd3, 0xa8, 0xcd, 0xa2, 0x47, 0xa0, 0x4e, 0x9e, 0x61, 0xf3, 0x85, 0x79,
+	0xd0, 0x31, 0x8d, 0x5a, 0xe9, 0x5d, 0xca, 0x93, 0x53, 0xf3, 0xd4, 0x34, 0x6a, 0x73, 0xef, 0x3a,
+	0x3b, 0x33, 0x3b, 0x96, 0x69, 0xd4, 0xe6, 0xd1, 0x43, 0xd8, 0x98, 0x3c, 0x33, 0xbf, 0xb5, 0xdb,
+	0xd8, 0x34, 0x6a, 0xe5, 0x9d, 0x57, 0x00, 0xb7, 0x5f, 0xb6, 0x94, 0x7a, 0x66, 0x75, 0x4c, 0xd7,
+	0xb2, 0x3b, 0x6d, 0xeb, 0x78, 0x62, 0xb8, 0x35, 0x58, 0x19, 0x3f, 0xfc, 0xce, 0x74, 0x6a, 0x0a,
+	0xda, 0x80, 0xb5, 0x71, 0xb0, 0xb5, 0xef, 0x74, 0x5a, 0xed, 0xe3, 0xda, 0x2c, 0x42, 0x50, 0x1d,
+	0x3f, 0x38, 0xb6, 0x6a, 0xa5, 0xfd, 0xc3, 0x3f, 0xae, 0xeb, 0xca, 0x9b, 0xeb, 0xba, 0xf2, 0xf7,
+	0x75, 0x5d, 0xf9, 0xf1, 0xa6, 0x3e, 0xf3, 0xe6, 0xa6, 0x3e, 0xf3, 0xd7, 0x4d, 0x7d, 0xe6, 0xfb,
+	0x67, 0xf2, 0x2d, 0x31, 0xff, 0x95, 0x1e, 0xd2, 0xc6, 0xd5, 0xfb, 0xff, 0xa3, 0x9d, 0x97, 0xc5,
+	0x15, 0xb0, 0xf7, 0xcf, 0x00, 0xde, 0x77, 0xc7, 0x70, 0xd2, 0x09, 0x00, 0x00,
 }
 
 func (m *MsgInit) Marshal() (dAtA []byte, err error) {
@@ -1364,6 +1521,57 @@ func (m *MsgExecuteProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1491,6 +1699,21 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryPeriod != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExpiryPeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.VetoQuorum != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.VetoQuorum))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExecutionDelay != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExecutionDelay))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.EarlyExecution {
 		i--
 		if m.EarlyExecution {
@@ -1549,6 +1772,16 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExecutableAt != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExecutableAt))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Status != 0 {
 		i = encodeVarintMultisig(dAtA, i, uint64(m.Status))
 		i--
@@ -1891,6 +2124,27 @@ func (m *MsgExecuteProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovMultisig(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgVetoProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1956,6 +2210,15 @@ func (m *Config) Size() (n int) {
 	if m.EarlyExecution {
 		n += 2
 	}
+	if m.ExecutionDelay != 0 {
+		n += 1 + sovMultisig(uint64(m.ExecutionDelay))
+	}
+	if m.VetoQuorum != 0 {
+		n += 1 + sovMultisig(uint64(m.VetoQuorum))
+	}
+	if m.ExpiryPeriod != 0 {
+		n += 1 + sovMultisig(uint64(m.ExpiryPeriod))
+	}
 	return n
 }
 
@@ -1985,6 +2248,12 @@ func (m *Proposal) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovMultisig(uint64(m.Status))
 	}
+	if m.ExecutableAt != 0 {
+		n += 1 + sovMultisig(uint64(m.ExecutableAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovMultisig(uint64(m.ExpiresAt))
+	}
 	return n
 }
 
@@ -2684,6 +2953,125 @@ func (m *MsgExecuteProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3081,6 +3469,63 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EarlyExecution = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionDelay", wireType)
+			}
+			m.ExecutionDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoQuorum", wireType)
+			}
+			m.VetoQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VetoQuorum |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryPeriod", wireType)
+			}
+			m.ExpiryPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -3267,6 +3712,44 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableAt", wireType)
+			}
+			m.ExecutableAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutableAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
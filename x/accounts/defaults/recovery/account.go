@@ -0,0 +1,467 @@
+package recovery
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// the prefixes of the base account state are 0, 1 and 2.
+var (
+	GuardiansPrefix        = collections.NewPrefix(3)
+	ConfigPrefix           = collections.NewPrefix(4)
+	RecoveriesPrefix       = collections.NewPrefix(5)
+	ApprovalsPrefix        = collections.NewPrefix(6)
+	RecoverySequencePrefix = collections.NewPrefix(7)
+)
+
+// NewAccount returns a new social recovery account creator function. The account pubkey is
+// verified with the provided sign mode handlers, the options define the supported pubkey types.
+func NewAccount(name string, handlerMap *signing.HandlerMap, options ...base.Option) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, baseAcc, err := base.NewAccount(name, handlerMap, options...)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+
+		acc := Account{
+			Account:          baseAcc.(base.Account),
+			Guardians:        collections.NewMap(deps.SchemaBuilder, GuardiansPrefix, "guardians", collections.BytesKey, collections.Uint64Value),
+			Config:           collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
+			Recoveries:       collections.NewMap(deps.SchemaBuilder, RecoveriesPrefix, "recoveries", collections.Uint64Key, codec.CollValue[v1.Recovery](deps.LegacyStateCodec)),
+			Approvals:        collections.NewKeySet(deps.SchemaBuilder, ApprovalsPrefix, "approvals", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
+			RecoverySequence: collections.NewSequence(deps.SchemaBuilder, RecoverySequencePrefix, "recovery_sequence"),
+			addrCodec:        deps.AddressCodec,
+			headerService:    deps.Environment.HeaderService,
+			eventService:     deps.Environment.EventService,
+		}
+		return name, acc, nil
+	}
+}
+
+// Account implements a base account whose pubkey can be rotated by a threshold of guardians,
+// after a delay during which the current pubkey can cancel the recovery.
+type Account struct {
+	base.Account
+
+	// Guardians maps the address of a guardian to its weight.
+	Guardians collections.Map[[]byte, uint64]
+	Config    collections.Item[v1.Config]
+
+	Recoveries       collections.Map[uint64, v1.Recovery]
+	Approvals        collections.KeySet[collections.Pair[uint64, []byte]] // key: recoveryID + guardian address
+	RecoverySequence collections.Sequence
+
+	addrCodec     address.Codec
+	headerService header.Service
+	eventService  event.Service
+}
+
+// Init initializes the account with its pubkey, guardians and recovery configuration.
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if _, err := a.Account.Init(ctx, &basev1.MsgInit{PubKey: msg.PubKey, InitSequence: msg.InitSequence}); err != nil {
+		return nil, err
+	}
+
+	if err := a.setGuardians(ctx, msg.Guardians, msg.Config); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitResponse{}, nil
+}
+
+// UpdateGuardians replaces the guardians and the configuration of the account, pending
+// recoveries are cancelled. It can only be executed by the account itself.
+func (a Account) UpdateGuardians(ctx context.Context, msg *v1.MsgUpdateGuardians) (*v1.MsgUpdateGuardiansResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if err := a.Guardians.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	if err := a.Recoveries.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	if err := a.Approvals.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	if err := a.setGuardians(ctx, msg.Guardians, msg.Config); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateGuardiansResponse{}, nil
+}
+
+// setGuardians stores the guardians and the configuration after validating them.
+func (a Account) setGuardians(ctx context.Context, guardians []*v1.Guardian, config *v1.Config) error {
+	if config == nil {
+		return errors.New("config must be specified")
+	}
+
+	if len(guardians) == 0 {
+		return errors.New("guardians must be specified")
+	}
+
+	totalWeight := uint64(0)
+	for _, guardian := range guardians {
+		addrBz, err := a.addrCodec.StringToBytes(guardian.Address)
+		if err != nil {
+			return err
+		}
+
+		if guardian.Weight == 0 {
+			return errors.New("guardian weight must be greater than zero")
+		}
+
+		exists, err := a.Guardians.Has(ctx, addrBz)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errors.New("duplicate guardian address found")
+		}
+
+		if err := a.Guardians.Set(ctx, addrBz, guardian.Weight); err != nil {
+			return err
+		}
+
+		totalWeight, err = safeAdd(totalWeight, guardian.Weight)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := validateConfig(*config, totalWeight); err != nil {
+		return err
+	}
+
+	return a.Config.Set(ctx, *config)
+}
+
+// InitiateRecovery creates a recovery rotating the account pubkey to a new one. The sender
+// must be a guardian, and its approval is counted.
+func (a Account) InitiateRecovery(ctx context.Context, msg *v1.MsgInitiateRecovery) (*v1.MsgInitiateRecoveryResponse, error) {
+	sender := accountstd.Sender(ctx)
+	if err := a.checkGuardian(ctx, sender); err != nil {
+		return nil, err
+	}
+
+	if msg.NewPubKey == nil {
+		return nil, errors.New("new pubkey must be specified")
+	}
+
+	if _, err := a.DecodePubKey(msg.NewPubKey); err != nil {
+		return nil, fmt.Errorf("invalid new pubkey: %w", err)
+	}
+
+	recoveryID, err := a.RecoverySequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recovery := v1.Recovery{NewPubKey: msg.NewPubKey}
+	if err := a.Recoveries.Set(ctx, recoveryID, recovery); err != nil {
+		return nil, err
+	}
+
+	addr, err := a.addrCodec.BytesToString(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("recovery_initiated",
+		event.NewAttribute("recovery_id", fmt.Sprint(recoveryID)),
+		event.NewAttribute("guardian", addr),
+	); err != nil {
+		return nil, err
+	}
+
+	if err := a.approve(ctx, recoveryID, recovery, sender); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgInitiateRecoveryResponse{RecoveryId: recoveryID}, nil
+}
+
+// ApproveRecovery approves a recovery on behalf of the sender, which must be a guardian.
+func (a Account) ApproveRecovery(ctx context.Context, msg *v1.MsgApproveRecovery) (*v1.MsgApproveRecoveryResponse, error) {
+	sender := accountstd.Sender(ctx)
+	if err := a.checkGuardian(ctx, sender); err != nil {
+		return nil, err
+	}
+
+	recovery, err := a.getRecovery(ctx, msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	approved, err := a.Approvals.Has(ctx, collections.Join(msg.RecoveryId, sender))
+	if err != nil {
+		return nil, err
+	}
+	if approved {
+		return nil, errors.New("guardian has already approved the recovery")
+	}
+
+	return &v1.MsgApproveRecoveryResponse{}, a.approve(ctx, msg.RecoveryId, recovery, sender)
+}
+
+// approve records the approval of a guardian. Once the approvals reach the threshold, the
+// recovery becomes executable after the recovery delay.
+func (a Account) approve(ctx context.Context, recoveryID uint64, recovery v1.Recovery, guardian []byte) error {
+	if err := a.Approvals.Set(ctx, collections.Join(recoveryID, guardian)); err != nil {
+		return err
+	}
+
+	addr, err := a.addrCodec.BytesToString(guardian)
+	if err != nil {
+		return err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("recovery_approved",
+		event.NewAttribute("recovery_id", fmt.Sprint(recoveryID)),
+		event.NewAttribute("guardian", addr),
+	); err != nil {
+		return err
+	}
+
+	// the delay starts when the threshold is first reached.
+	if recovery.ExecutableAt != 0 {
+		return nil
+	}
+
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	approvalsWeight := uint64(0)
+	rng := collections.NewPrefixedPairRange[uint64, []byte](recoveryID)
+	err = a.Approvals.Walk(ctx, rng, func(key collections.Pair[uint64, []byte]) (stop bool, err error) {
+		weight, err := a.Guardians.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		approvalsWeight, err = safeAdd(approvalsWeight, weight)
+		return err != nil, err
+	})
+	if err != nil {
+		return err
+	}
+
+	if approvalsWeight < config.Threshold {
+		return nil
+	}
+
+	recovery.ExecutableAt = a.headerService.HeaderInfo(ctx).Time.Add(time.Second * time.Duration(config.RecoveryDelay)).Unix()
+	if err := a.Recoveries.Set(ctx, recoveryID, recovery); err != nil {
+		return err
+	}
+
+	return a.eventService.EventManager(ctx).EmitKV("recovery_threshold_reached",
+		event.NewAttribute("recovery_id", fmt.Sprint(recoveryID)),
+		event.NewAttribute("executable_at", fmt.Sprint(recovery.ExecutableAt)),
+	)
+}
+
+// CancelRecovery cancels a recovery. It can only be executed by the account itself, so with
+// the current pubkey.
+func (a Account) CancelRecovery(ctx context.Context, msg *v1.MsgCancelRecovery) (*v1.MsgCancelRecoveryResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if _, err := a.getRecovery(ctx, msg.RecoveryId); err != nil {
+		return nil, err
+	}
+
+	if err := a.deleteRecoveryAndApprovals(ctx, msg.RecoveryId); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_cancelled",
+		event.NewAttribute("recovery_id", fmt.Sprint(msg.RecoveryId)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelRecoveryResponse{}, nil
+}
+
+// ExecuteRecovery swaps the account pubkey to the new pubkey of a recovery which reached the
+// threshold, once its delay has passed. It can be executed by anyone.
+func (a Account) ExecuteRecovery(ctx context.Context, msg *v1.MsgExecuteRecovery) (*v1.MsgExecuteRecoveryResponse, error) {
+	recovery, err := a.getRecovery(ctx, msg.RecoveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	if recovery.ExecutableAt == 0 {
+		return nil, errors.New("recovery threshold not reached")
+	}
+
+	if now := a.headerService.HeaderInfo(ctx).Time.Unix(); now < recovery.ExecutableAt {
+		return nil, fmt.Errorf("recovery delay has not passed yet, executable at %d", recovery.ExecutableAt)
+	}
+
+	if err := a.SetPubKey(ctx, recovery.NewPubKey); err != nil {
+		return nil, err
+	}
+
+	// other pending recoveries were made for the previous pubkey.
+	if err := a.Recoveries.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	if err := a.Approvals.Clear(ctx, nil); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("recovery_executed",
+		event.NewAttribute("recovery_id", fmt.Sprint(msg.RecoveryId)),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteRecoveryResponse{}, nil
+}
+
+func (a Account) checkGuardian(ctx context.Context, addr []byte) error {
+	isGuardian, err := a.Guardians.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if !isGuardian {
+		return errors.New("sender is not a guardian")
+	}
+	return nil
+}
+
+func (a Account) getRecovery(ctx context.Context, recoveryID uint64) (v1.Recovery, error) {
+	recovery, err := a.Recoveries.Get(ctx, recoveryID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.Recovery{}, errors.New("recovery not found")
+	}
+	return recovery, err
+}
+
+// deleteRecoveryAndApprovals deletes a recovery and its approvals, pruning the state.
+func (a Account) deleteRecoveryAndApprovals(ctx context.Context, recoveryID uint64) error {
+	if err := a.Recoveries.Remove(ctx, recoveryID); err != nil {
+		return err
+	}
+
+	rng := collections.NewPrefixedPairRange[uint64, []byte](recoveryID)
+	return a.Approvals.Clear(ctx, rng)
+}
+
+// QueryConfig returns the guardians and the recovery configuration of the account.
+func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	guardians := []*v1.Guardian{}
+	err = a.Guardians.Walk(ctx, nil, func(addr []byte, weight uint64) (stop bool, err error) {
+		addrStr, err := a.addrCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		guardians = append(guardians, &v1.Guardian{Address: addrStr, Weight: weight})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryConfigResponse{Guardians: guardians, Config: &config}, nil
+}
+
+// QueryRecoveries returns the pending recoveries of the account with their approvals.
+func (a Account) QueryRecoveries(ctx context.Context, _ *v1.QueryRecoveries) (*v1.QueryRecoveriesResponse, error) {
+	recoveries := []*v1.PendingRecovery{}
+	err := a.Recoveries.Walk(ctx, nil, func(recoveryID uint64, recovery v1.Recovery) (stop bool, err error) {
+		pending := &v1.PendingRecovery{Id: recoveryID, Recovery: &recovery}
+		rng := collections.NewPrefixedPairRange[uint64, []byte](recoveryID)
+		err = a.Approvals.Walk(ctx, rng, func(key collections.Pair[uint64, []byte]) (stop bool, err error) {
+			addrStr, err := a.addrCodec.BytesToString(key.K2())
+			if err != nil {
+				return true, err
+			}
+			pending.Approvals = append(pending.Approvals, addrStr)
+			return false, nil
+		})
+		if err != nil {
+			return true, err
+		}
+		recoveries = append(recoveries, pending)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryRecoveriesResponse{Recoveries: recoveries}, nil
+}
+
+func validateConfig(cfg v1.Config, totalWeight uint64) error {
+	if cfg.Threshold == 0 || cfg.RecoveryDelay <= 0 {
+		return errors.New("threshold and recovery delay must be greater than zero")
+	}
+
+	if totalWeight < cfg.Threshold {
+		return errors.New("threshold must be less than or equal to the total weight")
+	}
+
+	return nil
+}
+
+func safeAdd(nums ...uint64) (uint64, error) {
+	var sum uint64
+	for _, num := range nums {
+		if newSum := sum + num; newSum < sum {
+			return 0, errors.New("overflow")
+		} else {
+			sum = newSum
+		}
+	}
+	return sum, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.UpdateGuardians)
+	accountstd.RegisterExecuteHandler(builder, a.InitiateRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ApproveRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.CancelRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteRecovery)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
+	accountstd.RegisterQueryHandler(builder, a.QueryRecoveries)
+}
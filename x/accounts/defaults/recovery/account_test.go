@@ -0,0 +1,248 @@
+package recovery
+
+import (
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	v1 "cosmossdk.io/x/accounts/defaults/recovery/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+const accountAddr = "mock_recovery_account"
+
+func setupRecoveryAccount(t *testing.T, ss store.KVStoreService, hs *headerService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss, hs)
+
+	_, acc, err := NewAccount("recovery", signing.NewHandlerMap(directHandler{}), base.WithSecp256K1PubKey())(deps)
+	require.NoError(t, err)
+
+	return acc.(Account)
+}
+
+func validInit(t *testing.T) *v1.MsgInit {
+	t.Helper()
+	return &v1.MsgInit{
+		PubKey: toAny(t, secp256k1.GenPrivKey().PubKey()),
+		Guardians: []*v1.Guardian{
+			{Address: "guardian1", Weight: 1},
+			{Address: "guardian2", Weight: 1},
+			{Address: "guardian3", Weight: 1},
+		},
+		Config: &v1.Config{Threshold: 2, RecoveryDelay: 100},
+	}
+}
+
+func TestInit(t *testing.T) {
+	testcases := []struct {
+		name     string
+		malleate func(msg *v1.MsgInit)
+		expErr   string
+	}{
+		{
+			name: "valid init",
+		},
+		{
+			name:     "no config",
+			malleate: func(msg *v1.MsgInit) { msg.Config = nil },
+			expErr:   "config must be specified",
+		},
+		{
+			name:     "no guardians",
+			malleate: func(msg *v1.MsgInit) { msg.Guardians = nil },
+			expErr:   "guardians must be specified",
+		},
+		{
+			name:     "zero weight guardian",
+			malleate: func(msg *v1.MsgInit) { msg.Guardians[0].Weight = 0 },
+			expErr:   "guardian weight must be greater than zero",
+		},
+		{
+			name:     "duplicate guardian",
+			malleate: func(msg *v1.MsgInit) { msg.Guardians[1].Address = msg.Guardians[0].Address },
+			expErr:   "duplicate guardian address found",
+		},
+		{
+			name:     "zero recovery delay",
+			malleate: func(msg *v1.MsgInit) { msg.Config.RecoveryDelay = 0 },
+			expErr:   "threshold and recovery delay must be greater than zero",
+		},
+		{
+			name:     "threshold above total weight",
+			malleate: func(msg *v1.MsgInit) { msg.Config.Threshold = 4 },
+			expErr:   "threshold must be less than or equal to the total weight",
+		},
+		{
+			name:     "invalid pubkey",
+			malleate: func(msg *v1.MsgInit) { msg.PubKey = &codectypes.Any{TypeUrl: "invalid"} },
+			expErr:   "unknown pubkey type",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setupRecoveryAccount(t, ss, &headerService{now: time.Unix(1000, 0)})
+			msg := validInit(t)
+			if tc.malleate != nil {
+				tc.malleate(msg)
+			}
+
+			_, err := acc.Init(ctx, msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			resp, err := acc.QueryConfig(ctx, &v1.QueryConfig{})
+			require.NoError(t, err)
+			require.Len(t, resp.Guardians, 3)
+			require.Equal(t, uint64(2), resp.Config.Threshold)
+		})
+	}
+}
+
+func TestRecovery(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	hs := &headerService{now: time.Unix(1000, 0)}
+	acc := setupRecoveryAccount(t, ss, hs)
+	_, err := acc.Init(ctx, validInit(t))
+	require.NoError(t, err)
+
+	guardian1Ctx := accountstd.SetSender(ctx, []byte("guardian1"))
+	guardian2Ctx := accountstd.SetSender(ctx, []byte("guardian2"))
+	newPubKey := secp256k1.GenPrivKey().PubKey()
+
+	// only guardians can initiate a recovery
+	_, err = acc.InitiateRecovery(accountstd.SetSender(ctx, []byte("sender")), &v1.MsgInitiateRecovery{NewPubKey: toAny(t, newPubKey)})
+	require.ErrorContains(t, err, "sender is not a guardian")
+
+	_, err = acc.InitiateRecovery(guardian1Ctx, &v1.MsgInitiateRecovery{NewPubKey: &codectypes.Any{TypeUrl: "invalid"}})
+	require.ErrorContains(t, err, "invalid new pubkey")
+
+	resp, err := acc.InitiateRecovery(guardian1Ctx, &v1.MsgInitiateRecovery{NewPubKey: toAny(t, newPubKey)})
+	require.NoError(t, err)
+	recoveryID := resp.RecoveryId
+
+	_, err = acc.ApproveRecovery(guardian1Ctx, &v1.MsgApproveRecovery{RecoveryId: recoveryID})
+	require.ErrorContains(t, err, "guardian has already approved the recovery")
+
+	// the threshold is not reached yet
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: recoveryID})
+	require.ErrorContains(t, err, "recovery threshold not reached")
+
+	_, err = acc.ApproveRecovery(guardian2Ctx, &v1.MsgApproveRecovery{RecoveryId: recoveryID})
+	require.NoError(t, err)
+
+	recoveries, err := acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+	require.NoError(t, err)
+	require.Len(t, recoveries.Recoveries, 1)
+	require.Equal(t, recoveryID, recoveries.Recoveries[0].Id)
+	require.Equal(t, int64(1100), recoveries.Recoveries[0].Recovery.ExecutableAt)
+	require.ElementsMatch(t, []string{"guardian1", "guardian2"}, recoveries.Recoveries[0].Approvals)
+
+	// the delay has not passed yet
+	hs.now = time.Unix(1099, 0)
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: recoveryID})
+	require.ErrorContains(t, err, "recovery delay has not passed yet")
+
+	// a late approval does not restart the delay
+	_, err = acc.ApproveRecovery(accountstd.SetSender(ctx, []byte("guardian3")), &v1.MsgApproveRecovery{RecoveryId: recoveryID})
+	require.NoError(t, err)
+
+	hs.now = time.Unix(1100, 0)
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: recoveryID})
+	require.NoError(t, err)
+
+	pkBytes, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, toAny(t, newPubKey).Value, pkBytes)
+
+	recoveries, err = acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, recoveries.Recoveries)
+
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: recoveryID})
+	require.ErrorContains(t, err, "recovery not found")
+}
+
+func TestCancelRecovery(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	hs := &headerService{now: time.Unix(1000, 0)}
+	acc := setupRecoveryAccount(t, ss, hs)
+	msg := validInit(t)
+	_, err := acc.Init(ctx, msg)
+	require.NoError(t, err)
+
+	resp, err := acc.InitiateRecovery(accountstd.SetSender(ctx, []byte("guardian1")), &v1.MsgInitiateRecovery{NewPubKey: toAny(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+	_, err = acc.ApproveRecovery(accountstd.SetSender(ctx, []byte("guardian2")), &v1.MsgApproveRecovery{RecoveryId: resp.RecoveryId})
+	require.NoError(t, err)
+
+	// only the account can cancel a recovery
+	_, err = acc.CancelRecovery(accountstd.SetSender(ctx, []byte("guardian1")), &v1.MsgCancelRecovery{RecoveryId: resp.RecoveryId})
+	require.ErrorContains(t, err, "unauthorized")
+
+	selfCtx := accountstd.SetSender(ctx, []byte(accountAddr))
+	_, err = acc.CancelRecovery(selfCtx, &v1.MsgCancelRecovery{RecoveryId: resp.RecoveryId})
+	require.NoError(t, err)
+
+	hs.now = time.Unix(2000, 0)
+	_, err = acc.ExecuteRecovery(ctx, &v1.MsgExecuteRecovery{RecoveryId: resp.RecoveryId})
+	require.ErrorContains(t, err, "recovery not found")
+
+	pkBytes, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, msg.PubKey.Value, pkBytes)
+}
+
+func TestUpdateGuardians(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupRecoveryAccount(t, ss, &headerService{now: time.Unix(1000, 0)})
+	_, err := acc.Init(ctx, validInit(t))
+	require.NoError(t, err)
+
+	_, err = acc.InitiateRecovery(accountstd.SetSender(ctx, []byte("guardian1")), &v1.MsgInitiateRecovery{NewPubKey: toAny(t, secp256k1.GenPrivKey().PubKey())})
+	require.NoError(t, err)
+
+	update := &v1.MsgUpdateGuardians{
+		Guardians: []*v1.Guardian{{Address: "guardian4", Weight: 2}},
+		Config:    &v1.Config{Threshold: 2, RecoveryDelay: 10},
+	}
+
+	_, err = acc.UpdateGuardians(accountstd.SetSender(ctx, []byte("guardian1")), update)
+	require.ErrorContains(t, err, "unauthorized")
+
+	_, err = acc.UpdateGuardians(accountstd.SetSender(ctx, []byte(accountAddr)), update)
+	require.NoError(t, err)
+
+	config, err := acc.QueryConfig(ctx, &v1.QueryConfig{})
+	require.NoError(t, err)
+	require.Equal(t, []*v1.Guardian{{Address: "guardian4", Weight: 2}}, config.Guardians)
+	require.Equal(t, int64(10), config.Config.RecoveryDelay)
+
+	// pending recoveries are cancelled
+	recoveries, err := acc.QueryRecoveries(ctx, &v1.QueryRecoveries{})
+	require.NoError(t, err)
+	require.Empty(t, recoveries.Recoveries)
+
+	_, err = acc.InitiateRecovery(accountstd.SetSender(ctx, []byte("guardian1")), &v1.MsgInitiateRecovery{NewPubKey: toAny(t, secp256k1.GenPrivKey().PubKey())})
+	require.ErrorContains(t, err, "sender is not a guardian")
+}
+
+func toAny(t *testing.T, msg gogoproto.Message) *codectypes.Any {
+	t.Helper()
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	return anyMsg
+}
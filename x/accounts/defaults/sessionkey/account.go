@@ -0,0 +1,374 @@
+package sessionkey
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	v1 "cosmossdk.io/x/accounts/defaults/sessionkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// the prefixes of the base account state are 0, 1 and 2.
+var (
+	SessionKeysPrefix      = collections.NewPrefix(3)
+	ActiveSessionKeyPrefix = collections.NewPrefix(4)
+)
+
+// NewAccount returns a new session key account creator function. The account owner pubkey
+// and the session keys are verified with the provided sign mode handlers, the options define
+// the pubkey types supported for both.
+func NewAccount(name string, handlerMap *signing.HandlerMap, options ...base.Option) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, baseAcc, err := base.NewAccount(name, handlerMap, options...)(deps)
+		if err != nil {
+			return "", nil, err
+		}
+
+		acc := Account{
+			Account:          baseAcc.(base.Account),
+			SessionKeys:      collections.NewMap(deps.SchemaBuilder, SessionKeysPrefix, "session_keys", collections.BytesKey, codec.CollValue[v1.SessionKey](deps.LegacyStateCodec)),
+			ActiveSessionKey: collections.NewItem(deps.SchemaBuilder, ActiveSessionKeyPrefix, "active_session_key", collections.BytesValue),
+			addrCodec:        deps.AddressCodec,
+			headerService:    deps.Environment.HeaderService,
+		}
+		return name, acc, nil
+	}
+}
+
+// Account implements a base account whose owner can register temporary session keys,
+// restricted by an expiry, the messages they can execute and the amount they can spend.
+type Account struct {
+	base.Account
+
+	// SessionKeys maps the pubkey bytes of a session key to its limits.
+	SessionKeys collections.Map[[]byte, v1.SessionKey]
+	// ActiveSessionKey is the pubkey bytes of the session key which authenticated the
+	// last transaction of the account, if it was not the owner one.
+	ActiveSessionKey collections.Item[[]byte]
+
+	addrCodec     address.Codec
+	headerService header.Service
+}
+
+// Authenticate implements the authentication flow of an abstracted session key account.
+// Transactions signed with a registered session key are checked against its limits,
+// the other ones are authenticated with the owner pubkey.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	signerPubKey := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].PublicKey
+	if signerPubKey != nil {
+		sessionKey, err := a.SessionKeys.Get(ctx, signerPubKey.Value)
+		switch {
+		case err == nil && sessionKey.PubKey.TypeUrl == signerPubKey.TypeUrl:
+			return &aa_interface_v1.MsgAuthenticateResponse{}, a.authenticateSession(ctx, msg, sessionKey)
+		case err != nil && !errors.Is(err, collections.ErrNotFound):
+			return nil, err
+		}
+	}
+
+	if err := a.ActiveSessionKey.Remove(ctx); err != nil {
+		return nil, err
+	}
+
+	return a.Account.Authenticate(ctx, msg)
+}
+
+// authenticateSession verifies the signature of a session key and checks the transaction
+// only executes allowed messages through MsgExecuteSession, and its fees fit in the spend limit.
+func (a Account) authenticateSession(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate, sessionKey v1.SessionKey) error {
+	if err := a.checkExpiry(ctx, sessionKey); err != nil {
+		return err
+	}
+
+	self, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return err
+	}
+
+	for i, anyMsg := range msg.Tx.Body.Messages {
+		if err := checkSessionTxMessage(self, anyMsg, sessionKey); err != nil {
+			return fmt.Errorf("invalid message %d: %w", i, err)
+		}
+	}
+
+	// fees paid by the account are spent by the session key.
+	if fee := msg.Tx.AuthInfo.Fee; fee != nil && fee.Granter == "" && (fee.Payer == "" || fee.Payer == self) {
+		sessionKey.SpendLimit, err = spend(sessionKey.SpendLimit, fee.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	pubKey, err := a.DecodePubKey(sessionKey.PubKey)
+	if err != nil {
+		return err
+	}
+
+	signBytes, err := a.GetSignBytes(ctx, msg, pubKey)
+	if err != nil {
+		return err
+	}
+
+	if !pubKey.VerifySignature(signBytes, msg.Tx.Signatures[msg.SignerIndex]) {
+		return errors.New("signature verification failed")
+	}
+
+	if err := a.SessionKeys.Set(ctx, sessionKey.PubKey.Value, sessionKey); err != nil {
+		return err
+	}
+
+	return a.ActiveSessionKey.Set(ctx, sessionKey.PubKey.Value)
+}
+
+// AddSessionKey registers a session key, replacing any session key with the same pubkey.
+func (a Account) AddSessionKey(ctx context.Context, msg *v1.MsgAddSessionKey) (*v1.MsgAddSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	sessionKey := msg.SessionKey
+	if sessionKey == nil || sessionKey.PubKey == nil {
+		return nil, errors.New("session key pubkey must be specified")
+	}
+
+	if _, err := a.DecodePubKey(sessionKey.PubKey); err != nil {
+		return nil, err
+	}
+
+	ownerPubKey, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(ownerPubKey, sessionKey.PubKey.Value) {
+		return nil, errors.New("the account pubkey cannot be a session key")
+	}
+
+	if err := a.checkExpiry(ctx, *sessionKey); err != nil {
+		return nil, err
+	}
+
+	if len(sessionKey.AllowedMessages) == 0 {
+		return nil, errors.New("session key allowed messages must be specified")
+	}
+
+	if err := sessionKey.SpendLimit.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid session key spend limit: %w", err)
+	}
+
+	return &v1.MsgAddSessionKeyResponse{}, a.SessionKeys.Set(ctx, sessionKey.PubKey.Value, *sessionKey)
+}
+
+// RemoveSessionKey revokes a session key.
+func (a Account) RemoveSessionKey(ctx context.Context, msg *v1.MsgRemoveSessionKey) (*v1.MsgRemoveSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	if msg.PubKey == nil {
+		return nil, errors.New("session key pubkey must be specified")
+	}
+
+	exists, err := a.SessionKeys.Has(ctx, msg.PubKey.Value)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.New("session key not found")
+	}
+
+	return &v1.MsgRemoveSessionKeyResponse{}, a.SessionKeys.Remove(ctx, msg.PubKey.Value)
+}
+
+// ExecuteSession executes messages on behalf of the account within the limits of the
+// session key which authenticated the transaction. The coins the account spent while
+// executing them are deducted from the session key spend limit.
+func (a Account) ExecuteSession(ctx context.Context, msg *v1.MsgExecuteSession) (*v1.MsgExecuteSessionResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	key, err := a.ActiveSessionKey.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errors.New("transaction was not authenticated by a session key")
+		}
+		return nil, err
+	}
+
+	sessionKey, err := a.SessionKeys.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errors.New("session key not found")
+		}
+		return nil, err
+	}
+
+	if err := a.checkExpiry(ctx, sessionKey); err != nil {
+		return nil, err
+	}
+
+	self, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkAllowedMessages(self, msg.Messages, sessionKey); err != nil {
+		return nil, err
+	}
+
+	balancesBefore, err := getBalances(ctx, self)
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := accountstd.ExecModuleAnys(ctx, msg.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	balancesAfter, err := getBalances(ctx, self)
+	if err != nil {
+		return nil, err
+	}
+
+	spent := sdk.NewCoins()
+	for _, coin := range balancesBefore {
+		if diff := coin.Amount.Sub(balancesAfter.AmountOf(coin.Denom)); diff.IsPositive() {
+			spent = spent.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+
+	sessionKey.SpendLimit, err = spend(sessionKey.SpendLimit, spent)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.SessionKeys.Set(ctx, key, sessionKey); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgExecuteSessionResponse{Responses: responses}, nil
+}
+
+// QuerySessionKeys returns the session keys of the account, including the expired ones.
+func (a Account) QuerySessionKeys(ctx context.Context, _ *v1.QuerySessionKeys) (*v1.QuerySessionKeysResponse, error) {
+	sessionKeys := []*v1.SessionKey{}
+	err := a.SessionKeys.Walk(ctx, nil, func(_ []byte, sessionKey v1.SessionKey) (stop bool, err error) {
+		sessionKeys = append(sessionKeys, &sessionKey)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QuerySessionKeysResponse{SessionKeys: sessionKeys}, nil
+}
+
+func (a Account) checkExpiry(ctx context.Context, sessionKey v1.SessionKey) error {
+	if a.headerService.HeaderInfo(ctx).Time.Unix() >= sessionKey.Expiry {
+		return errors.New("session key expired")
+	}
+	return nil
+}
+
+// checkSessionTxMessage checks a message of a transaction signed by a session key is
+// a MsgExecuteSession sent by the account to itself, executing allowed messages.
+func checkSessionTxMessage(self string, anyMsg *codectypes.Any, sessionKey v1.SessionKey) error {
+	if anyMsg.TypeUrl != sdk.MsgTypeURL(&accountsv1.MsgExecute{}) {
+		return fmt.Errorf("session keys can only sign %s messages, got %s", sdk.MsgTypeURL(&accountsv1.MsgExecute{}), anyMsg.TypeUrl)
+	}
+
+	execMsg := new(accountsv1.MsgExecute)
+	if err := gogoproto.Unmarshal(anyMsg.Value, execMsg); err != nil {
+		return err
+	}
+
+	if execMsg.Sender != self || execMsg.Target != self || execMsg.Message == nil || execMsg.Message.TypeUrl != sdk.MsgTypeURL(&v1.MsgExecuteSession{}) {
+		return fmt.Errorf("session keys can only execute %s on the account itself", sdk.MsgTypeURL(&v1.MsgExecuteSession{}))
+	}
+
+	sessionMsg := new(v1.MsgExecuteSession)
+	if err := gogoproto.Unmarshal(execMsg.Message.Value, sessionMsg); err != nil {
+		return err
+	}
+
+	return checkAllowedMessages(self, sessionMsg.Messages, sessionKey)
+}
+
+// checkAllowedMessages checks the messages are allowed by the session key and do not
+// execute the account itself, so that a session key cannot manage the account.
+func checkAllowedMessages(self string, msgs []*codectypes.Any, sessionKey v1.SessionKey) error {
+	for _, anyMsg := range msgs {
+		if !slices.Contains(sessionKey.AllowedMessages, anyMsg.TypeUrl) {
+			return fmt.Errorf("message %s is not allowed by the session key", anyMsg.TypeUrl)
+		}
+
+		if anyMsg.TypeUrl != sdk.MsgTypeURL(&accountsv1.MsgExecute{}) {
+			continue
+		}
+
+		execMsg := new(accountsv1.MsgExecute)
+		if err := gogoproto.Unmarshal(anyMsg.Value, execMsg); err != nil {
+			return err
+		}
+		if execMsg.Target == self {
+			return errors.New("session keys cannot execute messages on the account itself")
+		}
+	}
+
+	return nil
+}
+
+// spend deducts an amount from a spend limit, failing if it exceeds it.
+func spend(spendLimit, amount sdk.Coins) (sdk.Coins, error) {
+	remaining, hasNeg := spendLimit.SafeSub(amount...)
+	if hasNeg {
+		return nil, fmt.Errorf("session key spend limit exceeded: spending %s, limit %s", amount, spendLimit)
+	}
+	return remaining, nil
+}
+
+func getBalances(ctx context.Context, addr string) (sdk.Coins, error) {
+	resp, err := accountstd.QueryModule[*banktypes.QueryAllBalancesResponse](ctx, &banktypes.QueryAllBalancesRequest{
+		Address:    addr,
+		Pagination: &query.PageRequest{Limit: query.PaginationMaxLimit},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Balances, nil
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.AddSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.RemoveSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.ExecuteSession)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	a.Account.RegisterQueryHandlers(builder)
+	accountstd.RegisterQueryHandler(builder, a.QuerySessionKeys)
+}
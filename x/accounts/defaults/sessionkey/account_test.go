@@ -0,0 +1,303 @@
+package sessionkey
+
+import (
+	"context"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	basev1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	v1 "cosmossdk.io/x/accounts/defaults/sessionkey/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const accountAddr = "mock_session_key_account"
+
+func setupSessionKeyAccount(t *testing.T, ctx context.Context, ss store.KVStoreService, owner *secp256k1.PrivKey) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+
+	_, acc, err := NewAccount("session-key", signing.NewHandlerMap(directHandler{}), base.WithSecp256K1PubKey())(deps)
+	require.NoError(t, err)
+	sessionKeyAcc := acc.(Account)
+
+	_, err = sessionKeyAcc.Init(ctx, &basev1.MsgInit{PubKey: toAny(t, owner.PubKey())})
+	require.NoError(t, err)
+
+	return sessionKeyAcc
+}
+
+func TestAddSessionKey(t *testing.T) {
+	ctx, ss := newMockContext(t, &sdk.Coins{})
+	owner := secp256k1.GenPrivKey()
+	acc := setupSessionKeyAccount(t, ctx, ss, owner)
+	selfCtx := accountstd.SetSender(ctx, []byte(accountAddr))
+
+	sessionPubKey := toAny(t, secp256k1.GenPrivKey().PubKey())
+	validSessionKey := func() *v1.SessionKey {
+		return &v1.SessionKey{
+			PubKey:          sessionPubKey,
+			Expiry:          2000,
+			AllowedMessages: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+			SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		}
+	}
+
+	testcases := []struct {
+		name     string
+		ctx      context.Context
+		malleate func(sessionKey *v1.SessionKey)
+		expErr   string
+	}{
+		{
+			name:   "sender is not self",
+			ctx:    accountstd.SetSender(ctx, []byte("sender")),
+			expErr: "unauthorized",
+		},
+		{
+			name:     "owner pubkey",
+			ctx:      selfCtx,
+			malleate: func(sessionKey *v1.SessionKey) { sessionKey.PubKey = toAny(t, owner.PubKey()) },
+			expErr:   "the account pubkey cannot be a session key",
+		},
+		{
+			name: "invalid pubkey",
+			ctx:  selfCtx,
+			malleate: func(sessionKey *v1.SessionKey) {
+				sessionKey.PubKey = toAny(t, &secp256k1.PubKey{Key: []byte("invalid")})
+			},
+			expErr: "unable to validate pubkey",
+		},
+		{
+			name:     "expired",
+			ctx:      selfCtx,
+			malleate: func(sessionKey *v1.SessionKey) { sessionKey.Expiry = 1000 },
+			expErr:   "session key expired",
+		},
+		{
+			name:     "no allowed messages",
+			ctx:      selfCtx,
+			malleate: func(sessionKey *v1.SessionKey) { sessionKey.AllowedMessages = nil },
+			expErr:   "session key allowed messages must be specified",
+		},
+		{
+			name:     "invalid spend limit",
+			ctx:      selfCtx,
+			malleate: func(sessionKey *v1.SessionKey) { sessionKey.SpendLimit = sdk.Coins{sdk.NewInt64Coin("stake", 0)} },
+			expErr:   "invalid session key spend limit",
+		},
+		{
+			name: "valid session key",
+			ctx:  selfCtx,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			sessionKey := validSessionKey()
+			if tc.malleate != nil {
+				tc.malleate(sessionKey)
+			}
+			_, err := acc.AddSessionKey(tc.ctx, &v1.MsgAddSessionKey{SessionKey: sessionKey})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	resp, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Len(t, resp.SessionKeys, 1)
+	require.Equal(t, sessionPubKey.Value, resp.SessionKeys[0].PubKey.Value)
+	require.Equal(t, validSessionKey().SpendLimit, resp.SessionKeys[0].SpendLimit)
+
+	_, err = acc.RemoveSessionKey(selfCtx, &v1.MsgRemoveSessionKey{PubKey: sessionPubKey})
+	require.NoError(t, err)
+	_, err = acc.RemoveSessionKey(selfCtx, &v1.MsgRemoveSessionKey{PubKey: sessionPubKey})
+	require.ErrorContains(t, err, "session key not found")
+
+	resp, err = acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Empty(t, resp.SessionKeys)
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx, ss := newMockContext(t, &sdk.Coins{})
+	owner := secp256k1.GenPrivKey()
+	acc := setupSessionKeyAccount(t, ctx, ss, owner)
+
+	sessionPrivKey := secp256k1.GenPrivKey()
+	_, err := acc.AddSessionKey(accountstd.SetSender(ctx, []byte(accountAddr)), &v1.MsgAddSessionKey{SessionKey: &v1.SessionKey{
+		PubKey:          toAny(t, sessionPrivKey.PubKey()),
+		Expiry:          2000,
+		AllowedMessages: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+	send := &banktypes.MsgSend{FromAddress: accountAddr, ToAddress: "recipient", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 40))
+
+	// session key executing an allowed message
+	_, err = acc.Authenticate(ctx, signTx(t, sessionPrivKey, 0, fee, executeSession(t, accountAddr, send)))
+	require.NoError(t, err)
+
+	sessionKey, err := acc.SessionKeys.Get(ctx, toAny(t, sessionPrivKey.PubKey()).Value)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), sessionKey.SpendLimit)
+	active, err := acc.ActiveSessionKey.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sessionKey.PubKey.Value, active)
+
+	// session key executing a message directly
+	_, err = acc.Authenticate(ctx, signTx(t, sessionPrivKey, 1, nil, toAny(t, send)))
+	require.ErrorContains(t, err, "session keys can only sign")
+
+	// session key executing a message which is not allowed
+	_, err = acc.Authenticate(ctx, signTx(t, sessionPrivKey, 1, nil, executeSession(t, accountAddr, &banktypes.MsgMultiSend{})))
+	require.ErrorContains(t, err, "is not allowed by the session key")
+
+	// session key paying fees above its spend limit
+	_, err = acc.Authenticate(ctx, signTx(t, sessionPrivKey, 1, fee.Add(fee...), executeSession(t, accountAddr, send)))
+	require.ErrorContains(t, err, "session key spend limit exceeded")
+
+	// owner executing any message
+	_, err = acc.Authenticate(ctx, signTx(t, owner, 1, fee.Add(fee...), toAny(t, &banktypes.MsgMultiSend{})))
+	require.NoError(t, err)
+	_, err = acc.ActiveSessionKey.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestExecuteSession(t *testing.T) {
+	balances := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("atom", 1000))
+	ctx, ss := newMockContext(t, &balances)
+	acc := setupSessionKeyAccount(t, ctx, ss, secp256k1.GenPrivKey())
+	ctx = accountstd.SetSender(ctx, []byte(accountAddr))
+
+	sessionPubKey := toAny(t, secp256k1.GenPrivKey().PubKey())
+	_, err := acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{SessionKey: &v1.SessionKey{
+		PubKey:          sessionPubKey,
+		Expiry:          2000,
+		AllowedMessages: []string{sdk.MsgTypeURL(&banktypes.MsgSend{}), sdk.MsgTypeURL(&accountsv1.MsgExecute{})},
+		SpendLimit:      sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+	}})
+	require.NoError(t, err)
+
+	sendMsgs := func(amount sdk.Coins) *v1.MsgExecuteSession {
+		return &v1.MsgExecuteSession{Messages: []*codectypes.Any{
+			toAny(t, &banktypes.MsgSend{FromAddress: accountAddr, ToAddress: "recipient", Amount: amount}),
+		}}
+	}
+
+	// the transaction was not authenticated by the session key
+	_, err = acc.ExecuteSession(ctx, sendMsgs(sdk.NewCoins(sdk.NewInt64Coin("stake", 30))))
+	require.ErrorContains(t, err, "transaction was not authenticated by a session key")
+
+	require.NoError(t, acc.ActiveSessionKey.Set(ctx, sessionPubKey.Value))
+
+	_, err = acc.ExecuteSession(accountstd.SetSender(ctx, []byte("sender")), sendMsgs(sdk.NewCoins(sdk.NewInt64Coin("stake", 30))))
+	require.ErrorContains(t, err, "unauthorized")
+
+	_, err = acc.ExecuteSession(ctx, &v1.MsgExecuteSession{Messages: []*codectypes.Any{
+		toAny(t, &accountsv1.MsgExecute{Sender: accountAddr, Target: accountAddr, Message: toAny(t, &v1.MsgRemoveSessionKey{PubKey: sessionPubKey})}),
+	}})
+	require.ErrorContains(t, err, "session keys cannot execute messages on the account itself")
+
+	resp, err := acc.ExecuteSession(ctx, sendMsgs(sdk.NewCoins(sdk.NewInt64Coin("stake", 30))))
+	require.NoError(t, err)
+	require.Len(t, resp.Responses, 1)
+
+	sessionKey, err := acc.SessionKeys.Get(ctx, sessionPubKey.Value)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), sessionKey.SpendLimit)
+
+	// spending above the remaining limit or a denom without limit fails
+	_, err = acc.ExecuteSession(ctx, sendMsgs(sdk.NewCoins(sdk.NewInt64Coin("stake", 30))))
+	require.ErrorContains(t, err, "session key spend limit exceeded")
+	_, err = acc.ExecuteSession(ctx, sendMsgs(sdk.NewCoins(sdk.NewInt64Coin("atom", 1))))
+	require.ErrorContains(t, err, "session key spend limit exceeded")
+}
+
+// executeSession returns a MsgExecute of the account executing the messages through MsgExecuteSession.
+func executeSession(t *testing.T, self string, msgs ...gogoproto.Message) *codectypes.Any {
+	t.Helper()
+	sessionMsg := &v1.MsgExecuteSession{}
+	for _, msg := range msgs {
+		sessionMsg.Messages = append(sessionMsg.Messages, toAny(t, msg))
+	}
+	return toAny(t, &accountsv1.MsgExecute{Sender: self, Target: self, Message: toAny(t, sessionMsg)})
+}
+
+// signTx returns the authentication message of a tx signed in direct mode by the private key.
+func signTx(t *testing.T, privKey *secp256k1.PrivKey, sequence uint64, fee sdk.Coins, msgs ...*codectypes.Any) *aa_interface_v1.MsgAuthenticate {
+	t.Helper()
+	transaction := tx.Tx{
+		Body: &tx.TxBody{Messages: msgs},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					PublicKey: toAny(t, privKey.PubKey()),
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{
+								Mode: 1,
+							},
+						},
+					},
+					Sequence: sequence,
+				},
+			},
+			Fee: &tx.Fee{Amount: fee},
+		},
+	}
+
+	bodyBytes, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authInfoBytes, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+
+	signBytes, err := (&tx.SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}).Marshal()
+	require.NoError(t, err)
+	sig, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+	transaction.Signatures = [][]byte{sig}
+
+	return &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{
+			BodyBytes:     bodyBytes,
+			AuthInfoBytes: authInfoBytes,
+			Signatures:    transaction.Signatures,
+		},
+		Tx:          &transaction,
+		SignerIndex: 0,
+	}
+}
+
+func toAny(t *testing.T, msg gogoproto.Message) *codectypes.Any {
+	t.Helper()
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	return anyMsg
+}
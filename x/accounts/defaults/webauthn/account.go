@@ -0,0 +1,217 @@
+package webauthn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/accounts/accountstd"
+	"cosmossdk.io/x/accounts/defaults/base"
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+var (
+	RpIDPrefix        = collections.NewPrefix(3)
+	CredentialsPrefix = collections.NewPrefix(4)
+)
+
+// NewAccount returns a new WebAuthn account creator function. The sign bytes the
+// credentials assert are computed with the provided sign mode handlers.
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		_, baseAcc, err := base.NewAccount(name, handlerMap, base.WithPubKey[secp256r1.PubKey]())(deps)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return name, Account{
+			RpID:        collections.NewItem(deps.SchemaBuilder, RpIDPrefix, "rp_id", collections.StringValue),
+			Credentials: collections.NewMap(deps.SchemaBuilder, CredentialsPrefix, "credentials", collections.BytesKey, codec.CollValue[v1.Credential](deps.LegacyStateCodec)),
+			Sequence:    baseAcc.(base.Account).Sequence,
+			baseAccount: baseAcc.(base.Account),
+		}, nil
+	}
+}
+
+// Account implements an account authenticated by WebAuthn (passkey) assertions of
+// one of its registered credentials.
+type Account struct {
+	// RpID is the relying party ID the credentials are scoped to.
+	RpID collections.Item[string]
+	// Credentials maps the ID of a credential to the credential.
+	Credentials collections.Map[[]byte, v1.Credential]
+
+	Sequence collections.Sequence
+
+	// baseAccount holds the sequence and computes the sign bytes of the authenticated
+	// txs, its pubkey is never set as the credentials sign for the account.
+	baseAccount base.Account
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if msg.RpId == "" {
+		return nil, errors.New("relying party ID must be specified")
+	}
+
+	if len(msg.Credentials) == 0 {
+		return nil, errors.New("credentials must be specified")
+	}
+
+	if err := a.RpID.Set(ctx, msg.RpId); err != nil {
+		return nil, err
+	}
+
+	for _, credential := range msg.Credentials {
+		if err := a.addCredential(ctx, credential); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.MsgInitResponse{}, nil
+}
+
+func (a Account) AddCredential(ctx context.Context, msg *v1.MsgAddCredential) (*v1.MsgAddCredentialResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	return &v1.MsgAddCredentialResponse{}, a.addCredential(ctx, msg.Credential)
+}
+
+func (a Account) RemoveCredential(ctx context.Context, msg *v1.MsgRemoveCredential) (*v1.MsgRemoveCredentialResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	exists, err := a.Credentials.Has(ctx, msg.CredentialId)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.New("credential not found")
+	}
+
+	if err := a.Credentials.Remove(ctx, msg.CredentialId); err != nil {
+		return nil, err
+	}
+
+	// the account must keep a credential able to sign for it.
+	iter, err := a.Credentials.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, errors.New("cannot remove the last credential of the account")
+	}
+
+	return &v1.MsgRemoveCredentialResponse{}, nil
+}
+
+func (a Account) addCredential(ctx context.Context, credential *v1.Credential) error {
+	if credential == nil || len(credential.Id) == 0 {
+		return errors.New("credential ID must be specified")
+	}
+
+	if credential.PubKey == nil || credential.PubKey.Key == nil {
+		return errors.New("credential pubkey must be specified")
+	}
+
+	exists, err := a.Credentials.Has(ctx, credential.Id)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errors.New("credential already exists")
+	}
+
+	return a.Credentials.Set(ctx, credential.Id, *credential)
+}
+
+// Authenticate implements the authentication flow of an abstracted WebAuthn account.
+// The signature of the signer must be a Signature holding a WebAuthn assertion of one
+// of the account credentials, with the SHA-256 hash of the sign bytes as challenge.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	sig := new(v1.Signature)
+	if err := gogoproto.Unmarshal(msg.Tx.Signatures[msg.SignerIndex], sig); err != nil {
+		return nil, fmt.Errorf("unable to decode signature: %w", err)
+	}
+
+	credential, err := a.Credentials.Get(ctx, sig.CredentialId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errors.New("unknown credential")
+		}
+		return nil, err
+	}
+
+	signBytes, err := a.baseAccount.GetSignBytes(ctx, msg, credential.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rpID, err := a.RpID.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	credential.SignCount, err = verifyAssertion(rpID, credential, sig, signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, a.Credentials.Set(ctx, credential.Id, credential)
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+func (a Account) QueryCredentials(ctx context.Context, _ *v1.QueryCredentials) (*v1.QueryCredentialsResponse, error) {
+	rpID, err := a.RpID.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := []*v1.Credential{}
+	err = a.Credentials.Walk(ctx, nil, func(_ []byte, credential v1.Credential) (stop bool, err error) {
+		credentials = append(credentials, &credential)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryCredentialsResponse{RpId: rpID, Credentials: credentials}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.AddCredential)
+	accountstd.RegisterExecuteHandler(builder, a.RemoveCredential)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QueryCredentials)
+}
@@ -0,0 +1,209 @@
+package webauthn
+
+import (
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func setupWebAuthnAccount(t *testing.T, ss store.KVStoreService) Account {
+	t.Helper()
+	deps := makeMockDependencies(ss)
+
+	_, acc, err := NewAccount("webauthn", signing.NewHandlerMap(directHandler{}))(deps)
+	require.NoError(t, err)
+
+	return acc.(Account)
+}
+
+func newCredential(t *testing.T, id string) (*v1.Credential, *secp256r1.PrivKey) {
+	t.Helper()
+	privKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	return &v1.Credential{Id: []byte(id), PubKey: privKey.PubKey().(*secp256r1.PubKey)}, privKey
+}
+
+func TestInit(t *testing.T) {
+	credential, _ := newCredential(t, "credential")
+
+	testcases := []struct {
+		name   string
+		msg    *v1.MsgInit
+		expErr string
+	}{
+		{
+			"valid init",
+			&v1.MsgInit{RpId: testRpID, Credentials: []*v1.Credential{credential}},
+			"",
+		},
+		{
+			"no relying party ID",
+			&v1.MsgInit{Credentials: []*v1.Credential{credential}},
+			"relying party ID must be specified",
+		},
+		{
+			"no credentials",
+			&v1.MsgInit{RpId: testRpID},
+			"credentials must be specified",
+		},
+		{
+			"duplicate credentials",
+			&v1.MsgInit{RpId: testRpID, Credentials: []*v1.Credential{credential, credential}},
+			"credential already exists",
+		},
+		{
+			"credential without pubkey",
+			&v1.MsgInit{RpId: testRpID, Credentials: []*v1.Credential{{Id: []byte("credential")}}},
+			"credential pubkey must be specified",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, ss := newMockContext(t)
+			acc := setupWebAuthnAccount(t, ss)
+			_, err := acc.Init(ctx, tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAddRemoveCredential(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupWebAuthnAccount(t, ss)
+	credential1, _ := newCredential(t, "credential1")
+	credential2, _ := newCredential(t, "credential2")
+	_, err := acc.Init(ctx, &v1.MsgInit{RpId: testRpID, Credentials: []*v1.Credential{credential1}})
+	require.NoError(t, err)
+
+	_, err = acc.AddCredential(accountstd.SetSender(ctx, []byte("sender")), &v1.MsgAddCredential{Credential: credential2})
+	require.ErrorContains(t, err, "unauthorized")
+
+	ctx = accountstd.SetSender(ctx, []byte("mock_webauthn_account"))
+	_, err = acc.AddCredential(ctx, &v1.MsgAddCredential{Credential: credential2})
+	require.NoError(t, err)
+
+	resp, err := acc.QueryCredentials(ctx, &v1.QueryCredentials{})
+	require.NoError(t, err)
+	require.Equal(t, testRpID, resp.RpId)
+	require.Len(t, resp.Credentials, 2)
+
+	_, err = acc.RemoveCredential(ctx, &v1.MsgRemoveCredential{CredentialId: []byte("unknown")})
+	require.ErrorContains(t, err, "credential not found")
+	_, err = acc.RemoveCredential(ctx, &v1.MsgRemoveCredential{CredentialId: credential1.Id})
+	require.NoError(t, err)
+	_, err = acc.RemoveCredential(ctx, &v1.MsgRemoveCredential{CredentialId: credential2.Id})
+	require.ErrorContains(t, err, "cannot remove the last credential of the account")
+}
+
+func TestAuthenticate(t *testing.T) {
+	ctx, ss := newMockContext(t)
+	acc := setupWebAuthnAccount(t, ss)
+	credential1, privKey1 := newCredential(t, "credential1")
+	credential2, privKey2 := newCredential(t, "credential2")
+	_, err := acc.Init(ctx, &v1.MsgInit{RpId: testRpID, Credentials: []*v1.Credential{credential1, credential2}})
+	require.NoError(t, err)
+
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	// any registered credential can sign
+	_, err = acc.Authenticate(ctx, signTx(t, privKey1, credential1.Id, 0, 1))
+	require.NoError(t, err)
+	_, err = acc.Authenticate(ctx, signTx(t, privKey2, credential2.Id, 1, 1))
+	require.NoError(t, err)
+
+	credential, err := acc.Credentials.Get(ctx, credential1.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), credential.SignCount)
+
+	// replayed signature counter
+	_, err = acc.Authenticate(ctx, signTx(t, privKey1, credential1.Id, 2, 1))
+	require.ErrorContains(t, err, "signature counter did not increase")
+
+	// assertion of a credential with the ID of another one
+	_, err = acc.Authenticate(ctx, signTx(t, privKey2, credential1.Id, 3, 2))
+	require.ErrorContains(t, err, "signature verification failed")
+
+	// unknown credential
+	_, err = acc.Authenticate(ctx, signTx(t, privKey1, []byte("unknown"), 4, 2))
+	require.ErrorContains(t, err, "unknown credential")
+
+	// wrong sequence
+	_, err = acc.Authenticate(ctx, signTx(t, privKey1, credential1.Id, 0, 2))
+	require.ErrorContains(t, err, "unexpected sequence number")
+}
+
+// signTx returns the authentication message of a tx whose sign bytes are asserted by the credential.
+func signTx(t *testing.T, privKey *secp256r1.PrivKey, credentialID []byte, sequence uint64, signCount uint32) *aa_interface_v1.MsgAuthenticate {
+	t.Helper()
+	pkAny, err := codectypes.NewAnyWithValue(privKey.PubKey())
+	require.NoError(t, err)
+
+	transaction := tx.Tx{
+		Body: &tx.TxBody{},
+		AuthInfo: &tx.AuthInfo{
+			SignerInfos: []*tx.SignerInfo{
+				{
+					PublicKey: pkAny,
+					ModeInfo: &tx.ModeInfo{
+						Sum: &tx.ModeInfo_Single_{
+							Single: &tx.ModeInfo_Single{
+								Mode: 1,
+							},
+						},
+					},
+					Sequence: sequence,
+				},
+			},
+		},
+	}
+
+	bodyBytes, err := transaction.Body.Marshal()
+	require.NoError(t, err)
+	authInfoBytes, err := transaction.AuthInfo.Marshal()
+	require.NoError(t, err)
+
+	signBytes, err := (&tx.SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       "test",
+		AccountNumber: 1,
+	}).Marshal()
+	require.NoError(t, err)
+
+	sig, err := gogoproto.Marshal(newAssertion(t, privKey, credentialID, assertion{
+		rpID:          testRpID,
+		flags:         flagUserPresent,
+		signCount:     signCount,
+		clientDataTyp: assertionType,
+		challenge:     Challenge(signBytes),
+	}))
+	require.NoError(t, err)
+	transaction.Signatures = [][]byte{sig}
+
+	return &aa_interface_v1.MsgAuthenticate{
+		RawTx: &tx.TxRaw{
+			BodyBytes:     bodyBytes,
+			AuthInfoBytes: authInfoBytes,
+			Signatures:    transaction.Signatures,
+		},
+		Tx:          &transaction,
+		SignerIndex: 0,
+	}
+}
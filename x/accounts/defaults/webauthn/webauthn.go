@@ -0,0 +1,89 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+)
+
+// this file implements the verification of WebAuthn assertions, see https://www.w3.org/TR/webauthn-2/#sctn-verifying-assertion
+
+const (
+	// assertionType is the type of the client data of an assertion.
+	assertionType = "webauthn.get"
+
+	// flagUserPresent is the user present (UP) bit of the authenticator data flags.
+	flagUserPresent = 0x01
+
+	// authenticatorDataMinLength is the length of the rpIdHash, flags and signCount of the authenticator data.
+	authenticatorDataMinLength = 37
+)
+
+// clientData is the part of the client data JSON of an assertion verified by the account.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+}
+
+// Challenge returns the WebAuthn challenge a credential must sign for the given sign bytes:
+// the base64url encoding of their SHA-256 hash, as it appears in the client data JSON.
+func Challenge(signBytes []byte) string {
+	hash := sha256.Sum256(signBytes)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// verifyAssertion verifies a WebAuthn assertion of the credential over the sign bytes,
+// and returns the new signature counter of the credential.
+func verifyAssertion(rpID string, credential v1.Credential, sig *v1.Signature, signBytes []byte) (uint32, error) {
+	var data clientData
+	if err := json.Unmarshal(sig.ClientDataJson, &data); err != nil {
+		return 0, fmt.Errorf("unable to decode client data: %w", err)
+	}
+
+	if data.Type != assertionType {
+		return 0, fmt.Errorf("unexpected client data type, wanted: %s, got: %s", assertionType, data.Type)
+	}
+
+	if data.Challenge != Challenge(signBytes) {
+		return 0, errors.New("client data challenge does not match the transaction sign bytes")
+	}
+
+	authData := sig.AuthenticatorData
+	if len(authData) < authenticatorDataMinLength {
+		return 0, fmt.Errorf("authenticator data is too short, wanted at least %d bytes, got: %d", authenticatorDataMinLength, len(authData))
+	}
+
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	if !bytes.Equal(authData[:32], rpIDHash[:]) {
+		return 0, errors.New("authenticator data relying party ID hash mismatch")
+	}
+
+	if authData[32]&flagUserPresent == 0 {
+		return 0, errors.New("user is not present")
+	}
+
+	// authenticators which do not support a signature counter always report 0.
+	signCount := binary.BigEndian.Uint32(authData[33:37])
+	if (signCount != 0 || credential.SignCount != 0) && signCount <= credential.SignCount {
+		return 0, fmt.Errorf("signature counter did not increase, the authenticator may be cloned: stored %d, got %d", credential.SignCount, signCount)
+	}
+
+	clientDataHash := sha256.Sum256(sig.ClientDataJson)
+	signedData := make([]byte, 0, len(authData)+len(clientDataHash))
+	signedData = append(signedData, authData...)
+	signedData = append(signedData, clientDataHash[:]...)
+	hash := sha256.Sum256(signedData)
+
+	if !ecdsa.VerifyASN1(&credential.PubKey.Key.PublicKey, hash[:], sig.Signature) {
+		return 0, errors.New("signature verification failed")
+	}
+
+	return signCount, nil
+}
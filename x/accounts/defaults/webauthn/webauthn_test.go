@@ -0,0 +1,157 @@
+package webauthn
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+)
+
+const testRpID = "wallet.example.com"
+
+// assertion defines the parts of a WebAuthn assertion generated by newAssertion.
+type assertion struct {
+	rpID          string
+	flags         byte
+	signCount     uint32
+	clientDataTyp string
+	challenge     string
+}
+
+// newAssertion generates a WebAuthn assertion of the credential the way an authenticator
+// and a browser do, with the given client data and authenticator data.
+func newAssertion(t *testing.T, privKey *secp256r1.PrivKey, credentialID []byte, a assertion) *v1.Signature {
+	t.Helper()
+	rpIDHash := sha256.Sum256([]byte(a.rpID))
+	authData := append(rpIDHash[:], a.flags)
+	authData = binary.BigEndian.AppendUint32(authData, a.signCount)
+
+	clientDataJSON, err := json.Marshal(map[string]any{
+		"type":        a.clientDataTyp,
+		"challenge":   a.challenge,
+		"origin":      "https://" + a.rpID,
+		"crossOrigin": false,
+	})
+	require.NoError(t, err)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	hash := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, &privKey.Secret.PrivateKey, hash[:])
+	require.NoError(t, err)
+
+	return &v1.Signature{
+		CredentialId:      credentialID,
+		AuthenticatorData: authData,
+		ClientDataJson:    clientDataJSON,
+		Signature:         sig,
+	}
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	privKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	otherPrivKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	credential := v1.Credential{
+		Id:        []byte("credential"),
+		PubKey:    privKey.PubKey().(*secp256r1.PubKey),
+		SignCount: 5,
+	}
+	signBytes := []byte("sign bytes")
+
+	validAssertion := func() assertion {
+		return assertion{
+			rpID:          testRpID,
+			flags:         flagUserPresent,
+			signCount:     6,
+			clientDataTyp: assertionType,
+			challenge:     Challenge(signBytes),
+		}
+	}
+
+	testcases := []struct {
+		name         string
+		privKey      *secp256r1.PrivKey
+		malleate     func(a *assertion)
+		signCount    uint32
+		expSignCount uint32
+		expErr       string
+	}{
+		{
+			name:         "valid assertion",
+			signCount:    5,
+			expSignCount: 6,
+		},
+		{
+			name:         "authenticator without signature counter",
+			malleate:     func(a *assertion) { a.signCount = 0 },
+			expSignCount: 0,
+		},
+		{
+			name:      "signature counter did not increase",
+			signCount: 6,
+			expErr:    "signature counter did not increase",
+		},
+		{
+			name:     "registration client data",
+			malleate: func(a *assertion) { a.clientDataTyp = "webauthn.create" },
+			expErr:   "unexpected client data type",
+		},
+		{
+			name:     "challenge of other sign bytes",
+			malleate: func(a *assertion) { a.challenge = Challenge([]byte("other sign bytes")) },
+			expErr:   "client data challenge does not match the transaction sign bytes",
+		},
+		{
+			name:     "other relying party",
+			malleate: func(a *assertion) { a.rpID = "evil.example.com" },
+			expErr:   "relying party ID hash mismatch",
+		},
+		{
+			name:     "user not present",
+			malleate: func(a *assertion) { a.flags = 0 },
+			expErr:   "user is not present",
+		},
+		{
+			name:    "signed by another key",
+			privKey: otherPrivKey,
+			expErr:  "signature verification failed",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := validAssertion()
+			if tc.malleate != nil {
+				tc.malleate(&a)
+			}
+			signer := privKey
+			if tc.privKey != nil {
+				signer = tc.privKey
+			}
+			credential.SignCount = tc.signCount
+
+			signCount, err := verifyAssertion(testRpID, credential, newAssertion(t, signer, credential.Id, a), signBytes)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expSignCount, signCount)
+		})
+	}
+
+	// truncated authenticator data
+	sig := newAssertion(t, privKey, credential.Id, validAssertion())
+	sig.AuthenticatorData = sig.AuthenticatorData[:36]
+	_, err = verifyAssertion(testRpID, credential, sig, signBytes)
+	require.ErrorContains(t, err, "authenticator data is too short")
+}
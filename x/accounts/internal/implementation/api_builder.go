@@ -108,5 +108,5 @@ func IsRoutingError(err error) bool {
 	if err == nil {
 		return false
 	}
-	return errors.Is(err, errInvalidMessage)
+	return errors.Is(err, errInvalidMessage) || errors.Is(err, errNoExecuteHandler)
 }
@@ -20,6 +20,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -284,7 +285,12 @@ func (k Keeper) Query(
 
 	// make the context and execute the account query
 	ctx = k.makeAccountContext(ctx, accountNum, accountAddr, nil, nil, true)
-	return impl.Query(ctx, queryRequest)
+	resp, err := impl.Query(ctx, queryRequest)
+	if implementation.IsRoutingError(err) {
+		// the account type does not handle the query.
+		return nil, fmt.Errorf("%w: %w", sdkerrors.ErrNotSupported, err)
+	}
+	return resp, err
 }
 
 func (k Keeper) getImplementation(ctx context.Context, addr []byte) (implementation.Implementation, error) {
@@ -9,6 +9,8 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/x/accounts/accountstd"
 	"cosmossdk.io/x/accounts/internal/implementation"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestKeeper_Init(t *testing.T) {
@@ -89,4 +91,9 @@ func TestKeeper_Query(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, implementation.Equal(&types.Int64Value{Value: 1000}, resp))
 	})
+
+	t.Run("unsupported query", func(t *testing.T) {
+		_, err := m.Query(ctx, accAddr, &types.BoolValue{Value: true})
+		require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+	})
 }
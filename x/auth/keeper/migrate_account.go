@@ -0,0 +1,249 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	lockupv1 "cosmossdk.io/api/cosmos/accounts/defaults/lockup"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/core/transaction"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// x/accounts lockup account types the legacy vesting accounts are migrated to.
+const (
+	continuousLockingAccount = "continuous-locking-account"
+	delayedLockingAccount    = "delayed-locking-account"
+	periodicLockingAccount   = "periodic-locking-account"
+	permanentLockingAccount  = "permanent-locking-account"
+)
+
+// migrateAccount moves a legacy account from x/auth to x/accounts, keeping its address, account number and sequence.
+// Base accounts are initialized with the given init message, vesting accounts are migrated to the matching
+// lockup account type with their vesting schedule set on the lockup init message.
+func (ak AccountKeeper) migrateAccount(ctx context.Context, addr sdk.AccAddress, accType string, initMsgAny *codectypes.Any) (transaction.Msg, error) {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.ErrUnknownAddress.Wrap("account not found")
+	}
+
+	var (
+		initMsg transaction.Msg
+		err     error
+	)
+	switch acc := acc.(type) {
+	case *types.BaseAccount:
+		initMsg, err = initMsgWithSequence(initMsgAny, acc.GetSequence())
+	case *vestingtypes.ContinuousVestingAccount:
+		initMsg, err = lockupInitMsgFromVesting(continuousLockingAccount, accType, initMsgAny, acc.BaseVestingAccount, acc.StartTime)
+	case *vestingtypes.DelayedVestingAccount:
+		initMsg, err = lockupInitMsgFromVesting(delayedLockingAccount, accType, initMsgAny, acc.BaseVestingAccount, 0)
+	case *vestingtypes.PermanentLockedAccount:
+		initMsg, err = lockupInitMsgFromVesting(permanentLockingAccount, accType, initMsgAny, acc.BaseVestingAccount, 0)
+	case *vestingtypes.PeriodicVestingAccount:
+		initMsg, err = periodicLockingInitMsgFromVesting(accType, initMsgAny, acc)
+	default:
+		return nil, sdkerrors.ErrInvalidType.Wrapf("account of type %T cannot be migrated", acc)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ak.Accounts.Remove(ctx, addr); err != nil {
+		return nil, err
+	}
+
+	resp, err := ak.AccountsModKeeper.MigrateLegacyAccount(ctx, addr, acc.GetAccountNumber(), accType, initMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	// accounts which can be represented as a legacy account must keep the sequence of the migrated
+	// account, otherwise the transactions it signed could be replayed.
+	legacyResp, err := ak.AccountsModKeeper.Query(ctx, addr, &types.QueryLegacyAccount{})
+	if errors.Is(err, sdkerrors.ErrNotSupported) {
+		// the account type has no legacy account representation
+		return resp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	legacyAcc, ok := legacyResp.(*types.QueryLegacyAccountResponse)
+	if !ok || legacyAcc.Info == nil {
+		return resp, nil
+	}
+
+	if legacyAcc.Info.Sequence != acc.GetSequence() {
+		return nil, sdkerrors.ErrInvalidSequence.Wrapf("migrated account sequence must be %d, got %d", acc.GetSequence(), legacyAcc.Info.Sequence)
+	}
+
+	return resp, nil
+}
+
+// lockupInitMsgFromVesting sets the vesting schedule of a continuous, delayed or permanent locked
+// vesting account on the lockup init message provided by the user.
+func lockupInitMsgFromVesting(
+	expAccType, accType string,
+	initMsgAny *codectypes.Any,
+	bva *vestingtypes.BaseVestingAccount,
+	startTime int64,
+) (transaction.Msg, error) {
+	if accType != expAccType {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("vesting account can only be migrated to a %s account, got %s", expAccType, accType)
+	}
+
+	initMsg := &lockupv1.MsgInitLockupAccount{}
+	if err := unpackLockupInitMsg(initMsgAny, initMsg); err != nil {
+		return nil, err
+	}
+
+	initMsg.StartTime, initMsg.EndTime = nil, nil
+	if startTime != 0 {
+		initMsg.StartTime = timestamppb.New(time.Unix(startTime, 0))
+	}
+	if bva.EndTime != 0 {
+		initMsg.EndTime = timestamppb.New(time.Unix(bva.EndTime, 0))
+	}
+	initMsg.LegacyVestingState = legacyVestingState(bva)
+
+	return toAccountInitMsg(initMsg)
+}
+
+// periodicLockingInitMsgFromVesting sets the vesting schedule of a periodic vesting account on the
+// periodic lockup init message provided by the user.
+func periodicLockingInitMsgFromVesting(accType string, initMsgAny *codectypes.Any, pva *vestingtypes.PeriodicVestingAccount) (transaction.Msg, error) {
+	if accType != periodicLockingAccount {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("vesting account can only be migrated to a %s account, got %s", periodicLockingAccount, accType)
+	}
+
+	initMsg := &lockupv1.MsgInitPeriodicLockingAccount{}
+	if err := unpackLockupInitMsg(initMsgAny, initMsg); err != nil {
+		return nil, err
+	}
+
+	initMsg.StartTime = timestamppb.New(time.Unix(pva.StartTime, 0))
+	initMsg.LockingPeriods = make([]*lockupv1.Period, len(pva.VestingPeriods))
+	for i, period := range pva.VestingPeriods {
+		initMsg.LockingPeriods[i] = &lockupv1.Period{
+			Length: durationpb.New(period.Duration()),
+			Amount: intoV2Coins(period.Amount),
+		}
+	}
+	initMsg.LegacyVestingState = legacyVestingState(pva.BaseVestingAccount)
+
+	return toAccountInitMsg(initMsg)
+}
+
+func legacyVestingState(bva *vestingtypes.BaseVestingAccount) *lockupv1.LegacyVestingState {
+	return &lockupv1.LegacyVestingState{
+		OriginalLocking:  intoV2Coins(bva.OriginalVesting),
+		DelegatedFree:    intoV2Coins(bva.DelegatedFree),
+		DelegatedLocking: intoV2Coins(bva.DelegatedVesting),
+		Sequence:         bva.GetSequence(),
+	}
+}
+
+// initMsgWithSequence decodes the init message provided by the user and sets its init_sequence, such as the one of
+// the x/accounts base account, to the sequence of the migrated account. Init messages without it are left as is.
+func initMsgWithSequence(initMsgAny *codectypes.Any, sequence uint64) (transaction.Msg, error) {
+	if initMsgAny == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("account init message cannot be empty")
+	}
+
+	split := strings.Split(initMsgAny.TypeUrl, "/")
+	name := protoreflect.FullName(split[len(split)-1])
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(name)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("no message type found for %s", name)
+	}
+
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("%s is not a message", name)
+	}
+
+	field := msgDesc.Fields().ByName("init_sequence")
+	if field == nil || field.Kind() != protoreflect.Uint64Kind {
+		return unpackAccountInitMsg(initMsgAny)
+	}
+
+	initMsg := dynamicpb.NewMessage(msgDesc)
+	if err := protov2.Unmarshal(initMsgAny.Value, initMsg); err != nil {
+		return nil, err
+	}
+	initMsg.Set(field, protoreflect.ValueOfUint64(sequence))
+
+	return toAccountInitMsg(initMsg)
+}
+
+// unpackLockupInitMsg decodes the lockup init message provided by the user, x/auth cannot depend on
+// the lockup implementation so its API types are used instead.
+func unpackLockupInitMsg(initMsgAny *codectypes.Any, initMsg protov2.Message) error {
+	if initMsgAny == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("account init message cannot be empty")
+	}
+
+	typeURL := "/" + string(initMsg.ProtoReflect().Descriptor().FullName())
+	if initMsgAny.TypeUrl != typeURL {
+		return sdkerrors.ErrInvalidType.Wrapf("expected init message of type %s, got %s", typeURL, initMsgAny.TypeUrl)
+	}
+
+	return protov2.Unmarshal(initMsgAny.Value, initMsg)
+}
+
+// toAccountInitMsg converts an init message API or dynamic type to the type x/accounts expects.
+func toAccountInitMsg(initMsg protov2.Message) (transaction.Msg, error) {
+	bz, err := protov2.Marshal(initMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return unpackAccountInitMsg(&codectypes.Any{
+		TypeUrl: "/" + string(initMsg.ProtoReflect().Descriptor().FullName()),
+		Value:   bz,
+	})
+}
+
+// unpackAccountInitMsg decodes the init message of an x/accounts account type from its registered type.
+func unpackAccountInitMsg(initMsgAny *codectypes.Any) (transaction.Msg, error) {
+	if initMsgAny == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("account init message cannot be empty")
+	}
+
+	split := strings.Split(initMsgAny.TypeUrl, "/")
+	name := split[len(split)-1]
+	typ := gogoproto.MessageType(name)
+	if typ == nil {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("no message type found for %s", name)
+	}
+
+	initMsg := reflect.New(typ.Elem()).Interface().(gogoproto.Message)
+	return initMsg, gogoproto.Unmarshal(initMsgAny.Value, initMsg)
+}
+
+func intoV2Coins(coins sdk.Coins) []*basev1beta1.Coin {
+	v2Coins := make([]*basev1beta1.Coin, len(coins))
+	for i, c := range coins {
+		v2Coins[i] = &basev1beta1.Coin{
+			Denom:  c.Denom,
+			Amount: c.Amount.String(),
+		}
+	}
+	return v2Coins
+}
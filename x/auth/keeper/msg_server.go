@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -51,6 +52,34 @@ func (ms msgServer) NonAtomicExec(ctx context.Context, msg *types.MsgNonAtomicEx
 	}, nil
 }
 
+// MigrateAccount migrates the signer account from x/auth to x/accounts.
+func (ms msgServer) MigrateAccount(ctx context.Context, msg *types.MsgMigrateAccount) (*types.MsgMigrateAccountResponse, error) {
+	if msg.Signer == "" {
+		return nil, errors.New("empty signer address string is not allowed")
+	}
+
+	signer, err := ms.ak.AddressCodec().StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: %s", err)
+	}
+
+	if msg.AccountType == "" {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("account type cannot be empty")
+	}
+
+	resp, err := ms.ak.migrateAccount(ctx, signer, msg.AccountType, msg.AccountInitMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	anyResp, err := codectypes.NewAnyWithValue(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateAccountResponse{InitResponse: anyResp}, nil
+}
+
 func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if ms.ak.authority != msg.Authority {
 		return nil, fmt.Errorf(
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"errors"
 
 	"github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
@@ -10,7 +11,10 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 func (s *KeeperTestSuite) TestUpdateParams() {
@@ -200,3 +204,154 @@ func (s *KeeperTestSuite) TestNonAtomicExec() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMigrateAccount() {
+	vestingtypes.RegisterInterfaces(s.encCfg.InterfaceRegistry)
+
+	initMsg, err := codectypes.NewAnyWithValue(&testdata.TestMsg{})
+	s.Require().NoError(err)
+
+	newBaseAccount := func() *types.BaseAccount {
+		_, _, addr := testdata.KeyTestPubAddr()
+		acc := s.accountKeeper.NewAccountWithAddress(s.ctx, addr).(*types.BaseAccount)
+		s.Require().NoError(acc.SetSequence(1))
+		s.accountKeeper.SetAccount(s.ctx, acc)
+		return acc
+	}
+
+	newVestingAccount := func() sdk.AccountI {
+		baseAcc := newBaseAccount()
+		acc, err := vestingtypes.NewContinuousVestingAccount(baseAcc, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), 1, 100)
+		s.Require().NoError(err)
+		s.accountKeeper.SetAccount(s.ctx, acc)
+		return acc
+	}
+
+	testCases := []struct {
+		name      string
+		req       func() *types.MsgMigrateAccount
+		expErrMsg string
+	}{
+		{
+			name: "error: empty signer",
+			req: func() *types.MsgMigrateAccount {
+				return &types.MsgMigrateAccount{AccountType: "base", AccountInitMsg: initMsg}
+			},
+			expErrMsg: "empty signer address string is not allowed",
+		},
+		{
+			name: "error: invalid signer",
+			req: func() *types.MsgMigrateAccount {
+				return &types.MsgMigrateAccount{Signer: "invalid_signer", AccountType: "base", AccountInitMsg: initMsg}
+			},
+			expErrMsg: "invalid signer address",
+		},
+		{
+			name: "error: empty account type",
+			req: func() *types.MsgMigrateAccount {
+				return &types.MsgMigrateAccount{Signer: newBaseAccount().Address, AccountInitMsg: initMsg}
+			},
+			expErrMsg: "account type cannot be empty",
+		},
+		{
+			name: "error: account not found",
+			req: func() *types.MsgMigrateAccount {
+				_, _, addr := testdata.KeyTestPubAddr()
+				return &types.MsgMigrateAccount{Signer: addr.String(), AccountType: "base", AccountInitMsg: initMsg}
+			},
+			expErrMsg: "account not found",
+		},
+		{
+			name: "error: module account",
+			req: func() *types.MsgMigrateAccount {
+				macc := s.accountKeeper.GetModuleAccount(s.ctx, "fee_collector")
+				return &types.MsgMigrateAccount{Signer: macc.GetAddress().String(), AccountType: "base", AccountInitMsg: initMsg}
+			},
+			expErrMsg: "cannot be migrated",
+		},
+		{
+			name: "error: empty init message",
+			req: func() *types.MsgMigrateAccount {
+				return &types.MsgMigrateAccount{Signer: newBaseAccount().Address, AccountType: "base"}
+			},
+			expErrMsg: "account init message cannot be empty",
+		},
+		{
+			name: "error: vesting account migrated to another account type",
+			req: func() *types.MsgMigrateAccount {
+				return &types.MsgMigrateAccount{Signer: newVestingAccount().GetAddress().String(), AccountType: "base", AccountInitMsg: initMsg}
+			},
+			expErrMsg: "vesting account can only be migrated to a continuous-locking-account account",
+		},
+		{
+			name: "error: vesting account with another init message",
+			req: func() *types.MsgMigrateAccount {
+				return &types.MsgMigrateAccount{Signer: newVestingAccount().GetAddress().String(), AccountType: "continuous-locking-account", AccountInitMsg: initMsg}
+			},
+			expErrMsg: "expected init message of type /cosmos.accounts.defaults.lockup.MsgInitLockupAccount",
+		},
+		{
+			name: "error: sequence not preserved",
+			req: func() *types.MsgMigrateAccount {
+				acc := newBaseAccount()
+				s.acctsModKeeper.EXPECT().MigrateLegacyAccount(gomock.Any(), acc.GetAddress().Bytes(), acc.AccountNumber, "base", gomock.Any()).
+					Return(&testdata.TestMsg{}, nil)
+				s.acctsModKeeper.EXPECT().Query(gomock.Any(), acc.GetAddress().Bytes(), gomock.Any()).
+					Return(&types.QueryLegacyAccountResponse{Info: &types.BaseAccount{AccountNumber: acc.AccountNumber}}, nil)
+				return &types.MsgMigrateAccount{Signer: acc.Address, AccountType: "base", AccountInitMsg: initMsg}
+			},
+			expErrMsg: "migrated account sequence must be 1, got 0",
+		},
+		{
+			name: "error: legacy account query fails",
+			req: func() *types.MsgMigrateAccount {
+				acc := newBaseAccount()
+				s.acctsModKeeper.EXPECT().MigrateLegacyAccount(gomock.Any(), acc.GetAddress().Bytes(), acc.AccountNumber, "base", gomock.Any()).
+					Return(&testdata.TestMsg{}, nil)
+				s.acctsModKeeper.EXPECT().Query(gomock.Any(), acc.GetAddress().Bytes(), gomock.Any()).
+					Return(nil, errors.New("query failed"))
+				return &types.MsgMigrateAccount{Signer: acc.Address, AccountType: "base", AccountInitMsg: initMsg}
+			},
+			expErrMsg: "query failed",
+		},
+		{
+			name: "valid migration to an account type without legacy representation",
+			req: func() *types.MsgMigrateAccount {
+				acc := newBaseAccount()
+				s.acctsModKeeper.EXPECT().MigrateLegacyAccount(gomock.Any(), acc.GetAddress().Bytes(), acc.AccountNumber, "multisig", gomock.Any()).
+					Return(&testdata.TestMsg{}, nil)
+				s.acctsModKeeper.EXPECT().Query(gomock.Any(), acc.GetAddress().Bytes(), gomock.Any()).
+					Return(nil, sdkerrors.ErrNotSupported)
+				return &types.MsgMigrateAccount{Signer: acc.Address, AccountType: "multisig", AccountInitMsg: initMsg}
+			},
+		},
+		{
+			name: "valid migration",
+			req: func() *types.MsgMigrateAccount {
+				acc := newBaseAccount()
+				s.acctsModKeeper.EXPECT().MigrateLegacyAccount(gomock.Any(), acc.GetAddress().Bytes(), acc.AccountNumber, "base", gomock.Any()).
+					Return(&testdata.TestMsg{}, nil)
+				s.acctsModKeeper.EXPECT().Query(gomock.Any(), acc.GetAddress().Bytes(), gomock.Any()).
+					Return(&types.QueryLegacyAccountResponse{Info: &types.BaseAccount{AccountNumber: acc.AccountNumber, Sequence: 1}}, nil)
+				return &types.MsgMigrateAccount{Signer: acc.Address, AccountType: "base", AccountInitMsg: initMsg}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			req := tc.req()
+			resp, err := s.msgServer.MigrateAccount(s.ctx, req)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(resp.InitResponse)
+
+			signer, err := s.accountKeeper.AddressCodec().StringToBytes(req.Signer)
+			s.Require().NoError(err)
+			s.Require().Nil(s.accountKeeper.GetAccount(s.ctx, signer))
+		})
+	}
+}
@@ -22,6 +22,7 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	registrar.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential")
 
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(registrar, &MsgMigrateAccount{}, "cosmos-sdk/x/auth/MsgMigrateAccount")
 
 	legacytx.RegisterLegacyAminoCodec(registrar)
 }
@@ -59,5 +60,6 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgNonAtomicExec{},
+		&MsgMigrateAccount{},
 	)
 }
@@ -31,4 +31,8 @@ type AccountsModKeeper interface {
 	// InitAccountNumberSeqUnsafe is use to set accounts module account number with value
 	// of auth module current account number
 	InitAccountNumberSeqUnsafe(ctx context.Context, currentAccNum uint64) error
+
+	// MigrateLegacyAccount is used to migrate a legacy x/auth account to an x/accounts
+	// account, keeping its address and account number.
+	MigrateLegacyAccount(ctx context.Context, addr []byte, accNum uint64, accType string, msg transaction.Msg) (transaction.Msg, error)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/bank/v2/types"
 )
 
@@ -13,6 +14,18 @@ func (k *Keeper) InitGenesis(ctx context.Context, state *types.GenesisState) err
 		return fmt.Errorf("failed to set params: %w", err)
 	}
 
+	for _, metadata := range state.DenomMetadata {
+		if err := k.SetDenomMetadata(ctx, metadata); err != nil {
+			return fmt.Errorf("failed to set denom metadata %s: %w", metadata.Base, err)
+		}
+	}
+
+	for _, se := range state.SendEnabled {
+		if err := k.SetSendEnabled(ctx, se.Denom, se.Enabled); err != nil {
+			return fmt.Errorf("failed to set send enabled %s: %w", se.Denom, err)
+		}
+	}
+
 	return nil
 }
 
@@ -22,5 +35,21 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, fmt.Errorf("failed to get params: %w", err)
 	}
 
-	return types.NewGenesisState(params), nil
+	var denomMetadata []banktypes.Metadata
+	if err := k.denomMetadata.Walk(ctx, nil, func(_ string, metadata banktypes.Metadata) (bool, error) {
+		denomMetadata = append(denomMetadata, metadata)
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to get denom metadata: %w", err)
+	}
+
+	var sendEnabled []banktypes.SendEnabled
+	if err := k.sendEnabled.Walk(ctx, nil, func(denom string, enabled bool) (bool, error) {
+		sendEnabled = append(sendEnabled, banktypes.SendEnabled{Denom: denom, Enabled: enabled})
+		return false, nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to get send enabled: %w", err)
+	}
+
+	return types.NewGenesisState(params, denomMetadata, sendEnabled), nil
 }
@@ -6,7 +6,13 @@ import (
 	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/bank/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 type handlers struct {
@@ -20,29 +26,124 @@ func NewHandlers(k *Keeper) handlers {
 
 // UpdateParams updates the parameters of the bank/v2 module.
 func (h handlers) MsgUpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	authorityBytes, err := h.addressCodec.StringToBytes(msg.Authority)
+	if err := h.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := h.params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// MsgSend sends coins from one account to another.
+func (h handlers) MsgSend(ctx context.Context, msg *types.MsgSend) (*types.MsgSendResponse, error) {
+	from, err := h.addressCodec.StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from address: %w", err)
+	}
+	to, err := h.addressCodec.StringToBytes(msg.ToAddress)
 	if err != nil {
+		return nil, fmt.Errorf("invalid to address: %w", err)
+	}
+
+	if !msg.Amount.IsAllPositive() {
+		return nil, fmt.Errorf("invalid amount: %s", msg.Amount)
+	}
+
+	if err := h.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
 		return nil, err
 	}
 
-	if !bytes.Equal(h.authority, authorityBytes) {
-		expectedAuthority, err := h.addressCodec.BytesToString(h.authority)
-		if err != nil {
-			return nil, err
-		}
+	if err := h.SendCoins(ctx, from, to, msg.Amount); err != nil {
+		return nil, err
+	}
 
-		return nil, fmt.Errorf("invalid authority; expected %s, got %s", expectedAuthority, msg.Authority)
+	return &types.MsgSendResponse{}, nil
+}
+
+// MsgMultiSend sends coins from one account to many.
+func (h handlers) MsgMultiSend(ctx context.Context, msg *types.MsgMultiSend) (*types.MsgMultiSendResponse, error) {
+	if len(msg.Inputs) == 0 {
+		return nil, types.ErrNoInputs
+	}
+	if len(msg.Inputs) != 1 {
+		return nil, types.ErrMultipleSenders
+	}
+	if len(msg.Outputs) == 0 {
+		return nil, types.ErrNoOutputs
 	}
 
-	if err := msg.Params.Validate(); err != nil {
+	// NOTE: totalIn == totalOut is checked by InputOutputCoins.
+	if err := h.IsSendEnabledCoins(ctx, msg.Inputs[0].Coins...); err != nil {
 		return nil, err
 	}
 
-	if err := h.params.Set(ctx, msg.Params); err != nil {
+	if err := h.InputOutputCoins(ctx, msg.Inputs[0], msg.Outputs); err != nil {
 		return nil, err
 	}
 
-	return &types.MsgUpdateParamsResponse{}, nil
+	return &types.MsgMultiSendResponse{}, nil
+}
+
+// MsgBurn burns coins of the sender.
+func (h handlers) MsgBurn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	from, err := h.addressCodec.StringToBytes(msg.FromAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from address: %w", err)
+	}
+
+	if !msg.Amount.IsAllPositive() {
+		return nil, fmt.Errorf("invalid amount: %s", msg.Amount)
+	}
+
+	if err := h.BurnCoins(ctx, from, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+// MsgSetSendEnabled sets or deletes the SendEnabled flags of denoms.
+func (h handlers) MsgSetSendEnabled(ctx context.Context, msg *types.MsgSetSendEnabled) (*types.MsgSetSendEnabledResponse, error) {
+	if err := h.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	for _, se := range msg.SendEnabled {
+		if _, alreadySeen := seen[se.Denom]; alreadySeen {
+			return nil, types.ErrDuplicateEntry.Wrapf("duplicate denom entries found for %q", se.Denom)
+		}
+		if err := sdk.ValidateDenom(se.Denom); err != nil {
+			return nil, err
+		}
+		seen[se.Denom] = true
+	}
+	for _, denom := range msg.UseDefaultFor {
+		if seen[denom] {
+			return nil, fmt.Errorf("denom %q is both set and reset to the default", denom)
+		}
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, se := range msg.SendEnabled {
+		if err := h.SetSendEnabled(ctx, se.Denom, se.Enabled); err != nil {
+			return nil, err
+		}
+	}
+	if err := h.DeleteSendEnabled(ctx, msg.UseDefaultFor...); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSendEnabledResponse{}, nil
 }
 
 // QueryParams queries the parameters of the bank/v2 module.
@@ -58,3 +159,213 @@ func (h handlers) QueryParams(ctx context.Context, req *types.QueryParamsRequest
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// QueryBalance queries the balance of a single denom of an account.
+func (h handlers) QueryBalance(ctx context.Context, req *types.QueryBalanceRequest) (*types.QueryBalanceResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	addr, err := h.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBalanceResponse{Balance: h.GetBalance(ctx, addr, req.Denom)}, nil
+}
+
+// QueryAllBalances queries the balances of all the denoms of an account.
+func (h handlers) QueryAllBalances(ctx context.Context, req *types.QueryAllBalancesRequest) (*types.QueryAllBalancesResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	addr, err := h.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+
+	balances, pageRes, err := query.CollectionPaginate(
+		ctx,
+		h.balances,
+		req.Pagination,
+		func(key collections.Pair[[]byte, string], value math.Int) (sdk.Coin, error) {
+			return sdk.NewCoin(key.K2(), value), nil
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, string](addr),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("paginate: %w", err)
+	}
+
+	return &types.QueryAllBalancesResponse{Balances: balances, Pagination: pageRes}, nil
+}
+
+// QueryTotalSupply queries the supply of all the denoms.
+func (h handlers) QueryTotalSupply(ctx context.Context, req *types.QueryTotalSupplyRequest) (*types.QueryTotalSupplyResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	supply, pageRes, err := query.CollectionPaginate(
+		ctx,
+		h.supply,
+		req.Pagination,
+		func(denom string, value math.Int) (sdk.Coin, error) {
+			return sdk.NewCoin(denom, value), nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("paginate: %w", err)
+	}
+
+	return &types.QueryTotalSupplyResponse{Supply: supply, Pagination: pageRes}, nil
+}
+
+// QuerySupplyOf queries the supply of a single denom.
+func (h handlers) QuerySupplyOf(ctx context.Context, req *types.QuerySupplyOfRequest) (*types.QuerySupplyOfResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySupplyOfResponse{Amount: h.GetSupply(ctx, req.Denom)}, nil
+}
+
+// QueryDenomOwners queries the accounts holding a denom, using the denom index of
+// the balances.
+func (h handlers) QueryDenomOwners(ctx context.Context, req *types.QueryDenomOwnersRequest) (*types.QueryDenomOwnersResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, err
+	}
+
+	denomOwners, pageRes, err := query.CollectionPaginate(
+		ctx,
+		h.balances.Indexes.Denom,
+		req.Pagination,
+		func(key collections.Pair[string, []byte], _ collections.NoValue) (*types.DenomOwner, error) {
+			amt, err := h.balances.Get(ctx, collections.Join(key.K2(), req.Denom))
+			if err != nil {
+				return nil, err
+			}
+			addr, err := h.addressCodec.BytesToString(key.K2())
+			if err != nil {
+				return nil, err
+			}
+			return &types.DenomOwner{Address: addr, Balance: sdk.NewCoin(req.Denom, amt)}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, []byte](req.Denom),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("paginate: %w", err)
+	}
+
+	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
+}
+
+// QueryDenomMetadata queries the metadata of a denom.
+func (h handlers) QueryDenomMetadata(ctx context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, err
+	}
+
+	metadata, found := h.GetDenomMetadata(ctx, req.Denom)
+	if !found {
+		return nil, types.ErrDenomMetadataNotFound.Wrapf("client metadata for denom %s", req.Denom)
+	}
+
+	return &types.QueryDenomMetadataResponse{Metadata: metadata}, nil
+}
+
+// QueryDenomsMetadata queries the metadata of all the denoms.
+func (h handlers) QueryDenomsMetadata(ctx context.Context, req *types.QueryDenomsMetadataRequest) (*types.QueryDenomsMetadataResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	metadatas, pageRes, err := query.CollectionPaginate(
+		ctx,
+		h.denomMetadata,
+		req.Pagination,
+		func(_ string, metadata banktypes.Metadata) (banktypes.Metadata, error) {
+			return metadata, nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("paginate: %w", err)
+	}
+
+	return &types.QueryDenomsMetadataResponse{Metadatas: metadatas, Pagination: pageRes}, nil
+}
+
+// QuerySendEnabled queries the SendEnabled entries of the given denoms, or of all
+// the denoms if none is given.
+func (h handlers) QuerySendEnabled(ctx context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty request")
+	}
+
+	resp := &types.QuerySendEnabledResponse{}
+	if len(req.Denoms) > 0 {
+		for _, denom := range req.Denoms {
+			enabled, err := h.sendEnabled.Get(ctx, denom)
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			resp.SendEnabled = append(resp.SendEnabled, banktypes.SendEnabled{Denom: denom, Enabled: enabled})
+		}
+
+		return resp, nil
+	}
+
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		h.sendEnabled,
+		req.Pagination,
+		func(denom string, enabled bool) (banktypes.SendEnabled, error) {
+			return banktypes.SendEnabled{Denom: denom, Enabled: enabled}, nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("paginate: %w", err)
+	}
+	resp.SendEnabled = results
+	resp.Pagination = pageRes
+
+	return resp, nil
+}
+
+// checkAuthority returns an error if the given address is not the module authority.
+func (h handlers) checkAuthority(authority string) error {
+	authorityBytes, err := h.addressCodec.StringToBytes(authority)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(h.authority, authorityBytes) {
+		expectedAuthority, err := h.addressCodec.BytesToString(h.authority)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("invalid authority; expected %s, got %s", expectedAuthority, authority)
+	}
+
+	return nil
+}
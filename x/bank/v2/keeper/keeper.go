@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/collections/indexes"
@@ -10,6 +11,7 @@ import (
 	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/bank/v2/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -22,12 +24,15 @@ import (
 type Keeper struct {
 	appmodulev2.Environment
 
-	authority    []byte
-	addressCodec address.Codec
-	schema       collections.Schema
-	params       collections.Item[types.Params]
-	balances     *collections.IndexedMap[collections.Pair[[]byte, string], math.Int, BalancesIndexes]
-	supply       collections.Map[string, math.Int]
+	authority       []byte
+	addressCodec    address.Codec
+	schema          collections.Schema
+	params          collections.Item[types.Params]
+	balances        *collections.IndexedMap[collections.Pair[[]byte, string], math.Int, BalancesIndexes]
+	supply          collections.Map[string, math.Int]
+	denomMetadata   collections.Map[string, banktypes.Metadata]
+	sendEnabled     collections.Map[string, bool]
+	sendRestriction *sendRestriction
 }
 
 func NewKeeper(authority []byte, addressCodec address.Codec, env appmodulev2.Environment, cdc codec.BinaryCodec) *Keeper {
@@ -40,6 +45,11 @@ func NewKeeper(authority []byte, addressCodec address.Codec, env appmodulev2.Env
 		params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		balances:     collections.NewIndexedMap(sb, types.BalancesPrefix, "balances", collections.PairKeyCodec(collections.BytesKey, collections.StringKey), sdk.IntValue, newBalancesIndexes(sb)),
 		supply:       collections.NewMap(sb, types.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
+		denomMetadata: collections.NewMap(
+			sb, types.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[banktypes.Metadata](cdc),
+		),
+		sendEnabled:     collections.NewMap(sb, types.SendEnabledPrefix, "send_enabled", collections.StringKey, collections.BoolValue),
+		sendRestriction: newSendRestriction(),
 	}
 
 	schema, err := sb.Build()
@@ -68,7 +78,9 @@ func (k Keeper) MintCoins(ctx context.Context, addr []byte, amounts sdk.Coins) e
 	for _, amount := range amounts {
 		supply := k.GetSupply(ctx, amount.GetDenom())
 		supply = supply.Add(amount)
-		k.setSupply(ctx, supply)
+		if err := k.setSupply(ctx, supply); err != nil {
+			return err
+		}
 	}
 
 	addrStr, err := k.addressCodec.BytesToString(addr)
@@ -84,6 +96,54 @@ func (k Keeper) MintCoins(ctx context.Context, addr []byte, amounts sdk.Coins) e
 	)
 }
 
+// BurnCoins burns coins from the given account, deleting them from the supply.
+// An error is returned if the account does not hold enough coins.
+func (k Keeper) BurnCoins(ctx context.Context, addr []byte, amounts sdk.Coins) error {
+	if !amounts.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amounts.String())
+	}
+
+	err := k.subUnlockedCoins(ctx, addr, amounts)
+	if err != nil {
+		return err
+	}
+
+	for _, amount := range amounts {
+		supply := k.GetSupply(ctx, amount.GetDenom())
+		supply = supply.Sub(amount)
+		if err := k.setSupply(ctx, supply); err != nil {
+			return err
+		}
+	}
+
+	addrStr, err := k.addressCodec.BytesToString(addr)
+	if err != nil {
+		return err
+	}
+
+	// emit burn event
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCoinBurn,
+		event.NewAttribute(types.AttributeKeyBurner, addrStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, amounts.String()),
+	)
+}
+
+// AppendSendRestriction adds the provided SendRestrictionFn to run after previously provided restrictions.
+func (k Keeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.append(restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before previously provided restrictions.
+func (k Keeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.prepend(restriction)
+}
+
+// ClearSendRestriction removes the send restriction (if there is one).
+func (k Keeper) ClearSendRestriction() {
+	k.sendRestriction.clear()
+}
+
 // SendCoins transfers amt coins from a sending account to a receiving account.
 // Function take sender & receipient as []byte.
 // They can be sdk address or module name.
@@ -94,7 +154,10 @@ func (k Keeper) SendCoins(ctx context.Context, from, to []byte, amt sdk.Coins) e
 	}
 
 	var err error
-	// TODO: Send restriction
+	to, err = k.sendRestriction.apply(ctx, from, to, amt)
+	if err != nil {
+		return err
+	}
 
 	err = k.subUnlockedCoins(ctx, from, amt)
 	if err != nil {
@@ -123,6 +186,69 @@ func (k Keeper) SendCoins(ctx context.Context, from, to []byte, amt sdk.Coins) e
 	)
 }
 
+// InputOutputCoins performs multi-send functionality. It accepts an input that
+// corresponds to a series of outputs. It returns an error if the input and outputs
+// don't line up or if any single transfer of tokens fails.
+func (k Keeper) InputOutputCoins(ctx context.Context, input banktypes.Input, outputs []banktypes.Output) error {
+	inAddress, err := k.addressCodec.StringToBytes(input.Address)
+	if err != nil {
+		return err
+	}
+	if !input.Coins.IsValid() || !input.Coins.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, input.Coins.String())
+	}
+
+	outAddresses := make([][]byte, len(outputs))
+	var totalOut sdk.Coins
+	for i, out := range outputs {
+		outAddresses[i], err = k.addressCodec.StringToBytes(out.Address)
+		if err != nil {
+			return err
+		}
+		if !out.Coins.IsValid() || !out.Coins.IsAllPositive() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, out.Coins.String())
+		}
+		totalOut = totalOut.Add(out.Coins...)
+	}
+
+	// make sure inputs and outputs match
+	if !input.Coins.Equal(totalOut) {
+		return types.ErrInputOutputMismatch
+	}
+
+	err = k.subUnlockedCoins(ctx, inAddress, input.Coins)
+	if err != nil {
+		return err
+	}
+
+	for i, out := range outputs {
+		outAddress, err := k.sendRestriction.apply(ctx, inAddress, outAddresses[i], out.Coins)
+		if err != nil {
+			return err
+		}
+
+		if err := k.addCoins(ctx, outAddress, out.Coins); err != nil {
+			return err
+		}
+
+		outAddrStr, err := k.addressCodec.BytesToString(outAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeTransfer,
+			event.NewAttribute(types.AttributeKeyRecipient, outAddrStr),
+			event.NewAttribute(types.AttributeKeySender, input.Address),
+			event.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // GetSupply retrieves the Supply from store
 func (k Keeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
 	amt, err := k.supply.Get(ctx, denom)
@@ -142,6 +268,73 @@ func (k Keeper) GetBalance(ctx context.Context, addr []byte, denom string) sdk.C
 	return sdk.NewCoin(denom, amt)
 }
 
+// GetDenomMetadata retrieves the metadata of the given denom, returning false if
+// it does not exist.
+func (k Keeper) GetDenomMetadata(ctx context.Context, denom string) (banktypes.Metadata, bool) {
+	m, err := k.denomMetadata.Get(ctx, denom)
+	return m, err == nil
+}
+
+// SetDenomMetadata sets the metadata of its base denom.
+func (k Keeper) SetDenomMetadata(ctx context.Context, metadata banktypes.Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+
+	return k.denomMetadata.Set(ctx, metadata.Base, metadata)
+}
+
+// IsSendEnabledDenom returns the current SendEnabled status of the provided denom.
+func (k Keeper) IsSendEnabledDenom(ctx context.Context, denom string) (bool, error) {
+	enabled, err := k.sendEnabled.Get(ctx, denom)
+	if err == nil {
+		return enabled, nil
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return false, err
+	}
+
+	params, err := k.params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	return params.DefaultSendEnabled, nil
+}
+
+// IsSendEnabledCoins checks the coins provided and returns an ErrSendDisabled
+// if any of the coins are not configured for sending.
+func (k Keeper) IsSendEnabledCoins(ctx context.Context, coins ...sdk.Coin) error {
+	for _, coin := range coins {
+		enabled, err := k.IsSendEnabledDenom(ctx, coin.Denom)
+		if err != nil {
+			return err
+		}
+		if !enabled {
+			return types.ErrSendDisabled.Wrapf("%s transfers are currently disabled", coin.Denom)
+		}
+	}
+
+	return nil
+}
+
+// SetSendEnabled sets the SendEnabled flag for a denom to the provided value.
+func (k Keeper) SetSendEnabled(ctx context.Context, denom string, value bool) error {
+	return k.sendEnabled.Set(ctx, denom, value)
+}
+
+// DeleteSendEnabled deletes the SendEnabled flags for one or more denoms, which
+// then use the default value of the params.
+// If a denom is provided that doesn't have a SendEnabled entry, it is ignored.
+func (k Keeper) DeleteSendEnabled(ctx context.Context, denoms ...string) error {
+	for _, denom := range denoms {
+		if err := k.sendEnabled.Remove(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // subUnlockedCoins removes the unlocked amt coins of the given account.
 // An error is returned if the resulting balance is negative.
 //
@@ -213,13 +406,12 @@ func (k Keeper) addCoins(ctx context.Context, addr []byte, amt sdk.Coins) error
 }
 
 // setSupply sets the supply for the given coin
-func (k Keeper) setSupply(ctx context.Context, coin sdk.Coin) {
+func (k Keeper) setSupply(ctx context.Context, coin sdk.Coin) error {
 	// Bank invariants and IBC requires to remove zero coins.
 	if coin.IsZero() {
-		_ = k.supply.Remove(ctx, coin.Denom)
-	} else {
-		_ = k.supply.Set(ctx, coin.Denom, coin.Amount)
+		return k.supply.Remove(ctx, coin.Denom)
 	}
+	return k.supply.Set(ctx, coin.Denom, coin.Amount)
 }
 
 // setBalance sets the coin balance for an account by address.
@@ -239,6 +431,44 @@ func (k Keeper) setBalance(ctx context.Context, addr []byte, balance sdk.Coin) e
 	return k.balances.Set(ctx, collections.Join(addr, balance.Denom), balance.Amount)
 }
 
+// sendRestriction is a struct that houses a SendRestrictionFn.
+// It exists so that the SendRestrictionFn can be updated in the Keeper without needing to have a pointer receiver.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
+// newSendRestriction creates a new sendRestriction with nil send restriction.
+func newSendRestriction() *sendRestriction {
+	return &sendRestriction{
+		fn: nil,
+	}
+}
+
+// append adds the provided restriction to this, to be run after the existing function.
+func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
+	r.fn = r.fn.Then(restriction)
+}
+
+// prepend adds the provided restriction to this, to be run before the existing function.
+func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
+	r.fn = restriction.Then(r.fn)
+}
+
+// clear removes the send restriction (sets it to nil).
+func (r *sendRestriction) clear() {
+	r.fn = nil
+}
+
+var _ types.SendRestrictionFn = (*sendRestriction)(nil).apply
+
+// apply applies the send restriction if there is one. If not, it's a no-op.
+func (r *sendRestriction) apply(ctx context.Context, from, to []byte, amt sdk.Coins) ([]byte, error) {
+	if r == nil || r.fn == nil {
+		return to, nil
+	}
+	return r.fn(ctx, from, to, amt)
+}
+
 func newBalancesIndexes(sb *collections.SchemaBuilder) BalancesIndexes {
 	return BalancesIndexes{
 		Denom: indexes.NewReversePair[math.Int](
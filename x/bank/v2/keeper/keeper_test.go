@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -12,15 +14,17 @@ import (
 	coretesting "cosmossdk.io/core/testing"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/bank/v2/keeper"
 	banktestutil "cosmossdk.io/x/bank/v2/testutil"
-	banktypes "cosmossdk.io/x/bank/v2/types"
+	bankv2types "cosmossdk.io/x/bank/v2/types"
 
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -31,7 +35,7 @@ const (
 
 var (
 	burnerAcc = authtypes.NewEmptyModuleAccount(authtypes.Burner, authtypes.Burner, authtypes.Staking)
-	mintAcc   = authtypes.NewEmptyModuleAccount(banktypes.MintModuleName, authtypes.Minter)
+	mintAcc   = authtypes.NewEmptyModuleAccount(bankv2types.MintModuleName, authtypes.Minter)
 
 	accAddrs = []sdk.AccAddress{
 		sdk.AccAddress([]byte("addr1_______________")),
@@ -63,7 +67,7 @@ func TestKeeperTestSuite(t *testing.T) {
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
-	key := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	key := storetypes.NewKVStoreKey(bankv2types.StoreKey)
 	testCtx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test"))
 	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: time.Now()})
 	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
@@ -184,3 +188,219 @@ func (suite *KeeperTestSuite) TestSendCoins_Module_To_Module() {
 	mintBarBalance := suite.bankKeeper.GetBalance(ctx, mintAcc.GetAddress(), barDenom)
 	require.Equal(mintBarBalance.Amount, math.NewInt(0))
 }
+
+func (suite *KeeperTestSuite) TestBurnCoins() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	require.NoError(suite.bankKeeper.MintCoins(ctx, accAddrs[0], balances))
+
+	// Try burn more than the balance
+	require.Error(suite.bankKeeper.BurnCoins(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(101))))
+
+	require.NoError(suite.bankKeeper.BurnCoins(ctx, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(10))))
+
+	// Check balances and supply
+	require.True(suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom).IsZero())
+	require.Equal(math.NewInt(40), suite.bankKeeper.GetBalance(ctx, accAddrs[0], barDenom).Amount)
+	require.True(suite.bankKeeper.GetSupply(ctx, fooDenom).IsZero())
+	require.Equal(math.NewInt(40), suite.bankKeeper.GetSupply(ctx, barDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestSendRestriction() {
+	ctx := suite.ctx
+	require := suite.Require()
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], balances))
+
+	// redirect foo coins to acc2, and deny sends to acc3
+	suite.bankKeeper.AppendSendRestriction(func(_ context.Context, _, to []byte, amount sdk.Coins) ([]byte, error) {
+		if amount.AmountOf(fooDenom).IsPositive() {
+			return accAddrs[2], nil
+		}
+		return to, nil
+	})
+	suite.bankKeeper.PrependSendRestriction(func(_ context.Context, _, to []byte, _ sdk.Coins) ([]byte, error) {
+		if bytes.Equal(to, accAddrs[3]) {
+			return nil, errors.New("denied")
+		}
+		return to, nil
+	})
+	defer suite.bankKeeper.ClearSendRestriction()
+
+	require.ErrorContains(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[3], sdk.NewCoins(newBarCoin(10))), "denied")
+
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newFooCoin(10))))
+	require.NoError(suite.bankKeeper.SendCoins(ctx, accAddrs[0], accAddrs[1], sdk.NewCoins(newBarCoin(10))))
+	require.True(suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom).IsZero())
+	require.Equal(math.NewInt(10), suite.bankKeeper.GetBalance(ctx, accAddrs[2], fooDenom).Amount)
+	require.Equal(math.NewInt(10), suite.bankKeeper.GetBalance(ctx, accAddrs[1], barDenom).Amount)
+
+	// multi-send outputs are restricted as well
+	input := banktypes.NewInput(suite.addrString(accAddrs[0]), sdk.NewCoins(newFooCoin(5), newBarCoin(5)))
+	outputs := []banktypes.Output{
+		banktypes.NewOutput(suite.addrString(accAddrs[1]), sdk.NewCoins(newFooCoin(5))),
+		banktypes.NewOutput(suite.addrString(accAddrs[4]), sdk.NewCoins(newBarCoin(5))),
+	}
+	require.NoError(suite.bankKeeper.InputOutputCoins(ctx, input, outputs))
+	require.Equal(math.NewInt(15), suite.bankKeeper.GetBalance(ctx, accAddrs[2], fooDenom).Amount)
+	require.Equal(math.NewInt(5), suite.bankKeeper.GetBalance(ctx, accAddrs[4], barDenom).Amount)
+}
+
+func (suite *KeeperTestSuite) TestMsgSendAndMultiSend() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+	require.NoError(suite.bankKeeper.InitGenesis(ctx, bankv2types.DefaultGenesisState()))
+	require.NoError(banktestutil.FundAccount(ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+
+	_, err := handlers.MsgSend(ctx, &bankv2types.MsgSend{
+		FromAddress: suite.addrString(accAddrs[0]),
+		ToAddress:   suite.addrString(accAddrs[1]),
+		Amount:      sdk.NewCoins(newFooCoin(10)),
+	})
+	require.NoError(err)
+	require.Equal(math.NewInt(10), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom).Amount)
+
+	// disable foo sends
+	authority := suite.addrString(authtypes.NewModuleAddress("gov"))
+	_, err = handlers.MsgSetSendEnabled(ctx, &bankv2types.MsgSetSendEnabled{
+		Authority:   authority,
+		SendEnabled: []banktypes.SendEnabled{{Denom: fooDenom, Enabled: false}},
+	})
+	require.NoError(err)
+
+	_, err = handlers.MsgSend(ctx, &bankv2types.MsgSend{
+		FromAddress: suite.addrString(accAddrs[0]),
+		ToAddress:   suite.addrString(accAddrs[1]),
+		Amount:      sdk.NewCoins(newFooCoin(10)),
+	})
+	require.ErrorIs(err, bankv2types.ErrSendDisabled)
+
+	multiSend := &bankv2types.MsgMultiSend{
+		Inputs: []banktypes.Input{banktypes.NewInput(suite.addrString(accAddrs[0]), sdk.NewCoins(newFooCoin(10), newBarCoin(10)))},
+		Outputs: []banktypes.Output{
+			banktypes.NewOutput(suite.addrString(accAddrs[1]), sdk.NewCoins(newFooCoin(10))),
+			banktypes.NewOutput(suite.addrString(accAddrs[2]), sdk.NewCoins(newBarCoin(10))),
+		},
+	}
+	_, err = handlers.MsgMultiSend(ctx, multiSend)
+	require.ErrorIs(err, bankv2types.ErrSendDisabled)
+
+	// reset foo to the default
+	_, err = handlers.MsgSetSendEnabled(ctx, &bankv2types.MsgSetSendEnabled{
+		Authority:     authority,
+		UseDefaultFor: []string{fooDenom},
+	})
+	require.NoError(err)
+
+	_, err = handlers.MsgMultiSend(ctx, multiSend)
+	require.NoError(err)
+	require.Equal(math.NewInt(20), suite.bankKeeper.GetBalance(ctx, accAddrs[1], fooDenom).Amount)
+	require.Equal(math.NewInt(10), suite.bankKeeper.GetBalance(ctx, accAddrs[2], barDenom).Amount)
+	require.Equal(math.NewInt(80), suite.bankKeeper.GetBalance(ctx, accAddrs[0], fooDenom).Amount)
+
+	// inputs and outputs must match
+	multiSend.Outputs = multiSend.Outputs[:1]
+	_, err = handlers.MsgMultiSend(ctx, multiSend)
+	require.ErrorIs(err, bankv2types.ErrInputOutputMismatch)
+
+	// only the authority can set send enabled
+	_, err = handlers.MsgSetSendEnabled(ctx, &bankv2types.MsgSetSendEnabled{
+		Authority:   suite.addrString(accAddrs[0]),
+		SendEnabled: []banktypes.SendEnabled{{Denom: fooDenom, Enabled: false}},
+	})
+	require.Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueries() {
+	ctx := suite.ctx
+	require := suite.Require()
+	handlers := keeper.NewHandlers(&suite.bankKeeper)
+
+	metadata := banktypes.Metadata{
+		Description: "The native token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: fooDenom, Exponent: 0},
+			{Denom: "kfoo", Exponent: 3},
+		},
+		Base:    fooDenom,
+		Display: "kfoo",
+		Name:    "Foo",
+		Symbol:  "FOO",
+	}
+	gs := bankv2types.NewGenesisState(bankv2types.DefaultParams(), []banktypes.Metadata{metadata}, []banktypes.SendEnabled{{Denom: barDenom}})
+	require.NoError(gs.Validate())
+	require.NoError(suite.bankKeeper.InitGenesis(ctx, gs))
+
+	exported, err := suite.bankKeeper.ExportGenesis(ctx)
+	require.NoError(err)
+	require.Equal(gs, exported)
+
+	for _, addr := range accAddrs[:3] {
+		require.NoError(suite.bankKeeper.MintCoins(ctx, addr, sdk.NewCoins(newFooCoin(100), newBarCoin(50))))
+	}
+
+	balance, err := handlers.QueryBalance(ctx, &bankv2types.QueryBalanceRequest{Address: suite.addrString(accAddrs[0]), Denom: fooDenom})
+	require.NoError(err)
+	require.Equal(newFooCoin(100), balance.Balance)
+
+	allBalances, err := handlers.QueryAllBalances(ctx, &bankv2types.QueryAllBalancesRequest{
+		Address:    suite.addrString(accAddrs[0]),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newBarCoin(50)), allBalances.Balances)
+	require.Equal(uint64(2), allBalances.Pagination.Total)
+
+	allBalances, err = handlers.QueryAllBalances(ctx, &bankv2types.QueryAllBalancesRequest{
+		Address:    suite.addrString(accAddrs[0]),
+		Pagination: &query.PageRequest{Key: allBalances.Pagination.NextKey},
+	})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(100)), allBalances.Balances)
+
+	supply, err := handlers.QueryTotalSupply(ctx, &bankv2types.QueryTotalSupplyRequest{})
+	require.NoError(err)
+	require.Equal(sdk.NewCoins(newFooCoin(300), newBarCoin(150)), supply.Supply)
+
+	supplyOf, err := handlers.QuerySupplyOf(ctx, &bankv2types.QuerySupplyOfRequest{Denom: barDenom})
+	require.NoError(err)
+	require.Equal(newBarCoin(150), supplyOf.Amount)
+
+	owners, err := handlers.QueryDenomOwners(ctx, &bankv2types.QueryDenomOwnersRequest{
+		Denom:      fooDenom,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(err)
+	require.Len(owners.DenomOwners, 2)
+	require.NotNil(owners.Pagination.NextKey)
+	for _, owner := range owners.DenomOwners {
+		require.Equal(newFooCoin(100), owner.Balance)
+	}
+
+	md, err := handlers.QueryDenomMetadata(ctx, &bankv2types.QueryDenomMetadataRequest{Denom: fooDenom})
+	require.NoError(err)
+	require.Equal(metadata, md.Metadata)
+	_, err = handlers.QueryDenomMetadata(ctx, &bankv2types.QueryDenomMetadataRequest{Denom: barDenom})
+	require.ErrorIs(err, bankv2types.ErrDenomMetadataNotFound)
+
+	mds, err := handlers.QueryDenomsMetadata(ctx, &bankv2types.QueryDenomsMetadataRequest{})
+	require.NoError(err)
+	require.Equal([]banktypes.Metadata{metadata}, mds.Metadatas)
+
+	sendEnabled, err := handlers.QuerySendEnabled(ctx, &bankv2types.QuerySendEnabledRequest{Denoms: []string{fooDenom, barDenom}})
+	require.NoError(err)
+	require.Equal([]banktypes.SendEnabled{{Denom: barDenom}}, sendEnabled.SendEnabled)
+
+	sendEnabled, err = handlers.QuerySendEnabled(ctx, &bankv2types.QuerySendEnabledRequest{})
+	require.NoError(err)
+	require.Equal([]banktypes.SendEnabled{{Denom: barDenom}}, sendEnabled.SendEnabled)
+}
+
+func (suite *KeeperTestSuite) addrString(addr []byte) string {
+	s, err := suite.addressCodec.BytesToString(addr)
+	suite.Require().NoError(err)
+	return s
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/bank/v2/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// v1State is the x/bank v1 state layout, as defined in x/bank/keeper.
+type v1State struct {
+	params        collections.Item[banktypes.Params]
+	balances      collections.Map[collections.Pair[sdk.AccAddress, string], math.Int]
+	supply        collections.Map[string, math.Int]
+	denomMetadata collections.Map[string, banktypes.Metadata]
+	sendEnabled   collections.Map[string, bool]
+}
+
+func newV1State(storeService corestore.KVStoreService, cdc codec.BinaryCodec) (v1State, error) {
+	sb := collections.NewSchemaBuilder(storeService)
+	s := v1State{
+		params:        collections.NewItem(sb, banktypes.ParamsKey, "params", codec.CollValue[banktypes.Params](cdc)),
+		balances:      collections.NewMap(sb, banktypes.BalancesPrefix, "balances", collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey), banktypes.BalanceValueCodec),
+		supply:        collections.NewMap(sb, banktypes.SupplyKey, "supply", collections.StringKey, sdk.IntValue),
+		denomMetadata: collections.NewMap(sb, banktypes.DenomMetadataPrefix, "denom_metadata", collections.StringKey, codec.CollValue[banktypes.Metadata](cdc)),
+		sendEnabled:   collections.NewMap(sb, banktypes.SendEnabledPrefix, "send_enabled", collections.StringKey, codec.BoolValue),
+	}
+	_, err := sb.Build()
+	return s, err
+}
+
+// MigrateFromV1 migrates the x/bank v1 state, read from the given store service,
+// to the bank/v2 state. It is meant to be called from an x/upgrade handler.
+// The bank/v2 state must not hold any balance or supply, so that the v1 state is
+// migrated only once, while the denom metadata, send enabled entries and default
+// send enabled param overwrite the ones of bank/v2.
+// Once migrated, the total supply invariant is verified.
+// The v1 state is left untouched, it should be deleted with the v1 store.
+func (k Keeper) MigrateFromV1(ctx context.Context, v1StoreService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	v1, err := newV1State(v1StoreService, cdc)
+	if err != nil {
+		return err
+	}
+
+	empty, err := k.isBalancesAndSupplyEmpty(ctx)
+	if err != nil {
+		return err
+	}
+	if !empty {
+		return errors.New("bank/v2 already holds balances or supply, the v1 state can only be migrated once")
+	}
+
+	if err := v1.balances.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, string], amount math.Int) (bool, error) {
+		return false, k.setBalance(ctx, key.K1(), sdk.NewCoin(key.K2(), amount))
+	}); err != nil {
+		return fmt.Errorf("failed to migrate balances: %w", err)
+	}
+
+	if err := v1.supply.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		return false, k.setSupply(ctx, sdk.NewCoin(denom, amount))
+	}); err != nil {
+		return fmt.Errorf("failed to migrate supply: %w", err)
+	}
+
+	if err := v1.denomMetadata.Walk(ctx, nil, func(_ string, metadata banktypes.Metadata) (bool, error) {
+		return false, k.SetDenomMetadata(ctx, metadata)
+	}); err != nil {
+		return fmt.Errorf("failed to migrate denom metadata: %w", err)
+	}
+
+	if err := v1.sendEnabled.Walk(ctx, nil, func(denom string, enabled bool) (bool, error) {
+		return false, k.SetSendEnabled(ctx, denom, enabled)
+	}); err != nil {
+		return fmt.Errorf("failed to migrate send enabled entries: %w", err)
+	}
+
+	v1Params, err := v1.params.Get(ctx)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+	case err != nil:
+		return fmt.Errorf("failed to get v1 params: %w", err)
+	default:
+		if err := k.params.Set(ctx, types.NewParams(v1Params.DefaultSendEnabled)); err != nil {
+			return fmt.Errorf("failed to migrate params: %w", err)
+		}
+	}
+
+	return k.verifyTotalSupply(ctx)
+}
+
+// isBalancesAndSupplyEmpty returns true if bank/v2 holds no balance and no supply.
+func (k Keeper) isBalancesAndSupplyEmpty(ctx context.Context) (bool, error) {
+	empty := true
+	if err := k.balances.Walk(ctx, nil, func(collections.Pair[[]byte, string], math.Int) (bool, error) {
+		empty = false
+		return true, nil
+	}); err != nil {
+		return false, fmt.Errorf("failed to get balances: %w", err)
+	}
+	if !empty {
+		return false, nil
+	}
+
+	if err := k.supply.Walk(ctx, nil, func(string, math.Int) (bool, error) {
+		empty = false
+		return true, nil
+	}); err != nil {
+		return false, fmt.Errorf("failed to get supply: %w", err)
+	}
+	return empty, nil
+}
+
+// verifyTotalSupply verifies that the supply of every denom equals the sum of
+// its balances.
+func (k Keeper) verifyTotalSupply(ctx context.Context) error {
+	expected := sdk.NewMapCoins(sdk.Coins{})
+	if err := k.balances.Walk(ctx, nil, func(key collections.Pair[[]byte, string], amount math.Int) (bool, error) {
+		expected.Add(sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	}); err != nil {
+		return fmt.Errorf("failed to get balances: %w", err)
+	}
+
+	supply := sdk.NewMapCoins(sdk.Coins{})
+	if err := k.supply.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		supply.Add(sdk.NewCoin(denom, amount))
+		return false, nil
+	}); err != nil {
+		return fmt.Errorf("failed to get supply: %w", err)
+	}
+
+	if expectedCoins, supplyCoins := expected.ToCoins(), supply.ToCoins(); !expectedCoins.Equal(supplyCoins) {
+		return fmt.Errorf("total supply invariant broken: sum of balances is %s, supply is %s", expectedCoins, supplyCoins)
+	}
+	return nil
+}
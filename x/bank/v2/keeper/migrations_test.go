@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	coretesting "cosmossdk.io/core/testing"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	bankkeeper "cosmossdk.io/x/bank/keeper"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/bank/v2/keeper"
+	banktestutil "cosmossdk.io/x/bank/v2/testutil"
+	bankv2types "cosmossdk.io/x/bank/v2/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestMigrateFromV1(t *testing.T) {
+	// the second bank/v2 store is migrated from a v1 state breaking the total supply invariant
+	const brokenV2StoreKey = "bankv2_broken"
+	keys := map[string]*storetypes.KVStoreKey{
+		banktypes.StoreKey:   storetypes.NewKVStoreKey(banktypes.StoreKey),
+		bankv2types.StoreKey: storetypes.NewKVStoreKey(bankv2types.StoreKey),
+		brokenV2StoreKey:     storetypes.NewKVStoreKey(brokenV2StoreKey),
+	}
+	ctx := testutil.DefaultContextWithKeys(keys, map[string]*storetypes.TransientStoreKey{}, nil)
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
+	ac := codectestutil.CodecOptions{}.GetAddressCodec()
+	authority := authtypes.NewModuleAddress("gov")
+	authorityStr, err := ac.BytesToString(authority)
+	require.NoError(t, err)
+
+	ak := banktestutil.NewMockAccountKeeper(gomock.NewController(t))
+	ak.EXPECT().AddressCodec().Return(ac).AnyTimes()
+
+	v1StoreService := runtime.NewKVStoreService(keys[banktypes.StoreKey])
+	v1Keeper := bankkeeper.NewBaseKeeper(
+		runtime.NewEnvironment(v1StoreService, coretesting.NewNopLogger()),
+		encCfg.Codec,
+		ak,
+		map[string]bool{},
+		authorityStr,
+	)
+	newV2Keeper := func(storeKey string) *keeper.Keeper {
+		return keeper.NewKeeper(
+			authority,
+			ac,
+			runtime.NewEnvironment(runtime.NewKVStoreService(keys[storeKey]), coretesting.NewNopLogger()),
+			encCfg.Codec,
+		)
+	}
+
+	// load the x/bank v1 state of a simapp genesis export
+	bz, err := os.ReadFile("testdata/simapp_export.json")
+	require.NoError(t, err)
+	var export struct {
+		AppState map[string]json.RawMessage `json:"app_state"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &export))
+	var genState banktypes.GenesisState
+	encCfg.Codec.MustUnmarshalJSON(export.AppState[banktypes.ModuleName], &genState)
+	require.NoError(t, v1Keeper.InitGenesis(ctx, &genState))
+
+	k := newV2Keeper(bankv2types.StoreKey)
+	require.NoError(t, k.MigrateFromV1(ctx, v1StoreService, encCfg.Codec))
+
+	for _, balance := range genState.Balances {
+		addr, err := ac.StringToBytes(balance.Address)
+		require.NoError(t, err)
+		for _, coin := range balance.Coins {
+			require.Equal(t, coin, k.GetBalance(ctx, addr, coin.Denom), balance.Address)
+		}
+	}
+	for _, supply := range genState.Supply {
+		require.Equal(t, supply, k.GetSupply(ctx, supply.Denom))
+	}
+	for _, metadata := range genState.DenomMetadata {
+		expected, found := v1Keeper.GetDenomMetaData(ctx, metadata.Base)
+		require.True(t, found)
+		got, found := k.GetDenomMetadata(ctx, metadata.Base)
+		require.True(t, found)
+		require.Equal(t, expected, got)
+	}
+	for _, se := range genState.SendEnabled {
+		enabled, err := k.IsSendEnabledDenom(ctx, se.Denom)
+		require.NoError(t, err)
+		require.Equal(t, se.Enabled, enabled)
+	}
+	v2GenState, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genState.Params.DefaultSendEnabled, v2GenState.Params.DefaultSendEnabled)
+	require.Len(t, v2GenState.SendEnabled, len(genState.SendEnabled))
+
+	// the v1 state can only be migrated once
+	require.ErrorContains(t, k.MigrateFromV1(ctx, v1StoreService, encCfg.Codec), "can only be migrated once")
+	for _, supply := range genState.Supply {
+		require.Equal(t, supply, k.GetSupply(ctx, supply.Denom))
+	}
+
+	// the v1 state is left untouched
+	v1GenState, err := v1Keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genState.Supply, v1GenState.Supply)
+
+	// a v1 state breaking the total supply invariant fails the migration
+	require.NoError(t, v1Keeper.Supply.Set(ctx, "stake", math.NewInt(1)))
+	k = newV2Keeper(brokenV2StoreKey)
+	require.ErrorContains(t, k.MigrateFromV1(ctx, v1StoreService, encCfg.Codec), "total supply invariant broken")
+}
@@ -102,6 +102,30 @@ func (am AppModule) RegisterMsgHandlers(router appmodulev2.MsgRouter) {
 		errs = errors.Join(errs, err)
 	}
 
+	if err := appmodulev2.RegisterHandler(
+		router, gogoproto.MessageName(&types.MsgSend{}), handlers.MsgSend,
+	); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	if err := appmodulev2.RegisterHandler(
+		router, gogoproto.MessageName(&types.MsgMultiSend{}), handlers.MsgMultiSend,
+	); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	if err := appmodulev2.RegisterHandler(
+		router, gogoproto.MessageName(&types.MsgBurn{}), handlers.MsgBurn,
+	); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	if err := appmodulev2.RegisterHandler(
+		router, gogoproto.MessageName(&types.MsgSetSendEnabled{}), handlers.MsgSetSendEnabled,
+	); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
 	if errs != nil {
 		panic(errs)
 	}
@@ -118,6 +142,54 @@ func (am AppModule) RegisterQueryHandlers(router appmodulev2.QueryRouter) {
 		errs = errors.Join(errs, err)
 	}
 
+	if err := appmodulev2.RegisterHandler(
+		router, gogoproto.MessageName(&types.QueryBalanceRequest{}), handlers.QueryBalance,
+	); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	if err := appmodulev2.RegisterHandler(
+		router, gogoproto.MessageName(&types.QueryAllBalancesRequest{}), handlers.QueryAllBalances,
+	); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	if err := appmodulev2.RegisterHandler(
+		router, gogoproto.MessageName(&types.QueryTotalSupplyRequest{}), handlers.QueryTotalSupply,
+	); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	if err := appmodulev2.RegisterHandler(
+		router, gogoproto.MessageName(&types.QuerySupplyOfRequest{}), handlers.QuerySupplyOf,
+	); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	if err := appmodulev2.RegisterHandler(
+		router, gogoproto.MessageName(&types.QueryDenomOwnersRequest{}), handlers.QueryDenomOwners,
+	); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	if err := appmodulev2.RegisterHandler(
+		router, gogoproto.MessageName(&types.QueryDenomMetadataRequest{}), handlers.QueryDenomMetadata,
+	); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	if err := appmodulev2.RegisterHandler(
+		router, gogoproto.MessageName(&types.QueryDenomsMetadataRequest{}), handlers.QueryDenomsMetadata,
+	); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
+	if err := appmodulev2.RegisterHandler(
+		router, gogoproto.MessageName(&types.QuerySendEnabledRequest{}), handlers.QuerySendEnabled,
+	); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
 	if errs != nil {
 		panic(errs)
 	}
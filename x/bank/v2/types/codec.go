@@ -8,5 +8,9 @@ import (
 func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*transaction.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSend{},
+		&MsgMultiSend{},
+		&MsgBurn{},
+		&MsgSetSendEnabled{},
 	)
 }
@@ -1,17 +1,55 @@
 package types
 
+import (
+	"fmt"
+
+	banktypes "cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params) *GenesisState {
+func NewGenesisState(params Params, denomMetadata []banktypes.Metadata, sendEnabled []banktypes.SendEnabled) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:        params,
+		DenomMetadata: denomMetadata,
+		SendEnabled:   sendEnabled,
 	}
 }
 
 // DefaultGenesisState returns a default bank/v2 module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams())
+	return NewGenesisState(DefaultParams(), nil, nil)
 }
 
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
 func (gs *GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenMetadatas := make(map[string]struct{})
+	for _, metadata := range gs.DenomMetadata {
+		if _, exists := seenMetadatas[metadata.Base]; exists {
+			return ErrDuplicateEntry.Wrapf("duplicate client metadata for denom %s", metadata.Base)
+		}
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+		seenMetadatas[metadata.Base] = struct{}{}
+	}
+
+	seenSendEnabled := make(map[string]struct{})
+	for _, p := range gs.SendEnabled {
+		if _, exists := seenSendEnabled[p.Denom]; exists {
+			return ErrDuplicateEntry.Wrapf("duplicate send enabled found: %s", p.Denom)
+		}
+		if err := sdk.ValidateDenom(p.Denom); err != nil {
+			return fmt.Errorf("invalid send enabled denom %s: %w", p.Denom, err)
+		}
+		seenSendEnabled[p.Denom] = struct{}{}
+	}
+
+	return nil
 }
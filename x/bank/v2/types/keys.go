@@ -31,4 +31,10 @@ var (
 	DenomAddressPrefix = collections.NewPrefix(4)
 
 	SupplyKey = collections.NewPrefix(5)
+
+	// DenomMetadataPrefix is the prefix for the denom metadata store.
+	DenomMetadataPrefix = collections.NewPrefix(6)
+
+	// SendEnabledPrefix is the prefix for the SendEnabled flags of the denoms.
+	SendEnabledPrefix = collections.NewPrefix(7)
 )
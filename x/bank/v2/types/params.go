@@ -1,13 +1,18 @@
 package types
 
+// DefaultDefaultSendEnabled is the value that DefaultSendEnabled will have from DefaultParams().
+var DefaultDefaultSendEnabled = true
+
 // NewParams creates a new parameter configuration for the bank/v2 module
-func NewParams() Params {
-	return Params{}
+func NewParams(defaultSendEnabled bool) Params {
+	return Params{
+		DefaultSendEnabled: defaultSendEnabled,
+	}
 }
 
 // DefaultParams is the default parameter configuration for the bank/v2 module
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultDefaultSendEnabled)
 }
 
 // Validate all bank/v2 module parameters
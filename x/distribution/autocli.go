@@ -79,6 +79,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "delegator_address"},
 					},
 				},
+				{
+					RpcMethod: "DelegatorAutoCompound",
+					Use:       "auto-compound <delegator-addr>",
+					Short:     "Query whether auto-compounding of the rewards is enabled for a delegator",
+					Example:   fmt.Sprintf("$ %s query distribution auto-compound [delegator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 				{
 					RpcMethod: "CommunityPool",
 					Use:       "community-pool",
@@ -99,6 +108,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "withdraw_address"},
 					},
 				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound <enabled>",
+					Short:     "Enable or disable the auto-compounding of the staking rewards of an address",
+					Long:      "Enable or disable the auto-compounding of the staking rewards of an address. Auto-compounding requires the address to be its own withdraw address.",
+					Example:   fmt.Sprintf("%s tx distribution set-auto-compound true --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "enabled"},
+					},
+				},
 				{
 					RpcMethod: "WithdrawDelegatorReward",
 					Use:       "withdraw-rewards <validator-addr>",
@@ -107,6 +126,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "WithdrawAllDelegatorRewards",
+					Use:       "withdraw-all-delegator-rewards",
+					Short:     "Withdraw the rewards of all the delegations of a delegator in a single message",
+					Example:   fmt.Sprintf("%s tx distribution withdraw-all-delegator-rewards --from mykey", version.AppName),
+				},
 				{
 					RpcMethod: "WithdrawValidatorCommission",
 					Use:       "withdraw-validator-commission <validator-addr>",
@@ -115,6 +140,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "validator_address"},
 					},
 				},
+				{
+					RpcMethod: "WithdrawValidatorRewardsAndCommission",
+					Use:       "withdraw-rewards-and-commission <validator-addr>",
+					Short:     "Withdraw the self-delegation rewards and the commission of a validator (must be a validator operator)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_address"},
+					},
+				},
+				{
+					RpcMethod: "WithdrawTokenizeShareRecordReward",
+					Use:       "withdraw-tokenize-share-rewards <record-id>",
+					Short:     "Withdraw rewards of a tokenize share record (must be the record owner)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "record_id"},
+					},
+				},
 				{
 					RpcMethod: "DepositValidatorRewardsPool",
 					Use:       "fund-validator-rewards-pool <validator-addr> <amount>",
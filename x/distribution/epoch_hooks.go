@@ -0,0 +1,19 @@
+package distribution
+
+import (
+	"context"
+
+	epochstypes "cosmossdk.io/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = AppModule{}
+
+// BeforeEpochStart starts a round of auto-compounding of the delegators rewards.
+func (am AppModule) BeforeEpochStart(ctx context.Context, epochIdentifier string, _ int64) error {
+	return am.keeper.StartAutoCompoundRound(ctx, epochIdentifier)
+}
+
+// AfterEpochEnd is a noop
+func (am AppModule) AfterEpochEnd(_ context.Context, _ string, _ int64) error {
+	return nil
+}
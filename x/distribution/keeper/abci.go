@@ -9,7 +9,8 @@ import (
 )
 
 // BeginBlocker sets the proposer for determining distribution during endblock
-// and distribute rewards for the previous block.
+// and distribute rewards for the previous block. It then carries on with the
+// auto-compounding round in progress, if any.
 func (k Keeper) BeginBlocker(ctx context.Context) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -36,5 +37,5 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 		}
 	}
 
-	return nil
+	return k.ProcessAutoCompound(ctx)
 }
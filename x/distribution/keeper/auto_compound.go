@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/branch"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetAutoCompound enables or disables the auto-compounding of the staking rewards of a
+// delegator. Auto-compounding can only be enabled while the delegator is its own withdraw
+// address, as the compounded rewards are delegated from the delegator account.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, enabled bool) error {
+	if !enabled {
+		return k.AutoCompoundDelegators.Remove(ctx, delAddr)
+	}
+
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	if !withdrawAddr.Equals(delAddr) {
+		return types.ErrAutoCompoundWithdrawAddr
+	}
+
+	return k.AutoCompoundDelegators.Set(ctx, delAddr)
+}
+
+// StartAutoCompoundRound starts a new round of auto-compounding at the beginning of the
+// epoch configured in the params. A round still in progress is not restarted.
+func (k Keeper) StartAutoCompoundRound(ctx context.Context, epochIdentifier string) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundEpochIdentifier == "" || params.AutoCompoundEpochIdentifier != epochIdentifier {
+		return nil
+	}
+
+	inProgress, err := k.AutoCompoundCursor.Has(ctx)
+	if err != nil || inProgress {
+		return err
+	}
+
+	first, found, err := k.nextAutoCompoundDelegator(ctx, nil)
+	if err != nil || !found {
+		return err
+	}
+
+	return k.AutoCompoundCursor.Set(ctx, first)
+}
+
+// ProcessAutoCompound compounds the rewards of the delegators of the round in progress,
+// starting from the cursor, until the auto-compound gas budget of the block is spent.
+// The delegators left are processed in the next blocks.
+func (k Keeper) ProcessAutoCompound(ctx context.Context) error {
+	cursor, err := k.AutoCompoundCursor.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+
+	remaining := params.AutoCompoundGasBudget
+	delAddr, found := cursor, true
+	for found && remaining > 0 {
+		// the delegator at the cursor may have disabled auto-compounding since the
+		// cursor was saved
+		enabled, err := k.AutoCompoundDelegators.Has(ctx, delAddr)
+		if err != nil {
+			return err
+		}
+
+		if enabled {
+			gasUsed, err := k.BranchService.ExecuteWithGasLimit(ctx, remaining, func(ctx context.Context) error {
+				return k.compoundDelegatorRewards(ctx, delAddr, bondDenom)
+			})
+			if err != nil {
+				outOfGas := errors.Is(err, sdkerrors.ErrOutOfGas) || errors.Is(err, branch.ErrGasLimitExceeded)
+				if outOfGas && remaining < params.AutoCompoundGasBudget {
+					// retry the delegator in the next block, with the full budget
+					return k.AutoCompoundCursor.Set(ctx, delAddr)
+				}
+
+				k.Logger.Error("failed to auto-compound delegator rewards", "delegator", delAddr.String(), "err", err)
+			}
+
+			remaining -= min(gasUsed, remaining)
+		}
+
+		delAddr, found, err = k.nextAutoCompoundDelegator(ctx, delAddr)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !found {
+		return k.AutoCompoundCursor.Remove(ctx)
+	}
+
+	return k.AutoCompoundCursor.Set(ctx, delAddr)
+}
+
+// nextAutoCompoundDelegator returns the first delegator with auto-compounding enabled
+// following the given one, or the first one at all if after is nil.
+func (k Keeper) nextAutoCompoundDelegator(ctx context.Context, after sdk.AccAddress) (sdk.AccAddress, bool, error) {
+	rng := new(collections.Range[sdk.AccAddress])
+	if after != nil {
+		rng = rng.StartExclusive(after)
+	}
+
+	iter, err := k.AutoCompoundDelegators.Iterate(ctx, rng)
+	if err != nil {
+		return nil, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil, false, nil
+	}
+
+	delAddr, err := iter.Key()
+	return delAddr, err == nil, err
+}
+
+// compoundDelegatorRewards withdraws the rewards of all the delegations of a delegator and
+// delegates the bond denom part of each back to the validator it was earned from.
+func (k Keeper) compoundDelegatorRewards(ctx context.Context, delAddr sdk.AccAddress, bondDenom string) error {
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	// the rewards would not be in the delegator account
+	if !withdrawAddr.Equals(delAddr) {
+		return nil
+	}
+
+	delegations, err := k.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr)
+	if err != nil {
+		return err
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return err
+	}
+
+	for _, delegation := range delegations {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if err != nil {
+			return err
+		}
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return err
+		}
+
+		amount := rewards.AmountOf(bondDenom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		// the staking keeper enforces the validator bond cap of the validator
+		if _, err = k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+			return err
+		}
+
+		if err = k.EventService.EventManager(ctx).EmitKV(
+			types.EventTypeAutoCompound,
+			event.NewAttribute(types.AttributeKeyDelegator, delegator),
+			event.NewAttribute(types.AttributeKeyValidator, delegation.GetValidatorAddr()),
+			event.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	distrtestutil "cosmossdk.io/x/distribution/testutil"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAutoCompoundRound(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	dep.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), gomock.Any()).Return([]stakingtypes.Delegation{}, nil).Times(8)
+
+	delegators := simtestutil.CreateIncrementalAccounts(3)
+	for _, delAddr := range delegators {
+		require.NoError(t, distrKeeper.SetAutoCompound(ctx, delAddr, true))
+	}
+
+	requireCursor := func(expected sdk.AccAddress) {
+		t.Helper()
+		cursor, err := distrKeeper.AutoCompoundCursor.Get(ctx)
+		if expected == nil {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, expected, cursor)
+	}
+
+	// no round is in progress until the configured epoch starts
+	require.NoError(t, distrKeeper.BeginBlocker(ctx))
+	requireCursor(nil)
+	require.NoError(t, distrKeeper.StartAutoCompoundRound(ctx, "week"))
+	requireCursor(nil)
+
+	// with enough gas, all the delegators are processed in a single block
+	require.NoError(t, distrKeeper.StartAutoCompoundRound(ctx, types.DefaultAutoCompoundEpochIdentifier))
+	requireCursor(delegators[0])
+	require.NoError(t, distrKeeper.ProcessAutoCompound(ctx))
+	requireCursor(nil)
+
+	// a delegator without delegations only costs the read of its withdraw address
+	gasBefore := ctx.GasMeter().GasConsumed()
+	_, err := distrKeeper.GetDelegatorWithdrawAddr(ctx, delegators[0])
+	require.NoError(t, err)
+	gasPerDelegator := ctx.GasMeter().GasConsumed() - gasBefore
+
+	// with a budget fitting a single delegator, one delegator is processed per block
+	params, err := distrKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.AutoCompoundGasBudget = gasPerDelegator * 3 / 2
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	require.NoError(t, distrKeeper.StartAutoCompoundRound(ctx, types.DefaultAutoCompoundEpochIdentifier))
+	for i := 1; i < len(delegators); i++ {
+		require.NoError(t, distrKeeper.ProcessAutoCompound(ctx))
+		requireCursor(delegators[i])
+
+		// a new epoch does not restart the round in progress
+		require.NoError(t, distrKeeper.StartAutoCompoundRound(ctx, types.DefaultAutoCompoundEpochIdentifier))
+		requireCursor(delegators[i])
+	}
+	require.NoError(t, distrKeeper.ProcessAutoCompound(ctx))
+	requireCursor(nil)
+
+	// a delegator disabling auto-compounding after the cursor was saved is skipped
+	require.NoError(t, distrKeeper.StartAutoCompoundRound(ctx, types.DefaultAutoCompoundEpochIdentifier))
+	requireCursor(delegators[0])
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, delegators[0], false))
+	require.NoError(t, distrKeeper.ProcessAutoCompound(ctx))
+	requireCursor(delegators[2])
+	require.NoError(t, distrKeeper.ProcessAutoCompound(ctx))
+	requireCursor(nil)
+}
+
+func TestAutoCompoundDelegation(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithHeaderInfo(header.Info{Height: 1})
+	require.NoError(t, distrKeeper.FeePool.Set(ctx, types.InitialFeePool()))
+
+	delAddr, otherDelAddr := addrs[0], addrs[1]
+	valAddr := sdk.ValAddress(valConsAddr0)
+	operatorAddr, err := dep.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+	val, err := distrtestutil.CreateValidator(valConsPk0, operatorAddr, math.NewInt(100))
+	require.NoError(t, err)
+	delAddrStr, err := dep.accountKeeper.AddressCodec().BytesToString(delAddr)
+	require.NoError(t, err)
+	del := stakingtypes.NewDelegation(delAddrStr, operatorAddr, val.DelegatorShares)
+
+	dep.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(val, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), delAddr, valAddr).Return(del, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), delAddr).Return([]stakingtypes.Delegation{del}, nil).Times(2)
+	// the delegator following the failing one is still processed
+	dep.stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), otherDelAddr).Return([]stakingtypes.Delegation{}, nil).Times(2)
+
+	require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, delAddr, valAddr))
+	for _, addr := range []sdk.AccAddress{delAddr, otherDelAddr} {
+		require.NoError(t, distrKeeper.SetAutoCompound(ctx, addr, true))
+	}
+
+	// allocate some rewards, all earned by the single delegation
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 2})
+	initial := sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+	require.NoError(t, distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)}))
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial))
+
+	requireOutstanding := func(expected math.Int) {
+		t.Helper()
+		outstanding, err := distrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, expected.String(), outstanding.AmountOf(sdk.DefaultBondDenom).TruncateInt().String())
+	}
+
+	// the delegation fails, e.g. on the validator bond cap: the withdrawal is reverted
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, delAddr, rewards).Return(nil)
+	dep.stakingKeeper.EXPECT().Delegate(gomock.Any(), delAddr, initial, stakingtypes.Unbonded, val, true).
+		Return(math.LegacyZeroDec(), stakingtypes.ErrInsufficientValidatorBond)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, distrKeeper.StartAutoCompoundRound(ctx, types.DefaultAutoCompoundEpochIdentifier))
+	require.NoError(t, distrKeeper.ProcessAutoCompound(ctx))
+	_, err = distrKeeper.AutoCompoundCursor.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	requireOutstanding(initial)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// the rewards are withdrawn and delegated back to the validator
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, delAddr, rewards).Return(nil)
+	dep.stakingKeeper.EXPECT().Delegate(gomock.Any(), delAddr, initial, stakingtypes.Unbonded, val, true).
+		Return(math.LegacyNewDecFromInt(initial), nil)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, distrKeeper.StartAutoCompoundRound(ctx, types.DefaultAutoCompoundEpochIdentifier))
+	require.NoError(t, distrKeeper.ProcessAutoCompound(ctx))
+	_, err = distrKeeper.AutoCompoundCursor.Get(ctx)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	requireOutstanding(math.ZeroInt())
+
+	var compounded []sdk.Event
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypeAutoCompound {
+			compounded = append(compounded, e)
+		}
+	}
+	require.Len(t, compounded, 1)
+	for key, value := range map[string]string{
+		types.AttributeKeyDelegator: delAddrStr,
+		types.AttributeKeyValidator: operatorAddr,
+		sdk.AttributeKeyAmount:      sdk.NewCoin(sdk.DefaultBondDenom, initial).String(),
+	} {
+		attr, found := compounded[0].GetAttribute(key)
+		require.True(t, found, key)
+		require.Equal(t, value, attr.Value)
+	}
+}
@@ -113,6 +113,16 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) error
 		}
 	}
 
+	for _, del := range data.AutoCompoundDelegators {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(del)
+		if err != nil {
+			return err
+		}
+		if err = k.AutoCompoundDelegators.Set(ctx, delAddr); err != nil {
+			return err
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.DecimalPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -285,5 +295,19 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
-	return types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes), nil
+	var autoCompound []string
+	err = k.AutoCompoundDelegators.Walk(ctx, nil, func(delAddr sdk.AccAddress) (stop bool, err error) {
+		addr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+		if err != nil {
+			return true, err
+		}
+
+		autoCompound = append(autoCompound, addr)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, feePool, dwi, outstanding, acc, his, cur, dels, slashes, autoCompound), nil
 }
@@ -52,6 +52,10 @@ type Keeper struct {
 	ValidatorHistoricalRewards collections.Map[collections.Pair[sdk.ValAddress, uint64], types.ValidatorHistoricalRewards]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// AutoCompoundDelegators key: delAddr of the delegators having enabled auto-compounding
+	AutoCompoundDelegators collections.KeySet[sdk.AccAddress]
+	// AutoCompoundCursor stores the next delegator to compound while a round of auto-compounding is in progress
+	AutoCompoundCursor collections.Item[sdk.AccAddress]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -133,6 +137,8 @@ func NewKeeper(
 			collections.TripleKeyCodec(sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), collections.Uint64Key, collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		AutoCompoundDelegators: collections.NewKeySet(sb, types.AutoCompoundDelegatorsPrefix, "auto_compound_delegators", sdk.AccAddressKey),
+		AutoCompoundCursor:     collections.NewItem(sb, types.AutoCompoundCursorKey, "auto_compound_cursor", collcodec.KeyToValueCodec(sdk.AccAddressKey)),
 	}
 
 	schema, err := sb.Build()
@@ -212,6 +218,105 @@ func (k Keeper) WithdrawDelegationRewards(ctx context.Context, delAddr sdk.AccAd
 	return rewards, nil
 }
 
+// gasCostPerDelegation is the gas charged for each delegation whose rewards are
+// withdrawn in a batch, so that the cost of a batch grows with its size.
+const gasCostPerDelegation = uint64(1000)
+
+// WithdrawAllDelegationRewards withdraws the rewards of all the delegations of a
+// delegator and returns their sum.
+func (k Keeper) WithdrawAllDelegationRewards(ctx context.Context, delAddr sdk.AccAddress) (sdk.Coins, error) {
+	delegations, err := k.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	total := sdk.NewCoins()
+	for _, delegation := range delegations {
+		if err := k.GasService.GasMeter(ctx).Consume(gasCostPerDelegation, "withdraw all delegation rewards"); err != nil {
+			return nil, err
+		}
+
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if err != nil {
+			return nil, err
+		}
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		total = total.Add(rewards...)
+	}
+
+	return total, nil
+}
+
+// WithdrawValidatorRewardsAndCommission withdraws the rewards of the
+// self-delegation of a validator, if any, along with its accumulated commission.
+func (k Keeper) WithdrawValidatorRewardsAndCommission(ctx context.Context, valAddr sdk.ValAddress) (rewards, commission sdk.Coins, err error) {
+	delAddr := sdk.AccAddress(valAddr)
+	_, err = k.stakingKeeper.Delegation(ctx, delAddr, valAddr)
+	switch {
+	case err == nil:
+		rewards, err = k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, nil, err
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, nil, err
+	}
+
+	commission, err = k.WithdrawValidatorCommission(ctx, valAddr)
+	if err != nil && !errors.Is(err, types.ErrNoValidatorCommission) {
+		return nil, nil, err
+	}
+
+	return rewards, commission, nil
+}
+
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegation
+// backing a tokenize share record and sends them, along with any rewards already
+// held by the record address, to the owner of the record.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, ownerAddr sdk.AccAddress, recordID uint64) (sdk.Coins, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ownerAddr.Equals(sdk.AccAddress(owner)) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of tokenize share record %d", record.Owner, recordID)
+	}
+
+	valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	// the rewards are paid to the record address, which is the withdraw
+	// address of the record delegation
+	recordAddr := record.GetModuleAddress()
+	if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+		return nil, err
+	}
+
+	rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+	if rewards.IsZero() {
+		return rewards, nil
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, recordAddr, ownerAddr, rewards); err != nil {
+		return nil, err
+	}
+
+	return rewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
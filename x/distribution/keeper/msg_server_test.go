@@ -6,9 +6,11 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	"cosmossdk.io/x/distribution/keeper"
 	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -68,6 +70,63 @@ func TestMsgSetWithdrawAddress(t *testing.T) {
 	}
 }
 
+func TestMsgSetAutoCompound(t *testing.T) {
+	ctx, addrs, distrKeeper, _ := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	addr0Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+	addr1Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addrs[1])
+	require.NoError(t, err)
+
+	// addrs[1] withdraws its rewards to addrs[0]
+	require.NoError(t, distrKeeper.DelegatorsWithdrawAddress.Set(ctx, addrs[1], addrs[0]))
+
+	cases := []struct {
+		name    string
+		msg     *types.MsgSetAutoCompound
+		errMsg  string
+		enabled bool
+	}{
+		{
+			name:    "enable",
+			msg:     types.NewMsgSetAutoCompound(addr0Str, true),
+			enabled: true,
+		},
+		{
+			name:    "disable",
+			msg:     types.NewMsgSetAutoCompound(addr0Str, false),
+			enabled: false,
+		},
+		{
+			name:   "invalid delegator address",
+			msg:    types.NewMsgSetAutoCompound("invalid", true),
+			errMsg: "invalid delegator address",
+		},
+		{
+			name:   "withdraw address is not the delegator",
+			msg:    types.NewMsgSetAutoCompound(addr1Str, true),
+			errMsg: types.ErrAutoCompoundWithdrawAddr.Error(),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.SetAutoCompound(ctx, tc.msg)
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			res, err := keeper.NewQuerier(distrKeeper).DelegatorAutoCompound(ctx, &types.QueryDelegatorAutoCompoundRequest{DelegatorAddress: tc.msg.DelegatorAddress})
+			require.NoError(t, err)
+			require.Equal(t, tc.enabled, res.Enabled)
+		})
+	}
+}
+
 func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	ctx, addrs, distrKeeper, dep := initFixture(t)
 	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), gomock.Any()).AnyTimes()
@@ -126,6 +185,157 @@ func TestMsgWithdrawDelegatorReward(t *testing.T) {
 	}
 }
 
+func TestMsgWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	addr0Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+	addr1Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addrs[1])
+	require.NoError(t, err)
+	valAddr1Str, err := codectestutil.CodecOptions{}.GetValidatorCodec().BytesToString(addrs[1])
+	require.NoError(t, err)
+
+	record := stakingtypes.TokenizeShareRecord{Id: 1, Owner: addr0Str, Validator: valAddr1Str}
+	dep.stakingKeeper.EXPECT().GetTokenizeShareRecord(gomock.Any(), uint64(1)).Return(record, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().GetTokenizeShareRecord(gomock.Any(), uint64(2)).Return(stakingtypes.TokenizeShareRecord{}, stakingtypes.ErrTokenizeShareRecordNotFound).AnyTimes()
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), gomock.Any()).AnyTimes()
+
+	cases := []struct {
+		name   string
+		msg    *types.MsgWithdrawTokenizeShareRecordReward
+		errMsg string
+	}{
+		{
+			name: "invalid owner address",
+			msg: &types.MsgWithdrawTokenizeShareRecordReward{
+				OwnerAddress: "invalid",
+				RecordId:     1,
+			},
+			errMsg: "invalid owner address",
+		},
+		{
+			name: "record not found",
+			msg: &types.MsgWithdrawTokenizeShareRecordReward{
+				OwnerAddress: addr0Str,
+				RecordId:     2,
+			},
+			errMsg: "tokenize share record not found",
+		},
+		{
+			name: "not the record owner",
+			msg: &types.MsgWithdrawTokenizeShareRecordReward{
+				OwnerAddress: addr1Str,
+				RecordId:     1,
+			},
+			errMsg: "is not the owner",
+		},
+		{
+			name: "no validator",
+			msg: &types.MsgWithdrawTokenizeShareRecordReward{
+				OwnerAddress: addr0Str,
+				RecordId:     1,
+			},
+			errMsg: "no validator distribution info",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.WithdrawTokenizeShareRecordReward(ctx, tc.msg)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawAllDelegatorRewards(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	addr0Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addrs[0])
+	require.NoError(t, err)
+	addr1Str, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(addrs[1])
+	require.NoError(t, err)
+	valAddr1Str, err := codectestutil.CodecOptions{}.GetValidatorCodec().BytesToString(addrs[1])
+	require.NoError(t, err)
+
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), gomock.Any()).AnyTimes()
+	dep.stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), addrs[0]).Return([]stakingtypes.Delegation{
+		stakingtypes.NewDelegation(addr0Str, valAddr1Str, math.LegacyOneDec()),
+	}, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().GetAllDelegatorDelegations(gomock.Any(), addrs[1]).Return([]stakingtypes.Delegation{}, nil).AnyTimes()
+
+	cases := []struct {
+		name   string
+		msg    *types.MsgWithdrawAllDelegatorRewards
+		errMsg string
+	}{
+		{
+			name:   "invalid delegator address",
+			msg:    types.NewMsgWithdrawAllDelegatorRewards("invalid"),
+			errMsg: "invalid delegator address",
+		},
+		{
+			name: "no delegations",
+			msg:  types.NewMsgWithdrawAllDelegatorRewards(addr1Str),
+		},
+		{
+			name:   "no validator",
+			msg:    types.NewMsgWithdrawAllDelegatorRewards(addr0Str),
+			errMsg: "no validator distribution info",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.WithdrawAllDelegatorRewards(ctx, tc.msg)
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawValidatorRewardsAndCommission(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	valAddr := sdk.ValAddress(addrs[1])
+	valAddr1Str, err := codectestutil.CodecOptions{}.GetValidatorCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+
+	dep.stakingKeeper.EXPECT().Delegation(gomock.Any(), addrs[1], valAddr).Return(nil, collections.ErrNotFound).AnyTimes()
+
+	// the validator has no self-delegation nor commission
+	res, err := msgServer.WithdrawValidatorRewardsAndCommission(ctx, types.NewMsgWithdrawValidatorRewardsAndCommission(valAddr1Str))
+	require.NoError(t, err)
+	require.True(t, res.Rewards.IsZero())
+	require.True(t, res.Commission.IsZero())
+
+	_, err = msgServer.WithdrawValidatorRewardsAndCommission(ctx, types.NewMsgWithdrawValidatorRewardsAndCommission("invalid"))
+	require.ErrorContains(t, err, "invalid validator address")
+
+	// the accumulated commission is withdrawn
+	commission := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", math.LegacyNewDec(3))}
+	require.NoError(t, distrKeeper.ValidatorOutstandingRewards.Set(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: commission}))
+	require.NoError(t, distrKeeper.ValidatorsAccumulatedCommission.Set(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission}))
+	coins := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(3)))
+	dep.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addrs[1], coins).Return(nil)
+
+	res, err = msgServer.WithdrawValidatorRewardsAndCommission(ctx, types.NewMsgWithdrawValidatorRewardsAndCommission(valAddr1Str))
+	require.NoError(t, err)
+	require.True(t, res.Rewards.IsZero())
+	require.Equal(t, coins, res.Commission)
+}
+
 func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	ctx, addrs, distrKeeper, _ := initFixture(t)
 	msgServer := keeper.NewMsgServerImpl(distrKeeper)
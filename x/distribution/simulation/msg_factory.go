@@ -29,6 +29,26 @@ func MsgSetWithdrawAddressFactory(k keeper.Keeper) simsx.SimMsgFactoryFn[*types.
 	}
 }
 
+func MsgSetAutoCompoundFactory(k keeper.Keeper) simsx.SimMsgFactoryFn[*types.MsgSetAutoCompound] {
+	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *types.MsgSetAutoCompound) {
+		delegator := testData.AnyAccount(reporter)
+		enabled := testData.Rand().Intn(2) == 0
+		if enabled {
+			withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delegator.Address)
+			if err != nil {
+				reporter.Skip(err.Error())
+				return nil, nil
+			}
+			if !withdrawAddr.Equals(delegator.Address) {
+				reporter.Skip("delegator is not its own withdraw address")
+				return nil, nil
+			}
+		}
+		msg := types.NewMsgSetAutoCompound(delegator.AddressBech32, enabled)
+		return []simsx.SimAccount{delegator}, msg
+	}
+}
+
 func MsgWithdrawDelegatorRewardFactory(k keeper.Keeper, sk types.StakingKeeper) simsx.SimMsgFactoryFn[*types.MsgWithdrawDelegatorReward] {
 	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *types.MsgWithdrawDelegatorReward) {
 		delegator := testData.AnyAccount(reporter)
@@ -80,6 +100,47 @@ func MsgWithdrawDelegatorRewardFactory(k keeper.Keeper, sk types.StakingKeeper)
 	}
 }
 
+func MsgWithdrawAllDelegatorRewardsFactory(k keeper.Keeper, sk types.StakingKeeper) simsx.SimMsgFactoryFn[*types.MsgWithdrawAllDelegatorRewards] {
+	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *types.MsgWithdrawAllDelegatorRewards) {
+		delegator := testData.AnyAccount(reporter)
+
+		delegations, err := sk.GetAllDelegatorDelegations(ctx, delegator.Address)
+		switch {
+		case err != nil:
+			reporter.Skipf("error getting delegations: %v", err)
+			return nil, nil
+		case len(delegations) == 0:
+			reporter.Skip("no delegations found")
+			return nil, nil
+		}
+
+		for _, delegation := range delegations {
+			valAddr, err := sk.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+			if err != nil {
+				reporter.Skip(err.Error())
+				return nil, nil
+			}
+
+			// get outstanding rewards so we can first check if the withdrawable coins are sendable
+			outstanding, err := k.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
+			if err != nil {
+				reporter.Skipf("get outstanding rewards: %v", err)
+				return nil, nil
+			}
+
+			for _, v := range outstanding {
+				if !testData.IsSendEnabledDenom(v.Denom) {
+					reporter.Skipf("denom send not enabled: " + v.Denom)
+					return nil, nil
+				}
+			}
+		}
+
+		msg := types.NewMsgWithdrawAllDelegatorRewards(delegator.AddressBech32)
+		return []simsx.SimAccount{delegator}, msg
+	}
+}
+
 func MsgWithdrawValidatorCommissionFactory(k keeper.Keeper, sk types.StakingKeeper) simsx.SimMsgFactoryFn[*types.MsgWithdrawValidatorCommission] {
 	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *types.MsgWithdrawValidatorCommission) {
 		allVals, err := sk.GetAllValidators(ctx)
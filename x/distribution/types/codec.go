@@ -17,6 +17,10 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/distribution/MsgUpdateParams")
 	legacy.RegisterAminoMsg(registrar, &MsgDepositValidatorRewardsPool{}, "cosmos-sdk/distr/MsgDepositValRewards")
+	legacy.RegisterAminoMsg(registrar, &MsgWithdrawTokenizeShareRecordReward{}, "cosmos-sdk/MsgWithdrawTokenizeReward")
+	legacy.RegisterAminoMsg(registrar, &MsgSetAutoCompound{}, "cosmos-sdk/MsgSetAutoCompound")
+	legacy.RegisterAminoMsg(registrar, &MsgWithdrawAllDelegatorRewards{}, "cosmos-sdk/MsgWithdrawAllRewards")
+	legacy.RegisterAminoMsg(registrar, &MsgWithdrawValidatorRewardsAndCommission{}, "cosmos-sdk/MsgWithdrawValRewardsComm")
 
 	registrar.RegisterConcrete(Params{}, "cosmos-sdk/x/distribution/Params")
 }
@@ -29,6 +33,10 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgSetWithdrawAddress{},
 		&MsgUpdateParams{},
 		&MsgDepositValidatorRewardsPool{},
+		&MsgWithdrawTokenizeShareRecordReward{},
+		&MsgSetAutoCompound{},
+		&MsgWithdrawAllDelegatorRewards{},
+		&MsgWithdrawValidatorRewardsAndCommission{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -38,6 +38,13 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_epoch_identifier is the identifier of the x/epochs epoch at the start of which
+	// a round re-delegating the rewards of the delegators having enabled auto-compounding starts.
+	// An empty identifier disables auto-compounding.
+	AutoCompoundEpochIdentifier string `protobuf:"bytes,5,opt,name=auto_compound_epoch_identifier,json=autoCompoundEpochIdentifier,proto3" json:"auto_compound_epoch_identifier,omitempty"`
+	// auto_compound_gas_budget is the maximum amount of gas spent compounding rewards in a block,
+	// a round of auto-compounding continuing in the next blocks once the budget is spent.
+	AutoCompoundGasBudget uint64 `protobuf:"varint,6,opt,name=auto_compound_gas_budget,json=autoCompoundGasBudget,proto3" json:"auto_compound_gas_budget,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +87,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundEpochIdentifier() string {
+	if m != nil {
+		return m.AutoCompoundEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetAutoCompoundGasBudget() uint64 {
+	if m != nil {
+		return m.AutoCompoundGasBudget
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -657,73 +678,77 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
+	// 1108 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	0x14, 0xf6, 0xa4, 0xae, 0xd3, 0x4c, 0xd2, 0xa4, 0x9d, 0xfc, 0xa8, 0xe3, 0x14, 0xdb, 0xb5, 0xa8,
+	0x30, 0x81, 0xd8, 0xa4, 0x95, 0x00, 0xe5, 0x82, 0x1a, 0x27, 0x85, 0x4a, 0x05, 0xa2, 0x0d, 0x02,
+	0x09, 0x0e, 0xab, 0xf1, 0xee, 0xc4, 0x1e, 0xb2, 0x3b, 0xb3, 0xcc, 0xcc, 0x3a, 0xc9, 0x09, 0x8e,
+	0x85, 0x03, 0x70, 0xa3, 0xe2, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
+	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0xb5, 0x13, 0x02, 0x0a,
+	0xa9, 0x22, 0x2e, 0x96, 0xe7, 0xbd, 0xb7, 0xef, 0xfb, 0xbe, 0x37, 0x6f, 0xde, 0x0c, 0xac, 0x79,
+	0x5c, 0x86, 0x5c, 0xd6, 0x7d, 0x2a, 0x95, 0xa0, 0xcd, 0x58, 0x51, 0xce, 0xea, 0x9d, 0xf9, 0x26,
+	0x51, 0x78, 0xfe, 0x90, 0xb1, 0x16, 0x09, 0xae, 0x38, 0x9a, 0x31, 0xf1, 0xb5, 0x43, 0x2e, 0x1b,
+	0x5f, 0x98, 0x68, 0xf1, 0x16, 0x4f, 0xe2, 0xea, 0xfa, 0x9f, 0xf9, 0xa4, 0x50, 0xb4, 0x10, 0x4d,
+	0x2c, 0x49, 0x9a, 0xda, 0xe3, 0xd4, 0xa6, 0x2c, 0x4c, 0x1b, 0xbf, 0x6b, 0x3e, 0xb4, 0xf9, 0x8d,
+	0xeb, 0x32, 0x0e, 0x29, 0xe3, 0xf5, 0xe4, 0xd7, 0x98, 0x2a, 0xf7, 0xb3, 0x30, 0xb7, 0x82, 0x05,
+	0x0e, 0x25, 0xfa, 0x00, 0x5e, 0xf4, 0x78, 0x18, 0xc6, 0x8c, 0xaa, 0x2d, 0x57, 0xe1, 0xcd, 0x3c,
+	0x28, 0x83, 0xea, 0xd0, 0xe2, 0xcb, 0x8f, 0x76, 0x4b, 0x99, 0x5f, 0x76, 0x4b, 0x96, 0xaa, 0xf4,
+	0xd7, 0x6b, 0x94, 0xd7, 0x43, 0xac, 0xda, 0xb5, 0xbb, 0xa4, 0x85, 0xbd, 0xad, 0x25, 0xe2, 0x3d,
+	0xd9, 0x99, 0x83, 0x16, 0x69, 0x89, 0x78, 0x0f, 0x0f, 0xb6, 0x67, 0x81, 0x33, 0x92, 0x26, 0x7b,
+	0x07, 0x6f, 0xa2, 0x0f, 0xe1, 0x84, 0x26, 0xac, 0x59, 0x45, 0x5c, 0x12, 0xe1, 0x0a, 0xb2, 0x81,
+	0x85, 0x9f, 0x1f, 0x48, 0x30, 0x5e, 0x3d, 0x1d, 0x46, 0x1e, 0x38, 0x48, 0x67, 0x5d, 0xb1, 0x49,
+	0x9d, 0x24, 0x27, 0x0a, 0xe0, 0x64, 0x93, 0xb3, 0x58, 0xfe, 0x0d, 0xec, 0xdc, 0x53, 0x82, 0x8d,
+	0x27, 0x69, 0x8f, 0xa0, 0xdd, 0x80, 0x93, 0x1b, 0x54, 0xb5, 0x7d, 0x81, 0x37, 0x5c, 0xec, 0xfb,
+	0xc2, 0x25, 0x0c, 0x37, 0x03, 0xe2, 0xe7, 0xb3, 0x65, 0x50, 0xbd, 0xe0, 0x8c, 0x77, 0x9d, 0xb7,
+	0x7c, 0x5f, 0x2c, 0x1b, 0x17, 0x6a, 0xc0, 0x22, 0x8e, 0x15, 0x77, 0x3d, 0x1e, 0x46, 0x3c, 0x66,
+	0xbe, 0x4b, 0x22, 0xee, 0xb5, 0x5d, 0xea, 0x13, 0xa6, 0xe8, 0x1a, 0x25, 0x22, 0x7f, 0x5e, 0x53,
+	0x75, 0x66, 0x74, 0x54, 0xc3, 0x06, 0x2d, 0xeb, 0x98, 0x3b, 0x69, 0x08, 0x7a, 0x05, 0xe6, 0x0f,
+	0x27, 0x69, 0x61, 0xe9, 0x36, 0x63, 0xbf, 0x45, 0x54, 0x3e, 0x57, 0x06, 0xd5, 0xac, 0x33, 0xd9,
+	0xff, 0xf9, 0xeb, 0x58, 0x2e, 0x26, 0xce, 0x85, 0xeb, 0x9f, 0x1d, 0x6c, 0xcf, 0x96, 0x8d, 0xbc,
+	0x39, 0xe9, 0xaf, 0xd7, 0x37, 0x0f, 0xf7, 0xab, 0xe9, 0x87, 0xca, 0x4f, 0x00, 0x16, 0xde, 0xc5,
+	0x01, 0xf5, 0xb1, 0xe2, 0xe2, 0x0d, 0x2a, 0x15, 0x17, 0xd4, 0xc3, 0x81, 0x91, 0x2d, 0xd1, 0xe7,
+	0x00, 0x5e, 0xf1, 0xe2, 0x30, 0x0e, 0xb0, 0xa2, 0x1d, 0x62, 0x4b, 0xec, 0x0a, 0xac, 0x28, 0xcf,
+	0x83, 0xf2, 0xb9, 0xea, 0xf0, 0x8d, 0xab, 0xf6, 0x34, 0xd4, 0xf4, 0x1e, 0x75, 0xbb, 0x5a, 0xd7,
+	0xb3, 0xc1, 0x29, 0x33, 0xdb, 0xf0, 0xdd, 0xaf, 0xa5, 0x17, 0x5a, 0x54, 0xb5, 0xe3, 0x66, 0xcd,
+	0xe3, 0xa1, 0xed, 0xd6, 0x7a, 0x1f, 0x35, 0xb5, 0x15, 0x11, 0xd9, 0xfd, 0x46, 0x9a, 0xce, 0x9a,
+	0xec, 0xc1, 0x1a, 0x32, 0x8e, 0x06, 0x45, 0xcf, 0xc1, 0x31, 0x41, 0xd6, 0x88, 0x20, 0xcc, 0x23,
+	0xae, 0xc7, 0x63, 0xa6, 0x92, 0xee, 0xba, 0xe8, 0x8c, 0xa6, 0xe6, 0x86, 0xb6, 0x56, 0xbe, 0x05,
+	0xf0, 0x4a, 0x2a, 0xac, 0x11, 0x0b, 0x41, 0x98, 0xea, 0xaa, 0x8a, 0xe0, 0xa0, 0x51, 0x22, 0xcf,
+	0x58, 0x44, 0x17, 0x06, 0x4d, 0xc1, 0x5c, 0x44, 0x04, 0xe5, 0xe6, 0x2c, 0x64, 0x1d, 0xbb, 0xaa,
+	0xdc, 0x07, 0xb0, 0x98, 0xb2, 0xbc, 0xe5, 0x59, 0xcd, 0xc4, 0x6f, 0xf0, 0x30, 0xa4, 0x52, 0x52,
+	0xce, 0x50, 0x07, 0x42, 0x2f, 0x5d, 0x9d, 0x31, 0xdf, 0x3e, 0xa4, 0xca, 0x17, 0x00, 0xce, 0xa4,
+	0xd4, 0xde, 0x8e, 0x95, 0x54, 0x98, 0xf9, 0x94, 0xb5, 0xfe, 0xb7, 0x22, 0x6a, 0x46, 0xe3, 0x29,
+	0xa3, 0xd5, 0x00, 0xcb, 0xf6, 0x72, 0x87, 0x30, 0x85, 0x9e, 0x87, 0x97, 0x3a, 0x5d, 0xb3, 0x6b,
+	0xcb, 0x0c, 0x92, 0x32, 0x8f, 0xa5, 0xf6, 0x95, 0xc4, 0x8c, 0xde, 0x84, 0x17, 0xd6, 0x04, 0xf6,
+	0xf4, 0x09, 0xb0, 0x53, 0x69, 0xfe, 0x3f, 0x0f, 0x0a, 0x27, 0x4d, 0x51, 0xf9, 0x14, 0xc0, 0x89,
+	0x63, 0x18, 0x49, 0xf4, 0x11, 0x9c, 0xea, 0x51, 0x92, 0xda, 0xe1, 0x92, 0xc4, 0x63, 0x6b, 0xf5,
+	0x52, 0xed, 0x5f, 0xee, 0x84, 0xda, 0x31, 0x29, 0x17, 0x87, 0x34, 0x4f, 0x53, 0x90, 0x89, 0xce,
+	0x31, 0x90, 0x95, 0x4f, 0x06, 0xe0, 0xe0, 0x6d, 0x42, 0x56, 0x38, 0x0f, 0xd0, 0xc7, 0x70, 0xb4,
+	0x37, 0xe5, 0x23, 0xce, 0x83, 0x13, 0x6d, 0xd1, 0xc2, 0x69, 0xb7, 0x28, 0x0f, 0x9c, 0xde, 0xad,
+	0x92, 0x10, 0x50, 0x70, 0xc4, 0x27, 0x1e, 0x0d, 0x71, 0x60, 0xe0, 0x07, 0x4e, 0x00, 0x7f, 0xf3,
+	0x14, 0xf0, 0xce, 0xb0, 0x85, 0xd1, 0xa8, 0x95, 0xaf, 0x06, 0x60, 0xa1, 0xd1, 0xcf, 0x63, 0x35,
+	0x22, 0xcc, 0x37, 0xa3, 0x1c, 0x07, 0x68, 0x02, 0x9e, 0x57, 0x54, 0x05, 0xc4, 0xdc, 0x79, 0x8e,
+	0x59, 0xa0, 0x32, 0x1c, 0xf6, 0x89, 0xf4, 0x04, 0x8d, 0x7a, 0x5d, 0xe1, 0xf4, 0x9b, 0xd0, 0x55,
+	0x38, 0x24, 0x88, 0x47, 0x23, 0x4a, 0x98, 0x32, 0xd7, 0x8b, 0xd3, 0x33, 0xa0, 0x2d, 0x98, 0xc3,
+	0x61, 0x32, 0x88, 0xb2, 0x89, 0xc8, 0xe9, 0x63, 0x45, 0x26, 0x0a, 0x6f, 0x5b, 0x85, 0xd5, 0x13,
+	0x28, 0x4c, 0xe4, 0x7d, 0x7d, 0xb0, 0x3d, 0x3b, 0x12, 0x24, 0x6d, 0xe8, 0x7a, 0xbd, 0x13, 0x61,
+	0x01, 0x17, 0xaa, 0xf7, 0x1e, 0x94, 0x32, 0x7f, 0x3c, 0x28, 0x65, 0xbe, 0xdf, 0x99, 0x2b, 0x58,
+	0xd4, 0x16, 0xef, 0xf4, 0x81, 0x32, 0xa5, 0x39, 0x83, 0xca, 0x0f, 0x00, 0x4e, 0x2e, 0x11, 0x9d,
+	0x49, 0x77, 0x8d, 0xc2, 0x42, 0x51, 0xd6, 0xba, 0xc3, 0xd6, 0x92, 0x81, 0x1a, 0x09, 0xd2, 0xa1,
+	0x5c, 0x5f, 0xa5, 0xfd, 0x67, 0x67, 0xb4, 0x6b, 0xb6, 0x47, 0xe7, 0x2e, 0x3c, 0x2f, 0x15, 0x5e,
+	0x27, 0xf9, 0x81, 0xa7, 0x7a, 0x31, 0x98, 0x24, 0x68, 0x09, 0xe6, 0xda, 0x84, 0xb6, 0xda, 0xa6,
+	0xa0, 0xd9, 0xc5, 0x17, 0xff, 0xdc, 0x2d, 0x8d, 0x79, 0x82, 0xe8, 0x21, 0xcf, 0x5c, 0xe3, 0xfa,
+	0xe6, 0x60, 0x7b, 0xf6, 0xa8, 0xcd, 0x16, 0xc0, 0x2c, 0x2a, 0xbf, 0x03, 0x38, 0x6d, 0x65, 0x51,
+	0xce, 0x52, 0x81, 0xf6, 0xd2, 0x7e, 0x0b, 0x5e, 0xee, 0x1d, 0x42, 0x7d, 0x6b, 0x13, 0x29, 0xed,
+	0x7b, 0xe7, 0xda, 0x93, 0x9d, 0xb9, 0x67, 0x2c, 0xb5, 0xde, 0xfc, 0x35, 0x21, 0xab, 0x4a, 0xe8,
+	0x31, 0x77, 0xa9, 0x73, 0xc4, 0x8e, 0x18, 0xcc, 0xa5, 0x0f, 0x9a, 0xb3, 0x1c, 0x78, 0x16, 0x65,
+	0x21, 0xab, 0xb7, 0xb7, 0xf2, 0x23, 0x80, 0xd7, 0xff, 0xb9, 0xa9, 0xdf, 0xa3, 0xaa, 0xbd, 0x44,
+	0x22, 0x2e, 0xa9, 0x3a, 0xa3, 0xfe, 0x9e, 0xea, 0xeb, 0x6f, 0xed, 0xb2, 0x2b, 0x94, 0x87, 0x83,
+	0xbe, 0x01, 0xb6, 0xef, 0x98, 0xee, 0x72, 0xe1, 0xd9, 0x7b, 0x27, 0x68, 0xc9, 0xc5, 0xd7, 0x1e,
+	0xee, 0x15, 0xc1, 0xa3, 0xbd, 0x22, 0x78, 0xbc, 0x57, 0x04, 0xbf, 0xed, 0x15, 0xc1, 0x97, 0xfb,
+	0xc5, 0xcc, 0xe3, 0xfd, 0x62, 0xe6, 0xe7, 0xfd, 0x62, 0xe6, 0xfd, 0x6b, 0x87, 0xda, 0xea, 0xc8,
+	0xdb, 0x25, 0x29, 0x5a, 0x33, 0x97, 0x3c, 0x6e, 0x6f, 0xfe, 0x35, 0x00, 0x0a, 0xa8, 0x91, 0xbb,
+	0x8f, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -757,6 +782,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundEpochIdentifier != that1.AutoCompoundEpochIdentifier {
+		return false
+	}
+	if this.AutoCompoundGasBudget != that1.AutoCompoundGasBudget {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1092,6 +1123,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundGasBudget != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundGasBudget))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AutoCompoundEpochIdentifier) > 0 {
+		i -= len(m.AutoCompoundEpochIdentifier)
+		copy(dAtA[i:], m.AutoCompoundEpochIdentifier)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.AutoCompoundEpochIdentifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1648,6 +1691,13 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = len(m.AutoCompoundEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.AutoCompoundGasBudget != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundGasBudget))
+	}
 	return n
 }
 
@@ -2016,6 +2066,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundGasBudget", wireType)
+			}
+			m.AutoCompoundGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -346,6 +346,8 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_delegators defines the delegators having enabled auto-compounding at genesis.
+	AutoCompoundDelegators []string `protobuf:"bytes,11,rep,name=auto_compound_delegators,json=autoCompoundDelegators,proto3" json:"auto_compound_delegators,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -397,65 +399,67 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 925 bytes of a gzipped FileDescriptorProto
+	// 951 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x8e, 0x93, 0x34, 0xcd, 0x4e, 0x8a, 0xd8, 0x9d, 0x6e, 0x17, 0xef, 0xb6, 0x75, 0x36, 0xa5,
-	0x87, 0x02, 0x5a, 0x87, 0x06, 0x04, 0x55, 0x11, 0xa0, 0x26, 0x6d, 0x81, 0x1e, 0xa0, 0x4a, 0x24,
-	0x10, 0x08, 0x29, 0x9a, 0xd8, 0x13, 0x67, 0xd4, 0xc4, 0x13, 0x79, 0x26, 0x0e, 0x20, 0x71, 0xe0,
-	0x54, 0xc4, 0x89, 0x13, 0xe2, 0x58, 0x71, 0xaa, 0x90, 0x90, 0x38, 0xf4, 0x47, 0x54, 0xe2, 0x52,
-	0x55, 0x1c, 0x38, 0x01, 0xca, 0x1e, 0x40, 0x1c, 0xf9, 0x05, 0xc8, 0x9e, 0xb1, 0x3d, 0x59, 0xbb,
-	0xd9, 0xec, 0xd2, 0x5c, 0x76, 0xe3, 0x99, 0xf7, 0xde, 0xf7, 0x7d, 0xef, 0x3d, 0xbf, 0x67, 0xf0,
-	0x82, 0x45, 0xd9, 0x88, 0xb2, 0xba, 0x4d, 0x18, 0xf7, 0x48, 0x6f, 0xc2, 0x09, 0x75, 0xeb, 0xfe,
-	0xe5, 0x1e, 0xe6, 0xe8, 0x72, 0xdd, 0xc1, 0x2e, 0x66, 0x84, 0x99, 0x63, 0x8f, 0x72, 0x0a, 0xcf,
-	0x0a, 0x53, 0x53, 0x35, 0x35, 0xa5, 0xe9, 0xce, 0xa6, 0x43, 0x1d, 0x1a, 0xda, 0xd5, 0x83, 0x5f,
-	0xc2, 0x65, 0xc7, 0x90, 0xd1, 0x7b, 0x88, 0xe1, 0x38, 0xaa, 0x45, 0x89, 0x2b, 0xef, 0xcd, 0x45,
-	0xe8, 0x73, 0x38, 0xc2, 0x7e, 0x5b, 0xd8, 0x77, 0x05, 0x90, 0xe4, 0x23, 0xae, 0x36, 0xd0, 0x88,
-	0xb8, 0xb4, 0x1e, 0xfe, 0x15, 0x47, 0x17, 0x7e, 0xd2, 0xc0, 0x99, 0xeb, 0x78, 0x88, 0x1d, 0xc4,
-	0xa9, 0xf7, 0x11, 0xe1, 0x03, 0xdb, 0x43, 0xd3, 0xf7, 0xdc, 0x3e, 0x85, 0x37, 0xc0, 0x86, 0x1d,
-	0x5d, 0x74, 0x91, 0x6d, 0x7b, 0x98, 0x31, 0x5d, 0xdb, 0xd5, 0x2e, 0xad, 0x35, 0xf5, 0xc7, 0x0f,
-	0xf6, 0x36, 0x65, 0xe4, 0x6b, 0xe2, 0xa6, 0xc3, 0x3d, 0xe2, 0x3a, 0xed, 0xf5, 0xd8, 0x45, 0x9e,
-	0xc3, 0x16, 0x58, 0x9f, 0xca, 0xb0, 0x71, 0x94, 0xfc, 0x21, 0x51, 0x9e, 0x8d, 0x3c, 0xe4, 0xf1,
-	0xd5, 0xf2, 0xd7, 0xf7, 0xaa, 0xb9, 0xbf, 0xef, 0x55, 0x73, 0x17, 0xee, 0xe6, 0x41, 0xed, 0x43,
-	0x34, 0x24, 0x76, 0x80, 0xf1, 0xc1, 0x84, 0x33, 0x8e, 0x5c, 0x3b, 0xf0, 0xc1, 0x53, 0xe4, 0xd9,
-	0xac, 0x8d, 0x2d, 0xea, 0xd9, 0xf0, 0x7d, 0xb0, 0xe1, 0x47, 0x46, 0x07, 0xb8, 0xd7, 0x1e, 0x3f,
-	0xd8, 0x3b, 0x2f, 0x51, 0xe3, 0x40, 0x07, 0x44, 0xf8, 0x07, 0xce, 0xe1, 0x5d, 0x0d, 0x9c, 0xa6,
-	0x09, 0x58, 0xd7, 0x13, 0x68, 0x7a, 0x7e, 0xb7, 0x70, 0xa9, 0xd2, 0x38, 0x27, 0x4b, 0x64, 0x06,
-	0x25, 0x8c, 0xaa, 0x6d, 0x5e, 0xc7, 0x56, 0x8b, 0x12, 0xb7, 0x79, 0xe5, 0xe1, 0xef, 0xd5, 0xdc,
-	0x8f, 0x7f, 0x54, 0x5f, 0x72, 0x08, 0x1f, 0x4c, 0x7a, 0xa6, 0x45, 0x47, 0xb2, 0x2a, 0xf2, 0xdf,
-	0x1e, 0xb3, 0xef, 0xd4, 0xf9, 0xe7, 0x63, 0xcc, 0x22, 0x1f, 0x76, 0xff, 0xaf, 0x9f, 0x5f, 0xd4,
-	0xda, 0x90, 0xa6, 0xf4, 0x29, 0x99, 0xf8, 0x47, 0x03, 0x17, 0x13, 0x01, 0x96, 0x35, 0x19, 0x4d,
-	0x86, 0x88, 0x63, 0xbb, 0x45, 0x47, 0x23, 0xc2, 0x18, 0xa1, 0xee, 0x8a, 0x92, 0x31, 0x00, 0x15,
-	0x94, 0xc0, 0x85, 0xc5, 0xac, 0x34, 0xde, 0x30, 0x17, 0x74, 0xbe, 0xb9, 0x98, 0x67, 0x73, 0x2d,
-	0x48, 0x91, 0xd0, 0xac, 0x86, 0x56, 0xc4, 0xfe, 0xab, 0x81, 0xdd, 0x38, 0xc8, 0xbb, 0x84, 0x71,
-	0xea, 0x11, 0x0b, 0x0d, 0x57, 0x5b, 0xf5, 0x2d, 0x50, 0x1a, 0x63, 0x8f, 0x50, 0xa1, 0xb1, 0xd8,
-	0x96, 0x4f, 0xf0, 0x53, 0x70, 0x32, 0x6a, 0x80, 0x42, 0x28, 0xfe, 0xf5, 0xe5, 0xc4, 0xa7, 0x78,
-	0xab, 0xc2, 0xa3, 0x90, 0x8a, 0xe8, 0x5f, 0x35, 0x70, 0x3e, 0x76, 0x6e, 0x4d, 0x3c, 0x0f, 0xbb,
-	0x7c, 0xb5, 0x8a, 0x3f, 0x4e, 0x94, 0x89, 0xb2, 0xbe, 0xba, 0x9c, 0xb2, 0x79, 0x72, 0x87, 0xc8,
-	0xfa, 0x21, 0x0f, 0xce, 0xc6, 0x23, 0xa7, 0xc3, 0x91, 0xc7, 0x89, 0xeb, 0x04, 0x23, 0x47, 0x8a,
-	0x7a, 0x4a, 0x83, 0x27, 0x33, 0x37, 0xf9, 0xe3, 0xe7, 0xa6, 0x07, 0x9e, 0x61, 0x92, 0x6c, 0x97,
-	0xb8, 0x7d, 0x2a, 0x6b, 0xdf, 0x58, 0x98, 0xa1, 0x4c, 0x9d, 0x6a, 0x7e, 0x4e, 0x31, 0xe5, 0x42,
-	0x49, 0xd2, 0x77, 0x79, 0xb0, 0x1d, 0x53, 0xeb, 0x0c, 0x11, 0x1b, 0xdc, 0xf0, 0xc3, 0x0c, 0xaf,
-	0xaa, 0xd3, 0x07, 0x98, 0x38, 0x03, 0x1e, 0x75, 0xba, 0x78, 0x52, 0xde, 0x80, 0xc2, 0xdc, 0x1b,
-	0x40, 0xc1, 0x99, 0x04, 0x9f, 0x05, 0xec, 0xba, 0x38, 0xa0, 0xa7, 0x17, 0xc3, 0x9c, 0xbc, 0xbc,
-	0x5c, 0xd7, 0x24, 0xb2, 0xd4, 0x8c, 0x9c, 0xf6, 0xd3, 0xf7, 0x4a, 0x62, 0x7e, 0x29, 0x83, 0x53,
-	0xef, 0x88, 0x9d, 0xdb, 0xe1, 0x88, 0x63, 0x78, 0x13, 0x94, 0xc6, 0xc8, 0x43, 0x23, 0x91, 0x80,
-	0x4a, 0xe3, 0xf9, 0x85, 0xe0, 0xb7, 0x43, 0x53, 0x15, 0x4f, 0x7a, 0xc3, 0x5b, 0xa0, 0xdc, 0xc7,
-	0xb8, 0x3b, 0xa6, 0x74, 0x28, 0x9b, 0xff, 0xe2, 0xc2, 0x48, 0x37, 0x31, 0xbe, 0x4d, 0xe9, 0x70,
-	0xae, 0xd9, 0xfb, 0xe2, 0x0c, 0x4e, 0x81, 0x9e, 0xb4, 0x70, 0xbc, 0xfe, 0x82, 0xae, 0x09, 0x46,
-	0x46, 0x61, 0xf9, 0xb6, 0x51, 0x37, 0xb2, 0x8a, 0xb4, 0x65, 0x67, 0x59, 0x30, 0xf8, 0x45, 0xf6,
-	0x9e, 0x3a, 0x11, 0x62, 0xbe, 0xb5, 0x5c, 0x59, 0x9e, 0xb4, 0x55, 0x55, 0xfc, 0x8c, 0xd5, 0x04,
-	0xbf, 0xd7, 0x40, 0x4d, 0xe9, 0xca, 0x64, 0x8e, 0x77, 0xad, 0x78, 0xd4, 0x33, 0xbd, 0x14, 0x52,
-	0xb9, 0xf6, 0x3f, 0xd6, 0x45, 0x9a, 0x4d, 0xd5, 0x5f, 0xe8, 0xc0, 0xe0, 0x37, 0x1a, 0x38, 0x97,
-	0x50, 0x1b, 0xc4, 0x73, 0x38, 0x4e, 0xd0, 0xc9, 0x90, 0xd5, 0x9b, 0xc7, 0x9c, 0xe3, 0x69, 0x46,
-	0x3b, 0xfe, 0x13, 0x8d, 0xe1, 0x57, 0x1a, 0xd8, 0x4e, 0xc8, 0x58, 0x62, 0x74, 0xc6, 0x4c, 0xca,
-	0x21, 0x93, 0xab, 0xc7, 0x99, 0xbb, 0x69, 0x1a, 0xcf, 0xf9, 0xd9, 0x96, 0xf0, 0x4b, 0xb5, 0x41,
-	0xe7, 0xc6, 0x1a, 0xd3, 0xd7, 0x42, 0x06, 0x57, 0x8e, 0x3e, 0xd7, 0xd2, 0xf8, 0x49, 0x9b, 0xaa,
-	0x76, 0x0c, 0x4e, 0xc1, 0x56, 0xe6, 0xfc, 0x60, 0x3a, 0x08, 0xc1, 0x5f, 0x3b, 0xea, 0x00, 0x49,
-	0x43, 0x6f, 0x66, 0x8c, 0x11, 0x65, 0x0b, 0xdd, 0x2a, 0x96, 0x8b, 0xeb, 0x27, 0x9a, 0x6f, 0xdf,
-	0x9f, 0x19, 0xda, 0xc3, 0x99, 0xa1, 0x3d, 0x9a, 0x19, 0xda, 0x9f, 0x33, 0x43, 0xfb, 0x76, 0xdf,
-	0xc8, 0x3d, 0xda, 0x37, 0x72, 0xbf, 0xed, 0x1b, 0xb9, 0x4f, 0x6a, 0x82, 0x05, 0xb3, 0xef, 0x98,
-	0x84, 0xd6, 0x3f, 0x9b, 0xff, 0x04, 0x0f, 0xbf, 0xd8, 0x7a, 0xa5, 0xf0, 0x33, 0xfa, 0x95, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x9c, 0xf4, 0xd9, 0x24, 0x0c, 0x00, 0x00,
+	0x14, 0x8e, 0x93, 0xed, 0x76, 0x77, 0x52, 0xc4, 0xee, 0x74, 0x1b, 0xbc, 0xdb, 0xd6, 0xd9, 0x2d,
+	0x3d, 0x14, 0xd0, 0x3a, 0x74, 0x41, 0x50, 0x15, 0x01, 0xea, 0xa6, 0x2d, 0xd0, 0x03, 0x54, 0x89,
+	0x04, 0x02, 0x21, 0x59, 0x13, 0x7b, 0xe2, 0x8c, 0x9a, 0x78, 0x22, 0xcf, 0xd8, 0x01, 0x24, 0x0e,
+	0x9c, 0x8a, 0x38, 0x71, 0x42, 0xdc, 0xa8, 0x38, 0x55, 0x48, 0x48, 0x1c, 0xfa, 0x23, 0x7a, 0xac,
+	0x2a, 0x0e, 0x9c, 0x00, 0x65, 0x0f, 0x20, 0x8e, 0xfc, 0x02, 0x64, 0xcf, 0xd8, 0x9e, 0xac, 0x5d,
+	0x37, 0x5d, 0x9a, 0xcb, 0x6e, 0x3c, 0xf3, 0xde, 0xfb, 0xbe, 0xef, 0xbd, 0xe7, 0xf7, 0x0c, 0x5e,
+	0xb0, 0x29, 0x1b, 0x51, 0xd6, 0x72, 0x08, 0xe3, 0x3e, 0xe9, 0x05, 0x9c, 0x50, 0xaf, 0x15, 0x5e,
+	0xec, 0x61, 0x8e, 0x2e, 0xb6, 0x5c, 0xec, 0x61, 0x46, 0x98, 0x39, 0xf6, 0x29, 0xa7, 0xf0, 0xb4,
+	0x30, 0x35, 0x55, 0x53, 0x53, 0x9a, 0x6e, 0x6d, 0xb8, 0xd4, 0xa5, 0xb1, 0x5d, 0x2b, 0xfa, 0x25,
+	0x5c, 0xb6, 0x0c, 0x19, 0xbd, 0x87, 0x18, 0x4e, 0xa3, 0xda, 0x94, 0x78, 0xf2, 0xde, 0x2c, 0x43,
+	0x9f, 0xc1, 0x11, 0xf6, 0x9b, 0xc2, 0xde, 0x12, 0x40, 0x92, 0x8f, 0xb8, 0x5a, 0x47, 0x23, 0xe2,
+	0xd1, 0x56, 0xfc, 0x57, 0x1c, 0x9d, 0xfb, 0x59, 0x03, 0xa7, 0xae, 0xe2, 0x21, 0x76, 0x11, 0xa7,
+	0xfe, 0x47, 0x84, 0x0f, 0x1c, 0x1f, 0x4d, 0xde, 0xf3, 0xfa, 0x14, 0x5e, 0x03, 0xeb, 0x4e, 0x72,
+	0x61, 0x21, 0xc7, 0xf1, 0x31, 0x63, 0xba, 0xb6, 0xad, 0x5d, 0x58, 0xdd, 0xd7, 0x1f, 0xde, 0xdb,
+	0xdd, 0x90, 0x91, 0xaf, 0x88, 0x9b, 0x2e, 0xf7, 0x89, 0xe7, 0x76, 0xd6, 0x52, 0x17, 0x79, 0x0e,
+	0xdb, 0x60, 0x6d, 0x22, 0xc3, 0xa6, 0x51, 0xaa, 0x8f, 0x89, 0xf2, 0x6c, 0xe2, 0x21, 0x8f, 0x2f,
+	0xaf, 0x7c, 0x7d, 0xa7, 0x59, 0xf9, 0xfb, 0x4e, 0xb3, 0x72, 0xee, 0x76, 0x15, 0xec, 0x7c, 0x88,
+	0x86, 0xc4, 0x89, 0x30, 0x3e, 0x08, 0x38, 0xe3, 0xc8, 0x73, 0x22, 0x1f, 0x3c, 0x41, 0xbe, 0xc3,
+	0x3a, 0xd8, 0xa6, 0xbe, 0x03, 0xdf, 0x07, 0xeb, 0x61, 0x62, 0x74, 0x88, 0xfb, 0xce, 0xc3, 0x7b,
+	0xbb, 0x67, 0x25, 0x6a, 0x1a, 0xe8, 0x90, 0x88, 0xf0, 0xd0, 0x39, 0xbc, 0xad, 0x81, 0x93, 0x34,
+	0x03, 0xb3, 0x7c, 0x81, 0xa6, 0x57, 0xb7, 0x6b, 0x17, 0xea, 0x7b, 0x67, 0x64, 0x89, 0xcc, 0xa8,
+	0x84, 0x49, 0xb5, 0xcd, 0xab, 0xd8, 0x6e, 0x53, 0xe2, 0xed, 0x5f, 0xba, 0xff, 0x7b, 0xb3, 0xf2,
+	0xd3, 0x1f, 0xcd, 0x97, 0x5c, 0xc2, 0x07, 0x41, 0xcf, 0xb4, 0xe9, 0x48, 0x56, 0x45, 0xfe, 0xdb,
+	0x65, 0xce, 0xad, 0x16, 0xff, 0x7c, 0x8c, 0x59, 0xe2, 0xc3, 0xee, 0xfe, 0xf5, 0xcb, 0x8b, 0x5a,
+	0x07, 0xd2, 0x9c, 0x3e, 0x25, 0x13, 0xff, 0x68, 0xe0, 0x7c, 0x26, 0xc0, 0xb6, 0x83, 0x51, 0x30,
+	0x44, 0x1c, 0x3b, 0x6d, 0x3a, 0x1a, 0x11, 0xc6, 0x08, 0xf5, 0x16, 0x94, 0x8c, 0x01, 0xa8, 0xa3,
+	0x0c, 0x2e, 0x2e, 0x66, 0x7d, 0xef, 0x0d, 0xb3, 0xa4, 0xf3, 0xcd, 0x72, 0x9e, 0xfb, 0xab, 0x51,
+	0x8a, 0x84, 0x66, 0x35, 0xb4, 0x22, 0xf6, 0x5f, 0x0d, 0x6c, 0xa7, 0x41, 0xde, 0x25, 0x8c, 0x53,
+	0x9f, 0xd8, 0x68, 0xb8, 0xd8, 0xaa, 0x37, 0xc0, 0xf2, 0x18, 0xfb, 0x84, 0x0a, 0x8d, 0x4b, 0x1d,
+	0xf9, 0x04, 0x3f, 0x05, 0xc7, 0x93, 0x06, 0xa8, 0xc5, 0xe2, 0x5f, 0x9f, 0x4f, 0x7c, 0x8e, 0xb7,
+	0x2a, 0x3c, 0x09, 0xa9, 0x88, 0xfe, 0x55, 0x03, 0x67, 0x53, 0xe7, 0x76, 0xe0, 0xfb, 0xd8, 0xe3,
+	0x8b, 0x55, 0xfc, 0x71, 0xa6, 0x4c, 0x94, 0xf5, 0xd5, 0xf9, 0x94, 0xcd, 0x92, 0x7b, 0x8c, 0xac,
+	0x1f, 0xab, 0xe0, 0x74, 0x3a, 0x72, 0xba, 0x1c, 0xf9, 0x9c, 0x78, 0x6e, 0x34, 0x72, 0xa4, 0xa8,
+	0xa7, 0x34, 0x78, 0x0a, 0x73, 0x53, 0x3d, 0x7a, 0x6e, 0x7a, 0xe0, 0x19, 0x26, 0xc9, 0x5a, 0xc4,
+	0xeb, 0x53, 0x59, 0xfb, 0xbd, 0xd2, 0x0c, 0x15, 0xea, 0x54, 0xf3, 0x73, 0x82, 0x29, 0x17, 0x4a,
+	0x92, 0xbe, 0xab, 0x82, 0xcd, 0x94, 0x5a, 0x77, 0x88, 0xd8, 0xe0, 0x5a, 0x18, 0x67, 0x78, 0x51,
+	0x9d, 0x3e, 0xc0, 0xc4, 0x1d, 0xf0, 0xa4, 0xd3, 0xc5, 0x93, 0xf2, 0x06, 0xd4, 0x66, 0xde, 0x00,
+	0x0a, 0x4e, 0x65, 0xf8, 0x2c, 0x62, 0x67, 0xe1, 0x88, 0x9e, 0xbe, 0x14, 0xe7, 0xe4, 0xe5, 0xf9,
+	0xba, 0x26, 0x93, 0xa5, 0x66, 0xe4, 0x64, 0x98, 0xbf, 0x57, 0x12, 0xf3, 0xc3, 0x2a, 0x38, 0xf1,
+	0x8e, 0xd8, 0xb9, 0x5d, 0x8e, 0x38, 0x86, 0xd7, 0xc1, 0xf2, 0x18, 0xf9, 0x68, 0x24, 0x12, 0x50,
+	0xdf, 0x7b, 0xbe, 0x14, 0xfc, 0x66, 0x6c, 0xaa, 0xe2, 0x49, 0x6f, 0x78, 0x03, 0xac, 0xf4, 0x31,
+	0xb6, 0xc6, 0x94, 0x0e, 0x65, 0xf3, 0x9f, 0x2f, 0x8d, 0x74, 0x1d, 0xe3, 0x9b, 0x94, 0x0e, 0x67,
+	0x9a, 0xbd, 0x2f, 0xce, 0xe0, 0x04, 0xe8, 0x59, 0x0b, 0xa7, 0xeb, 0x2f, 0xea, 0x9a, 0x68, 0x64,
+	0xd4, 0xe6, 0x6f, 0x1b, 0x75, 0x23, 0xab, 0x48, 0x0d, 0xa7, 0xc8, 0x82, 0xc1, 0x2f, 0x8a, 0xf7,
+	0xd4, 0xb1, 0x18, 0xf3, 0xad, 0xf9, 0xca, 0xf2, 0xa8, 0xad, 0xaa, 0xe2, 0x17, 0xac, 0x26, 0xf8,
+	0xbd, 0x06, 0x76, 0x94, 0xae, 0xcc, 0xe6, 0xb8, 0x65, 0xa7, 0xa3, 0x9e, 0xe9, 0xcb, 0x31, 0x95,
+	0x2b, 0xff, 0x63, 0x5d, 0xe4, 0xd9, 0x34, 0xc3, 0x52, 0x07, 0x06, 0xbf, 0xd1, 0xc0, 0x99, 0x8c,
+	0xda, 0x20, 0x9d, 0xc3, 0x69, 0x82, 0x8e, 0xc7, 0xac, 0xde, 0x3c, 0xe2, 0x1c, 0xcf, 0x33, 0xda,
+	0x0a, 0x1f, 0x69, 0x0c, 0xbf, 0xd2, 0xc0, 0x66, 0x46, 0xc6, 0x16, 0xa3, 0x33, 0x65, 0xb2, 0x12,
+	0x33, 0xb9, 0x7c, 0x94, 0xb9, 0x9b, 0xa7, 0xf1, 0x5c, 0x58, 0x6c, 0x09, 0xbf, 0x54, 0x1b, 0x74,
+	0x66, 0xac, 0x31, 0x7d, 0x35, 0x66, 0x70, 0xe9, 0xc9, 0xe7, 0x5a, 0x1e, 0xbf, 0xe1, 0x14, 0xd9,
+	0x31, 0x38, 0x01, 0x8d, 0xc2, 0xf9, 0xc1, 0x74, 0x10, 0x83, 0xbf, 0xf6, 0xa4, 0x03, 0x24, 0x0f,
+	0xbd, 0x51, 0x30, 0x46, 0x18, 0xec, 0x00, 0x1d, 0x05, 0x9c, 0x46, 0x1d, 0x39, 0xa6, 0x81, 0xe7,
+	0x58, 0x29, 0x41, 0xa6, 0xd7, 0xb7, 0x6b, 0xa5, 0x2b, 0xa6, 0x11, 0x79, 0xb6, 0xa5, 0x63, 0x9a,
+	0x00, 0x65, 0xb3, 0xdd, 0x58, 0x5a, 0x59, 0x5a, 0x3b, 0xb6, 0xff, 0xf6, 0xdd, 0xa9, 0xa1, 0xdd,
+	0x9f, 0x1a, 0xda, 0x83, 0xa9, 0xa1, 0xfd, 0x39, 0x35, 0xb4, 0x6f, 0x0f, 0x8c, 0xca, 0x83, 0x03,
+	0xa3, 0xf2, 0xdb, 0x81, 0x51, 0xf9, 0x64, 0x47, 0xc4, 0x66, 0xce, 0x2d, 0x93, 0xd0, 0xd6, 0x67,
+	0xb3, 0x9f, 0xf5, 0xf1, 0x57, 0x60, 0x6f, 0x39, 0xfe, 0x34, 0x7f, 0xe5, 0xbf, 0x01, 0x00, 0x72,
+	0x52, 0x05, 0x2c, 0x78, 0x0c, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -781,6 +785,15 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundDelegators) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoCompoundDelegators[iNdEx])
+			copy(dAtA[i:], m.AutoCompoundDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCompoundDelegators[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1089,6 +1102,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for _, s := range m.AutoCompoundDelegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2325,6 +2344,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegators = append(m.AutoCompoundDelegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -13,6 +13,10 @@ var (
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
 	_ sdk.Msg = (*MsgFundCommunityPool)(nil)
 	_ sdk.Msg = (*MsgDepositValidatorRewardsPool)(nil)
+	_ sdk.Msg = (*MsgWithdrawTokenizeShareRecordReward)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
+	_ sdk.Msg = (*MsgWithdrawAllDelegatorRewards)(nil)
+	_ sdk.Msg = (*MsgWithdrawValidatorRewardsAndCommission)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr string) *MsgSetWithdrawAddress {
@@ -53,3 +57,37 @@ func NewMsgDepositValidatorRewardsPool(depositor, valAddr string, amount sdk.Coi
 		ValidatorAddress: valAddr,
 	}
 }
+
+// NewMsgWithdrawTokenizeShareRecordReward returns a new
+// MsgWithdrawTokenizeShareRecordReward with an owner and a record id.
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr string, recordID uint64) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr,
+		RecordId:     recordID,
+	}
+}
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound enabling or disabling
+// auto-compounding for a delegator.
+func NewMsgSetAutoCompound(delAddr string, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr,
+		Enabled:          enabled,
+	}
+}
+
+// NewMsgWithdrawAllDelegatorRewards returns a new MsgWithdrawAllDelegatorRewards
+// withdrawing the rewards of all the delegations of a delegator.
+func NewMsgWithdrawAllDelegatorRewards(delAddr string) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress: delAddr,
+	}
+}
+
+// NewMsgWithdrawValidatorRewardsAndCommission returns a new
+// MsgWithdrawValidatorRewardsAndCommission for a validator.
+func NewMsgWithdrawValidatorRewardsAndCommission(valAddr string) *MsgWithdrawValidatorRewardsAndCommission {
+	return &MsgWithdrawValidatorRewardsAndCommission{
+		ValidatorAddress: valAddr,
+	}
+}
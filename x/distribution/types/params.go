@@ -7,6 +7,14 @@ import (
 	"cosmossdk.io/math"
 )
 
+const (
+	// DefaultAutoCompoundEpochIdentifier compounds the rewards daily
+	DefaultAutoCompoundEpochIdentifier = "day"
+
+	// DefaultAutoCompoundGasBudget is the default gas spent compounding rewards in a block
+	DefaultAutoCompoundGasBudget uint64 = 10_000_000
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
@@ -14,12 +22,23 @@ func DefaultParams() Params {
 		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
 		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
 		WithdrawAddrEnabled: true,
+
+		AutoCompoundEpochIdentifier: DefaultAutoCompoundEpochIdentifier,
+		AutoCompoundGasBudget:       DefaultAutoCompoundGasBudget,
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	if p.AutoCompoundEpochIdentifier != "" && p.AutoCompoundGasBudget == 0 {
+		return errors.New("auto-compound gas budget must be positive when auto-compounding is enabled")
+	}
+
+	return nil
 }
 
 func validateCommunityTax(i interface{}) error {
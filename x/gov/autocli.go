@@ -94,6 +94,29 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "constitution",
 					Short:     "Query the current chain constitution",
 				},
+				{
+					RpcMethod: "Governor",
+					Use:       "governor <governor-addr>",
+					Short:     "Query details of a governor and its governance voting power",
+					Example:   fmt.Sprintf("%s query gov governor cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "governor_address"},
+					},
+				},
+				{
+					RpcMethod: "Governors",
+					Use:       "governors",
+					Short:     "Query all the governors",
+				},
+				{
+					RpcMethod: "GovernanceDelegation",
+					Use:       "governance-delegation <delegator-addr>",
+					Short:     "Query the governor an account delegates its governance voting power to",
+					Example:   fmt.Sprintf("%s query gov governance-delegation cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -134,6 +157,60 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						"metadata": {Name: "metadata", Usage: "Add a description to the vote"},
 					},
 				},
+				{
+					RpcMethod: "VoteRanked",
+					Use:       "vote-ranked <proposal-id> <ranking>",
+					Short:     "Rank the options of an active ranked choice proposal, from the most to the least preferred",
+					Long:      fmt.Sprintf(`Submit a ranked vote for an active multiple choice proposal using the ranked choice tally strategy. Use the --metadata to optionally give a reason. You can find the proposal-id by running "%s query gov proposals"`, version.AppName),
+					Example:   fmt.Sprintf("$ %s tx gov vote-ranked 1 VOTE_OPTION_THREE VOTE_OPTION_ONE --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+						{ProtoField: "ranking", Varargs: true},
+					},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"metadata": {Name: "metadata", Usage: "Add a description to the vote"},
+					},
+				},
+				{
+					RpcMethod: "CreateGovernor",
+					Use:       "create-governor <address> [description]",
+					Short:     "Register an account as a governor, to which other accounts can delegate their governance voting power",
+					Example:   fmt.Sprintf("$ %s tx gov create-governor cosmos1... \"my governor\" --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+						{ProtoField: "description", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "EditGovernor",
+					Use:       "edit-governor <address> [description]",
+					Short:     "Edit the description of a governor",
+					Example:   fmt.Sprintf("$ %s tx gov edit-governor cosmos1... \"my governor\" --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+						{ProtoField: "description", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "DelegateGovernor",
+					Use:       "delegate-governor <delegator-address> <governor-address>",
+					Short:     "Delegate governance voting power to a governor, independently of staking delegations",
+					Long:      "Delegate governance voting power to a governor, independently of staking delegations. The governor votes with the voting power of the delegator, unless the delegator votes directly.",
+					Example:   fmt.Sprintf("$ %s tx gov delegate-governor cosmos1... cosmos1... --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+						{ProtoField: "governor_address"},
+					},
+				},
+				{
+					RpcMethod: "UndelegateGovernor",
+					Use:       "undelegate-governor <delegator-address>",
+					Short:     "Remove the governance delegation of an account",
+					Example:   fmt.Sprintf("$ %s tx gov undelegate-governor cosmos1... --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal <params>",
@@ -78,6 +78,15 @@ func QueryVotesByTxQuery(clientCtx client.Context, params QueryProposalVotesPara
 					})
 				}
 
+				if voteRankedMsg, ok := msg.(*v1.MsgVoteRanked); ok && len(voteRankedMsg.Ranking) > 0 {
+					votes = append(votes, &v1.Vote{
+						Voter:      voteRankedMsg.Voter,
+						ProposalId: params.ProposalID,
+						Options:    v1.NewNonSplitVoteOption(voteRankedMsg.Ranking[0]),
+						Ranking:    voteRankedMsg.Ranking,
+					})
+				}
+
 				if voteWeightedMsg, ok := msg.(*v1beta1.MsgVoteWeighted); ok {
 					votes = append(votes, convertVote(voteWeightedMsg))
 				}
@@ -153,6 +162,15 @@ func QueryVoteByTxQuery(clientCtx client.Context, params QueryVoteParams) ([]byt
 				}
 			}
 
+			if voteRankedMsg, ok := msg.(*v1.MsgVoteRanked); ok && len(voteRankedMsg.Ranking) > 0 {
+				vote = &v1.Vote{
+					Voter:      voteRankedMsg.Voter,
+					ProposalId: params.ProposalID,
+					Options:    v1.NewNonSplitVoteOption(voteRankedMsg.Ranking[0]),
+					Ranking:    voteRankedMsg.Ranking,
+				}
+			}
+
 			if voteWeightedMsg, ok := msg.(*v1beta1.MsgVoteWeighted); ok {
 				vote = convertVote(voteWeightedMsg)
 			}
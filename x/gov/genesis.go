@@ -73,6 +73,33 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	for _, governor := range data.Governors {
+		addr, err := ak.AddressCodec().StringToBytes(governor.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.Governors.Set(ctx, addr, *governor); err != nil {
+			return err
+		}
+	}
+
+	for _, delegation := range data.GovernanceDelegations {
+		delegatorAddr, err := ak.AddressCodec().StringToBytes(delegation.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		governorAddr, err := ak.AddressCodec().StringToBytes(delegation.GovernorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.GovernanceDelegations.Set(ctx, delegatorAddr, governorAddr); err != nil {
+			return err
+		}
+		if err := k.GovernorDelegators.Set(ctx, collections.Join(sdk.AccAddress(governorAddr), sdk.AccAddress(delegatorAddr))); err != nil {
+			return err
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -131,12 +158,28 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		return nil, err
 	}
 
+	var governors []*v1.Governor
+	err = k.Governors.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.Governor) (stop bool, err error) {
+		governors = append(governors, &value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	governanceDelegations, err := k.GetAllGovernanceDelegations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:    startingProposalID,
+		Deposits:              proposalsDeposits,
+		Votes:                 proposalsVotes,
+		Proposals:             proposals,
+		Params:                &params,
+		Constitution:          constitution,
+		Governors:             governors,
+		GovernanceDelegations: governanceDelegations,
 	}, nil
 }
@@ -33,6 +33,10 @@ type Config struct {
 	// CalculateVoteResultsAndVotingPowerFn is a function signature for calculating vote results and voting power
 	// Keeping it nil will use the default implementation
 	CalculateVoteResultsAndVotingPowerFn CalculateVoteResultsAndVotingPowerFn
+	// CalculateRankedChoiceVoteResultsAndVotingPowerFn is used instead of CalculateVoteResultsAndVotingPowerFn
+	// for the multiple choice proposals using the ranked choice tally strategy
+	// Keeping it nil will use the default instant-runoff implementation
+	CalculateRankedChoiceVoteResultsAndVotingPowerFn CalculateVoteResultsAndVotingPowerFn
 }
 
 // DefaultConfig returns the default config for gov.
@@ -43,5 +47,6 @@ func DefaultConfig() Config {
 		MaxSummaryLen:                        10200,
 		MaxVoteOptionsLen:                    0, // 0 means this param is disabled, hence all supported options are allowed
 		CalculateVoteResultsAndVotingPowerFn: nil,
+		CalculateRankedChoiceVoteResultsAndVotingPowerFn: nil,
 	}
 }
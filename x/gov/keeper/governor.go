@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"context"
+	stderrors "errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateGovernor registers an account as a governor. An account delegating its
+// governance voting power cannot become a governor.
+func (k Keeper) CreateGovernor(ctx context.Context, governorAddr sdk.AccAddress, description string) error {
+	exists, err := k.Governors.Has(ctx, governorAddr)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return types.ErrGovernorExists
+	}
+
+	delegating, err := k.GovernanceDelegations.Has(ctx, governorAddr)
+	if err != nil {
+		return err
+	}
+	if delegating {
+		return errors.Wrap(types.ErrInvalidGovernanceDelegation, "an account delegating its governance voting power cannot be a governor")
+	}
+
+	if err := k.assertMetadataLength(description); err != nil {
+		return err
+	}
+
+	governor, err := k.authKeeper.AddressCodec().BytesToString(governorAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.Governors.Set(ctx, governorAddr, v1.Governor{Address: governor, Description: description}); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeCreateGovernor,
+		event.NewAttribute(types.AttributeKeyGovernor, governor),
+	)
+}
+
+// EditGovernor edits the description of a governor.
+func (k Keeper) EditGovernor(ctx context.Context, governorAddr sdk.AccAddress, description string) error {
+	governor, err := k.Governors.Get(ctx, governorAddr)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return types.ErrUnknownGovernor
+		}
+
+		return err
+	}
+
+	if err := k.assertMetadataLength(description); err != nil {
+		return err
+	}
+
+	governor.Description = description
+	if err := k.Governors.Set(ctx, governorAddr, governor); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeEditGovernor,
+		event.NewAttribute(types.AttributeKeyGovernor, governor.Address),
+	)
+}
+
+// DelegateGovernor delegates the governance voting power of an account to a governor,
+// replacing any previous governance delegation of the account. Governors cannot delegate.
+func (k Keeper) DelegateGovernor(ctx context.Context, delegatorAddr, governorAddr sdk.AccAddress) error {
+	isGovernor, err := k.Governors.Has(ctx, delegatorAddr)
+	if err != nil {
+		return err
+	}
+	if isGovernor {
+		return errors.Wrap(types.ErrInvalidGovernanceDelegation, "a governor cannot delegate its governance voting power")
+	}
+
+	governor, err := k.Governors.Get(ctx, governorAddr)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return types.ErrUnknownGovernor
+		}
+
+		return err
+	}
+
+	previousGovernorAddr, err := k.GovernanceDelegations.Get(ctx, delegatorAddr)
+	switch {
+	case err == nil:
+		if err := k.GovernorDelegators.Remove(ctx, collections.Join(previousGovernorAddr, delegatorAddr)); err != nil {
+			return err
+		}
+	case !stderrors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.GovernanceDelegations.Set(ctx, delegatorAddr, governorAddr); err != nil {
+		return err
+	}
+
+	if err := k.GovernorDelegators.Set(ctx, collections.Join(governorAddr, delegatorAddr)); err != nil {
+		return err
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeDelegateGovernor,
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyGovernor, governor.Address),
+	)
+}
+
+// UndelegateGovernor removes the governance delegation of an account.
+func (k Keeper) UndelegateGovernor(ctx context.Context, delegatorAddr sdk.AccAddress) error {
+	governorAddr, err := k.GovernanceDelegations.Get(ctx, delegatorAddr)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return errors.Wrap(types.ErrInvalidGovernanceDelegation, "no governance delegation found")
+		}
+
+		return err
+	}
+
+	if err := k.GovernanceDelegations.Remove(ctx, delegatorAddr); err != nil {
+		return err
+	}
+
+	if err := k.GovernorDelegators.Remove(ctx, collections.Join(governorAddr, delegatorAddr)); err != nil {
+		return err
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	governor, err := k.authKeeper.AddressCodec().BytesToString(governorAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeUndelegateGovernor,
+		event.NewAttribute(types.AttributeKeyDelegator, delegator),
+		event.NewAttribute(types.AttributeKeyGovernor, governor),
+	)
+}
+
+// GetAllGovernanceDelegations returns all the governance delegations.
+func (k Keeper) GetAllGovernanceDelegations(ctx context.Context) ([]*v1.GovernanceDelegation, error) {
+	var delegations []*v1.GovernanceDelegation
+	err := k.GovernanceDelegations.Walk(ctx, nil, func(delegatorAddr, governorAddr sdk.AccAddress) (stop bool, err error) {
+		delegator, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+		if err != nil {
+			return true, err
+		}
+
+		governor, err := k.authKeeper.AddressCodec().BytesToString(governorAddr)
+		if err != nil {
+			return true, err
+		}
+
+		delegations = append(delegations, &v1.GovernanceDelegation{
+			DelegatorAddress: delegator,
+			GovernorAddress:  governor,
+		})
+		return false, nil
+	})
+
+	return delegations, err
+}
+
+// GetGovernorVotingPower returns the governance voting power of a governor: the voting
+// power of its own staking delegations and of the ones of the accounts delegating to it.
+// It is the voting power of the governor vote when none of its delegators votes directly.
+func (k Keeper) GetGovernorVotingPower(ctx context.Context, governorAddr sdk.AccAddress) (math.LegacyDec, error) {
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	votingPower, err := k.getStakingVotingPower(ctx, governorAddr, validators)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](governorAddr)
+	err = k.GovernorDelegators.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+		delegatorPower, err := k.getStakingVotingPower(ctx, key.K2(), validators)
+		if err != nil {
+			return true, err
+		}
+
+		votingPower = votingPower.Add(delegatorPower)
+		return false, nil
+	})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return votingPower, nil
+}
+
+// getStakingVotingPower returns the voting power of the delegations of an account to the given validators.
+func (k Keeper) getStakingVotingPower(ctx context.Context, addr sdk.AccAddress, validators map[string]v1.ValidatorGovInfo) (math.LegacyDec, error) {
+	votingPower := math.LegacyZeroDec()
+	err := k.sk.IterateDelegations(ctx, addr, func(_ int64, delegation sdk.DelegationI) (stop bool) {
+		if val, ok := validators[delegation.GetValidatorAddr()]; ok {
+			// delegation shares * bonded / total shares
+			votingPower = votingPower.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+		}
+
+		return false
+	})
+
+	return votingPower, err
+}
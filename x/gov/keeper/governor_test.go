@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGovernors(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	addrs := simtestutil.CreateRandomAccounts(4)
+	governor1, governor2, delegator, other := addrs[0], addrs[1], addrs[2], addrs[3]
+	governor1Str, err := mocks.acctKeeper.AddressCodec().BytesToString(governor1)
+	require.NoError(t, err)
+
+	// create governors
+	require.NoError(t, govKeeper.CreateGovernor(ctx, governor1, "first governor"))
+	require.NoError(t, govKeeper.CreateGovernor(ctx, governor2, ""))
+	require.ErrorIs(t, govKeeper.CreateGovernor(ctx, governor1, ""), types.ErrGovernorExists)
+
+	governor, err := govKeeper.Governors.Get(ctx, governor1)
+	require.NoError(t, err)
+	require.Equal(t, v1.Governor{Address: governor1Str, Description: "first governor"}, governor)
+
+	// edit governor
+	require.NoError(t, govKeeper.EditGovernor(ctx, governor1, "edited governor"))
+	governor, err = govKeeper.Governors.Get(ctx, governor1)
+	require.NoError(t, err)
+	require.Equal(t, "edited governor", governor.Description)
+	require.ErrorIs(t, govKeeper.EditGovernor(ctx, other, ""), types.ErrUnknownGovernor)
+
+	// delegate
+	require.ErrorIs(t, govKeeper.DelegateGovernor(ctx, delegator, other), types.ErrUnknownGovernor)
+	require.ErrorIs(t, govKeeper.DelegateGovernor(ctx, governor2, governor1), types.ErrInvalidGovernanceDelegation)
+	require.NoError(t, govKeeper.DelegateGovernor(ctx, delegator, governor1))
+	require.ErrorIs(t, govKeeper.CreateGovernor(ctx, delegator, ""), types.ErrInvalidGovernanceDelegation)
+
+	governorAddr, err := govKeeper.GovernanceDelegations.Get(ctx, delegator)
+	require.NoError(t, err)
+	require.Equal(t, governor1, governorAddr)
+	has, err := govKeeper.GovernorDelegators.Has(ctx, collections.Join(governor1, delegator))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// redelegate to another governor
+	require.NoError(t, govKeeper.DelegateGovernor(ctx, delegator, governor2))
+	governorAddr, err = govKeeper.GovernanceDelegations.Get(ctx, delegator)
+	require.NoError(t, err)
+	require.Equal(t, governor2, governorAddr)
+	has, err = govKeeper.GovernorDelegators.Has(ctx, collections.Join(governor1, delegator))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = govKeeper.GovernorDelegators.Has(ctx, collections.Join(governor2, delegator))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// undelegate
+	require.NoError(t, govKeeper.UndelegateGovernor(ctx, delegator))
+	has, err = govKeeper.GovernanceDelegations.Has(ctx, delegator)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = govKeeper.GovernorDelegators.Has(ctx, collections.Join(governor2, delegator))
+	require.NoError(t, err)
+	require.False(t, has)
+	require.ErrorIs(t, govKeeper.UndelegateGovernor(ctx, delegator), types.ErrInvalidGovernanceDelegation)
+}
+
+func TestGetGovernorVotingPower(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+	addrs := simtestutil.CreateRandomAccounts(4)
+	governor, delegator1, delegator2 := addrs[0], addrs[1], addrs[2]
+	valAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(sdk.ValAddress(addrs[3]))
+	require.NoError(t, err)
+
+	mocks.stakingKeeper.EXPECT().
+		IterateBondedValidatorsByPower(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+			fn(0, stakingtypes.Validator{
+				OperatorAddress: valAddr,
+				Status:          stakingtypes.Bonded,
+				Tokens:          sdkmath.NewInt(2000),
+				DelegatorShares: sdkmath.LegacyNewDec(1000),
+			})
+			return nil
+		})
+	expectDelegation := func(delAddr sdk.AccAddress, shares int64) {
+		mocks.stakingKeeper.EXPECT().
+			IterateDelegations(ctx, delAddr, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, delAddr sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+				fn(0, stakingtypes.Delegation{ValidatorAddress: valAddr, Shares: sdkmath.LegacyNewDec(shares)})
+				return nil
+			})
+	}
+	expectDelegation(governor, 10)
+	expectDelegation(delegator1, 20)
+	expectDelegation(delegator2, 30)
+
+	require.NoError(t, govKeeper.CreateGovernor(ctx, governor, ""))
+	require.NoError(t, govKeeper.DelegateGovernor(ctx, delegator1, governor))
+	require.NoError(t, govKeeper.DelegateGovernor(ctx, delegator2, governor))
+
+	// (10 + 20 + 30) shares * 2000 tokens / 1000 shares
+	votingPower, err := govKeeper.GetGovernorVotingPower(ctx, governor)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(120), votingPower)
+}
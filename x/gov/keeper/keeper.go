@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
@@ -65,6 +66,13 @@ type Keeper struct {
 	ActiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// InactiveProposalsQueue key: depositEndTime+proposalID | value: proposalID
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
+	// Governors key: governorAddr | value: Governor
+	Governors collections.Map[sdk.AccAddress, v1.Governor]
+	// GovernanceDelegations key: delegatorAddr | value: governorAddr
+	GovernanceDelegations collections.Map[sdk.AccAddress, sdk.AccAddress]
+	// GovernorDelegators key: governorAddr+delegatorAddr
+	// This is used to iterate over the delegators of a governor
+	GovernorDelegators collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -131,6 +139,9 @@ func NewKeeper(
 		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		Governors:              collections.NewMap(sb, types.GovernorsKeyPrefix, "governors", sdk.AccAddressKey, codec.CollValue[v1.Governor](cdc)),
+		GovernanceDelegations:  collections.NewMap(sb, types.GovernanceDelegationsPrefix, "governance_delegations", sdk.AccAddressKey, collcodec.KeyToValueCodec(sdk.AccAddressKey)),
+		GovernorDelegators:     collections.NewKeySet(sb, types.GovernorDelegatorsPrefix, "governor_delegators", collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -125,6 +125,10 @@ func (k msgServer) SubmitProposal(ctx context.Context, msg *v1.MsgSubmitProposal
 
 // SubmitMultipleChoiceProposal implements the MsgServer.SubmitMultipleChoiceProposal method.
 func (k msgServer) SubmitMultipleChoiceProposal(ctx context.Context, msg *v1.MsgSubmitMultipleChoiceProposal) (*v1.MsgSubmitMultipleChoiceProposalResponse, error) {
+	if _, ok := v1.TallyStrategy_name[int32(msg.TallyStrategy)]; !ok {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid tally strategy: %s", msg.TallyStrategy)
+	}
+
 	resp, err := k.SubmitProposal(ctx, &v1.MsgSubmitProposal{
 		InitialDeposit: msg.InitialDeposit,
 		Proposer:       msg.Proposer,
@@ -157,6 +161,18 @@ func (k msgServer) SubmitMultipleChoiceProposal(ctx context.Context, msg *v1.Msg
 		return nil, err
 	}
 
+	if msg.TallyStrategy != v1.TallyStrategy_TALLY_STRATEGY_UNSPECIFIED {
+		proposal, err := k.Proposals.Get(ctx, resp.ProposalId)
+		if err != nil {
+			return nil, err
+		}
+
+		proposal.TallyStrategy = msg.TallyStrategy
+		if err := k.Proposals.Set(ctx, resp.ProposalId, proposal); err != nil {
+			return nil, err
+		}
+	}
+
 	return &v1.MsgSubmitMultipleChoiceProposalResponse{
 		ProposalId: resp.ProposalId,
 	}, nil
@@ -237,6 +253,81 @@ func (k msgServer) Vote(ctx context.Context, msg *v1.MsgVote) (*v1.MsgVoteRespon
 	return &v1.MsgVoteResponse{}, nil
 }
 
+// VoteRanked implements the MsgServer.VoteRanked method.
+func (k msgServer) VoteRanked(ctx context.Context, msg *v1.MsgVoteRanked) (*v1.MsgVoteRankedResponse, error) {
+	accAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Voter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid voter address: %s", err)
+	}
+
+	if err = k.Keeper.AddRankedVote(ctx, msg.ProposalId, accAddr, msg.Ranking, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVoteRankedResponse{}, nil
+}
+
+// CreateGovernor implements the MsgServer.CreateGovernor method.
+func (k msgServer) CreateGovernor(ctx context.Context, msg *v1.MsgCreateGovernor) (*v1.MsgCreateGovernorResponse, error) {
+	governorAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.CreateGovernor(ctx, governorAddr, msg.Description); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCreateGovernorResponse{}, nil
+}
+
+// EditGovernor implements the MsgServer.EditGovernor method.
+func (k msgServer) EditGovernor(ctx context.Context, msg *v1.MsgEditGovernor) (*v1.MsgEditGovernorResponse, error) {
+	governorAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.EditGovernor(ctx, governorAddr, msg.Description); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgEditGovernorResponse{}, nil
+}
+
+// DelegateGovernor implements the MsgServer.DelegateGovernor method.
+func (k msgServer) DelegateGovernor(ctx context.Context, msg *v1.MsgDelegateGovernor) (*v1.MsgDelegateGovernorResponse, error) {
+	delegatorAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	governorAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.GovernorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.DelegateGovernor(ctx, delegatorAddr, governorAddr); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgDelegateGovernorResponse{}, nil
+}
+
+// UndelegateGovernor implements the MsgServer.UndelegateGovernor method.
+func (k msgServer) UndelegateGovernor(ctx context.Context, msg *v1.MsgUndelegateGovernor) (*v1.MsgUndelegateGovernorResponse, error) {
+	delegatorAddr, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.Keeper.UndelegateGovernor(ctx, delegatorAddr); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUndelegateGovernorResponse{}, nil
+}
+
 // VoteWeighted implements the MsgServer.VoteWeighted method.
 func (k msgServer) VoteWeighted(ctx context.Context, msg *v1.MsgVoteWeighted) (*v1.MsgVoteWeightedResponse, error) {
 	accAddr, accErr := k.authKeeper.AddressCodec().StringToBytes(msg.Voter)
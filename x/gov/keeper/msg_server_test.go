@@ -4,6 +4,7 @@ import (
 	"strings"
 	"time"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	banktypes "cosmossdk.io/x/bank/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
@@ -348,6 +349,25 @@ func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposal() {
 			expErr:    true,
 			expErrMsg: "if a vote option is provided, the previous one must also be provided",
 		},
+		"invalid tally strategy": {
+			preRun: func() (*v1.MsgSubmitMultipleChoiceProposal, error) {
+				msg, err := v1.NewMultipleChoiceMsgSubmitProposal(
+					initialDeposit,
+					proposerAddr,
+					"mandatory metadata",
+					"Proposal",
+					"description of proposal",
+					&v1.ProposalVoteOptions{
+						OptionOne: "Vote for me",
+						OptionTwo: "Vote for them",
+					},
+				)
+				msg.TallyStrategy = v1.TallyStrategy(3)
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "invalid tally strategy",
+		},
 		"valid proposal": {
 			preRun: func() (*v1.MsgSubmitMultipleChoiceProposal, error) {
 				return v1.NewMultipleChoiceMsgSubmitProposal(
@@ -363,6 +383,24 @@ func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposal() {
 				)
 			},
 		},
+		"valid ranked choice proposal": {
+			preRun: func() (*v1.MsgSubmitMultipleChoiceProposal, error) {
+				msg, err := v1.NewMultipleChoiceMsgSubmitProposal(
+					initialDeposit,
+					proposerAddr,
+					"mandatory metadata",
+					"Proposal",
+					"description of proposal",
+					&v1.ProposalVoteOptions{
+						OptionOne:   "Vote for me",
+						OptionTwo:   "Vote for them",
+						OptionThree: "Vote for us",
+					},
+				)
+				msg.TallyStrategy = v1.TallyStrategy_TALLY_STRATEGY_RANKED_CHOICE
+				return msg, err
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -376,6 +414,10 @@ func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposal() {
 			} else {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res.ProposalId)
+
+				proposal, err := suite.govKeeper.Proposals.Get(suite.ctx, res.ProposalId)
+				suite.Require().NoError(err)
+				suite.Require().Equal(msg.TallyStrategy, proposal.TallyStrategy)
 			}
 		})
 	}
@@ -664,6 +706,118 @@ func (suite *KeeperTestSuite) TestMsgVote() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgVoteRanked() {
+	suite.reset()
+	addrs := suite.addrs
+	proposer := addrs[0]
+	proposerAddr, err := suite.acctKeeper.AddressCodec().BytesToString(proposer)
+	suite.Require().NoError(err)
+
+	params, _ := suite.govKeeper.Params.Get(suite.ctx)
+	minDeposit := params.MinDeposit
+
+	submitProposal := func(strategy v1.TallyStrategy) uint64 {
+		msg, err := v1.NewMultipleChoiceMsgSubmitProposal(
+			minDeposit,
+			proposerAddr,
+			"mandatory metadata",
+			"Proposal",
+			"description of proposal",
+			&v1.ProposalVoteOptions{
+				OptionOne:   "Vote for me",
+				OptionTwo:   "Vote for them",
+				OptionThree: "Vote for us",
+			},
+		)
+		suite.Require().NoError(err)
+		msg.TallyStrategy = strategy
+
+		res, err := suite.msgSrvr.SubmitMultipleChoiceProposal(suite.ctx, msg)
+		suite.Require().NoError(err)
+		return res.ProposalId
+	}
+	proposalID := submitProposal(v1.TallyStrategy_TALLY_STRATEGY_RANKED_CHOICE)
+
+	cases := map[string]struct {
+		preRun    func() uint64
+		ranking   []v1.VoteOption
+		voter     sdk.AccAddress
+		expErr    bool
+		expErrMsg string
+	}{
+		"empty voter": {
+			preRun:    func() uint64 { return proposalID },
+			ranking:   []v1.VoteOption{v1.OptionOne},
+			voter:     sdk.AccAddress{},
+			expErr:    true,
+			expErrMsg: "invalid voter address",
+		},
+		"plurality proposal": {
+			preRun: func() uint64 {
+				return submitProposal(v1.TallyStrategy_TALLY_STRATEGY_PLURALITY)
+			},
+			ranking:   []v1.VoteOption{v1.OptionOne},
+			voter:     proposer,
+			expErr:    true,
+			expErrMsg: "ranked votes are only allowed on ranked choice proposals",
+		},
+		"empty ranking": {
+			preRun:    func() uint64 { return proposalID },
+			ranking:   []v1.VoteOption{},
+			voter:     proposer,
+			expErr:    true,
+			expErrMsg: "ranking cannot be empty",
+		},
+		"option not defined by the proposal": {
+			preRun:    func() uint64 { return proposalID },
+			ranking:   []v1.VoteOption{v1.OptionOne, v1.OptionFour},
+			voter:     proposer,
+			expErr:    true,
+			expErrMsg: v1.OptionFour.String(),
+		},
+		"duplicated option": {
+			preRun:    func() uint64 { return proposalID },
+			ranking:   []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionOne},
+			voter:     proposer,
+			expErr:    true,
+			expErrMsg: "duplicated vote option",
+		},
+		"spam with other options": {
+			preRun:    func() uint64 { return proposalID },
+			ranking:   []v1.VoteOption{v1.OptionSpam, v1.OptionOne},
+			voter:     proposer,
+			expErr:    true,
+			expErrMsg: "a spam vote cannot rank other options",
+		},
+		"all good": {
+			preRun:  func() uint64 { return proposalID },
+			ranking: []v1.VoteOption{v1.OptionThree, v1.OptionOne},
+			voter:   proposer,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			pID := tc.preRun()
+			voter, err := suite.acctKeeper.AddressCodec().BytesToString(tc.voter)
+			suite.Require().NoError(err)
+
+			_, err = suite.msgSrvr.VoteRanked(suite.ctx, v1.NewMsgVoteRanked(voter, pID, tc.ranking, ""))
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			vote, err := suite.govKeeper.Votes.Get(suite.ctx, collections.Join(pID, tc.voter))
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.ranking, vote.Ranking)
+			suite.Require().Equal(v1.WeightedVoteOptions(v1.NewNonSplitVoteOption(tc.ranking[0])), v1.WeightedVoteOptions(vote.Options))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMsgVoteWeighted() {
 	suite.reset()
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
@@ -22,7 +22,16 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 		k.config.CalculateVoteResultsAndVotingPowerFn = defaultCalculateVoteResultsAndVotingPower
 	}
 
-	totalVoterPower, results, err := k.config.CalculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
+	if k.config.CalculateRankedChoiceVoteResultsAndVotingPowerFn == nil {
+		k.config.CalculateRankedChoiceVoteResultsAndVotingPowerFn = defaultCalculateRankedChoiceVoteResultsAndVotingPower
+	}
+
+	calculateVoteResultsAndVotingPowerFn := k.config.CalculateVoteResultsAndVotingPowerFn
+	if proposal.IsRankedChoice() {
+		calculateVoteResultsAndVotingPowerFn = k.config.CalculateRankedChoiceVoteResultsAndVotingPowerFn
+	}
+
+	totalVoterPower, results, err := calculateVoteResultsAndVotingPowerFn(ctx, k, proposal.Id, validators)
 	if err != nil {
 		return false, false, v1.TallyResult{}, err
 	}
@@ -232,7 +241,7 @@ func (k Keeper) getCurrentValidators(ctx context.Context) (map[string]v1.Validat
 	return currValidators, nil
 }
 
-// calculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
+// defaultCalculateVoteResultsAndVotingPower iterate over all votes, tally up the voting power of each validator
 // and returns the votes results from voters
 func defaultCalculateVoteResultsAndVotingPower(
 	ctx context.Context,
@@ -243,28 +252,142 @@ func defaultCalculateVoteResultsAndVotingPower(
 	totalVP := math.LegacyZeroDec()
 	results := createEmptyResults()
 
-	// iterate over all votes, tally up the voting power of each validator
-	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
-	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
-	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
-		// if validator, just record it in the map
-		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
-		if err != nil {
-			return false, err
+	err := iterateVotesVotingPower(ctx, k, proposalID, validators, func(vote v1.Vote, votingPower math.LegacyDec) {
+		for _, option := range vote.Options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			subPower := votingPower.Mul(weight)
+			results[option.Option] = results[option.Option].Add(subPower)
 		}
+		totalVP = totalVP.Add(votingPower)
+	})
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
 
-		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
-		if err != nil {
-			return false, err
+	return totalVP, results, nil
+}
+
+// defaultCalculateRankedChoiceVoteResultsAndVotingPower tallies the votes of a ranked choice proposal by instant-runoff.
+// Each round, the votes count for the first option of their ranking still in the running, and the option with the least
+// voting power is eliminated, until an option gathers more than half of the voting power of the votes still counting.
+// The results hold the voting power of each option still in the running in the last round, so the winning option has
+// the most, and eliminated options are reported with no voting power.
+// Votes without ranking only count for the options they were cast for, and votes ranking spam first only count as spam.
+func defaultCalculateRankedChoiceVoteResultsAndVotingPower(
+	ctx context.Context,
+	k Keeper,
+	proposalID uint64,
+	validators map[string]v1.ValidatorGovInfo,
+) (math.LegacyDec, map[v1.VoteOption]math.LegacyDec, error) {
+	proposalOptions, err := k.ProposalVoteOptions.Get(ctx, proposalID)
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	type ballot struct {
+		ranking     []v1.VoteOption
+		votingPower math.LegacyDec
+	}
+
+	var ballots []ballot
+	totalVP := math.LegacyZeroDec()
+	results := createEmptyResults()
+
+	err = iterateVotesVotingPower(ctx, k, proposalID, validators, func(vote v1.Vote, votingPower math.LegacyDec) {
+		totalVP = totalVP.Add(votingPower)
+
+		if len(vote.Ranking) > 0 {
+			if vote.Ranking[0] == v1.OptionSpam {
+				results[v1.OptionSpam] = results[v1.OptionSpam].Add(votingPower)
+				return
+			}
+
+			ballots = append(ballots, ballot{ranking: vote.Ranking, votingPower: votingPower})
+			return
 		}
 
-		if val, ok := validators[valAddrStr]; ok {
-			val.Vote = vote.Options
-			validators[valAddrStr] = val
+		for _, option := range vote.Options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			subPower := votingPower.Mul(weight)
+			if option.Option == v1.OptionSpam {
+				results[v1.OptionSpam] = results[v1.OptionSpam].Add(subPower)
+				continue
+			}
+
+			ballots = append(ballots, ballot{ranking: []v1.VoteOption{option.Option}, votingPower: subPower})
+		}
+	})
+	if err != nil {
+		return math.LegacyDec{}, nil, err
+	}
+
+	var running []v1.VoteOption
+	for _, option := range []v1.VoteOption{v1.OptionOne, v1.OptionTwo, v1.OptionThree, v1.OptionFour} {
+		if isProposalVoteOption(proposalOptions, option) {
+			running = append(running, option)
+		}
+	}
+
+	for len(running) > 0 {
+		counts := make(map[v1.VoteOption]math.LegacyDec, len(running))
+		for _, option := range running {
+			counts[option] = math.LegacyZeroDec()
+		}
+
+		countingVP := math.LegacyZeroDec()
+		for _, b := range ballots {
+			for _, option := range b.ranking {
+				if count, ok := counts[option]; ok {
+					counts[option] = count.Add(b.votingPower)
+					countingVP = countingVP.Add(b.votingPower)
+					break
+				}
+			}
 		}
 
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
+		majority := false
+		for _, option := range running {
+			results[option] = counts[option]
+			majority = majority || counts[option].MulInt64(2).GT(countingVP)
+		}
+
+		if majority || len(running) == 1 {
+			break
+		}
+
+		// eliminate the option with the least voting power, the last one in case of a tie
+		eliminated := 0
+		for i, option := range running {
+			if counts[option].LTE(counts[running[eliminated]]) {
+				eliminated = i
+			}
+		}
+		// an eliminated option must not tie with the winner
+		results[running[eliminated]] = math.LegacyZeroDec()
+		running = append(running[:eliminated], running[eliminated+1:]...)
+	}
+
+	return totalVP, results, nil
+}
+
+// iterateVotesVotingPower iterates over all the votes of a proposal, removing them from the store, and calls fn
+// with each vote and the voting power it carries. The voting power of delegators voting is deducted from the voting
+// power of the validators they delegate to, and each vote of a validator is only called with its remaining power.
+// The vote of a governor is also called with the voting power of each of its delegators who did not vote directly.
+func iterateVotesVotingPower(
+	ctx context.Context,
+	k Keeper,
+	proposalID uint64,
+	validators map[string]v1.ValidatorGovInfo,
+	fn func(vote v1.Vote, votingPower math.LegacyDec),
+) error {
+	validatorVotes := make(map[string]v1.Vote)
+	voters := make(map[string]struct{})
+	var governorVotes []v1.Vote
+
+	// iterate over all delegations from voter, deduct from any delegated-to validators
+	tallyDelegations := func(voter sdk.AccAddress, vote v1.Vote) error {
+		return k.sk.IterateDelegations(ctx, voter, func(index int64, delegation sdk.DelegationI) (stop bool) {
 			valAddrStr := delegation.GetValidatorAddr()
 
 			if val, ok := validators[valAddrStr]; ok {
@@ -275,53 +398,89 @@ func defaultCalculateVoteResultsAndVotingPower(
 
 				// delegation shares * bonded / total shares
 				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-				for _, option := range vote.Options {
-					weight, _ := math.LegacyNewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
-
-				totalVP = totalVP.Add(votingPower)
+				fn(vote, votingPower)
 			}
 
 			return false
 		})
+	}
+
+	// iterate over all votes, tally up the voting power of each validator
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
+	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
+	if err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		// if validator, just record it in the map
+		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
+		if err != nil {
+			return false, err
+		}
+
+		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
 		if err != nil {
 			return false, err
 		}
 
+		if val, ok := validators[valAddrStr]; ok {
+			val.Vote = vote.Options
+			validators[valAddrStr] = val
+			validatorVotes[valAddrStr] = vote
+		}
+
+		// if governor, record it to tally the voting power of its delegators
+		isGovernor, err := k.Governors.Has(ctx, voter)
+		if err != nil {
+			return false, err
+		}
+		if isGovernor {
+			governorVotes = append(governorVotes, vote)
+		}
+		voters[string(voter)] = struct{}{}
+
+		if err := tallyDelegations(voter, vote); err != nil {
+			return false, err
+		}
+
 		votesToRemove = append(votesToRemove, key)
 		return false, nil
 	}); err != nil {
-		return math.LegacyDec{}, nil, err
+		return err
+	}
+
+	// iterate over the delegators of the governors who voted, a direct vote overrides the governor vote
+	for _, vote := range governorVotes {
+		governor, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
+		if err != nil {
+			return err
+		}
+
+		rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](governor)
+		if err := k.GovernorDelegators.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress]) (bool, error) {
+			if _, voted := voters[string(key.K2())]; voted {
+				return false, nil
+			}
+
+			return false, tallyDelegations(key.K2(), vote)
+		}); err != nil {
+			return err
+		}
 	}
 
 	// remove all votes from store
 	for _, key := range votesToRemove {
 		if err := k.Votes.Remove(ctx, key); err != nil {
-			return math.LegacyDec{}, nil, err
+			return err
 		}
 	}
 
 	// iterate over the validators again to tally their voting power
-	for _, val := range validators {
-		if len(val.Vote) == 0 {
-			continue
-		}
-
+	for valAddrStr, vote := range validatorVotes {
+		val := validators[valAddrStr]
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
 		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-		for _, option := range val.Vote {
-			weight, _ := math.LegacyNewDecFromStr(option.Weight)
-			subPower := votingPower.Mul(weight)
-			results[option.Option] = results[option.Option].Add(subPower)
-		}
-		totalVP = totalVP.Add(votingPower)
+		fn(vote, votingPower)
 	}
 
-	return totalVP, results, nil
+	return nil
 }
 
 func createEmptyResults() map[v1.VoteOption]math.LegacyDec {
@@ -52,6 +52,27 @@ var (
 		// validatorVote is like delegatorVote but without delegations
 		delegatorVote(s, sdk.AccAddress(voter), nil, vote)
 	}
+	governanceDelegation = func(s tallyFixture, delegator, governor sdk.AccAddress, delegations []stakingtypes.Delegation) {
+		// governanceDelegation is for delegators not voting, whose delegations are tallied for their governor vote
+		err := s.keeper.DelegateGovernor(s.ctx, delegator, governor)
+		require.NoError(s.t, err)
+		s.mocks.stakingKeeper.EXPECT().
+			IterateDelegations(s.ctx, delegator, gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, voter sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+					for i, d := range delegations {
+						fn(int64(i), d)
+					}
+					return nil
+				})
+	}
+	validatorRankedVote = func(s tallyFixture, voter sdk.ValAddress, ranking ...v1.VoteOption) {
+		err := s.keeper.AddRankedVote(s.ctx, s.proposal.Id, sdk.AccAddress(voter), ranking, "")
+		require.NoError(s.t, err)
+		s.mocks.stakingKeeper.EXPECT().
+			IterateDelegations(s.ctx, sdk.AccAddress(voter), gomock.Any()).
+			Return(nil)
+	}
 )
 
 func TestTally_Standard(t *testing.T) {
@@ -455,6 +476,74 @@ func TestTally_Standard(t *testing.T) {
 				SpamCount:        "0",
 			},
 		},
+		{
+			// governor delegates 42 shares to the first validator and votes yes
+			// first delegator delegates 10 shares to the second validator and does not vote
+			// second delegator delegates 10 shares to the second validator and votes no
+			// second validator votes abstain
+			name: "governor votes for its delegators, direct vote overrides: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				val0Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[0])
+				require.NoError(t, err)
+				val1Addr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(s.valAddrs[1])
+				require.NoError(t, err)
+				delegation := func(delAddr sdk.AccAddress, valAddr string, shares int64) []stakingtypes.Delegation {
+					del, err := s.mocks.acctKeeper.AddressCodec().BytesToString(delAddr)
+					require.NoError(t, err)
+					return []stakingtypes.Delegation{{
+						DelegatorAddress: del,
+						ValidatorAddress: valAddr,
+						Shares:           sdkmath.LegacyNewDec(shares),
+					}}
+				}
+
+				governor := s.delAddrs[0]
+				require.NoError(t, s.keeper.CreateGovernor(s.ctx, governor, ""))
+				governanceDelegation(s, s.delAddrs[1], governor, delegation(s.delAddrs[1], val1Addr, 10))
+				require.NoError(t, s.keeper.DelegateGovernor(s.ctx, s.delAddrs[2], governor))
+
+				delegatorVote(s, governor, delegation(governor, val0Addr, 42), v1.VoteOption_VOTE_OPTION_ONE)
+				delegatorVote(s, s.delAddrs[2], delegation(s.delAddrs[2], val1Addr, 10), v1.VoteOption_VOTE_OPTION_THREE)
+				validatorVote(s, s.valAddrs[1], v1.VoteOption_VOTE_OPTION_TWO)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "52",
+				AbstainCount:     "999980",
+				NoCount:          "10",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "52",
+				OptionTwoCount:   "999980",
+				OptionThreeCount: "10",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "governor does not vote: delegators are not tallied",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				governor := s.delAddrs[0]
+				require.NoError(t, s.keeper.CreateGovernor(s.ctx, governor, ""))
+				require.NoError(t, s.keeper.DelegateGovernor(s.ctx, s.delAddrs[1], governor))
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "0",
+				AbstainCount:     "0",
+				NoCount:          "1000000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "0",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "1000000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1546,3 +1635,231 @@ func TestTally_MultipleChoice(t *testing.T) {
 		})
 	}
 }
+
+func TestTally_RankedChoice(t *testing.T) {
+	tests := []struct {
+		name          string
+		setup         func(tallyFixture)
+		expectedPass  bool
+		expectedBurn  bool
+		expectedTally v1.TallyResult
+	}{
+		{
+			name: "no votes: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+			},
+			expectedPass: false,
+			expectedBurn: true, // burn because quorum not reached
+			expectedTally: v1.TallyResult{
+				YesCount:         "0",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "0",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "majority of first preferences: option wins in the first round",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				validatorRankedVote(s, s.valAddrs[0], v1.OptionOne, v1.OptionTwo)
+				validatorRankedVote(s, s.valAddrs[1], v1.OptionOne, v1.OptionThree)
+				validatorRankedVote(s, s.valAddrs[2], v1.OptionOne)
+				validatorRankedVote(s, s.valAddrs[3], v1.OptionThree, v1.OptionOne)
+			},
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "3000000",
+				AbstainCount:     "0",
+				NoCount:          "1000000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "3000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "1000000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			// option four is eliminated first and transfers to option three,
+			// then option two is eliminated and transfers to option three
+			name: "no majority of first preferences: eliminated options transfer to next preferences",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				validatorRankedVote(s, s.valAddrs[0], v1.OptionOne)
+				validatorRankedVote(s, s.valAddrs[1], v1.OptionOne)
+				validatorRankedVote(s, s.valAddrs[2], v1.OptionOne)
+				validatorRankedVote(s, s.valAddrs[3], v1.OptionTwo, v1.OptionThree)
+				validatorRankedVote(s, s.valAddrs[4], v1.OptionTwo, v1.OptionThree)
+				validatorRankedVote(s, s.valAddrs[5], v1.OptionThree, v1.OptionTwo)
+				validatorRankedVote(s, s.valAddrs[6], v1.OptionThree, v1.OptionTwo)
+				validatorRankedVote(s, s.valAddrs[7], v1.OptionFour, v1.OptionThree)
+			},
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "3000000",
+				AbstainCount:     "0",
+				NoCount:          "5000000",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "3000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "5000000",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			// all options tie in the first round, option three is eliminated as the last one,
+			// transferring the ranked vote to option one while the other vote is exhausted
+			name: "votes without ranking only count for their option",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				validatorVote(s, s.valAddrs[0], v1.OptionOne)
+				validatorRankedVote(s, s.valAddrs[1], v1.OptionTwo, v1.OptionOne)
+				validatorRankedVote(s, s.valAddrs[2], v1.OptionThree, v1.OptionOne)
+				validatorVote(s, s.valAddrs[3], v1.OptionThree)
+				validatorVote(s, s.valAddrs[4], v1.OptionTwo)
+				validatorVote(s, s.valAddrs[5], v1.OptionOne)
+			},
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "3000000",
+				AbstainCount:     "2000000",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "3000000",
+				OptionTwoCount:   "2000000",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			// option four is eliminated first, then options three and two, tying with option one
+			// as their votes are exhausted: only the winner holds voting power in the results
+			name: "eliminated options tying with the winner: winner has the most voting power",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				for i := 0; i < 3; i++ {
+					validatorRankedVote(s, s.valAddrs[i], v1.OptionOne)
+					validatorRankedVote(s, s.valAddrs[3+i], v1.OptionTwo)
+					validatorRankedVote(s, s.valAddrs[6+i], v1.OptionThree)
+				}
+			},
+			expectedPass: true,
+			expectedBurn: false,
+			expectedTally: v1.TallyResult{
+				YesCount:         "3000000",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "3000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "0",
+			},
+		},
+		{
+			name: "quorum reached with spam > all other votes: prop fails/burn deposit",
+			setup: func(s tallyFixture) {
+				setTotalBonded(s, 10000000)
+				validatorRankedVote(s, s.valAddrs[0], v1.OptionOne, v1.OptionTwo)
+				validatorRankedVote(s, s.valAddrs[1], v1.OptionSpam)
+				validatorRankedVote(s, s.valAddrs[2], v1.OptionSpam)
+				validatorVote(s, s.valAddrs[3], v1.OptionSpam)
+				validatorRankedVote(s, s.valAddrs[4], v1.OptionSpam)
+			},
+			expectedPass: false,
+			expectedBurn: true,
+			expectedTally: v1.TallyResult{
+				YesCount:         "1000000",
+				AbstainCount:     "0",
+				NoCount:          "0",
+				NoWithVetoCount:  "0",
+				OptionOneCount:   "1000000",
+				OptionTwoCount:   "0",
+				OptionThreeCount: "0",
+				OptionFourCount:  "0",
+				SpamCount:        "4000000",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			params.BurnVoteQuorum = true
+			params.BurnVoteVeto = true
+			err := govKeeper.Params.Set(ctx, params)
+			require.NoError(t, err)
+			var (
+				numVals       = 10
+				numDelegators = 5
+				addrs         = simtestutil.CreateRandomAccounts(numVals + numDelegators)
+				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+				delAddrs      = addrs[numVals:]
+			)
+			// Mocks a bunch of validators
+			mocks.stakingKeeper.EXPECT().
+				IterateBondedValidatorsByPower(ctx, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+						for i := int64(0); i < int64(numVals); i++ {
+							valAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[i])
+							require.NoError(t, err)
+							fn(i, stakingtypes.Validator{
+								OperatorAddress: valAddr,
+								Status:          stakingtypes.Bonded,
+								Tokens:          sdkmath.NewInt(1000000),
+								DelegatorShares: sdkmath.LegacyNewDec(1000000),
+							})
+						}
+						return nil
+					})
+
+			// Submit and activate a ranked choice proposal
+			proposal, err := govKeeper.SubmitProposal(ctx, nil, "", "title", "summary", delAddrs[0], v1.ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE)
+			require.NoError(t, err)
+			err = govKeeper.ProposalVoteOptions.Set(ctx, proposal.Id, v1.ProposalVoteOptions{
+				OptionOne:   "Vote Option 1",
+				OptionTwo:   "Vote Option 2",
+				OptionThree: "Vote Option 3",
+				OptionFour:  "Vote Option 4",
+			})
+			require.NoError(t, err)
+			proposal.TallyStrategy = v1.TallyStrategy_TALLY_STRATEGY_RANKED_CHOICE
+			err = govKeeper.Proposals.Set(ctx, proposal.Id, proposal)
+			require.NoError(t, err)
+			err = govKeeper.ActivateVotingPeriod(ctx, proposal)
+			require.NoError(t, err)
+			proposal, err = govKeeper.Proposals.Get(ctx, proposal.Id)
+			require.NoError(t, err)
+			suite := tallyFixture{
+				t:        t,
+				proposal: proposal,
+				valAddrs: valAddrs,
+				delAddrs: delAddrs,
+				ctx:      ctx,
+				keeper:   govKeeper,
+				mocks:    mocks,
+			}
+			tt.setup(suite)
+
+			pass, burn, tally, err := govKeeper.Tally(ctx, proposal)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPass, pass, "wrong pass")
+			assert.Equal(t, tt.expectedBurn, burn, "wrong burn")
+			assert.Equal(t, tt.expectedTally, tally)
+		})
+	}
+}
@@ -57,13 +57,7 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 			}
 
 			// verify votes only on existing votes
-			if proposalOptionsStr.OptionOne == "" && option.Option == v1.OptionOne {
-				return errors.Wrap(types.ErrInvalidVote, "invalid vote option")
-			} else if proposalOptionsStr.OptionTwo == "" && option.Option == v1.OptionTwo {
-				return errors.Wrap(types.ErrInvalidVote, "invalid vote option")
-			} else if proposalOptionsStr.OptionThree == "" && option.Option == v1.OptionThree {
-				return errors.Wrap(types.ErrInvalidVote, "invalid vote option")
-			} else if proposalOptionsStr.OptionFour == "" && option.Option == v1.OptionFour {
+			if !isProposalVoteOption(proposalOptionsStr, option.Option) {
 				return errors.Wrap(types.ErrInvalidVote, "invalid vote option")
 			}
 		}
@@ -77,24 +71,110 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 	if err != nil {
 		return err
 	}
-	vote := v1.NewVote(proposalID, voterStrAddr, options, metadata)
-	err = k.Votes.Set(ctx, collections.Join(proposalID, voterAddr), vote)
+
+	return k.setVote(ctx, voterAddr, v1.NewVote(proposalID, voterStrAddr, options, metadata))
+}
+
+// AddRankedVote adds a vote ranking the options of a multiple choice proposal
+// using the ranked choice tally strategy, from the most to the least preferred.
+// A spam vote cannot rank any other option.
+func (k Keeper) AddRankedVote(ctx context.Context, proposalID uint64, voterAddr sdk.AccAddress, ranking []v1.VoteOption, metadata string) error {
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+		}
+
+		return err
+	}
+
+	if proposal.Status != v1.StatusVotingPeriod {
+		return errors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	if !proposal.IsRankedChoice() {
+		return errors.Wrap(types.ErrInvalidVote, "ranked votes are only allowed on ranked choice proposals")
+	}
+
+	if err := k.assertMetadataLength(metadata); err != nil {
+		return err
+	}
+
+	if len(ranking) == 0 {
+		return errors.Wrap(types.ErrInvalidVote, "ranking cannot be empty")
+	}
+
+	proposalOptionsStr, err := k.ProposalVoteOptions.Get(ctx, proposalID)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return errors.Wrap(types.ErrInvalidProposal, "invalid multiple choice proposal, no options set")
+		}
+
+		return err
+	}
+
+	usedOptions := make(map[v1.VoteOption]bool)
+	for _, option := range ranking {
+		if !v1.ValidVoteOption(option) || !isProposalVoteOption(proposalOptionsStr, option) {
+			return errors.Wrap(types.ErrInvalidVote, option.String())
+		}
+		if option == v1.OptionSpam && len(ranking) > 1 {
+			return errors.Wrap(types.ErrInvalidVote, "a spam vote cannot rank other options")
+		}
+		if usedOptions[option] {
+			return errors.Wrap(types.ErrInvalidVote, "duplicated vote option")
+		}
+		usedOptions[option] = true
+	}
+
+	voterStrAddr, err := k.authKeeper.AddressCodec().BytesToString(voterAddr)
+	if err != nil {
+		return err
+	}
+
+	// the first preference is stored as the vote option, for clients unaware of rankings
+	vote := v1.NewVote(proposalID, voterStrAddr, v1.NewNonSplitVoteOption(ranking[0]), metadata)
+	vote.Ranking = ranking
+
+	return k.setVote(ctx, voterAddr, vote)
+}
+
+// setVote stores a vote, calls the AfterProposalVote hook and emits the vote event.
+func (k Keeper) setVote(ctx context.Context, voterAddr sdk.AccAddress, vote v1.Vote) error {
+	err := k.Votes.Set(ctx, collections.Join(vote.ProposalId, voterAddr), vote)
 	if err != nil {
 		return err
 	}
 
 	// called after a vote on a proposal is cast
-	if err = k.Hooks().AfterProposalVote(ctx, proposalID, voterAddr); err != nil {
+	if err = k.Hooks().AfterProposalVote(ctx, vote.ProposalId, voterAddr); err != nil {
 		return err
 	}
 
 	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeProposalVote,
-		event.NewAttribute(types.AttributeKeyVoter, voterStrAddr),
-		event.NewAttribute(types.AttributeKeyOption, options.String()),
-		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+		event.NewAttribute(types.AttributeKeyVoter, vote.Voter),
+		event.NewAttribute(types.AttributeKeyOption, v1.WeightedVoteOptions(vote.Options).String()),
+		event.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", vote.ProposalId)),
 	)
 }
 
+// isProposalVoteOption returns false if the option is not one of the options
+// defined by a multiple choice proposal.
+func isProposalVoteOption(proposalOptions v1.ProposalVoteOptions, option v1.VoteOption) bool {
+	switch option {
+	case v1.OptionOne:
+		return proposalOptions.OptionOne != ""
+	case v1.OptionTwo:
+		return proposalOptions.OptionTwo != ""
+	case v1.OptionThree:
+		return proposalOptions.OptionThree != ""
+	case v1.OptionFour:
+		return proposalOptions.OptionFour != ""
+	default:
+		return true
+	}
+}
+
 // deleteVotes deletes all the votes from a given proposalID.
 func (k Keeper) deleteVotes(ctx context.Context, proposalID uint64) error {
 	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
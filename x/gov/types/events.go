@@ -2,12 +2,16 @@ package types
 
 // Governance module event types
 const (
-	EventTypeSubmitProposal   = "submit_proposal"
-	EventTypeProposalDeposit  = "proposal_deposit"
-	EventTypeProposalVote     = "proposal_vote"
-	EventTypeInactiveProposal = "inactive_proposal"
-	EventTypeActiveProposal   = "active_proposal"
-	EventTypeCancelProposal   = "cancel_proposal"
+	EventTypeSubmitProposal     = "submit_proposal"
+	EventTypeProposalDeposit    = "proposal_deposit"
+	EventTypeProposalVote       = "proposal_vote"
+	EventTypeInactiveProposal   = "inactive_proposal"
+	EventTypeActiveProposal     = "active_proposal"
+	EventTypeCancelProposal     = "cancel_proposal"
+	EventTypeCreateGovernor     = "create_governor"
+	EventTypeEditGovernor       = "edit_governor"
+	EventTypeDelegateGovernor   = "delegate_governor"
+	EventTypeUndelegateGovernor = "undelegate_governor"
 
 	AttributeKeyProposalResult       = "proposal_result"
 	AttributeKeyVoter                = "voter"
@@ -19,6 +23,8 @@ const (
 	AttributeKeyProposalLog          = "proposal_log"           // log of proposal execution
 	AttributeKeyProposalDepositError = "proposal_deposit_error" // error on proposal deposit refund/burn
 	AttributeKeyProposalProposer     = "proposal_proposer"      // account address of the proposer
+	AttributeKeyGovernor             = "governor"
+	AttributeKeyDelegator            = "delegator"
 
 	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
 	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
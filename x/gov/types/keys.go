@@ -26,6 +26,9 @@ var (
 	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
 	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
 	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	GovernorsKeyPrefix           = collections.NewPrefix(52) // GovernorsKeyPrefix stores the governors.
+	GovernanceDelegationsPrefix  = collections.NewPrefix(53) // GovernanceDelegationsPrefix stores the governance delegations by delegator.
+	GovernorDelegatorsPrefix     = collections.NewPrefix(54) // GovernorDelegatorsPrefix stores the governance delegations by governor.
 )
 
 // Reserved kvstore keys
@@ -16,6 +16,11 @@ func RegisterLegacyAminoCodec(registrar registry.AminoRegistrar) {
 	legacy.RegisterAminoMsg(registrar, &MsgDeposit{}, "cosmos-sdk/v1/MsgDeposit")
 	legacy.RegisterAminoMsg(registrar, &MsgVote{}, "cosmos-sdk/v1/MsgVote")
 	legacy.RegisterAminoMsg(registrar, &MsgVoteWeighted{}, "cosmos-sdk/v1/MsgVoteWeighted")
+	legacy.RegisterAminoMsg(registrar, &MsgVoteRanked{}, "cosmos-sdk/v1/MsgVoteRanked")
+	legacy.RegisterAminoMsg(registrar, &MsgCreateGovernor{}, "cosmos-sdk/v1/MsgCreateGovernor")
+	legacy.RegisterAminoMsg(registrar, &MsgEditGovernor{}, "cosmos-sdk/v1/MsgEditGovernor")
+	legacy.RegisterAminoMsg(registrar, &MsgDelegateGovernor{}, "cosmos-sdk/v1/MsgDelegateGovernor")
+	legacy.RegisterAminoMsg(registrar, &MsgUndelegateGovernor{}, "cosmos-sdk/v1/MsgUndelegateGovernor")
 	legacy.RegisterAminoMsg(registrar, &MsgExecLegacyContent{}, "cosmos-sdk/v1/MsgExecLegacyContent")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
 	legacy.RegisterAminoMsg(registrar, &MsgUpdateMessageParams{}, "x/gov/v1/MsgUpdateMessageParams")
@@ -29,6 +34,11 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgSubmitMultipleChoiceProposal{},
 		&MsgVote{},
 		&MsgVoteWeighted{},
+		&MsgVoteRanked{},
+		&MsgCreateGovernor{},
+		&MsgEditGovernor{},
+		&MsgDelegateGovernor{},
+		&MsgUndelegateGovernor{},
 		&MsgDeposit{},
 		&MsgExecLegacyContent{},
 		&MsgUpdateParams{},
@@ -98,6 +98,45 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate governors and invalid governance delegations
+	errGroup.Go(func() error {
+		governors := make(map[string]struct{})
+		for _, g := range data.Governors {
+			if _, err := ac.StringToBytes(g.Address); err != nil {
+				return fmt.Errorf("invalid governor address %s: %w", g.Address, err)
+			}
+
+			if _, ok := governors[g.Address]; ok {
+				return fmt.Errorf("duplicate governor: %s", g.Address)
+			}
+
+			governors[g.Address] = struct{}{}
+		}
+
+		delegators := make(map[string]struct{})
+		for _, d := range data.GovernanceDelegations {
+			if _, err := ac.StringToBytes(d.DelegatorAddress); err != nil {
+				return fmt.Errorf("invalid delegator address %s: %w", d.DelegatorAddress, err)
+			}
+
+			if _, ok := governors[d.GovernorAddress]; !ok {
+				return fmt.Errorf("governance delegation %v has non-existent governor: %s", d, d.GovernorAddress)
+			}
+
+			if _, ok := governors[d.DelegatorAddress]; ok {
+				return fmt.Errorf("governor %s cannot delegate its governance voting power", d.DelegatorAddress)
+			}
+
+			if _, ok := delegators[d.DelegatorAddress]; ok {
+				return fmt.Errorf("duplicate governance delegation: %v", d)
+			}
+
+			delegators[d.DelegatorAddress] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
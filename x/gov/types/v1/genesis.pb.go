@@ -49,6 +49,10 @@ type GenesisState struct {
 	// There are no amendments, to go outside of scope, just fork.
 	// constitution is an immutable string in genesis for a chain builder to lay out their vision, ideas and ideals.
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// governors defines all the governors present at genesis.
+	Governors []*Governor `protobuf:"bytes,10,rep,name=governors,proto3" json:"governors,omitempty"`
+	// governance_delegations defines all the governance delegations present at genesis.
+	GovernanceDelegations []*GovernanceDelegation `protobuf:"bytes,11,rep,name=governance_delegations,json=governanceDelegations,proto3" json:"governance_delegations,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +154,20 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetGovernors() []*Governor {
+	if m != nil {
+		return m.Governors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernanceDelegations() []*GovernanceDelegation {
+	if m != nil {
+		return m.GovernanceDelegations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -157,32 +175,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbd, 0x6e, 0xe2, 0x40,
-	0x14, 0x85, 0x19, 0xfe, 0x16, 0x06, 0xb3, 0x2b, 0x0d, 0xbb, 0x8b, 0x17, 0x56, 0x96, 0xb5, 0x95,
-	0xb7, 0xc0, 0x06, 0x12, 0x44, 0x1d, 0x84, 0x84, 0xd2, 0x21, 0x27, 0x4a, 0x91, 0x06, 0x39, 0xd8,
-	0xb2, 0x2c, 0xc0, 0x63, 0x71, 0x27, 0xa3, 0xf0, 0x16, 0x79, 0x89, 0xbc, 0x41, 0x1e, 0x22, 0x25,
-	0x65, 0x94, 0x2a, 0x82, 0x17, 0x89, 0x98, 0xb1, 0x03, 0x38, 0x94, 0x73, 0xcf, 0x77, 0xce, 0x1c,
-	0x5d, 0x5d, 0xdc, 0x9c, 0x52, 0x58, 0x50, 0xb0, 0x7c, 0xca, 0x2d, 0xde, 0xb1, 0x7c, 0x2f, 0xf4,
-	0x20, 0x00, 0x33, 0x5a, 0x52, 0x46, 0x49, 0x55, 0x8a, 0xa6, 0x4f, 0xb9, 0xc9, 0x3b, 0x8d, 0x7a,
-	0x8a, 0xa5, 0x5c, 0x72, 0x8d, 0x3f, 0x52, 0x98, 0x88, 0x97, 0x15, 0x9b, 0xc4, 0xe3, 0xdf, 0x53,
-	0x1e, 0x2b, 0x23, 0x19, 0x7a, 0xc5, 0x1c, 0xe6, 0x91, 0x36, 0xfe, 0x09, 0xcc, 0x59, 0xb2, 0x20,
-	0xf4, 0x77, 0x7c, 0x44, 0xc1, 0x99, 0x4f, 0x02, 0x57, 0x45, 0x3a, 0x32, 0xf2, 0x36, 0x49, 0xb4,
-	0x71, 0x2c, 0x5d, 0xba, 0xa4, 0x8b, 0x4b, 0xae, 0x17, 0x51, 0x08, 0x18, 0xa8, 0x59, 0x3d, 0x67,
-	0x54, 0xba, 0xbf, 0xcd, 0xa3, 0x62, 0xe6, 0x50, 0xca, 0xf6, 0x27, 0x47, 0xfe, 0xe3, 0x02, 0xa7,
-	0xcc, 0x03, 0x35, 0x27, 0x0c, 0xb5, 0x94, 0xe1, 0x86, 0x32, 0xcf, 0x96, 0x04, 0xe9, 0xe1, 0x72,
-	0xd2, 0x03, 0xd4, 0xbc, 0xc0, 0xeb, 0x29, 0x3c, 0x29, 0x63, 0xef, 0x49, 0x32, 0xc2, 0xdf, 0xe3,
-	0xdf, 0x26, 0x91, 0xb3, 0x74, 0x16, 0xa0, 0x16, 0x74, 0x64, 0x54, 0xba, 0x7f, 0x4f, 0x77, 0x1b,
-	0x0b, 0x66, 0x90, 0x55, 0x91, 0x5d, 0x75, 0x0f, 0x47, 0x64, 0x88, 0xab, 0x9c, 0xca, 0x75, 0xc8,
-	0x9c, 0xa2, 0xc8, 0x69, 0x7e, 0xad, 0xbc, 0x5b, 0xcb, 0x3e, 0x46, 0xe1, 0x07, 0x13, 0x72, 0x81,
-	0x15, 0xe6, 0xcc, 0xe7, 0xab, 0x24, 0xe4, 0x9b, 0x08, 0x69, 0xa4, 0x42, 0xae, 0x77, 0xc8, 0x41,
-	0x46, 0x85, 0xed, 0x07, 0x64, 0x80, 0x8b, 0xb1, 0xb9, 0x24, 0xcc, 0xbf, 0xd2, 0x5b, 0x90, 0xbe,
-	0xda, 0xdb, 0x73, 0xeb, 0x87, 0x54, 0x5a, 0xe0, 0xce, 0xf4, 0xb6, 0x79, 0xde, 0xb7, 0x63, 0x27,
-	0xe9, 0x63, 0x65, 0x4a, 0x43, 0x60, 0x01, 0xbb, 0x67, 0x01, 0x0d, 0xd5, 0xb2, 0x8e, 0x8c, 0xf2,
-	0x09, 0x4b, 0xaf, 0x6d, 0x1f, 0x81, 0x83, 0xde, 0xcb, 0x46, 0x43, 0xeb, 0x8d, 0x86, 0xde, 0x37,
-	0x1a, 0x7a, 0xdc, 0x6a, 0x99, 0xf5, 0x56, 0xcb, 0xbc, 0x6e, 0xb5, 0xcc, 0x6d, 0x7c, 0xa1, 0xe0,
-	0xce, 0xcc, 0x80, 0x5a, 0x0f, 0xe2, 0xfa, 0xd8, 0x2a, 0xf2, 0xc0, 0xe2, 0x9d, 0xbb, 0xa2, 0xb8,
-	0xb2, 0xb3, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0xe0, 0xfa, 0xb1, 0xc7, 0x02, 0x00, 0x00,
+	// 462 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xeb, 0xb6, 0x09, 0xcd, 0xc6, 0x01, 0xb4, 0xa5, 0xed, 0x92, 0x22, 0xcb, 0x82, 0x4b,
+	0x38, 0x64, 0xed, 0x04, 0xa2, 0x9e, 0x89, 0x22, 0x45, 0xdc, 0x2a, 0x83, 0x38, 0x70, 0x89, 0x4c,
+	0xbc, 0xb2, 0xac, 0xba, 0x1e, 0xcb, 0xb3, 0xac, 0xe8, 0x5b, 0xf0, 0x30, 0x7d, 0x08, 0x8e, 0x3d,
+	0xa2, 0x8a, 0x03, 0x4a, 0x5e, 0x04, 0x79, 0xd7, 0x69, 0x52, 0xe3, 0xe3, 0xcc, 0xff, 0xfd, 0xff,
+	0x8c, 0xc6, 0x5e, 0x72, 0xbe, 0x04, 0xbc, 0x06, 0xf4, 0x62, 0x50, 0x9e, 0x1a, 0x79, 0xb1, 0xc8,
+	0x04, 0x26, 0xc8, 0xf3, 0x02, 0x24, 0xd0, 0x9e, 0x11, 0x79, 0x0c, 0x8a, 0xab, 0x51, 0xff, 0xac,
+	0xc6, 0x82, 0x32, 0x5c, 0xff, 0xa5, 0x11, 0x16, 0xba, 0xf2, 0x2a, 0x93, 0x2e, 0x5e, 0xff, 0x69,
+	0x11, 0x7b, 0x6e, 0x42, 0x3f, 0xc9, 0x50, 0x0a, 0xea, 0x93, 0x17, 0x28, 0xc3, 0x42, 0x26, 0x59,
+	0x5c, 0xf2, 0x39, 0x60, 0x98, 0x2e, 0x92, 0x88, 0x59, 0xae, 0x35, 0x38, 0x0c, 0xe8, 0x46, 0xbb,
+	0xac, 0xa4, 0x8f, 0x11, 0x1d, 0x93, 0xa3, 0x48, 0xe4, 0x80, 0x89, 0x44, 0xb6, 0xef, 0x1e, 0x0c,
+	0xba, 0xe3, 0x53, 0xfe, 0x68, 0x31, 0x3e, 0x33, 0x72, 0xf0, 0xc0, 0xd1, 0xb7, 0xa4, 0xa5, 0x40,
+	0x0a, 0x64, 0x07, 0xda, 0x70, 0x5c, 0x33, 0x7c, 0x01, 0x29, 0x02, 0x43, 0xd0, 0x09, 0xe9, 0x6c,
+	0xf6, 0x40, 0x76, 0xa8, 0xf1, 0xb3, 0x1a, 0xbe, 0x59, 0x26, 0xd8, 0x92, 0x74, 0x4e, 0x9e, 0x56,
+	0xd3, 0x16, 0x79, 0x58, 0x84, 0xd7, 0xc8, 0x5a, 0xae, 0x35, 0xe8, 0x8e, 0x5f, 0x35, 0xef, 0x76,
+	0xa9, 0x99, 0xe9, 0x3e, 0xb3, 0x82, 0x5e, 0xb4, 0xdb, 0xa2, 0x33, 0xd2, 0x53, 0x60, 0xce, 0x61,
+	0x72, 0xda, 0x3a, 0xe7, 0xfc, 0xff, 0x95, 0xcb, 0xb3, 0x6c, 0x63, 0x6c, 0xb5, 0xd3, 0xa1, 0x1f,
+	0x88, 0x2d, 0xc3, 0x34, 0xbd, 0xd9, 0x84, 0x3c, 0xd1, 0x21, 0xfd, 0x5a, 0xc8, 0xe7, 0x12, 0xd9,
+	0xc9, 0xe8, 0xca, 0x6d, 0x83, 0x4e, 0x49, 0xbb, 0x32, 0x1f, 0x69, 0xf3, 0x49, 0xfd, 0x0a, 0xc6,
+	0x77, 0x7c, 0x7f, 0x3b, 0x7c, 0x66, 0x94, 0x21, 0x46, 0x57, 0xae, 0xcf, 0xdf, 0x5f, 0x04, 0x95,
+	0x93, 0x5e, 0x10, 0x7b, 0x09, 0x19, 0xca, 0x44, 0x7e, 0x97, 0x09, 0x64, 0xac, 0xe3, 0x5a, 0x83,
+	0x4e, 0x83, 0x65, 0xe2, 0x07, 0x8f, 0x40, 0x3a, 0x27, 0x9d, 0x18, 0x94, 0x28, 0x32, 0x28, 0x90,
+	0x91, 0xc6, 0xaf, 0x30, 0xaf, 0xf4, 0xe9, 0xf3, 0xfb, 0xdb, 0xa1, 0xfd, 0xa3, 0xfc, 0xff, 0x5c,
+	0xe5, 0xf3, 0x31, 0xf7, 0x83, 0xad, 0x97, 0xa6, 0xe4, 0xd4, 0x14, 0x61, 0xb6, 0x14, 0x8b, 0x48,
+	0xa4, 0x22, 0x0e, 0xcb, 0x09, 0xc8, 0xba, 0x3a, 0xf5, 0x4d, 0x63, 0x6a, 0x09, 0xcf, 0x1e, 0xd8,
+	0x86, 0x09, 0x27, 0x71, 0x03, 0x87, 0xd3, 0xc9, 0xaf, 0x95, 0x63, 0xdd, 0xad, 0x1c, 0xeb, 0xef,
+	0xca, 0xb1, 0x7e, 0xae, 0x9d, 0xbd, 0xbb, 0xb5, 0xb3, 0xf7, 0x7b, 0xed, 0xec, 0x7d, 0xad, 0x1e,
+	0x16, 0x46, 0x57, 0x3c, 0x01, 0x4f, 0x67, 0x79, 0xf2, 0x26, 0x17, 0xe8, 0xa9, 0xd1, 0xb7, 0xb6,
+	0x7e, 0x1c, 0xef, 0xfe, 0x0d, 0x00, 0x6b, 0x79, 0xc2, 0xb4, 0x7e, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -205,6 +227,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GovernanceDelegations) > 0 {
+		for iNdEx := len(m.GovernanceDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernanceDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Governors) > 0 {
+		for iNdEx := len(m.Governors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Governors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -368,6 +418,18 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.Governors) > 0 {
+		for _, e := range m.Governors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovernanceDelegations) > 0 {
+		for _, e := range m.GovernanceDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -703,6 +765,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Governors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Governors = append(m.Governors, &Governor{})
+			if err := m.Governors[len(m.Governors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernanceDelegations = append(m.GovernanceDelegations, &GovernanceDelegation{})
+			if err := m.GovernanceDelegations[len(m.GovernanceDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
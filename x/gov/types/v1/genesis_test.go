@@ -23,6 +23,10 @@ func TestEmptyGenesis(t *testing.T) {
 func TestValidateGenesis(t *testing.T) {
 	codec := address.NewBech32Codec("cosmos")
 	params := v1.DefaultParams()
+	governor, err := codec.BytesToString(sdk.AccAddress("governor"))
+	require.NoError(t, err)
+	delegator, err := codec.BytesToString(sdk.AccAddress("delegator"))
+	require.NoError(t, err)
 
 	testCases := []struct {
 		name         string
@@ -172,6 +176,47 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "valid governance delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = []*v1.Governor{{Address: governor}}
+				state.GovernanceDelegations = []*v1.GovernanceDelegation{{DelegatorAddress: delegator, GovernorAddress: governor}}
+
+				return state
+			},
+		},
+		{
+			name: "duplicate governors",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = []*v1.Governor{{Address: governor}, {Address: governor}}
+
+				return state
+			},
+			expErrMsg: "duplicate governor",
+		},
+		{
+			name: "non-existent governor in governance delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovernanceDelegations = []*v1.GovernanceDelegation{{DelegatorAddress: delegator, GovernorAddress: governor}}
+
+				return state
+			},
+			expErrMsg: "has non-existent governor",
+		},
+		{
+			name: "governor delegating",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = []*v1.Governor{{Address: governor}, {Address: delegator}}
+				state.GovernanceDelegations = []*v1.GovernanceDelegation{{DelegatorAddress: delegator, GovernorAddress: governor}}
+
+				return state
+			},
+			expErrMsg: "cannot delegate its governance voting power",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -73,6 +73,40 @@ func (ProposalType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{0}
 }
 
+// TallyStrategy enumerates the strategies used to tally the votes of a multiple choice proposal.
+type TallyStrategy int32
+
+const (
+	// TALLY_STRATEGY_UNSPECIFIED defines no tally strategy, which fallback to TALLY_STRATEGY_PLURALITY.
+	TallyStrategy_TALLY_STRATEGY_UNSPECIFIED TallyStrategy = 0
+	// TALLY_STRATEGY_PLURALITY defines the tally strategy counting the voting power of each option.
+	TallyStrategy_TALLY_STRATEGY_PLURALITY TallyStrategy = 1
+	// TALLY_STRATEGY_RANKED_CHOICE defines the instant-runoff tally strategy, where the options
+	// with the least voting power are eliminated one by one and their votes transferred to the
+	// next option ranked by each voter, until an option gathers a majority.
+	TallyStrategy_TALLY_STRATEGY_RANKED_CHOICE TallyStrategy = 2
+)
+
+var TallyStrategy_name = map[int32]string{
+	0: "TALLY_STRATEGY_UNSPECIFIED",
+	1: "TALLY_STRATEGY_PLURALITY",
+	2: "TALLY_STRATEGY_RANKED_CHOICE",
+}
+
+var TallyStrategy_value = map[string]int32{
+	"TALLY_STRATEGY_UNSPECIFIED":   0,
+	"TALLY_STRATEGY_PLURALITY":     1,
+	"TALLY_STRATEGY_RANKED_CHOICE": 2,
+}
+
+func (x TallyStrategy) String() string {
+	return proto.EnumName(TallyStrategy_name, int32(x))
+}
+
+func (TallyStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{1}
+}
+
 // VoteOption enumerates the valid vote options for a given governance proposal.
 type VoteOption int32
 
@@ -130,7 +164,7 @@ func (x VoteOption) String() string {
 }
 
 func (VoteOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{1}
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
 }
 
 // ProposalStatus enumerates the valid statuses of a proposal.
@@ -179,7 +213,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{2}
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
 }
 
 // WeightedVoteOption defines a unit of vote for vote split.
@@ -342,6 +376,8 @@ type Proposal struct {
 	FailedReason string `protobuf:"bytes,15,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
 	// proposal_type defines the type of the proposal
 	ProposalType ProposalType `protobuf:"varint,16,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// tally_strategy defines the strategy used to tally the votes of a multiple choice proposal.
+	TallyStrategy TallyStrategy `protobuf:"varint,17,opt,name=tally_strategy,json=tallyStrategy,proto3,enum=cosmos.gov.v1.TallyStrategy" json:"tally_strategy,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -490,6 +526,13 @@ func (m *Proposal) GetProposalType() ProposalType {
 	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
 }
 
+func (m *Proposal) GetTallyStrategy() TallyStrategy {
+	if m != nil {
+		return m.TallyStrategy
+	}
+	return TallyStrategy_TALLY_STRATEGY_UNSPECIFIED
+}
+
 // ProposalVoteOptions defines the stringified vote options for proposals.
 // This allows to support multiple choice options for a given proposal.
 type ProposalVoteOptions struct {
@@ -710,6 +753,8 @@ type Vote struct {
 	// metadata is any arbitrary metadata attached to the vote.
 	// the recommended format of the metadata is to be found here: https://docs.cosmos.network/v0.47/modules/gov#vote-5
 	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ranking is the vote options ordered by preference, for proposals using the ranked choice tally strategy.
+	Ranking []VoteOption `protobuf:"varint,6,rep,packed,name=ranking,proto3,enum=cosmos.gov.v1.VoteOption" json:"ranking,omitempty"`
 }
 
 func (m *Vote) Reset()         { *m = Vote{} }
@@ -773,6 +818,13 @@ func (m *Vote) GetMetadata() string {
 	return ""
 }
 
+func (m *Vote) GetRanking() []VoteOption {
+	if m != nil {
+		return m.Ranking
+	}
+	return nil
+}
+
 // DepositParams defines the params for deposits on governance proposals.
 //
 // Deprecated: Do not use.
@@ -956,15 +1008,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
@@ -975,7 +1027,7 @@ type Params struct {
 	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -1279,8 +1331,121 @@ func (m *MessageBasedParams) GetVetoThreshold() string {
 	return ""
 }
 
+// Governor defines an account registered as a governor, to which other accounts
+// can delegate their governance voting power.
+type Governor struct {
+	// address is the account address of the governor.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// description is any arbitrary description of the governor.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *Governor) Reset()         { *m = Governor{} }
+func (m *Governor) String() string { return proto.CompactTextString(m) }
+func (*Governor) ProtoMessage()    {}
+func (*Governor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *Governor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Governor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Governor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Governor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Governor.Merge(m, src)
+}
+func (m *Governor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Governor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Governor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Governor proto.InternalMessageInfo
+
+func (m *Governor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Governor) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+// GovernanceDelegation defines the delegation of the governance voting power
+// of an account to a governor, independently of its staking delegations.
+type GovernanceDelegation struct {
+	// delegator_address is the account address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// governor_address is the account address of the governor.
+	GovernorAddress string `protobuf:"bytes,2,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+}
+
+func (m *GovernanceDelegation) Reset()         { *m = GovernanceDelegation{} }
+func (m *GovernanceDelegation) String() string { return proto.CompactTextString(m) }
+func (*GovernanceDelegation) ProtoMessage()    {}
+func (*GovernanceDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *GovernanceDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceDelegation.Merge(m, src)
+}
+func (m *GovernanceDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceDelegation proto.InternalMessageInfo
+
+func (m *GovernanceDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *GovernanceDelegation) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
+	proto.RegisterEnum("cosmos.gov.v1.TallyStrategy", TallyStrategy_name, TallyStrategy_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
@@ -1294,138 +1459,151 @@ func init() {
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*Governor)(nil), "cosmos.gov.v1.Governor")
+	proto.RegisterType((*GovernanceDelegation)(nil), "cosmos.gov.v1.GovernanceDelegation")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 2006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
-	0x19, 0x0e, 0x25, 0xf9, 0x43, 0xaf, 0x25, 0x99, 0x1e, 0xdb, 0x31, 0x63, 0xaf, 0x3f, 0x62, 0x14,
-	0x0b, 0x37, 0xbb, 0x96, 0xec, 0x6c, 0xdd, 0x6e, 0xd3, 0xcd, 0x41, 0xb2, 0x98, 0x84, 0x41, 0x6c,
-	0xa9, 0x14, 0xe3, 0x24, 0x2d, 0x0a, 0x82, 0x36, 0x27, 0x32, 0x77, 0x45, 0x8e, 0x4a, 0x8e, 0x6c,
-	0xab, 0xbf, 0x62, 0x8f, 0x3d, 0x15, 0xbd, 0x75, 0x8f, 0x3d, 0x04, 0xbd, 0xf7, 0xd4, 0x45, 0x0f,
-	0xc5, 0x22, 0xa7, 0x62, 0x81, 0xa6, 0x45, 0x72, 0x28, 0xb0, 0x3f, 0xa1, 0xe8, 0xa1, 0x98, 0xe1,
-	0x50, 0xa4, 0x3e, 0x1c, 0xcb, 0x8b, 0x5e, 0x12, 0x79, 0xe6, 0x79, 0x9e, 0x79, 0xe7, 0xfd, 0x9a,
-	0x57, 0x82, 0xa5, 0x13, 0x12, 0xb8, 0x24, 0x28, 0x35, 0xc9, 0x59, 0xe9, 0x6c, 0x97, 0xfd, 0x57,
-	0x6c, 0xfb, 0x84, 0x12, 0x94, 0x0f, 0x37, 0x8a, 0x6c, 0xe5, 0x6c, 0x77, 0x79, 0x4d, 0xe0, 0x8e,
-	0xad, 0x00, 0x97, 0xce, 0x76, 0x8f, 0x31, 0xb5, 0x76, 0x4b, 0x27, 0xc4, 0xf1, 0x42, 0xf8, 0xf2,
-	0x42, 0x93, 0x34, 0x09, 0xff, 0x58, 0x62, 0x9f, 0xc4, 0xea, 0x7a, 0x93, 0x90, 0x66, 0x0b, 0x97,
-	0xf8, 0x5f, 0xc7, 0x9d, 0x97, 0x25, 0xea, 0xb8, 0x38, 0xa0, 0x96, 0xdb, 0x16, 0x80, 0x5b, 0x83,
-	0x00, 0xcb, 0xeb, 0x8a, 0xad, 0xb5, 0xc1, 0x2d, 0xbb, 0xe3, 0x5b, 0xd4, 0x21, 0xd1, 0x89, 0xb7,
-	0x42, 0x8b, 0xcc, 0xf0, 0x50, 0x61, 0x6d, 0xb8, 0x35, 0x67, 0xb9, 0x8e, 0x47, 0x4a, 0xfc, 0xdf,
-	0x70, 0x69, 0x93, 0x00, 0x7a, 0x86, 0x9d, 0xe6, 0x29, 0xc5, 0xf6, 0x11, 0xa1, 0xb8, 0xd6, 0x66,
-	0x4a, 0x68, 0x17, 0x26, 0x09, 0xff, 0xa4, 0x48, 0x1b, 0xd2, 0x56, 0xe1, 0xee, 0xad, 0x62, 0xdf,
-	0xad, 0x8b, 0x31, 0x54, 0x17, 0x40, 0xf4, 0x21, 0x4c, 0x9e, 0x73, 0x21, 0x25, 0xb5, 0x21, 0x6d,
-	0x65, 0x2b, 0x85, 0xd7, 0xaf, 0xb6, 0x41, 0xb0, 0xaa, 0xf8, 0x44, 0x17, 0xbb, 0x9b, 0xbf, 0x97,
-	0x60, 0xaa, 0x8a, 0xdb, 0x24, 0x70, 0x28, 0x5a, 0x87, 0x99, 0xb6, 0x4f, 0xda, 0x24, 0xb0, 0x5a,
-	0xa6, 0x63, 0xf3, 0xb3, 0x32, 0x3a, 0x44, 0x4b, 0x9a, 0x8d, 0x7e, 0x0c, 0x59, 0x3b, 0xc4, 0x12,
-	0x5f, 0xe8, 0x2a, 0xaf, 0x5f, 0x6d, 0x2f, 0x08, 0xdd, 0xb2, 0x6d, 0xfb, 0x38, 0x08, 0x1a, 0xd4,
-	0x77, 0xbc, 0xa6, 0x1e, 0x43, 0xd1, 0x67, 0x30, 0x69, 0xb9, 0xa4, 0xe3, 0x51, 0x25, 0xbd, 0x91,
-	0xde, 0x9a, 0x89, 0xed, 0x67, 0x61, 0x2a, 0x8a, 0x30, 0x15, 0xf7, 0x89, 0xe3, 0x55, 0xb2, 0x5f,
-	0xbf, 0x59, 0xbf, 0xf1, 0xd5, 0xbf, 0xff, 0x78, 0x47, 0xd2, 0x05, 0x67, 0xf3, 0xcf, 0x53, 0x30,
-	0x5d, 0x17, 0x46, 0xa0, 0x02, 0xa4, 0x7a, 0xa6, 0xa5, 0x1c, 0x1b, 0xed, 0xc0, 0xb4, 0x8b, 0x83,
-	0xc0, 0x6a, 0xe2, 0x40, 0x49, 0x71, 0xf1, 0x85, 0x62, 0x18, 0x91, 0x62, 0x14, 0x91, 0x62, 0xd9,
-	0xeb, 0xea, 0x3d, 0x14, 0xda, 0x83, 0xc9, 0x80, 0x5a, 0xb4, 0x13, 0x28, 0x69, 0xee, 0xcc, 0xd5,
-	0x01, 0x67, 0x46, 0x47, 0x35, 0x38, 0x48, 0x17, 0x60, 0xf4, 0x08, 0xd0, 0x4b, 0xc7, 0xb3, 0x5a,
-	0x26, 0xb5, 0x5a, 0xad, 0xae, 0xe9, 0xe3, 0xa0, 0xd3, 0xa2, 0x4a, 0x66, 0x43, 0xda, 0x9a, 0xb9,
-	0xbb, 0x3c, 0x20, 0x61, 0x30, 0x88, 0xce, 0x11, 0xba, 0xcc, 0x59, 0x89, 0x15, 0x54, 0x86, 0x99,
-	0xa0, 0x73, 0xec, 0x3a, 0xd4, 0x64, 0x69, 0xa6, 0x4c, 0x08, 0x89, 0x41, 0xab, 0x8d, 0x28, 0x07,
-	0x2b, 0x99, 0x2f, 0xff, 0xb9, 0x2e, 0xe9, 0x10, 0x92, 0xd8, 0x32, 0x7a, 0x0c, 0xb2, 0xf0, 0xae,
-	0x89, 0x3d, 0x3b, 0xd4, 0x99, 0x1c, 0x53, 0xa7, 0x20, 0x98, 0xaa, 0x67, 0x73, 0x2d, 0x0d, 0xf2,
-	0x94, 0x50, 0xab, 0x65, 0x8a, 0x75, 0x65, 0xea, 0x1a, 0x31, 0xca, 0x71, 0x6a, 0x94, 0x40, 0x4f,
-	0x60, 0xee, 0x8c, 0x50, 0xc7, 0x6b, 0x9a, 0x01, 0xb5, 0x7c, 0x71, 0xbf, 0xe9, 0x31, 0xed, 0x9a,
-	0x0d, 0xa9, 0x0d, 0xc6, 0xe4, 0x86, 0x3d, 0x02, 0xb1, 0x14, 0xdf, 0x31, 0x3b, 0xa6, 0x56, 0x3e,
-	0x24, 0x46, 0x57, 0x5c, 0x66, 0x49, 0x42, 0x2d, 0xdb, 0xa2, 0x96, 0x02, 0x2c, 0x6d, 0xf5, 0xde,
-	0xdf, 0xe8, 0x87, 0x30, 0x41, 0x1d, 0xda, 0xc2, 0xca, 0x0c, 0xcf, 0xe7, 0xf9, 0x6f, 0x5f, 0x6d,
-	0xcf, 0x86, 0x37, 0xdf, 0x0e, 0xec, 0x2f, 0x36, 0x76, 0x8a, 0x3f, 0xfa, 0x89, 0x1e, 0x22, 0xd0,
-	0x36, 0x4c, 0x05, 0x1d, 0xd7, 0xb5, 0xfc, 0xae, 0x92, 0xbb, 0x1c, 0x1c, 0x61, 0xd0, 0x43, 0x98,
-	0x0e, 0x6b, 0x07, 0xfb, 0x4a, 0x9e, 0xe3, 0x3f, 0xba, 0xac, 0x58, 0x46, 0xe9, 0xf4, 0xc8, 0xe8,
-	0x13, 0xc8, 0xe2, 0x8b, 0x36, 0xb6, 0x1d, 0x8a, 0x6d, 0xa5, 0xb0, 0x21, 0x6d, 0x4d, 0x57, 0x16,
-	0x87, 0x18, 0x7b, 0x3b, 0x8a, 0xa4, 0xc7, 0x38, 0xf4, 0x29, 0xe4, 0x5f, 0x5a, 0x4e, 0x0b, 0xdb,
-	0xa6, 0x8f, 0xad, 0x80, 0x78, 0xca, 0xec, 0x25, 0x26, 0xef, 0xed, 0xe8, 0xb9, 0x10, 0xa9, 0x73,
-	0x20, 0xd2, 0x21, 0xdf, 0x6b, 0x03, 0xb4, 0xdb, 0xc6, 0x8a, 0xcc, 0xeb, 0x64, 0xe5, 0x92, 0x3a,
-	0x31, 0xba, 0x6d, 0x5c, 0x91, 0xbf, 0x7d, 0xb5, 0x9d, 0xbb, 0x60, 0x7d, 0x79, 0xe3, 0x6c, 0xa7,
-	0x78, 0xb7, 0xb8, 0xa3, 0xe7, 0xda, 0x89, 0xfd, 0xcd, 0xbf, 0x4a, 0x30, 0x1f, 0x11, 0xe2, 0x6e,
-	0x15, 0xa0, 0x55, 0x80, 0xb0, 0x61, 0x99, 0xc4, 0xc3, 0xbc, 0xac, 0xb3, 0x7a, 0x36, 0x5c, 0xa9,
-	0x79, 0x38, 0xb1, 0x4d, 0xcf, 0x49, 0xd8, 0x71, 0xa2, 0x6d, 0xe3, 0x9c, 0xa0, 0xdb, 0x90, 0x8b,
-	0xb6, 0x4f, 0x7d, 0x8c, 0x79, 0x41, 0x67, 0xf5, 0x19, 0x01, 0x60, 0x4b, 0xac, 0xa7, 0x09, 0xc8,
-	0x4b, 0xd2, 0xf1, 0x79, 0xbd, 0x66, 0x75, 0x21, 0xfa, 0x80, 0x74, 0xfc, 0x04, 0x20, 0x68, 0x5b,
-	0x2e, 0xaf, 0xc6, 0x1e, 0xa0, 0xd1, 0xb6, 0xdc, 0x7b, 0xf2, 0xeb, 0x81, 0xab, 0x6d, 0xfe, 0x37,
-	0x0d, 0x33, 0xc9, 0x82, 0xde, 0x86, 0x6c, 0x17, 0x07, 0xe6, 0x09, 0xef, 0x70, 0xfc, 0x0e, 0x15,
-	0x39, 0xd1, 0x6e, 0x35, 0xb6, 0xaa, 0x4f, 0x77, 0x71, 0xb0, 0xcf, 0x10, 0x68, 0x0f, 0xf2, 0xd6,
-	0x71, 0x40, 0x2d, 0xc7, 0x13, 0x94, 0xd4, 0x25, 0x94, 0x9c, 0x80, 0x85, 0xb4, 0x8f, 0x60, 0xda,
-	0x23, 0x82, 0x91, 0xbe, 0x84, 0x31, 0xe5, 0x91, 0x10, 0x7c, 0x1f, 0x90, 0x47, 0xcc, 0x73, 0x87,
-	0x9e, 0x9a, 0x67, 0x98, 0x46, 0xb4, 0xcc, 0x25, 0xb4, 0x59, 0x8f, 0x3c, 0x73, 0xe8, 0xe9, 0x11,
-	0xa6, 0x82, 0xfe, 0x29, 0xc8, 0x71, 0x58, 0x04, 0x79, 0x62, 0xe8, 0x1d, 0xd1, 0x3c, 0xaa, 0x17,
-	0x7a, 0xc1, 0x1a, 0x64, 0xd2, 0xf3, 0xe8, 0xd8, 0xc9, 0xf7, 0x31, 0x8d, 0x73, 0x71, 0xe6, 0x67,
-	0x80, 0x92, 0xc1, 0x14, 0xdc, 0xa9, 0x91, 0x5c, 0x39, 0x11, 0xe2, 0x90, 0x7d, 0x0f, 0xe6, 0x12,
-	0x71, 0x16, 0xe4, 0xe9, 0x91, 0xe4, 0xd9, 0x38, 0xfa, 0x21, 0x77, 0x1b, 0x80, 0xc5, 0x5e, 0x90,
-	0xb2, 0x23, 0x49, 0x59, 0x86, 0xe0, 0xf0, 0xcd, 0x3f, 0x49, 0x90, 0x61, 0x39, 0x7c, 0xf5, 0x7b,
-	0x59, 0x84, 0x89, 0x33, 0x42, 0xf1, 0xd5, 0x6f, 0x65, 0x08, 0x43, 0x3f, 0x83, 0xa9, 0xd0, 0xb6,
-	0x40, 0xc9, 0xf0, 0x26, 0x7c, 0x7b, 0xa0, 0xe6, 0x86, 0x67, 0x03, 0x3d, 0x62, 0xf4, 0x35, 0xb9,
-	0x89, 0xfe, 0x26, 0xf7, 0x38, 0x33, 0x9d, 0x96, 0x33, 0x9b, 0xff, 0x90, 0x20, 0x2f, 0x5a, 0x75,
-	0xdd, 0xf2, 0x2d, 0x37, 0x40, 0x2f, 0x60, 0xc6, 0x75, 0xbc, 0x5e, 0xe7, 0x97, 0xae, 0xea, 0xfc,
-	0xab, 0xac, 0xf3, 0x7f, 0xf7, 0x66, 0x7d, 0x31, 0xc1, 0xfa, 0x98, 0xb8, 0x0e, 0xc5, 0x6e, 0x9b,
-	0x76, 0x75, 0x70, 0x1d, 0x2f, 0x7a, 0x0b, 0x5c, 0x40, 0xae, 0x75, 0x11, 0x81, 0xcc, 0x36, 0xf6,
-	0x1d, 0x62, 0x73, 0x47, 0xb0, 0x13, 0x06, 0x1b, 0x78, 0x55, 0x0c, 0x4d, 0x95, 0x1f, 0x7c, 0xf7,
-	0x66, 0xfd, 0x83, 0x61, 0x62, 0x7c, 0xc8, 0x6f, 0x59, 0x7f, 0x97, 0x5d, 0xeb, 0x22, 0xba, 0x09,
-	0xdf, 0xbf, 0x97, 0x52, 0xa4, 0xcd, 0xe7, 0x90, 0x3b, 0xe2, 0x7d, 0x5f, 0xdc, 0xae, 0x0a, 0xe2,
-	0x1d, 0x88, 0x4e, 0x97, 0xae, 0x3a, 0x3d, 0xc3, 0xd5, 0x73, 0x21, 0x2b, 0xa1, 0xfc, 0x3b, 0x49,
-	0x54, 0xbc, 0x50, 0xfe, 0x10, 0x26, 0x7f, 0xdd, 0x21, 0x7e, 0xc7, 0x15, 0xe5, 0x3e, 0x34, 0x5d,
-	0x85, 0xbb, 0xe8, 0x63, 0xc8, 0xb2, 0x64, 0x0e, 0x4e, 0x49, 0xcb, 0xbe, 0x64, 0x10, 0x8b, 0x01,
-	0x68, 0x0f, 0x0a, 0xbc, 0x58, 0x63, 0x4a, 0x7a, 0x24, 0x25, 0xcf, 0x50, 0x46, 0x04, 0xe2, 0x06,
-	0xfe, 0x25, 0x0f, 0x93, 0xc2, 0x36, 0xf5, 0x9a, 0x31, 0x4d, 0xbc, 0xe6, 0xc9, 0xf8, 0x1d, 0x7c,
-	0xbf, 0xf8, 0x65, 0x46, 0xc7, 0x67, 0x38, 0x16, 0xe9, 0xef, 0x11, 0x8b, 0x84, 0xdf, 0x33, 0xe3,
-	0xfb, 0x7d, 0xe2, 0xfa, 0x7e, 0x9f, 0x1c, 0xc3, 0xef, 0x48, 0x83, 0x5b, 0xcc, 0xd1, 0x8e, 0xe7,
-	0x50, 0x27, 0x1e, 0x9f, 0x4c, 0x6e, 0xfe, 0x88, 0xbe, 0xc5, 0x14, 0x6e, 0xba, 0x8e, 0xa7, 0x85,
-	0x78, 0xe1, 0x1e, 0x9d, 0xa1, 0xd1, 0x53, 0x58, 0xec, 0x75, 0x92, 0x13, 0xcb, 0x3b, 0xc1, 0x2d,
-	0x21, 0x13, 0x76, 0xb0, 0xdb, 0xfd, 0x32, 0xa3, 0x9e, 0xf0, 0xf9, 0x88, 0xbf, 0xcf, 0xe9, 0xa1,
-	0xec, 0xaf, 0x60, 0x61, 0x50, 0xd6, 0xc6, 0x41, 0xd4, 0xe2, 0xc6, 0x9f, 0x46, 0xf6, 0x76, 0x74,
-	0xd4, 0xaf, 0x5f, 0xc5, 0x01, 0x45, 0x9f, 0xc3, 0x52, 0x6f, 0xde, 0x30, 0xfb, 0xa3, 0x0b, 0x57,
-	0x45, 0x77, 0x89, 0x45, 0x77, 0xd4, 0x41, 0x8b, 0x3d, 0xc9, 0xa3, 0x64, 0xe4, 0x75, 0x98, 0x8f,
-	0xcf, 0x8a, 0x03, 0x35, 0x33, 0xae, 0x7f, 0x50, 0x8f, 0x1d, 0x07, 0xf0, 0x39, 0xc4, 0x87, 0x99,
-	0xc9, 0x9a, 0xc9, 0x5d, 0xa3, 0x66, 0x62, 0xb3, 0x0e, 0xe2, 0xe2, 0xb9, 0x0f, 0xf2, 0x71, 0xc7,
-	0xf7, 0x98, 0x53, 0xb0, 0x29, 0x32, 0x36, 0xcf, 0x07, 0xb7, 0x91, 0x23, 0x63, 0x81, 0x81, 0x59,
-	0x4f, 0xff, 0x79, 0x98, 0xbe, 0x47, 0xb0, 0xca, 0xe9, 0xbd, 0xe0, 0xf5, 0xaa, 0xd0, 0xc7, 0x4c,
-	0x52, 0x0c, 0x81, 0x23, 0xb5, 0x96, 0x19, 0x33, 0x1a, 0xb5, 0xa2, 0x1a, 0x0c, 0x69, 0xe8, 0xa7,
-	0x50, 0x88, 0xcd, 0x62, 0xc9, 0xcc, 0x87, 0xc2, 0x4b, 0x84, 0x72, 0x91, 0x51, 0x6c, 0x2c, 0x40,
-	0x07, 0x30, 0x97, 0xf0, 0x90, 0xc8, 0x4e, 0x79, 0x5c, 0xef, 0xcf, 0xc6, 0x8d, 0x25, 0xcc, 0xcc,
-	0x5f, 0xc2, 0xf2, 0x60, 0x66, 0xb2, 0x6e, 0x23, 0xb2, 0x67, 0x8e, 0xeb, 0xae, 0x0d, 0xe9, 0xf6,
-	0x4f, 0x98, 0x4b, 0xfd, 0x29, 0x79, 0x60, 0x5d, 0x88, 0x5c, 0x69, 0xc3, 0x3a, 0x7b, 0x14, 0x5d,
-	0x27, 0xa0, 0xce, 0x89, 0x69, 0x75, 0xe8, 0x29, 0xf1, 0x9d, 0xdf, 0x60, 0xdb, 0xb4, 0xc2, 0x2c,
-	0xc7, 0x81, 0x82, 0x36, 0xd2, 0x5b, 0xd9, 0xca, 0xd6, 0x7b, 0x2a, 0xa0, 0xff, 0xac, 0xd5, 0x58,
-	0xb0, 0xdc, 0xd3, 0x2b, 0x47, 0x72, 0xe8, 0x18, 0x12, 0x00, 0xd3, 0xc7, 0x9f, 0xe3, 0x93, 0xfe,
-	0x3c, 0x9d, 0x1f, 0xeb, 0x46, 0x2b, 0xb1, 0x88, 0x2e, 0x34, 0xe2, 0x6c, 0xbd, 0x0f, 0xc0, 0xa6,
-	0x4c, 0x91, 0x4d, 0x0b, 0x63, 0x09, 0xb2, 0xb9, 0x54, 0xe4, 0x94, 0x06, 0x72, 0x9c, 0xec, 0x42,
-	0x64, 0xf1, 0x0a, 0x91, 0xdd, 0xe2, 0x4e, 0x71, 0x47, 0x9f, 0xed, 0xf1, 0x84, 0xd4, 0x03, 0xb8,
-	0xd9, 0x0b, 0x1e, 0xbe, 0xc0, 0x27, 0x1d, 0x3e, 0x77, 0x35, 0xad, 0x40, 0xb9, 0xc9, 0x46, 0xa0,
-	0x11, 0x5f, 0x06, 0x7a, 0x6d, 0x48, 0x8d, 0xe0, 0x0f, 0xad, 0xe0, 0xde, 0xfc, 0xeb, 0xe1, 0xb4,
-	0xdb, 0xfc, 0x2a, 0x05, 0xe8, 0x20, 0xfc, 0xae, 0x5e, 0xb1, 0x02, 0x6c, 0xff, 0x3f, 0xdf, 0xf2,
-	0xc4, 0xfb, 0x91, 0x7a, 0xef, 0xfb, 0xb1, 0x3d, 0xc2, 0xd7, 0x43, 0x0f, 0x48, 0xec, 0xdb, 0xbe,
-	0xe7, 0x26, 0x7d, 0xfd, 0xe7, 0x26, 0x33, 0xce, 0x33, 0x3f, 0xf4, 0x3d, 0xe4, 0xce, 0x1f, 0x24,
-	0xc8, 0x25, 0xbf, 0x85, 0xa1, 0x55, 0xb8, 0x55, 0xd7, 0x6b, 0xf5, 0x5a, 0xa3, 0xfc, 0xc4, 0x34,
-	0x5e, 0xd4, 0x55, 0xf3, 0xe9, 0x61, 0xa3, 0xae, 0xee, 0x6b, 0x0f, 0x34, 0xb5, 0x2a, 0xdf, 0x40,
-	0xcb, 0x70, 0xb3, 0x7f, 0xbb, 0x61, 0x94, 0x0f, 0xab, 0x65, 0xbd, 0x2a, 0x4b, 0xe8, 0x36, 0xac,
-	0xf6, 0xef, 0x1d, 0x3c, 0x7d, 0x62, 0x68, 0xf5, 0x27, 0xaa, 0xb9, 0xff, 0xa8, 0xa6, 0xed, 0xab,
-	0x72, 0x0a, 0x7d, 0x00, 0x4a, 0x3f, 0xa4, 0x56, 0x37, 0xb4, 0x03, 0xad, 0x61, 0x68, 0xfb, 0x72,
-	0x1a, 0xad, 0xc0, 0x52, 0xff, 0xae, 0xfa, 0xbc, 0xae, 0x56, 0x35, 0x43, 0xad, 0xca, 0x99, 0x3b,
-	0xff, 0x91, 0x00, 0x12, 0xbf, 0x67, 0xad, 0xc0, 0xd2, 0x51, 0xcd, 0x08, 0x05, 0x6a, 0x87, 0x03,
-	0x56, 0xce, 0xc3, 0x6c, 0x72, 0xf3, 0x85, 0xda, 0x90, 0xa5, 0xc1, 0xc5, 0xda, 0xa1, 0x2a, 0x4b,
-	0x68, 0x09, 0xe6, 0x93, 0x8b, 0xe5, 0x4a, 0xc3, 0x28, 0x6b, 0x87, 0x72, 0x6a, 0x10, 0x6d, 0x3c,
-	0xab, 0xc9, 0x29, 0x84, 0xa0, 0x90, 0x5c, 0x3c, 0xac, 0xc9, 0x69, 0xb4, 0x08, 0x73, 0x7d, 0xc0,
-	0x47, 0xba, 0xaa, 0xca, 0x69, 0x76, 0xd3, 0x7e, 0xa8, 0xf9, 0x4c, 0x33, 0x1e, 0x99, 0x47, 0xaa,
-	0x51, 0x93, 0x33, 0x68, 0x01, 0xe4, 0xe4, 0xee, 0x83, 0xda, 0x53, 0x7d, 0x78, 0xb5, 0x51, 0x2f,
-	0x1f, 0xc8, 0x13, 0xcb, 0x29, 0x59, 0xba, 0xf3, 0x37, 0x09, 0x0a, 0xfd, 0x3f, 0x2a, 0xa1, 0x75,
-	0x58, 0xe9, 0x39, 0xab, 0x61, 0x94, 0x8d, 0xa7, 0x8d, 0x01, 0x27, 0x6c, 0xc2, 0xda, 0x20, 0xa0,
-	0xaa, 0xd6, 0x6b, 0x0d, 0xcd, 0x30, 0xeb, 0xaa, 0xae, 0xd5, 0x06, 0x43, 0x26, 0x30, 0x47, 0x35,
-	0x43, 0x3b, 0x7c, 0x18, 0x41, 0x52, 0x7d, 0x11, 0x17, 0x90, 0x7a, 0xb9, 0xd1, 0x50, 0xab, 0xe1,
-	0x25, 0x07, 0xf7, 0x74, 0xf5, 0xb1, 0xba, 0xcf, 0x23, 0x36, 0x8a, 0xf9, 0xa0, 0xac, 0x3d, 0x51,
-	0xab, 0xf2, 0x44, 0x65, 0xef, 0xeb, 0xb7, 0x6b, 0xd2, 0x37, 0x6f, 0xd7, 0xa4, 0x7f, 0xbd, 0x5d,
-	0x93, 0xbe, 0x7c, 0xb7, 0x76, 0xe3, 0x9b, 0x77, 0x6b, 0x37, 0xfe, 0xfe, 0x6e, 0xed, 0xc6, 0x2f,
-	0x56, 0xc2, 0xf4, 0x0d, 0xec, 0x2f, 0x8a, 0x0e, 0x29, 0xf1, 0x64, 0x2d, 0xd1, 0x6e, 0x1b, 0x07,
-	0xa5, 0xb3, 0xdd, 0xe3, 0x49, 0x5e, 0xa3, 0x9f, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x10, 0x4b,
-	0xae, 0xb9, 0xcc, 0x15, 0x00, 0x00,
+	// 2181 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0xdb, 0xc8,
+	0xf9, 0x0e, 0x25, 0xd9, 0x96, 0x5e, 0x4b, 0x32, 0x3d, 0xb6, 0x63, 0xc6, 0x8e, 0x65, 0xc5, 0xf8,
+	0x61, 0xe1, 0x5f, 0x76, 0x2d, 0xdb, 0xd9, 0xba, 0xdd, 0xa6, 0xbb, 0x07, 0xc9, 0x62, 0x12, 0x6e,
+	0x6d, 0x4b, 0xa5, 0x68, 0x27, 0x6e, 0x51, 0x10, 0xb4, 0x39, 0x91, 0xb9, 0x11, 0x39, 0x2a, 0x49,
+	0xf9, 0x4f, 0x3f, 0xc5, 0x1e, 0xf7, 0x54, 0xf4, 0xd6, 0x3d, 0xf6, 0x90, 0xef, 0xd0, 0x45, 0x0f,
+	0xc5, 0x22, 0xa7, 0x62, 0x81, 0xa6, 0x45, 0x72, 0x28, 0xb0, 0x1f, 0xa1, 0x68, 0x81, 0x62, 0x86,
+	0x43, 0x91, 0xa2, 0xe4, 0x58, 0x59, 0xf4, 0x92, 0x58, 0x33, 0xcf, 0xf3, 0xcc, 0x3b, 0xf3, 0xfe,
+	0x95, 0x60, 0xf1, 0x94, 0x78, 0x36, 0xf1, 0x36, 0xdb, 0xe4, 0x7c, 0xf3, 0x7c, 0x9b, 0xfe, 0x57,
+	0xe9, 0xba, 0xc4, 0x27, 0xa8, 0x10, 0x6c, 0x54, 0xe8, 0xca, 0xf9, 0xf6, 0x52, 0x89, 0xe3, 0x4e,
+	0x0c, 0x0f, 0x6f, 0x9e, 0x6f, 0x9f, 0x60, 0xdf, 0xd8, 0xde, 0x3c, 0x25, 0x96, 0x13, 0xc0, 0x97,
+	0xe6, 0xdb, 0xa4, 0x4d, 0xd8, 0x9f, 0x9b, 0xf4, 0x2f, 0xbe, 0xba, 0xda, 0x26, 0xa4, 0xdd, 0xc1,
+	0x9b, 0xec, 0xd3, 0x49, 0xef, 0xf9, 0xa6, 0x6f, 0xd9, 0xd8, 0xf3, 0x0d, 0xbb, 0xcb, 0x01, 0x77,
+	0x92, 0x00, 0xc3, 0xb9, 0xe2, 0x5b, 0xa5, 0xe4, 0x96, 0xd9, 0x73, 0x0d, 0xdf, 0x22, 0xe1, 0x89,
+	0x77, 0x02, 0x8b, 0xf4, 0xe0, 0x50, 0x6e, 0x6d, 0xb0, 0x35, 0x6b, 0xd8, 0x96, 0x43, 0x36, 0xd9,
+	0xbf, 0xc1, 0xd2, 0x1a, 0x01, 0xf4, 0x14, 0x5b, 0xed, 0x33, 0x1f, 0x9b, 0x47, 0xc4, 0xc7, 0x8d,
+	0x2e, 0x55, 0x42, 0xdb, 0x30, 0x49, 0xd8, 0x5f, 0x92, 0x50, 0x16, 0xd6, 0x8b, 0x0f, 0xee, 0x54,
+	0x06, 0x6e, 0x5d, 0x89, 0xa0, 0x2a, 0x07, 0xa2, 0x0f, 0x60, 0xf2, 0x82, 0x09, 0x49, 0xa9, 0xb2,
+	0xb0, 0x9e, 0xab, 0x15, 0x5f, 0xbd, 0xdc, 0x00, 0xce, 0xaa, 0xe3, 0x53, 0x95, 0xef, 0xae, 0xfd,
+	0x5e, 0x80, 0xa9, 0x3a, 0xee, 0x12, 0xcf, 0xf2, 0xd1, 0x2a, 0x4c, 0x77, 0x5d, 0xd2, 0x25, 0x9e,
+	0xd1, 0xd1, 0x2d, 0x93, 0x9d, 0x95, 0x51, 0x21, 0x5c, 0x52, 0x4c, 0xf4, 0x63, 0xc8, 0x99, 0x01,
+	0x96, 0xb8, 0x5c, 0x57, 0x7a, 0xf5, 0x72, 0x63, 0x9e, 0xeb, 0x56, 0x4d, 0xd3, 0xc5, 0x9e, 0xd7,
+	0xf2, 0x5d, 0xcb, 0x69, 0xab, 0x11, 0x14, 0x7d, 0x0a, 0x93, 0x86, 0x4d, 0x7a, 0x8e, 0x2f, 0xa5,
+	0xcb, 0xe9, 0xf5, 0xe9, 0xc8, 0x7e, 0xea, 0xa6, 0x0a, 0x77, 0x53, 0x65, 0x97, 0x58, 0x4e, 0x2d,
+	0xf7, 0xcd, 0xeb, 0xd5, 0x5b, 0x5f, 0xff, 0xf3, 0x8f, 0xf7, 0x05, 0x95, 0x73, 0xd6, 0xbe, 0xca,
+	0x42, 0xb6, 0xc9, 0x8d, 0x40, 0x45, 0x48, 0xf5, 0x4d, 0x4b, 0x59, 0x26, 0xda, 0x82, 0xac, 0x8d,
+	0x3d, 0xcf, 0x68, 0x63, 0x4f, 0x4a, 0x31, 0xf1, 0xf9, 0x4a, 0xe0, 0x91, 0x4a, 0xe8, 0x91, 0x4a,
+	0xd5, 0xb9, 0x52, 0xfb, 0x28, 0xb4, 0x03, 0x93, 0x9e, 0x6f, 0xf8, 0x3d, 0x4f, 0x4a, 0xb3, 0xc7,
+	0x5c, 0x49, 0x3c, 0x66, 0x78, 0x54, 0x8b, 0x81, 0x54, 0x0e, 0x46, 0x4f, 0x00, 0x3d, 0xb7, 0x1c,
+	0xa3, 0xa3, 0xfb, 0x46, 0xa7, 0x73, 0xa5, 0xbb, 0xd8, 0xeb, 0x75, 0x7c, 0x29, 0x53, 0x16, 0xd6,
+	0xa7, 0x1f, 0x2c, 0x25, 0x24, 0x34, 0x0a, 0x51, 0x19, 0x42, 0x15, 0x19, 0x2b, 0xb6, 0x82, 0xaa,
+	0x30, 0xed, 0xf5, 0x4e, 0x6c, 0xcb, 0xd7, 0x69, 0x98, 0x49, 0x13, 0x5c, 0x22, 0x69, 0xb5, 0x16,
+	0xc6, 0x60, 0x2d, 0xf3, 0xe5, 0xdf, 0x57, 0x05, 0x15, 0x02, 0x12, 0x5d, 0x46, 0x9f, 0x83, 0xc8,
+	0x5f, 0x57, 0xc7, 0x8e, 0x19, 0xe8, 0x4c, 0x8e, 0xa9, 0x53, 0xe4, 0x4c, 0xd9, 0x31, 0x99, 0x96,
+	0x02, 0x05, 0x9f, 0xf8, 0x46, 0x47, 0xe7, 0xeb, 0xd2, 0xd4, 0x7b, 0xf8, 0x28, 0xcf, 0xa8, 0x61,
+	0x00, 0xed, 0xc1, 0xec, 0x39, 0xf1, 0x2d, 0xa7, 0xad, 0x7b, 0xbe, 0xe1, 0xf2, 0xfb, 0x65, 0xc7,
+	0xb4, 0x6b, 0x26, 0xa0, 0xb6, 0x28, 0x93, 0x19, 0xf6, 0x04, 0xf8, 0x52, 0x74, 0xc7, 0xdc, 0x98,
+	0x5a, 0x85, 0x80, 0x18, 0x5e, 0x71, 0x89, 0x06, 0x89, 0x6f, 0x98, 0x86, 0x6f, 0x48, 0x40, 0xc3,
+	0x56, 0xed, 0x7f, 0x46, 0xff, 0x0f, 0x13, 0xbe, 0xe5, 0x77, 0xb0, 0x34, 0xcd, 0xe2, 0x79, 0xee,
+	0xbb, 0x97, 0x1b, 0x33, 0xc1, 0xcd, 0x37, 0x3c, 0xf3, 0x45, 0x79, 0xab, 0xf2, 0xa3, 0x9f, 0xa8,
+	0x01, 0x02, 0x6d, 0xc0, 0x94, 0xd7, 0xb3, 0x6d, 0xc3, 0xbd, 0x92, 0xf2, 0xd7, 0x83, 0x43, 0x0c,
+	0x7a, 0x0c, 0xd9, 0x20, 0x77, 0xb0, 0x2b, 0x15, 0x18, 0xfe, 0xc3, 0xeb, 0x92, 0x65, 0x94, 0x4e,
+	0x9f, 0x8c, 0x3e, 0x86, 0x1c, 0xbe, 0xec, 0x62, 0xd3, 0xf2, 0xb1, 0x29, 0x15, 0xcb, 0xc2, 0x7a,
+	0xb6, 0xb6, 0x30, 0xc4, 0xd8, 0xd9, 0x92, 0x04, 0x35, 0xc2, 0xa1, 0x4f, 0xa0, 0xf0, 0xdc, 0xb0,
+	0x3a, 0xd8, 0xd4, 0x5d, 0x6c, 0x78, 0xc4, 0x91, 0x66, 0xae, 0x31, 0x79, 0x67, 0x4b, 0xcd, 0x07,
+	0x48, 0x95, 0x01, 0x91, 0x0a, 0x85, 0x7e, 0x19, 0xf0, 0xaf, 0xba, 0x58, 0x12, 0x59, 0x9e, 0x2c,
+	0x5f, 0x93, 0x27, 0xda, 0x55, 0x17, 0xd7, 0xc4, 0xef, 0x5e, 0x6e, 0xe4, 0x2f, 0x69, 0x5d, 0x2e,
+	0x9f, 0x6f, 0x55, 0x1e, 0x54, 0xb6, 0xd4, 0x7c, 0x37, 0xb6, 0x8f, 0x0e, 0xa1, 0x18, 0xe4, 0x8d,
+	0xe7, 0xbb, 0x86, 0x8f, 0xdb, 0x57, 0xd2, 0x2c, 0x13, 0xbd, 0x3b, 0x2a, 0x73, 0x5a, 0x1c, 0x33,
+	0x42, 0xb5, 0xe0, 0xc7, 0x01, 0x6b, 0x7f, 0x16, 0x60, 0x2e, 0xb4, 0x23, 0x2a, 0x82, 0x1e, 0x5a,
+	0x01, 0x08, 0xea, 0xa0, 0x4e, 0x1c, 0xcc, 0xaa, 0x45, 0x4e, 0xcd, 0x05, 0x2b, 0x0d, 0x07, 0xc7,
+	0xb6, 0xfd, 0x0b, 0x22, 0xa5, 0xe2, 0xdb, 0xda, 0x05, 0x41, 0xf7, 0x20, 0x1f, 0x6e, 0x9f, 0xb9,
+	0x18, 0xb3, 0x3a, 0x91, 0x53, 0xa7, 0x39, 0x80, 0x2e, 0xd1, 0x52, 0xc9, 0x21, 0xcf, 0x49, 0xcf,
+	0x65, 0x65, 0x20, 0xa7, 0x72, 0xd1, 0x47, 0xa4, 0xe7, 0xc6, 0x00, 0x5e, 0xd7, 0xb0, 0xa5, 0x89,
+	0x38, 0xa0, 0xd5, 0x35, 0xec, 0x87, 0xe2, 0xab, 0xc4, 0xdd, 0xd6, 0xfe, 0x9d, 0x86, 0xe9, 0x78,
+	0x9d, 0xd8, 0x80, 0xdc, 0x15, 0xf6, 0xf4, 0x53, 0x56, 0x38, 0xd9, 0x1d, 0x6a, 0x62, 0xac, 0x8a,
+	0x2b, 0x74, 0x55, 0xcd, 0x5e, 0x61, 0x6f, 0x97, 0x22, 0xd0, 0x0e, 0x14, 0x8c, 0x13, 0xcf, 0x37,
+	0x2c, 0x87, 0x53, 0x52, 0xd7, 0x50, 0xf2, 0x1c, 0x16, 0xd0, 0x3e, 0x84, 0xac, 0x43, 0x38, 0x23,
+	0x7d, 0x0d, 0x63, 0xca, 0x21, 0x01, 0xf8, 0x33, 0x40, 0x0e, 0xd1, 0x2f, 0x2c, 0xff, 0x4c, 0x3f,
+	0xc7, 0x7e, 0x48, 0xcb, 0x5c, 0x43, 0x9b, 0x71, 0xc8, 0x53, 0xcb, 0x3f, 0x3b, 0xc2, 0x3e, 0xa7,
+	0x7f, 0x02, 0x62, 0xe4, 0x16, 0x4e, 0x9e, 0x18, 0x6a, 0x4f, 0x8a, 0xe3, 0xab, 0xc5, 0xbe, 0xb3,
+	0x92, 0x4c, 0xff, 0x22, 0x3c, 0x76, 0xf2, 0x5d, 0x4c, 0xed, 0x82, 0x9f, 0xf9, 0x29, 0xa0, 0xb8,
+	0x33, 0x39, 0x77, 0x6a, 0x24, 0x57, 0x8c, 0xb9, 0x38, 0x60, 0x3f, 0x84, 0xd9, 0x98, 0x9f, 0x39,
+	0x39, 0x3b, 0x92, 0x3c, 0x13, 0x79, 0x3f, 0xe0, 0x6e, 0x00, 0x50, 0xdf, 0x73, 0x52, 0x6e, 0x24,
+	0x29, 0x47, 0x11, 0x0c, 0xbe, 0xf6, 0x1f, 0x01, 0x32, 0x34, 0x86, 0x6f, 0x6e, 0xc3, 0x15, 0x98,
+	0x38, 0x27, 0x3e, 0xbe, 0xb9, 0x05, 0x07, 0x30, 0xf4, 0x33, 0x98, 0x0a, 0x6c, 0xf3, 0xa4, 0x0c,
+	0xab, 0xed, 0xf7, 0x12, 0x59, 0x37, 0x3c, 0x72, 0xa8, 0x21, 0x63, 0xa0, 0x76, 0x4e, 0x24, 0x6a,
+	0xa7, 0x0c, 0x53, 0xae, 0xe1, 0xbc, 0xb0, 0x9c, 0xb6, 0x34, 0x59, 0x4e, 0xbf, 0x73, 0x30, 0x19,
+	0x91, 0xcb, 0x21, 0xf7, 0xf3, 0x4c, 0x36, 0x2d, 0x66, 0xd6, 0xfe, 0x26, 0x40, 0x81, 0x37, 0x92,
+	0xa6, 0xe1, 0x1a, 0xb6, 0x87, 0x8e, 0x61, 0xda, 0xb6, 0x9c, 0x7e, 0x5f, 0x12, 0x6e, 0xea, 0x4b,
+	0x2b, 0xb4, 0x2f, 0x7d, 0xff, 0x7a, 0x75, 0x21, 0xc6, 0xfa, 0x88, 0xd8, 0x96, 0x8f, 0xed, 0xae,
+	0x7f, 0xa5, 0x82, 0x6d, 0x39, 0x61, 0xa7, 0xb2, 0x01, 0xd9, 0xc6, 0x65, 0x08, 0xd2, 0xbb, 0xd8,
+	0xb5, 0x88, 0xc9, 0xde, 0x93, 0x9e, 0x90, 0x6c, 0x2f, 0x75, 0x3e, 0xd2, 0xd5, 0xfe, 0xef, 0xfb,
+	0xd7, 0xab, 0x77, 0x87, 0x89, 0xd1, 0x21, 0x5f, 0xd1, 0xee, 0x23, 0xda, 0xc6, 0x65, 0x78, 0x13,
+	0xb6, 0xff, 0x30, 0x25, 0x09, 0x6b, 0xcf, 0x20, 0x7f, 0xc4, 0xba, 0x12, 0xbf, 0x5d, 0x1d, 0x78,
+	0x97, 0x0a, 0x4f, 0x17, 0x6e, 0x3a, 0x3d, 0xc3, 0xd4, 0xf3, 0x01, 0x2b, 0xa6, 0xfc, 0x3b, 0x81,
+	0x17, 0x0e, 0xae, 0xfc, 0x01, 0x4c, 0xfe, 0xa6, 0x47, 0xdc, 0x9e, 0x2d, 0x09, 0x43, 0x41, 0xc7,
+	0x66, 0xbf, 0x60, 0x17, 0x7d, 0x04, 0x39, 0x9a, 0x13, 0xde, 0x19, 0xe9, 0x98, 0xd7, 0x8c, 0x89,
+	0x11, 0x00, 0xed, 0x40, 0x91, 0xe5, 0x7c, 0x44, 0x49, 0x8f, 0xa4, 0x14, 0x28, 0x4a, 0x0b, 0x41,
+	0xcc, 0xc0, 0x3f, 0x15, 0x60, 0x92, 0xdb, 0x26, 0xbf, 0xa7, 0x4f, 0x63, 0xb3, 0x46, 0xdc, 0x7f,
+	0xfb, 0x3f, 0xcc, 0x7f, 0x99, 0xd1, 0xfe, 0x19, 0xf6, 0x45, 0xfa, 0x07, 0xf8, 0x22, 0xf6, 0xee,
+	0x99, 0xf1, 0xdf, 0x7d, 0xe2, 0xfd, 0xdf, 0x7d, 0x72, 0x8c, 0x77, 0x47, 0x0a, 0xdc, 0xa1, 0x0f,
+	0x6d, 0x39, 0x96, 0x6f, 0x45, 0xc3, 0x9d, 0xce, 0xcc, 0x97, 0xa6, 0x46, 0x2a, 0xdc, 0xb6, 0x2d,
+	0x47, 0x09, 0xf0, 0xfc, 0x79, 0x54, 0x8a, 0x46, 0x87, 0xb0, 0xd0, 0x2f, 0x48, 0xa7, 0x86, 0x73,
+	0x8a, 0x3b, 0x5c, 0x26, 0x28, 0x84, 0xf7, 0x06, 0x65, 0x46, 0x0d, 0x18, 0x73, 0x21, 0x7f, 0x97,
+	0xd1, 0x03, 0xd9, 0x5f, 0xc3, 0x7c, 0x52, 0xd6, 0xc4, 0x5e, 0x58, 0x29, 0xc7, 0x9f, 0x95, 0x76,
+	0xb6, 0x54, 0x34, 0xa8, 0x5f, 0xc7, 0x9e, 0x8f, 0xbe, 0x80, 0xc5, 0xfe, 0x34, 0xa4, 0x0f, 0x7a,
+	0x17, 0x6e, 0xf2, 0xee, 0x22, 0xf5, 0xee, 0xa8, 0x83, 0x16, 0xfa, 0x92, 0x47, 0x71, 0xcf, 0xab,
+	0x30, 0x17, 0x9d, 0x15, 0x39, 0x6a, 0x7a, 0xdc, 0xf7, 0x41, 0x7d, 0x76, 0xe4, 0xc0, 0x67, 0x10,
+	0x1d, 0xa6, 0xc7, 0x73, 0x26, 0xff, 0x1e, 0x39, 0x13, 0x99, 0xb5, 0x1f, 0x25, 0xcf, 0x67, 0x20,
+	0x9e, 0xf4, 0x5c, 0x87, 0x3e, 0x0a, 0xd6, 0x79, 0xc4, 0x16, 0xd8, 0x58, 0x39, 0x72, 0xa0, 0x2d,
+	0x52, 0x30, 0xad, 0xe4, 0xbf, 0x08, 0xc2, 0xf7, 0x08, 0x56, 0x18, 0xbd, 0xef, 0xbc, 0x7e, 0x16,
+	0xba, 0x98, 0x4a, 0x4a, 0xc5, 0xeb, 0xb5, 0x96, 0x28, 0x33, 0x9c, 0xd8, 0xc2, 0x1c, 0x0c, 0x68,
+	0xe8, 0xa7, 0x50, 0x8c, 0xcc, 0xa2, 0xc1, 0x2c, 0xcd, 0x5c, 0x2f, 0x94, 0x0f, 0x8d, 0xa2, 0xd3,
+	0x05, 0xda, 0x87, 0xd9, 0xd8, 0x0b, 0xf1, 0xe8, 0x14, 0xc7, 0x7d, 0xfd, 0x99, 0xa8, 0xb0, 0x04,
+	0x91, 0xf9, 0x2b, 0x58, 0x4a, 0x46, 0x26, 0xad, 0x36, 0x3c, 0x7a, 0x66, 0x99, 0x6e, 0x69, 0x48,
+	0x77, 0xb0, 0xbb, 0x2d, 0x0e, 0x86, 0xe4, 0xbe, 0x71, 0xc9, 0x63, 0xa5, 0x0b, 0xab, 0xb4, 0xb7,
+	0xda, 0x96, 0xe7, 0x5b, 0xa7, 0xba, 0xd1, 0xf3, 0xcf, 0x88, 0x6b, 0xfd, 0x16, 0x9b, 0xba, 0x11,
+	0x44, 0x39, 0xf6, 0x24, 0x54, 0x4e, 0xaf, 0xe7, 0x6a, 0xeb, 0xef, 0xc8, 0x80, 0xc1, 0xb3, 0x56,
+	0x22, 0xc1, 0x6a, 0x5f, 0xaf, 0x1a, 0xca, 0xa1, 0x13, 0x88, 0x01, 0x74, 0x17, 0x7f, 0x81, 0x4f,
+	0x07, 0xe3, 0x74, 0x6e, 0xac, 0x1b, 0x2d, 0x47, 0x22, 0x2a, 0xd7, 0x88, 0xa2, 0xf5, 0x33, 0x00,
+	0x3a, 0xac, 0xf2, 0x68, 0x9a, 0x1f, 0x4b, 0x90, 0x8e, 0xb7, 0x3c, 0xa6, 0x14, 0x10, 0xa3, 0x60,
+	0xe7, 0x22, 0x0b, 0x37, 0x88, 0x6c, 0x57, 0xb6, 0x2a, 0x5b, 0xea, 0x4c, 0x9f, 0xc7, 0xa5, 0x1e,
+	0xc1, 0xed, 0xbe, 0xf3, 0xf0, 0x25, 0x3e, 0xed, 0xb1, 0xf1, 0xad, 0x6d, 0x78, 0xd2, 0x6d, 0x3a,
+	0x49, 0x8d, 0x18, 0x44, 0xfa, 0x65, 0x48, 0x0e, 0xe1, 0x8f, 0x0d, 0xef, 0xe1, 0xdc, 0xab, 0xe1,
+	0xb0, 0x5b, 0xfb, 0x3a, 0x05, 0x68, 0x3f, 0xf8, 0x25, 0xa1, 0x66, 0x78, 0xd8, 0xfc, 0x5f, 0xf6,
+	0xf2, 0x58, 0xff, 0x48, 0xbd, 0xb3, 0x7f, 0x6c, 0x8c, 0x78, 0xeb, 0xa1, 0x06, 0x12, 0xbd, 0xed,
+	0x40, 0xbb, 0x49, 0xbf, 0x7f, 0xbb, 0xc9, 0x8c, 0xd3, 0xe6, 0x87, 0xbf, 0xce, 0xb8, 0x90, 0x7d,
+	0x4c, 0xce, 0xb1, 0xeb, 0x10, 0x17, 0x3d, 0x80, 0x29, 0x1e, 0xdd, 0x92, 0x70, 0xc3, 0xcc, 0x1a,
+	0x02, 0x51, 0x19, 0xa6, 0x4d, 0xec, 0x9d, 0xba, 0x56, 0xf0, 0xcb, 0x57, 0xf0, 0x2d, 0x2d, 0xbe,
+	0x34, 0xe2, 0xcc, 0x97, 0x02, 0xcc, 0x07, 0x87, 0xd2, 0xa4, 0xab, 0xe3, 0x0e, 0x6e, 0xb3, 0xe7,
+	0x46, 0x32, 0xcc, 0x9a, 0xc1, 0x27, 0xe2, 0xea, 0xe3, 0x9a, 0x22, 0xf6, 0x29, 0x7c, 0x1d, 0xed,
+	0x82, 0xd8, 0xe6, 0x77, 0xea, 0xab, 0xdc, 0x34, 0x84, 0xcf, 0x84, 0x0c, 0xbe, 0x3c, 0x6c, 0xf6,
+	0xfd, 0x3f, 0x08, 0x90, 0x8f, 0x7f, 0x9d, 0x46, 0x2b, 0x70, 0xa7, 0xa9, 0x36, 0x9a, 0x8d, 0x56,
+	0x75, 0x4f, 0xd7, 0x8e, 0x9b, 0xb2, 0x7e, 0x78, 0xd0, 0x6a, 0xca, 0xbb, 0xca, 0x23, 0x45, 0xae,
+	0x8b, 0xb7, 0xd0, 0x12, 0xdc, 0x1e, 0xdc, 0x6e, 0x69, 0xd5, 0x83, 0x7a, 0x55, 0xad, 0x8b, 0x02,
+	0xba, 0x07, 0x2b, 0x83, 0x7b, 0xfb, 0x87, 0x7b, 0x9a, 0xd2, 0xdc, 0x93, 0xf5, 0xdd, 0x27, 0x0d,
+	0x65, 0x57, 0x16, 0x53, 0xe8, 0x2e, 0x48, 0x83, 0x90, 0x46, 0x53, 0x53, 0xf6, 0x95, 0x96, 0xa6,
+	0xec, 0x8a, 0x69, 0xb4, 0x0c, 0x8b, 0x83, 0xbb, 0xf2, 0xb3, 0xa6, 0x5c, 0x57, 0x34, 0xb9, 0x2e,
+	0x66, 0xee, 0x13, 0x28, 0x0c, 0x7c, 0x45, 0x47, 0x25, 0x58, 0xd2, 0xaa, 0x7b, 0x7b, 0xc7, 0x7a,
+	0x4b, 0x53, 0xab, 0x9a, 0xfc, 0xf8, 0x38, 0x61, 0xea, 0x5d, 0x90, 0x12, 0xfb, 0xcd, 0xbd, 0x43,
+	0xb5, 0xba, 0xa7, 0x68, 0xc7, 0xa2, 0x80, 0xca, 0x70, 0x37, 0xb1, 0xab, 0x56, 0x0f, 0x7e, 0x2e,
+	0xd7, 0xfb, 0xb6, 0xde, 0xff, 0x97, 0x00, 0x10, 0xfb, 0x25, 0x74, 0x19, 0x16, 0x8f, 0x1a, 0x5a,
+	0x60, 0x71, 0xe3, 0x20, 0x71, 0xd6, 0x1c, 0xcc, 0xc4, 0x37, 0x8f, 0xe5, 0x96, 0x28, 0x24, 0x17,
+	0x1b, 0x07, 0xb2, 0x28, 0xa0, 0x45, 0x98, 0x8b, 0x2f, 0x56, 0x6b, 0x2d, 0xad, 0xaa, 0x1c, 0x88,
+	0xa9, 0x24, 0x5a, 0x7b, 0xda, 0x10, 0x53, 0x08, 0x41, 0x31, 0xbe, 0x78, 0xd0, 0x10, 0xd3, 0x68,
+	0x01, 0x66, 0x07, 0x80, 0x4f, 0x54, 0x59, 0x16, 0xd3, 0xf4, 0xba, 0x83, 0x50, 0xfd, 0xa9, 0xa2,
+	0x3d, 0xd1, 0x8f, 0x64, 0xad, 0x21, 0x66, 0xd0, 0x3c, 0x88, 0xf1, 0xdd, 0x47, 0x8d, 0x43, 0x75,
+	0x78, 0xb5, 0xd5, 0xac, 0xee, 0x8b, 0x13, 0x4b, 0x29, 0x51, 0xb8, 0xff, 0x17, 0x01, 0x8a, 0x83,
+	0x3f, 0x47, 0xa2, 0x55, 0x58, 0xee, 0x7b, 0xa7, 0xa5, 0x55, 0xb5, 0xc3, 0x56, 0xe2, 0x11, 0xd6,
+	0xa0, 0x94, 0x04, 0xd4, 0xe5, 0x66, 0xa3, 0xa5, 0x68, 0x7a, 0x53, 0x56, 0x95, 0x46, 0x32, 0x46,
+	0x38, 0xe6, 0xa8, 0xa1, 0x29, 0x07, 0x8f, 0x43, 0x48, 0x6a, 0x20, 0xc4, 0x38, 0xa4, 0x59, 0x6d,
+	0xb5, 0xe4, 0x7a, 0x70, 0xc9, 0xe4, 0x9e, 0x2a, 0x7f, 0x2e, 0xef, 0xb2, 0x10, 0x19, 0xc5, 0x7c,
+	0x54, 0x55, 0xf6, 0xe4, 0xba, 0x38, 0x51, 0xdb, 0xf9, 0xe6, 0x4d, 0x49, 0xf8, 0xf6, 0x4d, 0x49,
+	0xf8, 0xc7, 0x9b, 0x92, 0xf0, 0xe5, 0xdb, 0xd2, 0xad, 0x6f, 0xdf, 0x96, 0x6e, 0xfd, 0xf5, 0x6d,
+	0xe9, 0xd6, 0x2f, 0x97, 0x83, 0xdc, 0xf1, 0xcc, 0x17, 0x15, 0x8b, 0x6c, 0xb2, 0xec, 0xd8, 0xa4,
+	0x3f, 0x3e, 0x79, 0xf4, 0x57, 0xfc, 0x49, 0x56, 0x3f, 0x3f, 0xfe, 0xef, 0x00, 0xa5, 0xeb, 0xa6,
+	0x7d, 0x06, 0x18, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1532,6 +1710,13 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TallyStrategy != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.TallyStrategy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.ProposalType != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
 		i--
@@ -1841,6 +2026,24 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Ranking) > 0 {
+		dAtA7 := make([]byte, len(m.Ranking)*10)
+		var j6 int
+		for _, num := range m.Ranking {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintGov(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1898,12 +2101,12 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.MaxDepositPeriod != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err6 != nil {
-			return 0, err6
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err8 != nil {
+			return 0, err8
 		}
-		i -= n6
-		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1945,12 +2148,12 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.VotingPeriod != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err7 != nil {
-			return 0, err7
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n7
-		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2136,12 +2339,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -2188,22 +2391,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err12 != nil {
+			return 0, err12
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n12
+		i = encodeVarintGov(dAtA, i, uint64(n12))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2275,12 +2478,86 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.VotingPeriod != nil {
-		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err11 != nil {
-			return 0, err11
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err13 != nil {
+			return 0, err13
 		}
-		i -= n11
-		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i -= n13
+		i = encodeVarintGov(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Governor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Governor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Governor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernanceDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2406,6 +2683,9 @@ func (m *Proposal) Size() (n int) {
 	if m.ProposalType != 0 {
 		n += 2 + sovGov(uint64(m.ProposalType))
 	}
+	if m.TallyStrategy != 0 {
+		n += 2 + sovGov(uint64(m.TallyStrategy))
+	}
 	return n
 }
 
@@ -2506,6 +2786,13 @@ func (m *Vote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.Ranking) > 0 {
+		l = 0
+		for _, e := range m.Ranking {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
 	return n
 }
 
@@ -2690,6 +2977,40 @@ func (m *MessageBasedParams) Size() (n int) {
 	return n
 }
 
+func (m *Governor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernanceDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3446,6 +3767,25 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyStrategy", wireType)
+			}
+			m.TallyStrategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TallyStrategy |= TallyStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -4161,6 +4501,75 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v VoteOption
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= VoteOption(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ranking = append(m.Ranking, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Ranking) == 0 {
+					m.Ranking = make([]VoteOption, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v VoteOption
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= VoteOption(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ranking = append(m.Ranking, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranking", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5469,6 +5878,234 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Governor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Governor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Governor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,8 +14,9 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _, _ sdk.Msg                              = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgSubmitMultipleChoiceProposal{}
-	_, _                   gogoprotoany.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _, _ sdk.Msg                              = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgVoteRanked{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgSubmitMultipleChoiceProposal{}
+	_, _, _, _                sdk.Msg                              = &MsgCreateGovernor{}, &MsgEditGovernor{}, &MsgDelegateGovernor{}, &MsgUndelegateGovernor{}
+	_, _                      gogoprotoany.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -103,6 +104,31 @@ func NewMsgVoteWeighted(voter string, proposalID uint64, options WeightedVoteOpt
 	return &MsgVoteWeighted{proposalID, voter, options, metadata}
 }
 
+// NewMsgVoteRanked creates a message to cast a ranked vote on an active proposal
+func NewMsgVoteRanked(voter string, proposalID uint64, ranking []VoteOption, metadata string) *MsgVoteRanked {
+	return &MsgVoteRanked{proposalID, voter, ranking, metadata}
+}
+
+// NewMsgCreateGovernor creates a message to register an account as a governor
+func NewMsgCreateGovernor(address, description string) *MsgCreateGovernor {
+	return &MsgCreateGovernor{Address: address, Description: description}
+}
+
+// NewMsgEditGovernor creates a message to edit the description of a governor
+func NewMsgEditGovernor(address, description string) *MsgEditGovernor {
+	return &MsgEditGovernor{Address: address, Description: description}
+}
+
+// NewMsgDelegateGovernor creates a message to delegate the governance voting power of an account to a governor
+func NewMsgDelegateGovernor(delegator, governor string) *MsgDelegateGovernor {
+	return &MsgDelegateGovernor{DelegatorAddress: delegator, GovernorAddress: governor}
+}
+
+// NewMsgUndelegateGovernor creates a message to remove the governance delegation of an account
+func NewMsgUndelegateGovernor(delegator string) *MsgUndelegateGovernor {
+	return &MsgUndelegateGovernor{DelegatorAddress: delegator}
+}
+
 // NewMsgExecLegacyContent creates a new MsgExecLegacyContent instance.
 func NewMsgExecLegacyContent(content *codectypes.Any, authority string) *MsgExecLegacyContent {
 	return &MsgExecLegacyContent{
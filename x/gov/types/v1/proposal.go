@@ -74,6 +74,13 @@ func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
 	return params.MinDeposit
 }
 
+// IsRankedChoice returns true if the votes of the proposal are tallied using the
+// ranked choice strategy. Only multiple choice proposals support it.
+func (p Proposal) IsRankedChoice() bool {
+	return p.ProposalType == ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE &&
+		p.TallyStrategy == TallyStrategy_TALLY_STRATEGY_RANKED_CHOICE
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
 	return sdktx.UnpackInterfaces(unpacker, p.Messages)
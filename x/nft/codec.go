@@ -11,6 +11,11 @@ import (
 func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 	registrar.RegisterImplementations((*coretransaction.Msg)(nil),
 		&MsgSend{},
+		&MsgSaveClass{},
+		&MsgMint{},
+		&MsgBurn{},
+		&MsgUpdate{},
+		&MsgUpdateClass{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -12,4 +12,8 @@ var (
 	ErrNFTNotExists   = errors.Register(ModuleName, 6, "nft does not exist")
 	ErrEmptyClassID   = errors.Register(ModuleName, 7, "empty class id")
 	ErrEmptyNFTID     = errors.Register(ModuleName, 8, "empty nft id")
+	ErrInvalidSigner  = errors.Register(ModuleName, 9, "invalid signer")
+	ErrInvalidRoyalty = errors.Register(ModuleName, 10, "invalid royalty")
+
+	ErrTransferHooksNotFound = errors.Register(ModuleName, 11, "transfer hooks not found")
 )
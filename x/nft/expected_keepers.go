@@ -20,3 +20,19 @@ type AccountKeeper interface {
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	AddressCodec() address.Codec
 }
+
+// TransferHooks defines the hooks run on the transfers of the nfts of a class
+// referencing them by name.
+type TransferHooks interface {
+	// BeforeTransfer is called before a nft is transferred, the transfer is vetoed if it returns an error.
+	BeforeTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+	// AfterTransfer is called after a nft is transferred.
+	AfterTransfer(ctx context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error
+}
+
+// TransferHooksWrapper is a wrapper for modules to inject TransferHooks using depinject,
+// the hooks being registered under the name of the module.
+type TransferHooksWrapper struct{ TransferHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (TransferHooksWrapper) IsOnePerModuleType() {}
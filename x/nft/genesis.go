@@ -10,6 +10,21 @@ func ValidateGenesis(data GenesisState, ac address.Codec) error {
 		if len(class.Id) == 0 {
 			return ErrEmptyClassID
 		}
+		if class.Minter != "" {
+			if _, err := ac.StringToBytes(class.Minter); err != nil {
+				return err
+			}
+		}
+		if class.Updater != "" {
+			if _, err := ac.StringToBytes(class.Updater); err != nil {
+				return err
+			}
+		}
+		if class.Royalty != nil {
+			if err := class.Royalty.Validate(ac); err != nil {
+				return err
+			}
+		}
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
@@ -259,3 +259,29 @@ func (k Keeper) Classes(ctx context.Context, r *nft.QueryClassesRequest) (*nft.Q
 		Pagination: pageRes,
 	}, nil
 }
+
+// Royalty return the royalty of a class, and the royalty amount of a sale price
+func (k Keeper) Royalty(ctx context.Context, r *nft.QueryRoyaltyRequest) (*nft.QueryRoyaltyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	class, has := k.GetClass(ctx, r.ClassId)
+	if !has {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	resp := &nft.QueryRoyaltyResponse{Royalty: class.Royalty}
+	if class.Royalty != nil && r.SalePrice != nil {
+		if err := r.SalePrice.Validate(); err != nil {
+			return nil, sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+		}
+		amount := class.Royalty.Amount(*r.SalePrice)
+		resp.Amount = &amount
+	}
+	return resp, nil
+}
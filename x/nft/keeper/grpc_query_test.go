@@ -8,9 +8,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/nft"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestGRPCQuery(t *testing.T) {
@@ -985,3 +987,32 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestRoyalty() {
+	require := s.Require()
+	class := ExpClass
+	class.Royalty = &nft.Royalty{Recipient: s.encodedAddrs[1], Rate: math.LegacyNewDecWithPrec(25, 3)}
+	require.NoError(s.nftKeeper.SaveClass(s.ctx, class))
+	require.NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "no-royalty"}))
+
+	_, err := s.queryClient.Royalty(gocontext.Background(), &nft.QueryRoyaltyRequest{})
+	require.ErrorContains(err, nft.ErrEmptyClassID.Error())
+	_, err = s.queryClient.Royalty(gocontext.Background(), &nft.QueryRoyaltyRequest{ClassId: "kitty1"})
+	require.ErrorContains(err, "not found class")
+
+	res, err := s.queryClient.Royalty(gocontext.Background(), &nft.QueryRoyaltyRequest{ClassId: "no-royalty"})
+	require.NoError(err)
+	require.Nil(res.Royalty)
+	require.Nil(res.Amount)
+
+	res, err = s.queryClient.Royalty(gocontext.Background(), &nft.QueryRoyaltyRequest{ClassId: testClassID})
+	require.NoError(err)
+	require.Equal(class.Royalty, res.Royalty)
+	require.Nil(res.Amount)
+
+	salePrice := sdk.NewInt64Coin("stake", 1010)
+	res, err = s.queryClient.Royalty(gocontext.Background(), &nft.QueryRoyaltyRequest{ClassId: testClassID, SalePrice: &salePrice})
+	require.NoError(err)
+	// the royalty amount is rounded down
+	require.Equal(sdk.NewInt64Coin("stake", 25), *res.Amount)
+}
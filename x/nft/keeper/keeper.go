@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	"context"
+	"fmt"
+
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/errors"
 	"cosmossdk.io/x/nft"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -15,11 +19,19 @@ type Keeper struct {
 	cdc codec.BinaryCodec
 	bk  nft.BankKeeper
 	ac  address.Codec
+
+	// the address capable of executing a MsgSaveClass message, and of minting
+	// and updating the nfts of any class. Typically, this should be the x/gov module account.
+	authority string
+
+	// transferHooks are the transfer hooks registered by name, run on the
+	// transfers of the nfts of the classes referencing them.
+	transferHooks map[string]nft.TransferHooks
 }
 
 // NewKeeper creates a new nft Keeper instance
 func NewKeeper(env appmodule.Environment,
-	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper,
+	cdc codec.BinaryCodec, ak nft.AccountKeeper, bk nft.BankKeeper, authority string,
 ) Keeper {
 	// ensure nft module account is set
 	if addr := ak.GetModuleAddress(nft.ModuleName); addr == nil {
@@ -31,5 +43,41 @@ func NewKeeper(env appmodule.Environment,
 		cdc:         cdc,
 		bk:          bk,
 		ac:          ak.AddressCodec(),
+		authority:   authority,
+
+		transferHooks: make(map[string]nft.TransferHooks),
 	}
 }
+
+// SetTransferHooks registers transfer hooks under the given name, so that classes
+// can reference them.
+func (k Keeper) SetTransferHooks(name string, hooks nft.TransferHooks) {
+	if _, ok := k.transferHooks[name]; ok {
+		panic(fmt.Sprintf("cannot set transfer hooks %s twice", name))
+	}
+	k.transferHooks[name] = hooks
+}
+
+// HasTransferHooks returns whether transfer hooks are registered under the given name.
+func (k Keeper) HasTransferHooks(name string) bool {
+	_, ok := k.transferHooks[name]
+	return ok
+}
+
+// classTransferHooks returns the transfer hooks of a class, or nil if it has none.
+func (k Keeper) classTransferHooks(ctx context.Context, classID string) (nft.TransferHooks, error) {
+	class, has := k.GetClass(ctx, classID)
+	if !has || class.TransferHook == "" {
+		return nil, nil
+	}
+	hooks, ok := k.transferHooks[class.TransferHook]
+	if !ok {
+		return nil, errors.Wrapf(nft.ErrTransferHooksNotFound, "%s of class %s", class.TransferHook, classID)
+	}
+	return hooks, nil
+}
+
+// GetAuthority returns the x/nft module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
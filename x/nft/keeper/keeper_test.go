@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -23,6 +25,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 const (
@@ -45,6 +48,8 @@ type TestSuite struct {
 	encodedAddrs  []string
 	queryClient   nft.QueryClient
 	nftKeeper     keeper.Keeper
+	msgServer     nft.MsgServer
+	authority     string
 	accountKeeper *nfttestutil.MockAccountKeeper
 
 	encCfg moduletestutil.TestEncodingConfig
@@ -74,12 +79,17 @@ func (s *TestSuite) SetupTest() {
 
 	s.accountKeeper = accountKeeper
 
+	authority, err := accountKeeper.AddressCodec().BytesToString(authtypes.NewModuleAddress(nft.GovModuleName))
+	s.Require().NoError(err)
+	s.authority = authority
+
 	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
-	nftKeeper := keeper.NewKeeper(env, s.encCfg.Codec, accountKeeper, bankKeeper)
+	nftKeeper := keeper.NewKeeper(env, s.encCfg.Codec, accountKeeper, bankKeeper, authority)
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, s.encCfg.InterfaceRegistry)
 	nft.RegisterQueryServer(queryHelper, nftKeeper)
 
 	s.nftKeeper = nftKeeper
+	s.msgServer = keeper.NewMsgServerImpl(nftKeeper)
 	s.queryClient = nft.NewQueryClient(queryHelper)
 	s.ctx = ctx
 }
@@ -334,6 +344,58 @@ func (s *TestSuite) TestTransfer() {
 	s.Require().EqualValues([]nft.NFT{expNFT}, actNFTs)
 }
 
+type transfer struct {
+	classID, nftID   string
+	sender, receiver sdk.AccAddress
+}
+
+// mockTransferHooks records the transfers, and vetoes the transfers to a denied receiver.
+type mockTransferHooks struct {
+	denied    sdk.AccAddress
+	transfers []transfer
+}
+
+func (h *mockTransferHooks) BeforeTransfer(_ context.Context, _, _ string, _, receiver sdk.AccAddress) error {
+	if receiver.Equals(h.denied) {
+		return errors.New("transfer denied")
+	}
+	return nil
+}
+
+func (h *mockTransferHooks) AfterTransfer(_ context.Context, classID, nftID string, sender, receiver sdk.AccAddress) error {
+	h.transfers = append(h.transfers, transfer{classID, nftID, sender, receiver})
+	return nil
+}
+
+func (s *TestSuite) TestTransferHooks() {
+	hooks := &mockTransferHooks{denied: s.addrs[2]}
+	s.nftKeeper.SetTransferHooks("marketplace", hooks)
+	s.Require().True(s.nftKeeper.HasTransferHooks("marketplace"))
+	s.Require().Panics(func() { s.nftKeeper.SetTransferHooks("marketplace", hooks) })
+
+	class := ExpClass
+	class.TransferHook = "marketplace"
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, class))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, ExpNFT, s.addrs[0]))
+
+	// the hooks veto the transfer
+	err := s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[2])
+	s.Require().ErrorContains(err, "transfer denied")
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+	s.Require().Empty(hooks.transfers)
+
+	// the hooks observe the transfer
+	s.Require().NoError(s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[1]))
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+	s.Require().Equal([]transfer{{testClassID, testID, s.addrs[0], s.addrs[1]}}, hooks.transfers)
+
+	// the transfers of a class referencing unknown hooks fail
+	class.TransferHook = "unknown"
+	s.Require().NoError(s.nftKeeper.UpdateClass(s.ctx, class))
+	err = s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[0])
+	s.Require().ErrorIs(err, nft.ErrTransferHooksNotFound)
+}
+
 func (s *TestSuite) TestExportGenesis() {
 	class := nft.Class{
 		Id:          testClassID,
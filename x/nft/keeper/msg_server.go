@@ -10,10 +10,22 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ nft.MsgServer = Keeper{}
+var _ nft.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/nft MsgServer interface.
+func NewMsgServerImpl(k Keeper) nft.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
 
 // Send implements Send method of the types.MsgServer.
-func (k Keeper) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+func (k msgServer) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	if len(msg.ClassId) == 0 {
 		return nil, nft.ErrEmptyClassID
 	}
@@ -52,3 +64,205 @@ func (k Keeper) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespons
 
 	return &nft.MsgSendResponse{}, nil
 }
+
+// SaveClass implements SaveClass method of the types.MsgServer.
+func (k msgServer) SaveClass(ctx context.Context, msg *nft.MsgSaveClass) (*nft.MsgSaveClassResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(nft.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.validateClass(msg.Class); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SaveClass(ctx, *msg.Class); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&nft.EventSaveClass{
+		ClassId: msg.Class.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgSaveClassResponse{}, nil
+}
+
+// Mint implements Mint method of the types.MsgServer.
+func (k msgServer) Mint(ctx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	if msg.Nft == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nft cannot be nil")
+	}
+
+	if len(msg.Nft.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Nft.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	if _, err := k.ac.StringToBytes(msg.Minter); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", msg.Minter)
+	}
+
+	receiver, err := k.ac.StringToBytes(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", msg.Receiver)
+	}
+
+	class, has := k.GetClass(ctx, msg.Nft.ClassId)
+	if !has {
+		return nil, errorsmod.Wrap(nft.ErrClassNotExists, msg.Nft.ClassId)
+	}
+
+	if msg.Minter != k.authority && (class.Minter == "" || msg.Minter != class.Minter) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the minter of class %s", msg.Minter, class.Id)
+	}
+
+	if err := k.Keeper.Mint(ctx, *msg.Nft, receiver); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgMintResponse{}, nil
+}
+
+// Burn implements Burn method of the types.MsgServer.
+func (k msgServer) Burn(ctx context.Context, msg *nft.MsgBurn) (*nft.MsgBurnResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	owner, err := k.ac.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", msg.Owner)
+	}
+
+	if !bytes.Equal(k.GetOwner(ctx, msg.ClassId, msg.Id), owner) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Owner, msg.Id)
+	}
+
+	if err := k.Keeper.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgBurnResponse{}, nil
+}
+
+// Update implements Update method of the types.MsgServer.
+func (k msgServer) Update(ctx context.Context, msg *nft.MsgUpdate) (*nft.MsgUpdateResponse, error) {
+	if msg.Nft == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nft cannot be nil")
+	}
+
+	if len(msg.Nft.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Nft.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	if _, err := k.ac.StringToBytes(msg.Updater); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid updater address (%s)", msg.Updater)
+	}
+
+	class, has := k.GetClass(ctx, msg.Nft.ClassId)
+	if !has {
+		return nil, errorsmod.Wrap(nft.ErrClassNotExists, msg.Nft.ClassId)
+	}
+
+	if msg.Updater != k.authority && (class.Updater == "" || msg.Updater != class.Updater) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the updater of class %s", msg.Updater, class.Id)
+	}
+
+	if err := k.Keeper.Update(ctx, *msg.Nft); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&nft.EventUpdate{
+		ClassId: msg.Nft.ClassId,
+		Id:      msg.Nft.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgUpdateResponse{}, nil
+}
+
+// UpdateClass implements UpdateClass method of the types.MsgServer.
+func (k msgServer) UpdateClass(ctx context.Context, msg *nft.MsgUpdateClass) (*nft.MsgUpdateClassResponse, error) {
+	if err := k.validateClass(msg.Class); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.ac.StringToBytes(msg.Updater); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid updater address (%s)", msg.Updater)
+	}
+
+	class, has := k.GetClass(ctx, msg.Class.Id)
+	if !has {
+		return nil, errorsmod.Wrap(nft.ErrClassNotExists, msg.Class.Id)
+	}
+
+	if msg.Updater != k.authority {
+		if class.Updater == "" || msg.Updater != class.Updater {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the updater of class %s", msg.Updater, class.Id)
+		}
+
+		if msg.Class.Minter != class.Minter || msg.Class.Updater != class.Updater {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the module authority can change the minter and updater of a class")
+		}
+	}
+
+	if err := k.Keeper.UpdateClass(ctx, *msg.Class); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&nft.EventUpdateClass{
+		ClassId: msg.Class.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgUpdateClassResponse{}, nil
+}
+
+// validateClass validates a class of a message.
+func (k msgServer) validateClass(class *nft.Class) error {
+	if class == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "class cannot be nil")
+	}
+
+	if len(class.Id) == 0 {
+		return nft.ErrEmptyClassID
+	}
+
+	if class.Minter != "" {
+		if _, err := k.ac.StringToBytes(class.Minter); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", class.Minter)
+		}
+	}
+
+	if class.Updater != "" {
+		if _, err := k.ac.StringToBytes(class.Updater); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid updater address (%s)", class.Updater)
+		}
+	}
+
+	if class.Royalty != nil {
+		if err := class.Royalty.Validate(k.ac); err != nil {
+			return err
+		}
+	}
+
+	if class.TransferHook != "" && !k.HasTransferHooks(class.TransferHook) {
+		return errorsmod.Wrap(nft.ErrTransferHooksNotFound, class.TransferHook)
+	}
+
+	return nil
+}
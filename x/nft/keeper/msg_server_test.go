@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/nft"
 )
 
@@ -121,7 +122,7 @@ func (s *TestSuite) TestSend() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			_, err := s.nftKeeper.Send(s.ctx, tc.req)
+			_, err := s.msgServer.Send(s.ctx, tc.req)
 			if tc.expErr {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), tc.errMsg)
@@ -131,3 +132,191 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestSaveClassMsg() {
+	class := ExpClass
+	class.Minter = s.encodedAddrs[1]
+	class.Updater = s.encodedAddrs[2]
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgSaveClass
+		expErr bool
+		errMsg string
+	}{
+		{
+			name:   "invalid authority",
+			req:    &nft.MsgSaveClass{Authority: s.encodedAddrs[0], Class: &class},
+			expErr: true,
+			errMsg: "invalid authority",
+		},
+		{
+			name:   "nil class",
+			req:    &nft.MsgSaveClass{Authority: s.authority},
+			expErr: true,
+			errMsg: "class cannot be nil",
+		},
+		{
+			name:   "empty class id",
+			req:    &nft.MsgSaveClass{Authority: s.authority, Class: &nft.Class{Name: testClassName}},
+			expErr: true,
+			errMsg: "empty class id",
+		},
+		{
+			name:   "invalid minter",
+			req:    &nft.MsgSaveClass{Authority: s.authority, Class: &nft.Class{Id: testClassID, Minter: "invalid"}},
+			expErr: true,
+			errMsg: "Invalid minter address",
+		},
+		{
+			name:   "valid transaction",
+			req:    &nft.MsgSaveClass{Authority: s.authority, Class: &class},
+			expErr: false,
+		},
+		{
+			name:   "class already exists",
+			req:    &nft.MsgSaveClass{Authority: s.authority, Class: &class},
+			expErr: true,
+			errMsg: "nft class already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.SaveClass(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	actual, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(class, actual)
+}
+
+func (s *TestSuite) TestMintBurnUpdateMsgs() {
+	class := ExpClass
+	class.Minter = s.encodedAddrs[1]
+	class.Updater = s.encodedAddrs[2]
+	_, err := s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{Authority: s.authority, Class: &class})
+	s.Require().NoError(err)
+
+	token := ExpNFT
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: s.encodedAddrs[0], Nft: &token, Receiver: s.encodedAddrs[0]})
+	s.Require().ErrorContains(err, fmt.Sprintf("%s is not the minter of class %s", s.encodedAddrs[0], testClassID))
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: s.encodedAddrs[1], Nft: &nft.NFT{ClassId: "unknown", Id: testID}, Receiver: s.encodedAddrs[0]})
+	s.Require().ErrorContains(err, "nft class does not exist")
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: s.encodedAddrs[1], Nft: &token, Receiver: s.encodedAddrs[0]})
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: s.encodedAddrs[1], Nft: &token, Receiver: s.encodedAddrs[0]})
+	s.Require().ErrorContains(err, "nft already exists")
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// the module authority can mint any nft
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: s.authority, Nft: &nft.NFT{ClassId: testClassID, Id: "kitty2"}, Receiver: s.encodedAddrs[1]})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+
+	updated := token
+	updated.Uri = "new kitty uri"
+	_, err = s.msgServer.Update(s.ctx, &nft.MsgUpdate{Updater: s.encodedAddrs[1], Nft: &updated})
+	s.Require().ErrorContains(err, fmt.Sprintf("%s is not the updater of class %s", s.encodedAddrs[1], testClassID))
+	_, err = s.msgServer.Update(s.ctx, &nft.MsgUpdate{Updater: s.encodedAddrs[2], Nft: &nft.NFT{ClassId: testClassID, Id: "unknown"}})
+	s.Require().ErrorContains(err, "nft does not exist")
+	_, err = s.msgServer.Update(s.ctx, &nft.MsgUpdate{Updater: s.encodedAddrs[2], Nft: &updated})
+	s.Require().NoError(err)
+	actual, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(updated, actual)
+
+	_, err = s.msgServer.Burn(s.ctx, &nft.MsgBurn{Owner: s.encodedAddrs[1], ClassId: testClassID, Id: testID})
+	s.Require().ErrorContains(err, fmt.Sprintf("%s is not the owner of nft %s", s.encodedAddrs[1], testID))
+	_, err = s.msgServer.Burn(s.ctx, &nft.MsgBurn{Owner: s.encodedAddrs[0], ClassId: testClassID, Id: ""})
+	s.Require().ErrorContains(err, "empty nft id")
+	_, err = s.msgServer.Burn(s.ctx, &nft.MsgBurn{Owner: s.encodedAddrs[0], ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+	s.Require().Equal(uint64(1), s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+}
+
+func (s *TestSuite) TestUpdateClassMsg() {
+	class := ExpClass
+	class.Minter = s.encodedAddrs[1]
+	class.Updater = s.encodedAddrs[2]
+	_, err := s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{Authority: s.authority, Class: &class})
+	s.Require().NoError(err)
+	s.nftKeeper.SetTransferHooks("marketplace", &mockTransferHooks{})
+
+	withRoyalty := class
+	withRoyalty.Royalty = &nft.Royalty{Recipient: s.encodedAddrs[2], Rate: math.LegacyNewDecWithPrec(5, 2)}
+	withRoyalty.TransferHook = "marketplace"
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgUpdateClass
+		expErr bool
+		errMsg string
+	}{
+		{
+			name:   "not the updater",
+			req:    &nft.MsgUpdateClass{Updater: s.encodedAddrs[1], Class: &withRoyalty},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not the updater of class %s", s.encodedAddrs[1], testClassID),
+		},
+		{
+			name:   "class not exists",
+			req:    &nft.MsgUpdateClass{Updater: s.encodedAddrs[2], Class: &nft.Class{Id: "kitty1"}},
+			expErr: true,
+			errMsg: "nft class does not exist",
+		},
+		{
+			name: "invalid royalty rate",
+			req: &nft.MsgUpdateClass{Updater: s.encodedAddrs[2], Class: &nft.Class{
+				Id: testClassID, Royalty: &nft.Royalty{Recipient: s.encodedAddrs[2], Rate: math.LegacyNewDec(2)},
+			}},
+			expErr: true,
+			errMsg: "rate must be between 0 and 1",
+		},
+		{
+			name:   "unknown transfer hooks",
+			req:    &nft.MsgUpdateClass{Updater: s.encodedAddrs[2], Class: &nft.Class{Id: testClassID, TransferHook: "unknown"}},
+			expErr: true,
+			errMsg: "transfer hooks not found",
+		},
+		{
+			name:   "updater cannot change the minter",
+			req:    &nft.MsgUpdateClass{Updater: s.encodedAddrs[2], Class: &nft.Class{Id: testClassID, Updater: s.encodedAddrs[2]}},
+			expErr: true,
+			errMsg: "only the module authority can change the minter and updater",
+		},
+		{
+			name:   "valid transaction",
+			req:    &nft.MsgUpdateClass{Updater: s.encodedAddrs[2], Class: &withRoyalty},
+			expErr: false,
+		},
+		{
+			name:   "authority can change the minter",
+			req:    &nft.MsgUpdateClass{Updater: s.authority, Class: &nft.Class{Id: testClassID}},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateClass(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+				actual, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+				s.Require().True(has)
+				s.Require().Equal(*tc.req.Class, actual)
+			}
+		})
+	}
+}
@@ -128,7 +128,8 @@ func (k Keeper) Transfer(ctx context.Context,
 	return nil
 }
 
-// transferWithNoCheck defines a method for sending a nft from one account to another account.
+// transferWithNoCheck defines a method for sending a nft from one account to another account,
+// running the transfer hooks of the class.
 // Note: this method does not check whether the class already exists in nft.
 // The upper-layer application needs to check it when it needs to use it
 func (k Keeper) transferWithNoCheck(ctx context.Context,
@@ -137,8 +138,22 @@ func (k Keeper) transferWithNoCheck(ctx context.Context,
 	receiver sdk.AccAddress,
 ) error {
 	owner := k.GetOwner(ctx, classID, nftID)
+	hooks, err := k.classTransferHooks(ctx, classID)
+	if err != nil {
+		return err
+	}
+	if hooks != nil {
+		if err := hooks.BeforeTransfer(ctx, classID, nftID, owner, receiver); err != nil {
+			return err
+		}
+	}
+
 	k.deleteOwner(ctx, classID, nftID, owner)
 	k.setOwner(ctx, classID, nftID, receiver)
+
+	if hooks != nil {
+		return hooks.AfterTransfer(ctx, classID, nftID, owner, receiver)
+	}
 	return nil
 }
 
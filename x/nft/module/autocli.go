@@ -79,6 +79,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all NFT classes.",
 					Example:   fmt.Sprintf(`%s query %s classes`, version.AppName, nft.ModuleName),
 				},
+				{
+					RpcMethod: "Royalty",
+					Use:       "royalty <class-id> [sale-price]",
+					Short:     "Query the royalty of an NFT class, and its amount for the sale price.",
+					Example:   fmt.Sprintf(`%s query %s royalty <class-id> 1000stake`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "sale_price", Optional: true},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -95,6 +105,51 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					// Sender is the signer of the transaction and is automatically added as from flag by AutoCLI.
 				},
+				{
+					RpcMethod:      "SaveClass",
+					Use:            "save-class-proposal <class>",
+					Short:          "Submit a proposal to create a new NFT class.",
+					Example:        fmt.Sprintf(`%s tx %s save-class-proposal '{ "id": "kitty", "name": "Crypto Kitty", "minter": "cosmos1...", ... }'`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "class"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod: "Mint",
+					Use:       "mint <nft> <receiver> --from <minter>",
+					Short:     "Mint a new NFT of a class, as the minter of the class or the module authority.",
+					Example:   fmt.Sprintf(`%s tx %s mint '{ "class_id": "kitty", "id": "kitty1", "uri": "..." }' <receiver> --from <minter>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "nft"},
+						{ProtoField: "receiver"},
+					},
+				},
+				{
+					RpcMethod: "Burn",
+					Use:       "burn <class-id> <nft-id> --from <owner>",
+					Short:     "Burn an owned NFT.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "Update",
+					Use:       "update <nft> --from <updater>",
+					Short:     "Update an NFT, as the updater of its class or the module authority.",
+					Example:   fmt.Sprintf(`%s tx %s update '{ "class_id": "kitty", "id": "kitty1", "uri": "..." }' --from <updater>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "nft"},
+					},
+				},
+				{
+					RpcMethod: "UpdateClass",
+					Use:       "update-class <class> --from <updater>",
+					Short:     "Update an NFT class, as its updater or the module authority.",
+					Example:   fmt.Sprintf(`%s tx %s update-class '{ "id": "kitty", "royalty": { "recipient": "cosmos1...", "rate": "0.05" }, ... }' --from <updater>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class"},
+					},
+				},
 			},
 		},
 	}
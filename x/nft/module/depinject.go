@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -20,12 +21,14 @@ func (am AppModule) IsOnePerModuleType() {}
 func init() {
 	appconfig.RegisterModule(&modulev1.Module{},
 		appconfig.Provide(ProvideModule),
+		appconfig.Invoke(InvokeSetTransferHooks),
 	)
 }
 
 type ModuleInputs struct {
 	depinject.In
 
+	Config      *modulev1.Module
 	Environment appmodule.Environment
 	Cdc         codec.Codec
 	Registry    cdctypes.InterfaceRegistry
@@ -42,8 +45,27 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AccountKeeper, in.BankKeeper)
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(nft.GovModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	as, err := in.AccountKeeper.AddressCodec().BytesToString(authority)
+	if err != nil {
+		panic(err)
+	}
+
+	k := keeper.NewKeeper(in.Environment, in.Cdc, in.AccountKeeper, in.BankKeeper, as)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.Registry)
 
 	return ModuleOutputs{NFTKeeper: k, Module: m}
 }
+
+// InvokeSetTransferHooks registers the transfer hooks provided by the modules
+// under their module name.
+func InvokeSetTransferHooks(keeper keeper.Keeper, hooks map[string]nft.TransferHooksWrapper) {
+	for name, h := range hooks {
+		keeper.SetTransferHooks(name, h)
+	}
+}
@@ -66,7 +66,7 @@ func (AppModule) Name() string {
 // RegisterServices registers a gRPC query service to respond to the
 // module-specific gRPC queries.
 func (am AppModule) RegisterServices(registrar grpc.ServiceRegistrar) error {
-	nft.RegisterMsgServer(registrar, am.keeper)
+	nft.RegisterMsgServer(registrar, keeper.NewMsgServerImpl(am.keeper))
 	nft.RegisterQueryServer(registrar, am.keeper)
 	return nil
 }
@@ -131,6 +131,14 @@ func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
 	sdr[keeper.StoreKey] = simulation.NewDecodeStore(am.cdc)
 }
 
+// ProposalMsgsX returns msgs used for governance proposals for simulations.
+func (AppModule) ProposalMsgsX(weights simsx.WeightSource, reg simsx.Registry) {
+	reg.Add(weights.Get("msg_save_class", 100), simulation.MsgSaveClassFactory())
+}
+
 func (am AppModule) WeightedOperationsX(weights simsx.WeightSource, reg simsx.Registry) {
 	reg.Add(weights.Get("msg_send", 100), simulation.MsgSendFactory(am.keeper))
+	reg.Add(weights.Get("msg_mint", 100), simulation.MsgMintFactory(am.keeper))
+	reg.Add(weights.Get("msg_burn", 50), simulation.MsgBurnFactory(am.keeper))
+	reg.Add(weights.Get("msg_update", 50), simulation.MsgUpdateFactory(am.keeper))
 }
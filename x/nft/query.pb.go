@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -1224,6 +1225,116 @@ func (m *QueryClassesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryRoyaltyRequest is the request type for the Query/Royalty RPC method
+type QueryRoyaltyRequest struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// sale_price is the price of a sale of a nft of the class. Optional
+	SalePrice *types.Coin `protobuf:"bytes,2,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+}
+
+func (m *QueryRoyaltyRequest) Reset()         { *m = QueryRoyaltyRequest{} }
+func (m *QueryRoyaltyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyRequest) ProtoMessage()    {}
+func (*QueryRoyaltyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{24}
+}
+func (m *QueryRoyaltyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyRequest.Merge(m, src)
+}
+func (m *QueryRoyaltyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyRequest proto.InternalMessageInfo
+
+func (m *QueryRoyaltyRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryRoyaltyRequest) GetSalePrice() *types.Coin {
+	if m != nil {
+		return m.SalePrice
+	}
+	return nil
+}
+
+// QueryRoyaltyResponse is the response type for the Query/Royalty RPC method
+type QueryRoyaltyResponse struct {
+	// royalty defines the royalty of the class, nil if the class has no royalty.
+	Royalty *Royalty `protobuf:"bytes,1,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// amount is the royalty amount of the sale price, nil if no sale price is given.
+	Amount *types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *QueryRoyaltyResponse) Reset()         { *m = QueryRoyaltyResponse{} }
+func (m *QueryRoyaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyResponse) ProtoMessage()    {}
+func (*QueryRoyaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{25}
+}
+func (m *QueryRoyaltyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyResponse.Merge(m, src)
+}
+func (m *QueryRoyaltyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyResponse proto.InternalMessageInfo
+
+func (m *QueryRoyaltyResponse) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+func (m *QueryRoyaltyResponse) GetAmount() *types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceByQueryStringRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceByQueryStringRequest")
@@ -1249,74 +1360,82 @@ func init() {
 	proto.RegisterType((*QueryClassByQueryStringResponse)(nil), "cosmos.nft.v1beta1.QueryClassByQueryStringResponse")
 	proto.RegisterType((*QueryClassesRequest)(nil), "cosmos.nft.v1beta1.QueryClassesRequest")
 	proto.RegisterType((*QueryClassesResponse)(nil), "cosmos.nft.v1beta1.QueryClassesResponse")
+	proto.RegisterType((*QueryRoyaltyRequest)(nil), "cosmos.nft.v1beta1.QueryRoyaltyRequest")
+	proto.RegisterType((*QueryRoyaltyResponse)(nil), "cosmos.nft.v1beta1.QueryRoyaltyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/query.proto", fileDescriptor_0d24e0db697b0f9d) }
 
 var fileDescriptor_0d24e0db697b0f9d = []byte{
-	// 987 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x41, 0x6f, 0xdc, 0x54,
-	0x10, 0xce, 0xdb, 0x64, 0x13, 0x98, 0x4a, 0xa1, 0x4c, 0x23, 0xba, 0xeb, 0xa6, 0x66, 0x71, 0x9b,
-	0x64, 0x9b, 0x12, 0x3b, 0x9b, 0x14, 0x0e, 0x08, 0x38, 0x04, 0xb1, 0x15, 0x14, 0x05, 0xd8, 0xe6,
-	0x42, 0x11, 0xaa, 0xbc, 0x59, 0xef, 0xca, 0x22, 0xb5, 0xb7, 0xb1, 0xb7, 0x10, 0x45, 0x3d, 0xd0,
-	0x03, 0xa2, 0xe2, 0x82, 0x44, 0x7f, 0x03, 0x47, 0x0e, 0x08, 0xc1, 0x5f, 0x40, 0x9c, 0x2a, 0xb8,
-	0x70, 0x44, 0x09, 0x3f, 0x04, 0xf9, 0xbd, 0xf1, 0xc6, 0x8e, 0x9f, 0x9f, 0xeb, 0x6d, 0x8e, 0x6f,
-	0xdf, 0xcc, 0x7c, 0xdf, 0xcc, 0x9b, 0x99, 0xcf, 0x0b, 0xfa, 0xae, 0x1f, 0xdc, 0xf3, 0x03, 0xcb,
-	0xeb, 0x87, 0xd6, 0x83, 0x56, 0xd7, 0x09, 0xed, 0x96, 0x75, 0x7f, 0xe4, 0xec, 0x1f, 0x98, 0xc3,
-	0x7d, 0x3f, 0xf4, 0x11, 0xc5, 0xbd, 0xe9, 0xf5, 0x43, 0x93, 0xee, 0xb5, 0x55, 0xf2, 0xe9, 0xda,
-	0x81, 0x23, 0x8c, 0xc7, 0xae, 0x43, 0x7b, 0xe0, 0x7a, 0x76, 0xe8, 0xfa, 0x9e, 0xf0, 0xd7, 0x16,
-	0x07, 0xbe, 0x3f, 0xd8, 0x73, 0x2c, 0x7b, 0xe8, 0x5a, 0xb6, 0xe7, 0xf9, 0x21, 0xbf, 0x0c, 0xe2,
-	0x5b, 0x09, 0x7a, 0x84, 0x24, 0x6e, 0xeb, 0xe2, 0xf6, 0x2e, 0x3f, 0x59, 0x44, 0x84, 0x1f, 0x8c,
-	0x36, 0x5c, 0xf8, 0x34, 0x02, 0xde, 0xb2, 0xf7, 0x6c, 0x6f, 0xd7, 0xe9, 0x38, 0xf7, 0x47, 0x4e,
-	0x10, 0x62, 0x1d, 0x5e, 0xd8, 0xdd, 0xb3, 0x83, 0xe0, 0xae, 0xdb, 0xab, 0xb1, 0x06, 0x6b, 0xbe,
-	0xd8, 0x99, 0xe3, 0xe7, 0x0f, 0x7a, 0xb8, 0x00, 0x55, 0xff, 0x2b, 0xcf, 0xd9, 0xaf, 0x55, 0xf8,
-	0xef, 0xe2, 0x60, 0xec, 0x42, 0x23, 0x19, 0x67, 0xeb, 0x80, 0x9f, 0x6e, 0x87, 0xfb, 0xae, 0x37,
-	0x98, 0x34, 0xe8, 0x5b, 0xf3, 0x7f, 0xfd, 0xba, 0x06, 0x5e, 0x3f, 0x6c, 0x3c, 0x58, 0x37, 0x5b,
-	0x66, 0xcb, 0x30, 0x61, 0x21, 0x4d, 0x36, 0x18, 0xfa, 0x5e, 0xe0, 0xe0, 0x2b, 0x30, 0x6b, 0xdf,
-	0xf3, 0x47, 0x5e, 0xc8, 0xc3, 0xce, 0x74, 0xe8, 0x64, 0xdc, 0x82, 0xd7, 0x14, 0xa4, 0xd4, 0xce,
-	0x19, 0xf0, 0x77, 0xe1, 0x65, 0xee, 0xfe, 0x71, 0x44, 0xed, 0x19, 0x52, 0x9a, 0x87, 0x8a, 0xdb,
-	0xa3, 0x7c, 0x2a, 0x6e, 0xcf, 0xf8, 0x1c, 0xf4, 0x13, 0xff, 0xb2, 0xf5, 0x39, 0x15, 0x2c, 0x43,
-	0x6e, 0x15, 0x30, 0x49, 0x8e, 0x52, 0x1b, 0x57, 0x95, 0x25, 0x9f, 0xea, 0x26, 0xbc, 0x9a, 0x4b,
-	0x44, 0xe5, 0x98, 0x01, 0xb5, 0x08, 0xf4, 0xf6, 0x68, 0x38, 0xdc, 0x3b, 0x28, 0xce, 0xc2, 0xf8,
-	0x88, 0x90, 0x85, 0x43, 0xc9, 0x1a, 0x64, 0xe0, 0xd7, 0xa8, 0x75, 0x63, 0xf8, 0x82, 0x66, 0xf8,
-	0x90, 0x3a, 0x54, 0x0a, 0x5e, 0xb2, 0x17, 0xbe, 0x67, 0x70, 0x9e, 0xfb, 0x6f, 0xb7, 0x77, 0x82,
-	0x49, 0xdb, 0x1b, 0xdb, 0x00, 0x27, 0x63, 0x5e, 0x9b, 0x6e, 0xb0, 0xe6, 0xb9, 0x8d, 0x65, 0x93,
-	0xc6, 0x33, 0xda, 0x09, 0xa6, 0x58, 0x20, 0x34, 0xd0, 0xe6, 0x27, 0xf6, 0x20, 0x1e, 0xd0, 0x4e,
-	0xc2, 0xd3, 0x78, 0xcc, 0xa8, 0x35, 0x05, 0x1b, 0xca, 0xe5, 0x3a, 0xcc, 0x78, 0xfd, 0x30, 0xa8,
-	0xb1, 0xc6, 0x74, 0xf3, 0xdc, 0xc6, 0x45, 0x33, 0xbb, 0x7f, 0xcc, 0xed, 0xf6, 0x4e, 0x87, 0x1b,
-	0xe1, 0xcd, 0x14, 0x95, 0x0a, 0xa7, 0xb2, 0x52, 0x48, 0x45, 0x20, 0xa5, 0xb8, 0xbc, 0x0d, 0x2f,
-	0xc5, 0x54, 0x26, 0x98, 0x91, 0xcf, 0x60, 0x31, 0xf6, 0x3e, 0xeb, 0x09, 0x79, 0xe7, 0xe4, 0xc5,
-	0xc6, 0x25, 0xba, 0x06, 0xd3, 0x5e, 0x5f, 0xbc, 0xb5, 0xa2, 0x42, 0x91, 0x8d, 0x71, 0x07, 0x2e,
-	0xe7, 0x30, 0x2b, 0x1d, 0x4b, 0xb2, 0xd6, 0xc4, 0xf3, 0xbd, 0x17, 0xa5, 0xf2, 0x0c, 0x63, 0x74,
-	0x8b, 0x36, 0x09, 0xb7, 0x7f, 0xde, 0x29, 0x7a, 0x9f, 0x86, 0x98, 0xc0, 0x29, 0x1b, 0x0b, 0xaa,
-	0xdc, 0x81, 0xf2, 0xa9, 0xcb, 0xf2, 0x11, 0x1e, 0xc2, 0xce, 0xe8, 0xd2, 0x68, 0xcb, 0x38, 0x4d,
-	0x18, 0x33, 0x43, 0xf5, 0x0b, 0x1a, 0x78, 0x6e, 0xe4, 0x8c, 0x2b, 0x95, 0x1e, 0x23, 0x36, 0xf1,
-	0x18, 0x3d, 0x61, 0x24, 0x2f, 0xe3, 0xf8, 0x44, 0x7c, 0x13, 0x44, 0xf5, 0x9c, 0x78, 0x98, 0x14,
-	0xd4, 0x63, 0xcb, 0x33, 0x9b, 0xa8, 0x8d, 0xdf, 0xe7, 0xa1, 0xca, 0x69, 0xe1, 0x13, 0x06, 0x73,
-	0x24, 0x65, 0xb8, 0x22, 0xa3, 0x20, 0x51, 0x72, 0xad, 0x59, 0x6c, 0x28, 0x40, 0x8d, 0x37, 0x1f,
-	0xfd, 0xfd, 0xdf, 0x8f, 0x95, 0x75, 0x34, 0x2d, 0xc9, 0xc7, 0x44, 0x57, 0x18, 0x5b, 0x87, 0x7c,
-	0x75, 0x3d, 0xb4, 0x0e, 0xe3, 0xfe, 0x7a, 0x88, 0xbf, 0x31, 0x58, 0x90, 0x29, 0x2c, 0xde, 0x28,
-	0x82, 0x96, 0xf5, 0xae, 0xf6, 0x46, 0x49, 0x2f, 0x62, 0xdf, 0xfa, 0x33, 0xd5, 0x2c, 0x3c, 0x97,
-	0xcb, 0x78, 0x49, 0x91, 0x0b, 0x3e, 0x66, 0x50, 0xe5, 0x22, 0x88, 0x4b, 0xb9, 0x98, 0x49, 0xb5,
-	0xd7, 0x96, 0x8b, 0xcc, 0x62, 0x2e, 0x1c, 0xfd, 0x3a, 0x5e, 0x93, 0xa1, 0xf3, 0x02, 0x26, 0xea,
-	0x67, 0x1d, 0x46, 0x45, 0xfc, 0x99, 0x01, 0x66, 0x05, 0x19, 0x37, 0xd4, 0x88, 0xd2, 0x02, 0x6e,
-	0x96, 0xf2, 0x21, 0xca, 0x96, 0xa4, 0x7c, 0x97, 0xb0, 0x9e, 0x9b, 0x00, 0x7e, 0xc7, 0x60, 0x56,
-	0x48, 0x29, 0xe6, 0x97, 0x25, 0xf5, 0x65, 0xa0, 0xad, 0x14, 0xda, 0x11, 0x99, 0x35, 0x0e, 0xbf,
-	0x82, 0x4b, 0x32, 0xf8, 0x80, 0xdb, 0x26, 0x1b, 0xf0, 0x17, 0x06, 0x17, 0x24, 0xaa, 0x8e, 0x9b,
-	0x05, 0x78, 0xd2, 0xea, 0xdd, 0x28, 0xe7, 0x44, 0x8c, 0xd7, 0x25, 0xe5, 0x5b, 0x44, 0x2d, 0x9f,
-	0x3f, 0x8e, 0x60, 0x26, 0x92, 0x6b, 0xbc, 0x9a, 0x8b, 0x97, 0xf8, 0xb6, 0xd0, 0x96, 0x0a, 0xac,
-	0x88, 0x46, 0x83, 0x03, 0x6b, 0x58, 0xb3, 0xe4, 0xff, 0x07, 0x02, 0x7c, 0xc4, 0x60, 0x7a, 0xbb,
-	0xbd, 0x83, 0x57, 0x54, 0x01, 0x63, 0xd4, 0xab, 0x6a, 0xa3, 0x38, 0x77, 0x0e, 0xba, 0x8a, 0xcd,
-	0x3c, 0xd0, 0x4c, 0xb3, 0xff, 0xc4, 0xe0, 0xfc, 0x69, 0x21, 0xc5, 0x75, 0x15, 0x98, 0xf4, 0xa9,
-	0x5a, 0x25, 0x3c, 0xe2, 0xce, 0x92, 0xbc, 0x53, 0x1d, 0x2f, 0xe6, 0x30, 0xc7, 0x6f, 0x19, 0x54,
-	0xf9, 0x5e, 0x57, 0x6c, 0x88, 0xa4, 0x6a, 0x2b, 0x36, 0x44, 0x4a, 0x5f, 0x0d, 0x93, 0x23, 0x37,
-	0x71, 0x59, 0x86, 0x4c, 0x12, 0x92, 0x6c, 0xf1, 0x68, 0x3d, 0x64, 0xa5, 0x55, 0xb1, 0x1e, 0x72,
-	0xbf, 0x0d, 0x14, 0xeb, 0x21, 0x5f, 0xbb, 0xcb, 0xac, 0x07, 0x4e, 0x1a, 0xbf, 0x61, 0x30, 0x47,
-	0x3a, 0xaa, 0xd0, 0xaa, 0xb4, 0x92, 0x2b, 0xb4, 0xea, 0x94, 0x24, 0x1b, 0x57, 0x54, 0xfb, 0x9d,
-	0xea, 0xb7, 0xf5, 0xfa, 0x1f, 0x47, 0x3a, 0x7b, 0x7a, 0xa4, 0xb3, 0x7f, 0x8f, 0x74, 0xf6, 0xc3,
-	0xb1, 0x3e, 0xf5, 0xf4, 0x58, 0x9f, 0xfa, 0xe7, 0x58, 0x9f, 0xba, 0x43, 0x7f, 0xc6, 0x83, 0xde,
-	0x97, 0xa6, 0xeb, 0x5b, 0x5f, 0x47, 0xde, 0xdd, 0x59, 0xfe, 0x87, 0x78, 0xf3, 0xff, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x2e, 0x12, 0xdc, 0xfc, 0xc9, 0x0f, 0x00, 0x00,
+	// 1081 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xef, 0x4d, 0x9b, 0x86, 0x9d, 0x49, 0xfb, 0x73, 0x5a, 0xb1, 0xc4, 0xed, 0xbc, 0xe0, 0xad,
+	0x6d, 0xd6, 0x51, 0xbb, 0x69, 0x36, 0x84, 0x10, 0xf0, 0x50, 0x44, 0x26, 0x18, 0x2a, 0x23, 0xeb,
+	0x0b, 0x43, 0xa8, 0x72, 0x12, 0x27, 0x32, 0x64, 0x76, 0x16, 0x3b, 0x83, 0xaa, 0x9a, 0x04, 0x7b,
+	0x40, 0x4c, 0xf0, 0x80, 0xc4, 0x3e, 0x03, 0x8f, 0x3c, 0x20, 0xc4, 0x47, 0x40, 0x88, 0xa7, 0x09,
+	0x5e, 0x78, 0x44, 0x2d, 0x1f, 0x04, 0xf9, 0xde, 0x6b, 0xc7, 0x9e, 0xaf, 0xed, 0x38, 0xdb, 0xe3,
+	0xcd, 0xfd, 0x9d, 0xf3, 0xfb, 0x9d, 0x73, 0xef, 0x39, 0xf7, 0xc4, 0x20, 0x77, 0x6c, 0xe7, 0x9e,
+	0xed, 0x68, 0x56, 0xcf, 0xd5, 0x1e, 0xd4, 0xdb, 0x86, 0xab, 0xd7, 0xb5, 0xfb, 0x63, 0x63, 0x74,
+	0xa8, 0x0e, 0x47, 0xb6, 0x6b, 0x23, 0xb2, 0x7d, 0xd5, 0xea, 0xb9, 0x2a, 0xdf, 0x97, 0x36, 0xb9,
+	0x4d, 0x5b, 0x77, 0x0c, 0x06, 0x0e, 0x4c, 0x87, 0x7a, 0xdf, 0xb4, 0x74, 0xd7, 0xb4, 0x2d, 0x66,
+	0x2f, 0xad, 0xf6, 0x6d, 0xbb, 0x3f, 0x30, 0x34, 0x7d, 0x68, 0x6a, 0xba, 0x65, 0xd9, 0x2e, 0xdd,
+	0x74, 0xfc, 0x5d, 0x01, 0xbb, 0xc7, 0xc4, 0x76, 0x2b, 0x6c, 0xf7, 0x80, 0xae, 0x34, 0xb6, 0xe0,
+	0x5b, 0x72, 0x58, 0x82, 0x6f, 0xd9, 0xb1, 0x4d, 0x4e, 0xab, 0x34, 0x61, 0xe9, 0x23, 0x4f, 0xd8,
+	0xae, 0x3e, 0xd0, 0xad, 0x8e, 0xd1, 0x32, 0xee, 0x8f, 0x0d, 0xc7, 0xc5, 0x0a, 0xbc, 0xd4, 0x19,
+	0xe8, 0x8e, 0x73, 0x60, 0x76, 0xcb, 0xa4, 0x4a, 0x6a, 0xa7, 0x5a, 0x25, 0xba, 0x7e, 0xaf, 0x8b,
+	0xcb, 0x50, 0xb4, 0xbf, 0xb0, 0x8c, 0x51, 0xb9, 0x40, 0x7f, 0x67, 0x0b, 0xa5, 0x03, 0xd5, 0xb0,
+	0x9f, 0xdd, 0x43, 0xba, 0xba, 0xe3, 0x8e, 0x4c, 0xab, 0x3f, 0xab, 0xd3, 0x37, 0xce, 0xfc, 0xf5,
+	0xeb, 0x16, 0x58, 0x3d, 0xb7, 0xfa, 0x60, 0x5b, 0xad, 0xab, 0x75, 0x45, 0x85, 0xe5, 0xa8, 0x58,
+	0x67, 0x68, 0x5b, 0x8e, 0x81, 0x2f, 0xc3, 0xa2, 0x7e, 0xcf, 0x1e, 0x5b, 0x2e, 0x75, 0xbb, 0xd0,
+	0xe2, 0x2b, 0xe5, 0x16, 0xbc, 0x92, 0x22, 0x2a, 0xdd, 0x38, 0x46, 0xfe, 0x36, 0x9c, 0xa7, 0xe6,
+	0x1f, 0x7a, 0xd2, 0xa6, 0x08, 0xe9, 0x0c, 0x14, 0xcc, 0x2e, 0x8f, 0xa7, 0x60, 0x76, 0x95, 0x4f,
+	0x40, 0x9e, 0xd8, 0xe7, 0xcd, 0xcf, 0x33, 0xce, 0x62, 0xe2, 0x36, 0x01, 0xc3, 0xe2, 0x78, 0x68,
+	0x41, 0x56, 0x49, 0xf8, 0xa8, 0x6e, 0xc2, 0xa5, 0x44, 0x21, 0x69, 0x86, 0x31, 0x52, 0x8d, 0x93,
+	0xde, 0x19, 0x0f, 0x87, 0x83, 0xc3, 0xec, 0x28, 0x94, 0x0f, 0xe0, 0x52, 0xc8, 0x20, 0x67, 0x0e,
+	0x62, 0xf4, 0x5b, 0xb0, 0x14, 0xf2, 0x96, 0x79, 0x19, 0xde, 0x87, 0x6a, 0x08, 0xfe, 0x7c, 0x77,
+	0xe1, 0x3b, 0x02, 0xe7, 0xa8, 0xfd, 0x5e, 0x73, 0xdf, 0x99, 0xf5, 0x7a, 0x63, 0x13, 0x60, 0xd2,
+	0x06, 0xca, 0xf3, 0x55, 0x52, 0x3b, 0xbd, 0xb3, 0xae, 0xf2, 0xf2, 0xf5, 0x0a, 0x56, 0x65, 0x0d,
+	0x86, 0x97, 0xad, 0x7a, 0x5b, 0xef, 0xfb, 0x05, 0xda, 0x0a, 0x59, 0x2a, 0x8f, 0x09, 0x9c, 0x0f,
+	0xa9, 0xe1, 0xb1, 0x5c, 0x83, 0x05, 0xab, 0xe7, 0x3a, 0x65, 0x52, 0x9d, 0xaf, 0x9d, 0xde, 0xb9,
+	0xa0, 0xc6, 0xfb, 0x93, 0xba, 0xd7, 0xdc, 0x6f, 0x51, 0x10, 0xde, 0x8c, 0x48, 0x29, 0x50, 0x29,
+	0x1b, 0x99, 0x52, 0x18, 0x53, 0x44, 0xcb, 0x9b, 0x70, 0xd6, 0x97, 0x32, 0x43, 0x8d, 0x7c, 0x0c,
+	0xab, 0xbe, 0xf5, 0x8b, 0xae, 0x90, 0xb7, 0x26, 0x27, 0x16, 0xa4, 0xe8, 0x2a, 0xcc, 0x5b, 0x3d,
+	0x76, 0xd6, 0x29, 0x19, 0xf2, 0x30, 0xca, 0x5d, 0xb8, 0x98, 0xa0, 0x2c, 0xb7, 0x2f, 0x41, 0x5b,
+	0x63, 0xc7, 0xf7, 0x8e, 0x17, 0xca, 0x14, 0x65, 0x74, 0x0b, 0xe4, 0x09, 0xfe, 0x79, 0xab, 0xe8,
+	0x5d, 0xc0, 0x89, 0xb3, 0x20, 0x1a, 0x0d, 0x8a, 0xd4, 0x80, 0xc7, 0x53, 0x11, 0xc5, 0xc3, 0x2c,
+	0x18, 0x4e, 0x69, 0xf3, 0xd2, 0x16, 0x69, 0x9a, 0xd1, 0x67, 0x4c, 0xea, 0xa7, 0xbc, 0xe0, 0x29,
+	0xc8, 0x08, 0x32, 0x15, 0x2d, 0x23, 0x32, 0x73, 0x19, 0x3d, 0x21, 0xb0, 0x1c, 0xf5, 0xcf, 0x85,
+	0x37, 0x80, 0x65, 0xcf, 0xf0, 0x8b, 0x29, 0x45, 0xba, 0x8f, 0x7c, 0x71, 0x15, 0xf5, 0x19, 0x8f,
+	0xba, 0x65, 0x1f, 0xea, 0x03, 0x77, 0x8a, 0x36, 0x8b, 0xaf, 0x03, 0x38, 0xfa, 0xc0, 0x38, 0x18,
+	0x8e, 0xcc, 0x8e, 0xc1, 0xa9, 0x2b, 0x11, 0xea, 0x40, 0xb3, 0x6d, 0x5a, 0xad, 0x53, 0x1e, 0xf8,
+	0xb6, 0x87, 0x55, 0xbe, 0xf2, 0x53, 0x10, 0x90, 0xf1, 0x14, 0xdc, 0x80, 0xd2, 0x88, 0xfd, 0xc4,
+	0x13, 0xbc, 0x22, 0x4a, 0x81, 0x6f, 0xe5, 0x63, 0xb1, 0x1e, 0xf4, 0xd3, 0x4c, 0x15, 0x1c, 0xb8,
+	0xf3, 0xfb, 0x59, 0x28, 0x52, 0x09, 0xf8, 0x84, 0x40, 0x89, 0xbf, 0xdc, 0xb8, 0x21, 0xa2, 0x13,
+	0x0c, 0x2e, 0x52, 0x2d, 0x1b, 0xc8, 0x42, 0x52, 0x5e, 0x7b, 0xf4, 0xf7, 0x7f, 0x3f, 0x16, 0xb6,
+	0x51, 0xd5, 0x04, 0xb3, 0x55, 0x9b, 0x81, 0xb5, 0x23, 0xda, 0xa9, 0x1f, 0x6a, 0x47, 0x7e, 0xae,
+	0x1f, 0xe2, 0x6f, 0x04, 0x96, 0x45, 0x03, 0x05, 0x5e, 0xcf, 0xa2, 0x16, 0x95, 0xaa, 0x74, 0x23,
+	0xa7, 0x15, 0x57, 0x5f, 0xff, 0x33, 0x52, 0x1b, 0x34, 0x96, 0x8b, 0xb8, 0x92, 0x12, 0x0b, 0x3e,
+	0x26, 0x50, 0xa4, 0x6f, 0x3e, 0xae, 0x25, 0x72, 0x86, 0x87, 0x1b, 0x69, 0x3d, 0x0b, 0xe6, 0x6b,
+	0xa1, 0xec, 0xd7, 0xf0, 0xaa, 0x88, 0x9d, 0x26, 0x30, 0x94, 0x3f, 0xed, 0xc8, 0x4b, 0xe2, 0xcf,
+	0x04, 0x30, 0x3e, 0x7f, 0xe0, 0x4e, 0x3a, 0xa3, 0x30, 0x81, 0x8d, 0x5c, 0x36, 0x5c, 0xb2, 0x26,
+	0x48, 0xdf, 0x0a, 0x56, 0x12, 0x03, 0xc0, 0x6f, 0x09, 0x2c, 0xb2, 0xc9, 0x01, 0x93, 0xd3, 0x12,
+	0x19, 0x84, 0xa4, 0x8d, 0x4c, 0x1c, 0x17, 0xb3, 0x45, 0xe9, 0x37, 0x70, 0x4d, 0x44, 0xef, 0x50,
+	0x6c, 0xf8, 0x02, 0xfe, 0x42, 0x60, 0x49, 0x30, 0xc4, 0x60, 0x23, 0x83, 0x4f, 0x98, 0xbd, 0xeb,
+	0xf9, 0x8c, 0xb8, 0xe2, 0x6d, 0x41, 0xfa, 0x56, 0x51, 0x4a, 0xd6, 0x8f, 0x63, 0x58, 0xf0, 0xa6,
+	0x13, 0xbc, 0x92, 0xc8, 0x17, 0x1a, 0xa5, 0xa4, 0xb5, 0x0c, 0x14, 0x97, 0x51, 0xa5, 0xc4, 0x12,
+	0x96, 0x35, 0xf1, 0xdf, 0x23, 0x07, 0x1f, 0x11, 0x98, 0xdf, 0x6b, 0xee, 0xe3, 0xe5, 0x34, 0x87,
+	0x3e, 0xeb, 0x95, 0x74, 0x90, 0x1f, 0x3b, 0x25, 0xdd, 0xc4, 0x5a, 0x12, 0x69, 0xec, 0xb2, 0xff,
+	0x44, 0xe0, 0xdc, 0xb3, 0x73, 0x03, 0x6e, 0xa7, 0x91, 0x09, 0x8f, 0xaa, 0x9e, 0xc3, 0xc2, 0xbf,
+	0x59, 0x82, 0x73, 0xaa, 0xe0, 0x85, 0x04, 0xe5, 0xf8, 0x0d, 0x81, 0x22, 0x7d, 0xc6, 0x52, 0x3a,
+	0x44, 0x78, 0x48, 0x91, 0xd6, 0xb3, 0x60, 0x5c, 0x87, 0x4a, 0x99, 0x6b, 0xb8, 0x2e, 0x62, 0xe6,
+	0x2f, 0x66, 0xf8, 0x8a, 0x7b, 0xed, 0x21, 0x3e, 0x49, 0xa4, 0xb4, 0x87, 0xc4, 0x51, 0x48, 0x6a,
+	0xe4, 0xb2, 0xc9, 0xdf, 0x1e, 0xa8, 0x68, 0xfc, 0x9a, 0x40, 0x89, 0x8f, 0x0d, 0x29, 0x6f, 0x55,
+	0x74, 0x70, 0x91, 0x6a, 0xd9, 0x40, 0xae, 0xe7, 0x72, 0x5a, 0x7f, 0xf7, 0x27, 0x8e, 0xef, 0x09,
+	0x94, 0xf8, 0x0b, 0x9c, 0xa2, 0x21, 0x3a, 0x46, 0x48, 0xb5, 0x6c, 0xe0, 0x34, 0x67, 0xc8, 0x1f,
+	0xfc, 0xd0, 0x19, 0xee, 0xbe, 0xfa, 0xc7, 0xb1, 0x4c, 0x9e, 0x1e, 0xcb, 0xe4, 0xdf, 0x63, 0x99,
+	0xfc, 0x70, 0x22, 0xcf, 0x3d, 0x3d, 0x91, 0xe7, 0xfe, 0x39, 0x91, 0xe7, 0xee, 0xf2, 0x4f, 0x25,
+	0x4e, 0xf7, 0x73, 0xd5, 0xb4, 0xb5, 0x2f, 0x3d, 0x47, 0xed, 0x45, 0xfa, 0x39, 0xa2, 0xf1, 0xff,
+	0x00, 0x88, 0xe5, 0x85, 0x80, 0x67, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1356,6 +1475,9 @@ type QueryClient interface {
 	ClassByQueryString(ctx context.Context, in *QueryClassByQueryStringRequest, opts ...grpc.CallOption) (*QueryClassByQueryStringResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// Royalty queries the royalty of an NFT class, and the royalty amount of a sale price, similar to royaltyInfo in
+	// ERC2981
+	Royalty(ctx context.Context, in *QueryRoyaltyRequest, opts ...grpc.CallOption) (*QueryRoyaltyResponse, error)
 }
 
 type queryClient struct {
@@ -1474,6 +1596,15 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) Royalty(ctx context.Context, in *QueryRoyaltyRequest, opts ...grpc.CallOption) (*QueryRoyaltyResponse, error) {
+	out := new(QueryRoyaltyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/Royalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the number of NFTs of a given class owned by the owner, same as balanceOf in ERC721
@@ -1501,6 +1632,9 @@ type QueryServer interface {
 	ClassByQueryString(context.Context, *QueryClassByQueryStringRequest) (*QueryClassByQueryStringResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// Royalty queries the royalty of an NFT class, and the royalty amount of a sale price, similar to royaltyInfo in
+	// ERC2981
+	Royalty(context.Context, *QueryRoyaltyRequest) (*QueryRoyaltyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1543,6 +1677,9 @@ func (*UnimplementedQueryServer) ClassByQueryString(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (*UnimplementedQueryServer) Royalty(ctx context.Context, req *QueryRoyaltyRequest) (*QueryRoyaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Royalty not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1764,6 +1901,24 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Royalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Royalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/Royalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Royalty(ctx, req.(*QueryRoyaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.nft.v1beta1.Query",
@@ -1817,6 +1972,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "Royalty",
+			Handler:    _Query_Royalty_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
@@ -2658,6 +2817,95 @@ func (m *QueryClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRoyaltyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SalePrice != nil {
+		{
+			size, err := m.SalePrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoyaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3021,6 +3269,40 @@ func (m *QueryClassesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRoyaltyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SalePrice != nil {
+		l = m.SalePrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoyaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5299,6 +5581,246 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRoyaltyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SalePrice == nil {
+				m.SalePrice = &types.Coin{}
+			}
+			if err := m.SalePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoyaltyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
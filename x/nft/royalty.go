@@ -0,0 +1,26 @@
+package nft
+
+import (
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if the royalty recipient is not a valid address or
+// if its rate is not between 0 and 1.
+func (r Royalty) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(r.Recipient); err != nil {
+		return errors.Wrapf(ErrInvalidRoyalty, "invalid recipient address (%s): %s", r.Recipient, err)
+	}
+	if r.Rate.IsNil() || r.Rate.IsNegative() || r.Rate.GT(math.LegacyOneDec()) {
+		return errors.Wrapf(ErrInvalidRoyalty, "rate must be between 0 and 1, got %s", r.Rate)
+	}
+	return nil
+}
+
+// Amount returns the royalty amount of a sale price, rounded down.
+func (r Royalty) Amount(salePrice sdk.Coin) sdk.Coin {
+	return sdk.NewCoin(salePrice.Denom, r.Rate.MulInt(salePrice.Amount).TruncateInt())
+}
@@ -31,6 +31,100 @@ func MsgSendFactory(k keeper.Keeper) simsx.SimMsgFactoryFn[*nft.MsgSend] {
 	}
 }
 
+// MsgSaveClassFactory creates a gov proposal to create a new class.
+func MsgSaveClassFactory() simsx.SimMsgFactoryFn[*nft.MsgSaveClass] {
+	return func(_ context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *nft.MsgSaveClass) {
+		r := testData.Rand()
+		minter := testData.AnyAccount(reporter)
+		updater := testData.AnyAccount(reporter)
+
+		return nil, &nft.MsgSaveClass{
+			Authority: testData.ModuleAccountAddress(reporter, "gov"),
+			Class: &nft.Class{
+				Id:          r.StringN(10),
+				Name:        r.StringN(10),
+				Symbol:      r.StringN(10),
+				Description: r.StringN(10),
+				Uri:         r.StringN(10),
+				Minter:      minter.AddressBech32,
+				Updater:     updater.AddressBech32,
+			},
+		}
+	}
+}
+
+func MsgMintFactory(k keeper.Keeper) simsx.SimMsgFactoryFn[*nft.MsgMint] {
+	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *nft.MsgMint) {
+		minter := testData.AnyAccount(reporter, simsx.WithSpendableBalance())
+		receiver := testData.AnyAccount(reporter)
+		r := testData.Rand()
+
+		c, err := randClassOf(ctx, r, k, minter.AddressBech32)
+		if err != nil {
+			reporter.Skip(err.Error())
+			return nil, nil
+		}
+		msg := &nft.MsgMint{
+			Minter: minter.AddressBech32,
+			Nft: &nft.NFT{
+				ClassId: c.Id,
+				Id:      r.StringN(10),
+				Uri:     r.StringN(10),
+			},
+			Receiver: receiver.AddressBech32,
+		}
+
+		return []simsx.SimAccount{minter}, msg
+	}
+}
+
+func MsgBurnFactory(k keeper.Keeper) simsx.SimMsgFactoryFn[*nft.MsgBurn] {
+	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *nft.MsgBurn) {
+		owner := testData.AnyAccount(reporter, simsx.WithSpendableBalance())
+
+		n, err := randNFT(ctx, testData.Rand(), k, owner.Address)
+		if err != nil {
+			reporter.Skip(err.Error())
+			return nil, nil
+		}
+		msg := &nft.MsgBurn{
+			Owner:   owner.AddressBech32,
+			ClassId: n.ClassId,
+			Id:      n.Id,
+		}
+
+		return []simsx.SimAccount{owner}, msg
+	}
+}
+
+func MsgUpdateFactory(k keeper.Keeper) simsx.SimMsgFactoryFn[*nft.MsgUpdate] {
+	return func(ctx context.Context, testData *simsx.ChainDataSource, reporter simsx.SimulationReporter) ([]simsx.SimAccount, *nft.MsgUpdate) {
+		updater := testData.AnyAccount(reporter, simsx.WithSpendableBalance())
+		r := testData.Rand()
+
+		// only existing NFTs of a class the updater may update are picked, as the
+		// factory must not change the state
+		var ns []nft.NFT
+		for _, c := range k.GetClasses(ctx) {
+			if c.Updater == updater.AddressBech32 {
+				ns = append(ns, k.GetNFTsOfClass(ctx, c.Id)...)
+			}
+		}
+		if len(ns) == 0 {
+			reporter.Skip("no nft to update")
+			return nil, nil
+		}
+		n := ns[r.Intn(len(ns))]
+		n.Uri = r.StringN(10)
+		msg := &nft.MsgUpdate{
+			Updater: updater.AddressBech32,
+			Nft:     &n,
+		}
+
+		return []simsx.SimAccount{updater}, msg
+	}
+}
+
 // randNFT picks a random NFT from a class belonging to the specified owner(minter).
 func randNFT(ctx context.Context, r *simsx.XRand, k keeper.Keeper, minter sdk.AccAddress) (nft.NFT, error) {
 	c, err := randClass(ctx, r, k)
@@ -64,3 +158,26 @@ func randClass(ctx context.Context, r *simsx.XRand, k keeper.Keeper) (nft.Class,
 	}
 	return c, k.SaveClass(ctx, c)
 }
+
+// randClassOf picks a random Class whose minter and updater is the specified address.
+func randClassOf(ctx context.Context, r *simsx.XRand, k keeper.Keeper, addr string) (nft.Class, error) {
+	var classes []nft.Class
+	for _, c := range k.GetClasses(ctx) {
+		if c.Minter == addr && c.Updater == addr {
+			classes = append(classes, *c)
+		}
+	}
+	if len(classes) != 0 {
+		return classes[r.Intn(len(classes))], nil
+	}
+	c := nft.Class{
+		Id:          r.StringN(10),
+		Name:        r.StringN(10),
+		Symbol:      r.StringN(10),
+		Description: r.StringN(10),
+		Uri:         r.StringN(10),
+		Minter:      addr,
+		Updater:     addr,
+	}
+	return c, k.SaveClass(ctx, c)
+}
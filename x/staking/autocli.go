@@ -132,6 +132,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod:      "TokenizeShareRecord",
+					Use:            "tokenize-share-record <id>",
+					Short:          "Query a tokenize share record by its id",
+					Example:        fmt.Sprintf("%s query staking tokenize-share-record 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "TokenizeShareRecords",
+					Use:       "tokenize-share-records",
+					Short:     "Query all the tokenize share records",
+				},
+				{
+					RpcMethod: "TotalLiquidStaked",
+					Use:       "total-liquid-staked",
+					Short:     "Query the total amount of tokenized staking tokens",
+				},
+				{
+					RpcMethod:      "ValidatorBondCapacity",
+					Use:            "validator-bond-capacity <validator-addr>",
+					Short:          "Query the self-bond of a validator and the amount of tokens that can still be delegated to it",
+					Example:        fmt.Sprintf("%s query staking validator-bond-capacity cosmosvaloper...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_addr"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -175,6 +199,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`%s tx staking rotate-cons-pubkey myvalidator {"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "new_pubkey"}},
 				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-share <validator-addr> <amount> <reward-owner> --from <delegator_address>",
+					Short:          "Tokenize delegation to share tokens",
+					Long:           "Convert an amount of a delegation into share tokens of the validator, the rewards of the tokenized delegation going to the reward owner.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-share cosmosvaloper... 100stake cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "tokenized_share_owner"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens <amount> --from <delegator_address>",
+					Short:          "Redeem share tokens for a delegation",
+					Long:           "Convert an amount of share tokens back into a delegation to their validator.",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100cosmosvaloper.../1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal <params>",
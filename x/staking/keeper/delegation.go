@@ -676,6 +676,7 @@ func (k Keeper) DequeueAllMatureRedelegationQueue(ctx context.Context, currTime
 
 // Delegate performs a delegation, set/update everything necessary within the store.
 // tokenSrc indicates the bond status of the incoming funds.
+// The delegation fails if it would exceed the validator bond cap of the validator.
 func (k Keeper) Delegate(
 	ctx context.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc types.BondStatus,
 	validator types.Validator, subtractAccount bool,
@@ -687,6 +688,10 @@ func (k Keeper) Delegate(
 		return math.LegacyZeroDec(), types.ErrDelegatorShareExRateInvalid
 	}
 
+	if err := k.checkValidatorBondCap(ctx, validator, delAddr, bondAmt); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
 	valbz, err := k.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
 	if err != nil {
 		return math.LegacyZeroDec(), err
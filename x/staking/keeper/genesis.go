@@ -195,6 +195,20 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) ([]ap
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.TokenizeShareRecords.Set(ctx, record.Id, record); err != nil {
+			return nil, err
+		}
+
+		if err := k.initTokenizeShareRecordLiquidStake(ctx, record); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.LastTokenizeShareRecordID.Set(ctx, data.LastTokenizeShareRecordId); err != nil {
+		return nil, err
+	}
+
 	// don't need to run CometBFT updates if we exported
 	var moduleValidatorUpdates []appmodule.ValidatorUpdate
 	if data.Exported {
@@ -331,6 +345,20 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	tokenizeShareRecords := []types.TokenizeShareRecord{}
+	err = k.TokenizeShareRecords.Walk(ctx, nil, func(_ uint64, record types.TokenizeShareRecord) (stop bool, err error) {
+		tokenizeShareRecords = append(tokenizeShareRecords, record)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lastTokenizeShareRecordID, err := k.LastTokenizeShareRecordID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		Params:               params,
 		LastTotalPower:       totalPower,
@@ -343,5 +371,8 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		RotationIndexRecords: rotationIndex,
 		RotationHistory:      conspubKeyRotationHistory,
 		RotationQueue:        rotationQueue,
+
+		TokenizeShareRecords:      tokenizeShareRecords,
+		LastTokenizeShareRecordId: lastTokenizeShareRecordID,
 	}, nil
 }
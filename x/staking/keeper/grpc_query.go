@@ -513,6 +513,89 @@ func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizeShareRecord queries a tokenize share record by its id
+func (k Querier) TokenizeShareRecord(ctx context.Context, req *types.QueryTokenizeShareRecordRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, types.ErrTokenizeShareRecordNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordResponse{Record: record}, nil
+}
+
+// TokenizeShareRecords queries all the tokenize share records
+func (k Querier) TokenizeShareRecords(ctx context.Context, req *types.QueryTokenizeShareRecordsRequest) (*types.QueryTokenizeShareRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	records, pageRes, err := query.CollectionPaginate(ctx, k.Keeper.TokenizeShareRecords, req.Pagination,
+		func(_ uint64, record types.TokenizeShareRecord) (types.TokenizeShareRecord, error) {
+			return record, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordsResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// TotalLiquidStaked queries the total amount of tokenized bond denom
+func (k Querier) TotalLiquidStaked(ctx context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	tokens, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: tokens}, nil
+}
+
+// ValidatorBondCapacity queries the self-bond of a validator and the amount of tokens that can still be delegated to it
+func (k Querier) ValidatorBondCapacity(ctx context.Context, req *types.QueryValidatorBondCapacityRequest) (*types.QueryValidatorBondCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	selfBond, delegated, err := k.GetValidatorBondShares(ctx, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity, capped, err := k.GetValidatorBondCapacity(ctx, validator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorBondCapacityResponse{
+		SelfBondShares:    selfBond,
+		DelegatedShares:   delegated,
+		Capped:            capped,
+		RemainingCapacity: capacity,
+	}, nil
+}
+
 func queryRedelegation(ctx context.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
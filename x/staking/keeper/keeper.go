@@ -112,6 +112,14 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// TokenizeShareRecords key: recordID | value: TokenizeShareRecord
+	TokenizeShareRecords collections.Map[uint64, types.TokenizeShareRecord]
+	// LastTokenizeShareRecordID value: id of the last tokenize share record created
+	LastTokenizeShareRecordID collections.Sequence
+	// TotalLiquidStakedTokens value: total amount of tokenized bond denom
+	TotalLiquidStakedTokens collections.Item[math.Int]
+	// ValidatorLiquidShares key: valAddr | value: tokenized delegator shares of the validator
+	ValidatorLiquidShares collections.Map[sdk.ValAddress, math.LegacyDec]
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -289,6 +297,23 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+
+		// key format is: 129 | recordID
+		TokenizeShareRecords: collections.NewMap(
+			sb, types.TokenizeShareRecordKey,
+			"tokenize_share_records",
+			collections.Uint64Key,
+			codec.CollValue[types.TokenizeShareRecord](cdc),
+		),
+		LastTokenizeShareRecordID: collections.NewSequence(sb, types.LastTokenizeShareRecordIDKey, "last_tokenize_share_record_id"),
+		TotalLiquidStakedTokens:   collections.NewItem(sb, types.TotalLiquidStakedTokensKey, "total_liquid_staked_tokens", sdk.IntValue),
+		// key format is: 132 | valAddr
+		ValidatorLiquidShares: collections.NewMap(
+			sb, types.ValidatorLiquidSharesKey,
+			"validator_liquid_shares",
+			sdk.ValAddressKey,
+			sdk.LegacyDecValue,
+		),
 	}
 
 	schema, err := sb.Build()
@@ -0,0 +1,418 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+// GetTokenizeShareRecord returns a tokenize share record by its id.
+func (k Keeper) GetTokenizeShareRecord(ctx context.Context, id uint64) (types.TokenizeShareRecord, error) {
+	record, err := k.TokenizeShareRecords.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return record, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "id %d", id)
+	}
+
+	return record, err
+}
+
+// GetTotalLiquidStakedTokens returns the total amount of tokenized bond denom.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	tokens, err := k.TotalLiquidStakedTokens.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+
+	return tokens, err
+}
+
+// GetValidatorLiquidShares returns the tokenized delegator shares of a validator.
+func (k Keeper) GetValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	shares, err := k.ValidatorLiquidShares.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return shares, err
+}
+
+// addLiquidStake increases the total liquid staked tokens and the liquid shares
+// of the validator, and checks that the liquid staking caps are not exceeded.
+func (k Keeper) addLiquidStake(ctx context.Context, validator types.Validator, valAddr sdk.ValAddress, tokens math.Int, shares math.LegacyDec) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	totalLiquidStaked, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+	totalLiquidStaked = totalLiquidStaked.Add(tokens)
+
+	totalBonded, err := k.TotalBondedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !totalBonded.IsPositive() ||
+		math.LegacyNewDecFromInt(totalLiquidStaked).Quo(math.LegacyNewDecFromInt(totalBonded)).GT(params.GlobalLiquidStakingCap) {
+		return types.ErrGlobalLiquidStakingCapExceeded
+	}
+
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	liquidShares = liquidShares.Add(shares)
+
+	if !validator.DelegatorShares.IsPositive() || liquidShares.Quo(validator.DelegatorShares).GT(params.ValidatorLiquidStakingCap) {
+		return types.ErrValidatorLiquidStakingCapExceeded
+	}
+
+	if err := k.TotalLiquidStakedTokens.Set(ctx, totalLiquidStaked); err != nil {
+		return err
+	}
+
+	return k.ValidatorLiquidShares.Set(ctx, valAddr, liquidShares)
+}
+
+// removeLiquidStake decreases the total liquid staked tokens and the liquid
+// shares of the validator. As the slashes of the liquid staked tokens are
+// truncated, the total is floored at zero.
+func (k Keeper) removeLiquidStake(ctx context.Context, valAddr sdk.ValAddress, tokens math.Int, shares math.LegacyDec) error {
+	if err := k.decreaseTotalLiquidStakedTokens(ctx, tokens); err != nil {
+		return err
+	}
+
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	liquidShares = liquidShares.Sub(shares)
+	if !liquidShares.IsPositive() {
+		return k.ValidatorLiquidShares.Remove(ctx, valAddr)
+	}
+
+	return k.ValidatorLiquidShares.Set(ctx, valAddr, liquidShares)
+}
+
+func (k Keeper) decreaseTotalLiquidStakedTokens(ctx context.Context, tokens math.Int) error {
+	totalLiquidStaked, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.TotalLiquidStakedTokens.Set(ctx, math.MaxInt(totalLiquidStaked.Sub(tokens), math.ZeroInt()))
+}
+
+// slashLiquidStakedTokens decreases the total liquid staked tokens by the part
+// of a validator slash applying to its tokenized delegator shares.
+func (k Keeper) slashLiquidStakedTokens(ctx context.Context, validator types.Validator, valAddr sdk.ValAddress, tokensToBurn math.Int) error {
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if liquidShares.IsZero() || !validator.DelegatorShares.IsPositive() {
+		return nil
+	}
+
+	slashedTokens := math.LegacyNewDecFromInt(tokensToBurn).Mul(liquidShares).Quo(validator.DelegatorShares).TruncateInt()
+	return k.decreaseTotalLiquidStakedTokens(ctx, slashedTokens)
+}
+
+// initTokenizeShareRecordLiquidStake adds the delegation of a tokenize share
+// record to the total liquid staked tokens and the liquid shares of its validator.
+// It is used at genesis, where the liquid stake is not exported.
+func (k Keeper) initTokenizeShareRecordLiquidStake(ctx context.Context, record types.TokenizeShareRecord) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return err
+	}
+
+	delegation, err := k.Delegations.Get(ctx, collections.Join(record.GetModuleAddress(), sdk.ValAddress(valAddr)))
+	if err != nil {
+		return fmt.Errorf("delegation of tokenize share record %d not found: %w", record.Id, err)
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	totalLiquidStaked, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	tokens := validator.TokensFromShares(delegation.Shares).TruncateInt()
+	if err := k.TotalLiquidStakedTokens.Set(ctx, totalLiquidStaked.Add(tokens)); err != nil {
+		return err
+	}
+
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.ValidatorLiquidShares.Set(ctx, valAddr, liquidShares.Add(delegation.Shares))
+}
+
+// TokenizeShares converts an amount of a delegation into share tokens. The
+// delegation is moved to the address of a new tokenize share record, the
+// rewards of which are owned by the given owner, and share tokens of the record
+// denom are minted to the delegator for each token moved.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner string,
+) (sdk.Coin, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// tokenizing the destination delegation of a redelegation would escape the
+	// slashing of the redelegation
+	hasRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if hasRedelegation {
+		return sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the delegation of vesting tokens cannot be tokenized, as share tokens are transferable
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestexported.VestingAccount); ok {
+		if !hasVestedDelegation(acc, k.HeaderService.HeaderInfo(ctx).Time, sdk.NewCoin(bondDenom, amount)) {
+			return sdk.Coin{}, types.ErrInsufficientVestedShares
+		}
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordID, err := k.LastTokenizeShareRecordID.Next(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	// ids start at 1
+	recordID++
+
+	record := types.TokenizeShareRecord{
+		Id:        recordID,
+		Owner:     owner,
+		Validator: validator.GetOperator(),
+	}
+	if err := k.TokenizeShareRecords.Set(ctx, recordID, record); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// undelegate the tokens to the delegator, and delegate them from the record address
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, recordAddr, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	newShares, err := k.Delegate(ctx, recordAddr, returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.addLiquidStake(ctx, validator, valAddr, returnAmount, newShares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// mint the share tokens to the delegator
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), returnAmount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens and converts them back into a
+// delegation of the delegator. The share tokens are redeemed for their part of
+// the delegation of the record, so that slashes are reflected in the amount
+// redeemed. The record is removed once all its share tokens are redeemed.
+func (k Keeper) RedeemTokensForShares(ctx context.Context, delAddr sdk.AccAddress, shareToken sdk.Coin) (sdk.Coin, error) {
+	_, recordID, err := types.ParseShareTokenDenom(shareToken.Denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if record.GetShareTokenDenom() != shareToken.Denom {
+		return sdk.Coin{}, types.ErrNotTokenizeShareDenom.Wrap(shareToken.Denom)
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	delegation, err := k.Delegations.Get(ctx, collections.Join(recordAddr, sdk.ValAddress(valAddr)))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, shareToken.Denom)
+	if shareToken.Amount.GT(supply.Amount) {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount exceeds the share token supply")
+	}
+
+	shares := delegation.Shares
+	if shareToken.Amount.LT(supply.Amount) {
+		shares = shares.MulInt(shareToken.Amount).QuoInt(supply.Amount)
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// undelegate the tokens to the record address, and delegate them from the delegator
+	returnAmount, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.removeLiquidStake(ctx, valAddr, returnAmount, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the rewards withdrawn by the unbonding belong to the record owner
+	if err := k.sendTokenizeShareRecordRewards(ctx, record); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, returnAmount); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, recordAddr, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, recordAddr, delAddr, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if returnAmount.IsPositive() {
+		validator, err = k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if _, err := k.Delegate(ctx, delAddr, returnAmount, types.Unbonded, validator, true); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if shares.Equal(delegation.Shares) {
+		if err := k.TokenizeShareRecords.Remove(ctx, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return sdk.NewCoin(bondDenom, returnAmount), nil
+}
+
+// sendTokenizeShareRecordRewards sends the balance of the record address,
+// made of the rewards withdrawn from its delegation, to the record owner.
+func (k Keeper) sendTokenizeShareRecordRewards(ctx context.Context, record types.TokenizeShareRecord) error {
+	recordAddr := record.GetModuleAddress()
+	balances := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+	if balances.IsZero() {
+		return nil
+	}
+
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoins(ctx, recordAddr, owner, balances)
+}
+
+// hasVestedDelegation returns whether the delegation of an amount of a vesting
+// account is made of vested tokens.
+func hasVestedDelegation(acc vestexported.VestingAccount, blockTime time.Time, coin sdk.Coin) bool {
+	vestingAmount := acc.GetVestingCoins(blockTime).AmountOf(coin.Denom)
+	delegatedVestingAmount := acc.GetDelegatedVesting().AmountOf(coin.Denom)
+	delegatedFreeAmount := acc.GetDelegatedFree().AmountOf(coin.Denom)
+
+	// the delegated vesting amount is only updated on undelegations, so
+	// the tokens vested since then are still counted as delegated vesting
+	if delegatedVestingAmount.GT(vestingAmount) {
+		delegatedFreeAmount = delegatedFreeAmount.Add(delegatedVestingAmount).Sub(vestingAmount)
+	}
+
+	return coin.Amount.LTE(delegatedFreeAmount)
+}
@@ -0,0 +1,304 @@
+package keeper_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	stakingkeeper "cosmossdk.io/x/staking/keeper"
+	"cosmossdk.io/x/staking/testutil"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// setupTokenizeShares creates a bonded validator with a delegation of 10 power,
+// and mocks the bank and account keeper calls of the share tokenization.
+func (s *KeeperTestSuite) setupTokenizeShares() (sdk.AccAddress, stakingtypes.Validator) {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	delegation := stakingtypes.NewDelegation(s.addressToString(delAddrs[1]), validator.GetOperator(), issuedShares)
+	require.NoError(keeper.SetDelegation(ctx, delegation))
+
+	s.accountKeeper.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s.accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), stakingtypes.BondedPoolName).Return(bondedAcc).AnyTimes()
+	s.bankKeeper.EXPECT().GetBalance(gomock.Any(), bondedAcc.GetAddress(), sdk.DefaultBondDenom).Return(sdk.NewCoin(sdk.DefaultBondDenom, startTokens)).AnyTimes()
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, gomock.Any(), gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), stakingtypes.BondedPoolName, gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoins(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().MintCoins(gomock.Any(), stakingtypes.ModuleName, gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), stakingtypes.ModuleName, delAddrs[1], gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	s.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), gomock.Any()).Return(sdk.NewCoins()).AnyTimes()
+
+	return delAddrs[1], validator
+}
+
+func (s *KeeperTestSuite) TestTokenizeSharesAndRedeem() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddr, validator := s.setupTokenizeShares()
+	valAddr, err := keeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	require.NoError(err)
+	delAddrStr := s.addressToString(delAddr)
+
+	// tokenize 4 of the 10 tokens delegated
+	tokenized := keeper.TokensFromConsensusPower(ctx, 4)
+	res, err := s.msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(
+		delAddrStr, validator.GetOperator(), sdk.NewCoin(sdk.DefaultBondDenom, tokenized), delAddrStr,
+	))
+	require.NoError(err)
+	require.Equal(sdk.NewCoin(stakingtypes.ShareTokenDenom(validator.GetOperator(), 1), tokenized), res.Amount)
+
+	record, err := keeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(err)
+	require.Equal(stakingtypes.TokenizeShareRecord{Id: 1, Owner: delAddrStr, Validator: validator.GetOperator()}, record)
+
+	delegation, err := keeper.Delegations.Get(ctx, collections.Join(delAddr, sdk.ValAddress(valAddr)))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 6)), delegation.Shares)
+
+	recordDelegation, err := keeper.Delegations.Get(ctx, collections.Join(record.GetModuleAddress(), sdk.ValAddress(valAddr)))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(tokenized), recordDelegation.Shares)
+
+	totalLiquidStaked, err := s.queryClient.TotalLiquidStaked(ctx, &stakingtypes.QueryTotalLiquidStakedRequest{})
+	require.NoError(err)
+	require.Equal(tokenized, totalLiquidStaked.Tokens)
+
+	liquidShares, err := keeper.GetValidatorLiquidShares(ctx, valAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(tokenized), liquidShares)
+
+	// redeem 1 of the 4 share tokens
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), res.Amount.Denom).Return(res.Amount)
+	redeemed := keeper.TokensFromConsensusPower(ctx, 1)
+	redeemRes, err := s.msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(
+		delAddrStr, sdk.NewCoin(res.Amount.Denom, redeemed),
+	))
+	require.NoError(err)
+	require.Equal(sdk.NewCoin(sdk.DefaultBondDenom, redeemed), redeemRes.Amount)
+
+	delegation, err = keeper.Delegations.Get(ctx, collections.Join(delAddr, sdk.ValAddress(valAddr)))
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 7)), delegation.Shares)
+
+	recordRes, err := s.queryClient.TokenizeShareRecord(ctx, &stakingtypes.QueryTokenizeShareRecordRequest{Id: 1})
+	require.NoError(err)
+	require.Equal(record, recordRes.Record)
+
+	// redeem the remaining share tokens, removing the record
+	remaining := tokenized.Sub(redeemed)
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), res.Amount.Denom).Return(sdk.NewCoin(res.Amount.Denom, remaining))
+	_, err = s.msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(
+		delAddrStr, sdk.NewCoin(res.Amount.Denom, remaining),
+	))
+	require.NoError(err)
+
+	_, err = keeper.GetTokenizeShareRecord(ctx, 1)
+	require.ErrorIs(err, stakingtypes.ErrTokenizeShareRecordNotFound)
+
+	has, err := keeper.Delegations.Has(ctx, collections.Join(record.GetModuleAddress(), sdk.ValAddress(valAddr)))
+	require.NoError(err)
+	require.False(has)
+
+	totalLiquidStakedTokens, err := keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.True(totalLiquidStakedTokens.IsZero())
+
+	liquidShares, err = keeper.GetValidatorLiquidShares(ctx, valAddr)
+	require.NoError(err)
+	require.True(liquidShares.IsZero())
+
+	// the next record gets a new id
+	_, err = s.msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(
+		delAddrStr, validator.GetOperator(), sdk.NewCoin(sdk.DefaultBondDenom, tokenized), delAddrStr,
+	))
+	require.NoError(err)
+	_, err = keeper.GetTokenizeShareRecord(ctx, 2)
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestTokenizeSharesChecks() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddr, validator := s.setupTokenizeShares()
+	valAddr, err := keeper.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	require.NoError(err)
+	delAddrStr := s.addressToString(delAddr)
+	msg := stakingtypes.NewMsgTokenizeShares(
+		delAddrStr, validator.GetOperator(), sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 4)), delAddrStr,
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func(ctx sdk.Context)
+		msg      *stakingtypes.MsgTokenizeShares
+		expErr   error
+	}{
+		{
+			name:     "invalid denom",
+			malleate: func(sdk.Context) {},
+			msg: stakingtypes.NewMsgTokenizeShares(
+				delAddrStr, validator.GetOperator(), sdk.NewInt64Coin("foo", 1), delAddrStr,
+			),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "validator cap exceeded",
+			malleate: func(ctx sdk.Context) {
+				params := stakingtypes.DefaultParams()
+				params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(3, 1)
+				require.NoError(keeper.Params.Set(ctx, params))
+			},
+			msg:    msg,
+			expErr: stakingtypes.ErrValidatorLiquidStakingCapExceeded,
+		},
+		{
+			name: "global cap exceeded",
+			malleate: func(ctx sdk.Context) {
+				params := stakingtypes.DefaultParams()
+				params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(3, 1)
+				require.NoError(keeper.Params.Set(ctx, params))
+			},
+			msg:    msg,
+			expErr: stakingtypes.ErrGlobalLiquidStakingCapExceeded,
+		},
+		{
+			name: "redelegation in progress",
+			malleate: func(ctx sdk.Context) {
+				_, srcValAddrs := createValAddrs(3)
+				require.NoError(keeper.SetRedelegation(ctx, stakingtypes.NewRedelegation(
+					delAddr, srcValAddrs[2], valAddr, 0, time.Unix(0, 0).UTC(), math.NewInt(5), math.LegacyNewDec(5), 0,
+					address.NewBech32Codec("cosmosvaloper"), address.NewBech32Codec("cosmos"),
+				)))
+			},
+			msg:    msg,
+			expErr: stakingtypes.ErrRedelegationInProgress,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cacheCtx, _ := ctx.CacheContext()
+			tc.malleate(cacheCtx)
+
+			_, err := s.msgServer.TokenizeShares(cacheCtx, tc.msg)
+			require.ErrorIs(err, tc.expErr)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRedeemTokensForSharesAfterSlash() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddr, validator := s.setupTokenizeShares()
+	delAddrStr := s.addressToString(delAddr)
+
+	tokenized := keeper.TokensFromConsensusPower(ctx, 4)
+	res, err := s.msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(
+		delAddrStr, validator.GetOperator(), sdk.NewCoin(sdk.DefaultBondDenom, tokenized), delAddrStr,
+	))
+	require.NoError(err)
+
+	// slash half of the validator tokens
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.BondedPoolName).Return(bondedAcc.GetAddress())
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(err)
+	_, err = keeper.Slash(ctx, consAddr, 0, 10, math.LegacyNewDecWithPrec(5, 1))
+	require.NoError(err)
+
+	totalLiquidStaked, err := keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 2), totalLiquidStaked)
+
+	// the share tokens are redeemed for half of the tokens tokenized
+	s.bankKeeper.EXPECT().GetSupply(gomock.Any(), res.Amount.Denom).Return(res.Amount)
+	redeemRes, err := s.msgServer.RedeemTokensForShares(ctx, stakingtypes.NewMsgRedeemTokensForShares(delAddrStr, res.Amount))
+	require.NoError(err)
+	require.Equal(sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 2)), redeemRes.Amount)
+
+	totalLiquidStaked, err = keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.True(totalLiquidStaked.IsZero())
+}
+
+func (s *KeeperTestSuite) TestTokenizeSharesAfterV7Migration() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddr, validator := s.setupTokenizeShares()
+	delAddrStr := s.addressToString(delAddr)
+
+	// store the params as a v6 chain does, without the liquid staking caps
+	bz, err := s.cdc.Marshal(&stakingtypes.Params{
+		UnbondingTime:     stakingtypes.DefaultUnbondingTime,
+		MaxValidators:     stakingtypes.DefaultMaxValidators,
+		MaxEntries:        stakingtypes.DefaultMaxEntries,
+		BondDenom:         sdk.DefaultBondDenom,
+		MinCommissionRate: stakingtypes.DefaultMinCommissionRate,
+		KeyRotationFee:    stakingtypes.DefaultKeyRotationFee,
+	})
+	require.NoError(err)
+	bz = removeFields(s.T(), bz, 8, 9)
+	ctx.KVStore(s.key).Set(stakingtypes.ParamsKey, bz)
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	require.True(params.GlobalLiquidStakingCap.IsNil())
+	require.True(params.ValidatorLiquidStakingCap.IsNil())
+
+	require.NoError(stakingkeeper.NewMigrator(keeper).Migrate6to7(ctx))
+
+	params, err = keeper.Params.Get(ctx)
+	require.NoError(err)
+	require.Equal(stakingtypes.DefaultGlobalLiquidStakingCap, params.GlobalLiquidStakingCap)
+	require.Equal(stakingtypes.DefaultValidatorLiquidStakingCap, params.ValidatorLiquidStakingCap)
+	require.NoError(params.Validate())
+
+	tokenized := keeper.TokensFromConsensusPower(ctx, 4)
+	_, err = s.msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(
+		delAddrStr, validator.GetOperator(), sdk.NewCoin(sdk.DefaultBondDenom, tokenized), delAddrStr,
+	))
+	require.NoError(err)
+}
+
+// removeFields removes the given fields from a protobuf encoded message.
+func removeFields(t *testing.T, bz []byte, fields ...protowire.Number) []byte {
+	t.Helper()
+
+	var out []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.GreaterOrEqual(t, m, 0)
+
+		if !slices.Contains(fields, num) {
+			out = append(out, bz[:n+m]...)
+		}
+		bz = bz[n+m:]
+	}
+	return out
+}
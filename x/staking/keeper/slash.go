@@ -173,6 +173,11 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionH
 		}
 	}
 
+	// Decrease the total liquid staked tokens by the slash of the tokenized delegations.
+	if err := k.slashLiquidStakedTokens(ctx, validator, operatorAddress, tokensToBurn); err != nil {
+		return math.NewInt(0), err
+	}
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator, err = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
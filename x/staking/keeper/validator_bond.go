@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetValidatorBondShares returns the shares delegated to a validator by its operator,
+// the self-bond, and the shares delegated to it by all the other accounts.
+func (k Keeper) GetValidatorBondShares(ctx context.Context, validator types.Validator) (selfBond, delegated math.LegacyDec, err error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return selfBond, delegated, err
+	}
+
+	selfBond = math.LegacyZeroDec()
+	delegation, err := k.Delegations.Get(ctx, collections.Join(sdk.AccAddress(valAddr), sdk.ValAddress(valAddr)))
+	switch {
+	case err == nil:
+		selfBond = delegation.Shares
+	case !errors.Is(err, collections.ErrNotFound):
+		return selfBond, delegated, err
+	}
+
+	return selfBond, validator.DelegatorShares.Sub(selfBond), nil
+}
+
+// GetValidatorBondCapacity returns the amount of tokens that can still be delegated to a
+// validator by accounts other than its operator. capped is false when the validator bond
+// factor is disabled, in which case the capacity is unbounded.
+func (k Keeper) GetValidatorBondCapacity(ctx context.Context, validator types.Validator) (capacity math.Int, capped bool, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return capacity, false, err
+	}
+
+	if !params.IsValidatorBondCapped() {
+		return math.ZeroInt(), false, nil
+	}
+
+	selfBond, delegated, err := k.GetValidatorBondShares(ctx, validator)
+	if err != nil {
+		return capacity, true, err
+	}
+
+	remaining := selfBond.Mul(*params.ValidatorBondFactor).Sub(delegated)
+	if !remaining.IsPositive() {
+		return math.ZeroInt(), true, nil
+	}
+
+	return validator.TokensFromSharesTruncated(remaining).TruncateInt(), true, nil
+}
+
+// checkValidatorBondCap returns an error if delegating tokens to the validator would bring
+// the shares delegated by accounts other than its operator above the validator bond factor
+// times the self-bond shares. Delegations of the operator always pass, as they raise the cap.
+func (k Keeper) checkValidatorBondCap(ctx context.Context, validator types.Validator, delAddr sdk.AccAddress, tokens math.Int) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.IsValidatorBondCapped() {
+		return nil
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	if delAddr.Equals(sdk.AccAddress(valAddr)) {
+		return nil
+	}
+
+	shares := math.LegacyNewDecFromInt(tokens)
+	if !validator.DelegatorShares.IsZero() {
+		shares, err = validator.SharesFromTokens(tokens)
+		if err != nil {
+			return err
+		}
+	}
+
+	selfBond, delegated, err := k.GetValidatorBondShares(ctx, validator)
+	if err != nil {
+		return err
+	}
+
+	maxShares := selfBond.Mul(*params.ValidatorBondFactor)
+	if delegated.Add(shares).GT(maxShares) {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientValidatorBond,
+			"delegated shares %s would exceed the maximum of %s allowed by self-bond shares %s",
+			delegated.Add(shares), maxShares, selfBond,
+		)
+	}
+
+	return nil
+}
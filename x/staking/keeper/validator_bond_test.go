@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"github.com/golang/mock/gomock"
+
+	"cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestValidatorBondCap() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), gomock.Any(), stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	params, err := keeper.Params.Get(ctx)
+	require.NoError(err)
+	factor := math.LegacyNewDec(2)
+	params.ValidatorBondFactor = &factor
+	require.NoError(keeper.Params.Set(ctx, params))
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	dstValAddr := sdk.ValAddress(PKS[2].Address())
+	comm := stakingtypes.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	for _, val := range []struct {
+		valAddr sdk.ValAddress
+		amount  int64
+	}{{ValAddr, 100}, {dstValAddr, 10}} {
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			s.valAddressToString(val.valAddr), ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin(sdk.DefaultBondDenom, val.amount),
+			stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt(),
+		)
+		require.NoError(err)
+		_, err = msgServer.CreateValidator(ctx, msg)
+		require.NoError(err)
+	}
+
+	requireCapacity := func(valAddr sdk.ValAddress, selfBond, delegated, remaining int64) {
+		res, err := s.queryClient.ValidatorBondCapacity(ctx, &stakingtypes.QueryValidatorBondCapacityRequest{ValidatorAddr: s.valAddressToString(valAddr)})
+		require.NoError(err)
+		require.True(res.Capped)
+		require.Equal(math.LegacyNewDec(selfBond), res.SelfBondShares)
+		require.Equal(math.LegacyNewDec(delegated), res.DelegatedShares)
+		require.Equal(math.NewInt(remaining), res.RemainingCapacity)
+	}
+	requireCapacity(ValAddr, 100, 0, 200)
+
+	delegate := func(delAddr sdk.AccAddress, amount int64) error {
+		_, err := msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(s.addressToString(delAddr), s.valAddressToString(ValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+		return err
+	}
+
+	// delegations of other accounts are capped to twice the self-bond
+	require.NoError(delegate(delAddr, 150))
+	requireCapacity(ValAddr, 100, 150, 50)
+	require.ErrorIs(delegate(delAddr, 51), stakingtypes.ErrInsufficientValidatorBond)
+
+	// the cap is enforced by the keeper, e.g. for the auto-compounded rewards of x/distribution
+	validator, err := keeper.GetValidator(ctx, ValAddr)
+	require.NoError(err)
+	_, err = keeper.Delegate(ctx, delAddr, math.NewInt(51), stakingtypes.Unbonded, validator, true)
+	require.ErrorIs(err, stakingtypes.ErrInsufficientValidatorBond)
+
+	// the operator can always delegate, which raises the cap
+	require.NoError(delegate(Addr, 50))
+	requireCapacity(ValAddr, 150, 150, 150)
+
+	// redelegations are capped by the destination validator
+	redelegate := func(amount int64) error {
+		_, err := msgServer.BeginRedelegate(ctx, stakingtypes.NewMsgBeginRedelegate(
+			s.addressToString(delAddr), s.valAddressToString(ValAddr), s.valAddressToString(dstValAddr), sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+		))
+		return err
+	}
+	require.ErrorIs(redelegate(21), stakingtypes.ErrInsufficientValidatorBond)
+	require.NoError(redelegate(20))
+	requireCapacity(dstValAddr, 10, 20, 0)
+
+	// an unset validator bond factor disables the cap
+	params.ValidatorBondFactor = stakingtypes.DefaultValidatorBondFactor
+	require.NoError(keeper.Params.Set(ctx, params))
+	require.NoError(delegate(delAddr, 1000))
+	res, err := s.queryClient.ValidatorBondCapacity(ctx, &stakingtypes.QueryValidatorBondCapacityRequest{ValidatorAddr: s.valAddressToString(ValAddr)})
+	require.NoError(err)
+	require.False(res.Capped)
+}
@@ -18,6 +18,7 @@ const (
 	maxValidators     = "max_validators"
 	historicalEntries = "historical_entries"
 	keyRotationFee    = "cons_pubkey_rotation_fee"
+	validatorBond     = "validator_bond_factor"
 )
 
 // genUnbondingTime returns randomized UnbondingTime
@@ -40,6 +41,17 @@ func getKeyRotationFee(r *rand.Rand) sdk.Coin {
 	return sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(types.DefaultKeyRotationFee.Amount.Int64()-10000)+10000)
 }
 
+// genValidatorBondFactor returns randomized ValidatorBondFactor, disabled half of the time
+// and between 10-250 otherwise.
+func genValidatorBondFactor(r *rand.Rand) *sdkmath.LegacyDec {
+	if r.Intn(2) == 0 {
+		return types.DefaultValidatorBondFactor
+	}
+
+	factor := sdkmath.LegacyNewDec(int64(simulation.RandIntBetween(r, 10, 250)))
+	return &factor
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
@@ -49,6 +61,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		histEntries       uint32
 		minCommissionRate sdkmath.LegacyDec
 		rotationFee       sdk.Coin
+		bondFactor        *sdkmath.LegacyDec
 	)
 
 	simState.AppParams.GetOrGenerate(unbondingTime, &unbondTime, simState.Rand, func(r *rand.Rand) { unbondTime = genUnbondingTime(r) })
@@ -62,7 +75,6 @@ func RandomizedGenState(simState *module.SimulationState) {
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, simState.BondDenom, minCommissionRate, rotationFee)
 
 	// validators & delegations
 	var (
@@ -105,6 +117,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		delegations = append(delegations, delegation)
 	}
 
+	// the validator bond factor is drawn after the validators, leaving their randomized
+	// values unchanged
+	simState.AppParams.GetOrGenerate(validatorBond, &bondFactor, simState.Rand, func(r *rand.Rand) { bondFactor = genValidatorBondFactor(r) })
+
+	params := types.NewParams(simState.UnbondTime, maxVals, 7, simState.BondDenom, minCommissionRate, rotationFee, types.DefaultGlobalLiquidStakingCap, types.DefaultValidatorLiquidStakingCap, bondFactor)
+
 	stakingGenesis := types.NewGenesisState(params, validators, delegations)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(stakingGenesis)
 }
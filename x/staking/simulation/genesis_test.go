@@ -54,6 +54,7 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, uint32(0), stakingGenesis.Params.HistoricalEntries)
 	require.Equal(t, "stake", stakingGenesis.Params.BondDenom)
 	require.Equal(t, float64(238280), stakingGenesis.Params.UnbondingTime.Seconds())
+	require.Equal(t, "161.000000000000000000", stakingGenesis.Params.ValidatorBondFactor.String())
 	// check numbers of Delegations and Validators
 	require.Len(t, stakingGenesis.Delegations, 3)
 	require.Len(t, stakingGenesis.Validators, 3)
@@ -1,6 +1,7 @@
 package simulation
 
 import (
+	"bytes"
 	"context"
 	"slices"
 	"time"
@@ -80,6 +81,10 @@ func MsgDelegateFactory(k *keeper.Keeper) simsx.SimMsgFactoryFn[*types.MsgDelega
 		}
 		sender := testData.AnyAccount(reporter)
 		delegation := sender.LiquidBalance().RandSubsetCoin(reporter, bondDenom)
+		if reporter.IsSkipped() {
+			return nil, nil
+		}
+		delegation.Amount = capToValidatorBond(ctx, reporter, k, val, sender.Address, delegation.Amount)
 		return []simsx.SimAccount{sender}, types.NewMsgDelegate(sender.AddressBech32, val.GetOperator(), delegation)
 	}
 }
@@ -215,6 +220,11 @@ func MsgBeginRedelegateFactory(k *keeper.Keeper) simsx.SimMsgFactoryFn[*types.Ms
 			return nil, nil
 		}
 
+		redAmount = capToValidatorBond(ctx, reporter, k, destVal, delAddrBz, redAmount)
+		if reporter.IsSkipped() {
+			return nil, nil
+		}
+
 		msg := types.NewMsgBeginRedelegate(
 			delAddr, srcVal.GetOperator(), destVal.GetOperator(),
 			sdk.NewCoin(bondDenom, redAmount),
@@ -342,6 +352,10 @@ func MsgUpdateParamsFactory() simsx.SimMsgFactoryFn[*types.MsgUpdateParams] {
 		params.MaxEntries = r.Uint32InRange(1, 1000)
 		params.MaxValidators = r.Uint32InRange(1, 1000)
 		params.UnbondingTime = time.Duration(r.Timestamp().UnixNano())
+		if r.Intn(2) == 0 {
+			factor := math.LegacyNewDec(r.Int63n(250) + 10)
+			params.ValidatorBondFactor = &factor
+		}
 		// modifying commission rate can cause issues for proposals within the same block
 		// params.MinCommissionRate = r.DecN(sdkmath.LegacyNewDec(1))
 
@@ -376,6 +390,29 @@ func assertKeyUnused(ctx context.Context, reporter simsx.SimulationReporter, k *
 	}
 }
 
+// capToValidatorBond reduces the amount to the tokens that can still be delegated to the
+// validator under the validator bond factor, and skips when none can be. Self-delegations
+// are never capped.
+func capToValidatorBond(ctx context.Context, reporter simsx.SimulationReporter, k *keeper.Keeper, val types.Validator, delAddr []byte, amount math.Int) math.Int {
+	valAddr := must(k.ValidatorAddressCodec().StringToBytes(val.GetOperator()))
+	if bytes.Equal(valAddr, delAddr) {
+		return amount
+	}
+
+	capacity, capped, err := k.GetValidatorBondCapacity(ctx, val)
+	if err != nil {
+		panic(err)
+	}
+	if !capped {
+		return amount
+	}
+	if !capacity.IsPositive() {
+		reporter.Skip("validator bond capacity exhausted")
+		return amount
+	}
+	return math.MinInt(amount, capacity)
+}
+
 func must[T any](r T, err error) T {
 	if err != nil {
 		panic(err)
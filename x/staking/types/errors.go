@@ -50,4 +50,15 @@ var (
 	// consensus key errors
 	ErrExceedingMaxConsPubKeyRotations = errors.Register(ModuleName, 46, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid       = errors.Register(ModuleName, 47, "consensus pubkey len is invalid")
+
+	// liquid staking errors
+	ErrTokenizeShareRecordNotFound       = errors.Register(ModuleName, 48, "tokenize share record not found")
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 49, "delegation or tokenization exceeds the global cap")
+	ErrValidatorLiquidStakingCapExceeded = errors.Register(ModuleName, 50, "delegation or tokenization exceeds the validator cap")
+	ErrRedelegationInProgress            = errors.Register(ModuleName, 51, "delegator is not allowed to tokenize shares from validator with a redelegation in progress")
+	ErrInsufficientVestedShares          = errors.Register(ModuleName, 52, "not enough vested shares to tokenize")
+	ErrNotTokenizeShareDenom             = errors.Register(ModuleName, 53, "denom is not a tokenize share denom")
+
+	// validator bond errors
+	ErrInsufficientValidatorBond = errors.Register(ModuleName, 54, "insufficient validator bond shares")
 )
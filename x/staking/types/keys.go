@@ -67,6 +67,11 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	ConsAddrToValidatorIdentifierMapPrefix      = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsAddrMap                         = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	TokenizeShareRecordKey       = collections.NewPrefix(129) // prefix for the tokenize share records
+	LastTokenizeShareRecordIDKey = collections.NewPrefix(130) // key for the counter for the incrementing id for tokenize share records
+	TotalLiquidStakedTokensKey   = collections.NewPrefix(131) // key for the total amount of tokenized bond denom
+	ValidatorLiquidSharesKey     = collections.NewPrefix(132) // prefix for the tokenized delegator shares of each validator
 )
 
 // Reserved kvstore keys
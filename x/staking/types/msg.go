@@ -23,6 +23,8 @@ var (
 	_ coretransaction.Msg                  = &MsgBeginRedelegate{}
 	_ coretransaction.Msg                  = &MsgCancelUnbondingDelegation{}
 	_ coretransaction.Msg                  = &MsgUpdateParams{}
+	_ coretransaction.Msg                  = &MsgTokenizeShares{}
+	_ coretransaction.Msg                  = &MsgRedeemTokensForShares{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -151,6 +153,24 @@ func NewMsgCancelUnbondingDelegation(delAddr, valAddr string, creationHeight int
 	}
 }
 
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+func NewMsgTokenizeShares(delAddr, valAddr string, amount sdk.Coin, owner string) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress:    delAddr,
+		ValidatorAddress:    valAddr,
+		Amount:              amount,
+		TokenizedShareOwner: owner,
+	}
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+func NewMsgRedeemTokensForShares(delAddr string, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr,
+		Amount:           amount,
+	}
+}
+
 // NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
 func NewMsgRotateConsPubKey(valAddr string, pubKey cryptotypes.PubKey) (*MsgRotateConsPubKey, error) {
 	var pkAny *codectypes.Any
@@ -32,22 +32,35 @@ var (
 
 	// DefaultKeyRotationFee is fees used to rotate the ConsPubkey or Operator key
 	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, which disables the cap
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, which disables the cap
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorBondFactor is unset, which disables the cap
+	DefaultValidatorBondFactor *math.LegacyDec
 )
 
 // NewParams creates a new Params instance
 func NewParams(unbondingTime time.Duration,
 	maxValidators, maxEntries uint32,
 	bondDenom string, minCommissionRate math.LegacyDec,
-	keyRotationFee sdk.Coin,
+	keyRotationFee sdk.Coin, globalLiquidStakingCap, validatorLiquidStakingCap math.LegacyDec,
+	validatorBondFactor *math.LegacyDec,
 ) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: 0,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
-		KeyRotationFee:    keyRotationFee,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         0,
+		BondDenom:                 bondDenom,
+		MinCommissionRate:         minCommissionRate,
+		KeyRotationFee:            keyRotationFee,
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+		ValidatorBondFactor:       validatorBondFactor,
 	}
 }
 
@@ -60,6 +73,9 @@ func DefaultParams() Params {
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
 		DefaultKeyRotationFee,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultValidatorBondFactor,
 	)
 }
 
@@ -113,6 +129,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateLiquidStakingCap("global", p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap("validator", p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -228,3 +256,33 @@ func validateKeyRotationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateLiquidStakingCap(name string, v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s liquid staking cap cannot be nil: %s", name, v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("%s liquid staking cap cannot be negative: %s", name, v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("%s liquid staking cap cannot be greater than 100%%: %s", name, v)
+	}
+
+	return nil
+}
+
+func validateValidatorBondFactor(v *math.LegacyDec) error {
+	if v == nil {
+		return nil
+	}
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("validator bond factor must be non-negative: %s", v)
+	}
+
+	return nil
+}
+
+// IsValidatorBondCapped returns true if the validator bond factor caps the delegations of validators.
+func (p Params) IsValidatorBondCapped() bool {
+	return p.ValidatorBondFactor != nil
+}
@@ -935,6 +935,15 @@ type Params struct {
 	// key_rotation_fee is fee to be spent when rotating validator's key
 	// (either consensus pubkey or operator key)
 	KeyRotationFee types.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// global_liquid_staking_cap is the maximum fraction of the bonded tokens that can be tokenized.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of the delegator shares of a validator
+	// that can be tokenized.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
+	// validator_bond_factor is the maximum ratio between the shares delegated to a validator
+	// by accounts other than its operator and the self-bond shares of the operator.
+	// An unset value disables the cap.
+	ValidatorBondFactor *cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_bond_factor,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1351,6 +1360,72 @@ func (m *ValAddrsOfRotatedConsKeys) GetAddresses() [][]byte {
 	return nil
 }
 
+// TokenizeShareRecord represents a tokenized delegation. The delegation is held by
+// the address derived from the record id, and is represented by the share tokens
+// of the record denom.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address receiving the rewards of the tokenized delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// validator is the address of the validator of the tokenized delegation.
+	Validator string `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
@@ -1377,6 +1452,7 @@ func init() {
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
 	proto.RegisterType((*ValAddrsOfRotatedConsKeys)(nil), "cosmos.staking.v1beta1.ValAddrsOfRotatedConsKeys")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 }
 
 func init() {
@@ -1384,136 +1460,144 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2065 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
-	0x19, 0xd6, 0x92, 0x0c, 0x25, 0xfd, 0x94, 0x44, 0x6a, 0xfc, 0xa2, 0xe8, 0x58, 0x92, 0x19, 0xb7,
-	0x91, 0xdd, 0x9a, 0x8a, 0xdc, 0xc2, 0x05, 0x84, 0x20, 0x85, 0x29, 0xd2, 0x31, 0xf3, 0x90, 0xd4,
-	0xa5, 0xa4, 0x3e, 0xd0, 0x66, 0x31, 0xdc, 0x1d, 0x52, 0x5b, 0x91, 0xb3, 0xec, 0xce, 0x50, 0x36,
-	0xef, 0x3d, 0x04, 0x2e, 0x0a, 0xe4, 0x54, 0x04, 0x28, 0x8c, 0x1a, 0xe8, 0x25, 0xbd, 0xe5, 0x60,
-	0xf4, 0xde, 0x5b, 0x5a, 0xa0, 0x80, 0xe1, 0x53, 0x11, 0xa0, 0x6e, 0x61, 0x1f, 0x12, 0x34, 0x97,
-	0xa2, 0xa7, 0x1e, 0x8b, 0x79, 0xec, 0x83, 0xa2, 0x68, 0x59, 0x72, 0x50, 0x04, 0xed, 0x45, 0xe0,
-	0xcc, 0xfc, 0xff, 0xb7, 0xf3, 0x7f, 0xf3, 0x3f, 0x66, 0x7e, 0xc1, 0x25, 0xdb, 0x63, 0x1d, 0x8f,
-	0x2d, 0x33, 0x8e, 0xf7, 0x5c, 0xda, 0x5a, 0xde, 0x5f, 0x69, 0x10, 0x8e, 0x57, 0x82, 0x71, 0xa9,
-	0xeb, 0x7b, 0xdc, 0x43, 0x67, 0x95, 0x54, 0x29, 0x98, 0xd5, 0x52, 0x85, 0xd3, 0x2d, 0xaf, 0xe5,
-	0x49, 0x91, 0x65, 0xf1, 0x4b, 0x49, 0x17, 0xe6, 0x5a, 0x9e, 0xd7, 0x6a, 0x93, 0x65, 0x39, 0x6a,
-	0xf4, 0x9a, 0xcb, 0x98, 0xf6, 0xf5, 0xd2, 0xfc, 0xc1, 0x25, 0xa7, 0xe7, 0x63, 0xee, 0x7a, 0x54,
-	0xaf, 0x2f, 0x1c, 0x5c, 0xe7, 0x6e, 0x87, 0x30, 0x8e, 0x3b, 0xdd, 0x00, 0x5b, 0xed, 0xc4, 0x52,
-	0x1f, 0xd5, 0xdb, 0xd2, 0xd8, 0xda, 0x94, 0x06, 0x66, 0x24, 0xb4, 0xc3, 0xf6, 0xdc, 0x00, 0x7b,
-	0x16, 0x77, 0x5c, 0xea, 0x2d, 0xcb, 0xbf, 0x7a, 0xea, 0x82, 0xed, 0x75, 0x08, 0x6f, 0x34, 0xf9,
-	0x32, 0xef, 0x77, 0x09, 0x5b, 0xde, 0x5f, 0x51, 0x3f, 0xf4, 0xf2, 0xcb, 0xe1, 0x32, 0x6e, 0xd8,
-	0xee, 0x81, 0xd5, 0xe2, 0x87, 0x06, 0xcc, 0xdc, 0x72, 0x19, 0xf7, 0x7c, 0xd7, 0xc6, 0xed, 0x1a,
-	0x6d, 0x7a, 0xe8, 0x75, 0x48, 0xef, 0x12, 0xec, 0x10, 0x3f, 0x6f, 0x2c, 0x1a, 0x4b, 0x99, 0x6b,
-	0x73, 0xa5, 0x00, 0xa1, 0xa4, 0x34, 0xf7, 0x57, 0x4a, 0xb7, 0xa4, 0x40, 0x79, 0xf2, 0x93, 0xc7,
-	0x0b, 0x63, 0x1f, 0x7d, 0xf6, 0xf1, 0x15, 0xc3, 0xd4, 0x3a, 0xa8, 0x02, 0xe9, 0x7d, 0xdc, 0x66,
-	0x84, 0xe7, 0x13, 0x8b, 0xc9, 0xa5, 0xcc, 0xb5, 0x8b, 0xa5, 0xc3, 0x69, 0x2f, 0xed, 0xe0, 0xb6,
-	0xeb, 0x60, 0xee, 0x0d, 0xa2, 0x28, 0xdd, 0xd5, 0x44, 0xde, 0x28, 0xfe, 0x2a, 0x01, 0xd9, 0x35,
-	0xaf, 0xd3, 0x71, 0x19, 0x73, 0x3d, 0x6a, 0x62, 0x4e, 0x18, 0x7a, 0x0b, 0x52, 0x3e, 0xe6, 0x44,
-	0xee, 0x6c, 0xb2, 0x7c, 0x5d, 0x28, 0x7e, 0xfa, 0x78, 0xe1, 0xbc, 0xfa, 0x04, 0x73, 0xf6, 0x4a,
-	0xae, 0xb7, 0xdc, 0xc1, 0x7c, 0xb7, 0xf4, 0x0e, 0x69, 0x61, 0xbb, 0x5f, 0x21, 0xf6, 0xa3, 0x07,
-	0x57, 0x41, 0xef, 0xa0, 0x42, 0x6c, 0xf5, 0x15, 0x89, 0x81, 0xbe, 0x07, 0x13, 0x1d, 0x7c, 0xc7,
-	0x92, 0x78, 0x89, 0x17, 0xc2, 0x1b, 0xef, 0xe0, 0x3b, 0x62, 0x7f, 0xe8, 0x3d, 0xc8, 0x0a, 0x48,
-	0x7b, 0x17, 0xd3, 0x16, 0x51, 0xc8, 0xc9, 0x17, 0x42, 0x9e, 0xee, 0xe0, 0x3b, 0x6b, 0x12, 0x4d,
-	0xe0, 0xaf, 0xa6, 0x3e, 0xbf, 0xbf, 0x60, 0x14, 0xff, 0x60, 0x00, 0x44, 0xc4, 0x20, 0x0c, 0x39,
-	0x3b, 0x1c, 0xc9, 0x8f, 0x32, 0x7d, 0x72, 0xaf, 0x8e, 0xe2, 0xfe, 0x00, 0xad, 0xe5, 0x69, 0xb1,
-	0xbd, 0x87, 0x8f, 0x17, 0x0c, 0xf5, 0xd5, 0xac, 0x3d, 0x44, 0x7b, 0xa6, 0xd7, 0x75, 0x30, 0x27,
-	0x96, 0x70, 0x65, 0xc9, 0x56, 0xe6, 0x5a, 0xa1, 0xa4, 0xfc, 0xbc, 0x14, 0xf8, 0x79, 0x69, 0x2b,
-	0xf0, 0x73, 0x05, 0xf8, 0xc1, 0xdf, 0x02, 0x40, 0x50, 0xda, 0x62, 0x5d, 0xdb, 0xf0, 0x91, 0x01,
-	0x99, 0x0a, 0x61, 0xb6, 0xef, 0x76, 0x45, 0xe4, 0xa0, 0x3c, 0x8c, 0x77, 0x3c, 0xea, 0xee, 0x69,
-	0xaf, 0x9b, 0x34, 0x83, 0x21, 0x2a, 0xc0, 0x84, 0xeb, 0x10, 0xca, 0x5d, 0xde, 0x57, 0xc7, 0x64,
-	0x86, 0x63, 0xa1, 0x75, 0x9b, 0x34, 0x98, 0x1b, 0xf0, 0x6c, 0x06, 0x43, 0x74, 0x19, 0x72, 0x8c,
-	0xd8, 0x3d, 0xdf, 0xe5, 0x7d, 0xcb, 0xf6, 0x28, 0xc7, 0x36, 0xcf, 0xa7, 0xa4, 0x48, 0x36, 0x98,
-	0x5f, 0x53, 0xd3, 0x02, 0xc4, 0x21, 0x1c, 0xbb, 0x6d, 0x96, 0x7f, 0x49, 0x81, 0xe8, 0xa1, 0xde,
-	0xea, 0xbd, 0x71, 0x98, 0x0c, 0x9d, 0x15, 0xad, 0x41, 0xce, 0xeb, 0x12, 0x5f, 0xfc, 0xb6, 0xb0,
-	0xe3, 0xf8, 0x84, 0x31, 0xed, 0x8d, 0xf9, 0x47, 0x0f, 0xae, 0x9e, 0xd6, 0x84, 0xdf, 0x50, 0x2b,
-	0x75, 0xee, 0xbb, 0xb4, 0x65, 0x66, 0x03, 0x0d, 0x3d, 0x8d, 0x7e, 0x28, 0x8e, 0x8c, 0x32, 0x42,
-	0x59, 0x8f, 0x59, 0xdd, 0x5e, 0x63, 0x8f, 0xf4, 0x35, 0xa9, 0xa7, 0x87, 0x48, 0xbd, 0x41, 0xfb,
-	0xe5, 0xfc, 0x9f, 0x22, 0x68, 0xdb, 0xef, 0x77, 0xb9, 0x57, 0xda, 0xec, 0x35, 0xde, 0x26, 0x7d,
-	0x71, 0x54, 0x1a, 0x67, 0x53, 0xc2, 0xa0, 0xb3, 0x90, 0xfe, 0x29, 0x76, 0xdb, 0xc4, 0x91, 0x8c,
-	0x4c, 0x98, 0x7a, 0x84, 0x56, 0x21, 0xcd, 0x38, 0xe6, 0x3d, 0x26, 0x69, 0x98, 0xb9, 0x56, 0x1c,
-	0xe5, 0x1b, 0x65, 0x8f, 0x3a, 0x75, 0x29, 0x69, 0x6a, 0x0d, 0xb4, 0x06, 0x69, 0xee, 0xed, 0x11,
-	0xaa, 0x09, 0x2a, 0x7f, 0x43, 0x7b, 0xf3, 0x99, 0x61, 0x6f, 0xae, 0x51, 0x1e, 0xf3, 0xe3, 0x1a,
-	0xe5, 0xa6, 0x56, 0x45, 0x3f, 0x86, 0x9c, 0x43, 0xda, 0xa4, 0x25, 0x99, 0x63, 0xbb, 0xd8, 0x27,
-	0x2c, 0x9f, 0x96, 0x70, 0x2b, 0xc7, 0x0e, 0x0e, 0x33, 0x1b, 0x42, 0xd5, 0x25, 0x12, 0xda, 0x84,
-	0x8c, 0x13, 0xb9, 0x53, 0x7e, 0x5c, 0x92, 0xf9, 0xca, 0x28, 0x1b, 0x63, 0x9e, 0x17, 0xcf, 0x3e,
-	0x71, 0x08, 0xe1, 0x41, 0x3d, 0xda, 0xf0, 0xa8, 0xe3, 0xd2, 0x96, 0xb5, 0x4b, 0xdc, 0xd6, 0x2e,
-	0xcf, 0x4f, 0x2c, 0x1a, 0x4b, 0x49, 0x33, 0x1b, 0xce, 0xdf, 0x92, 0xd3, 0x68, 0x13, 0x66, 0x22,
-	0x51, 0x19, 0x21, 0x93, 0xc7, 0x8d, 0x90, 0xe9, 0x10, 0x40, 0x88, 0xa0, 0x77, 0x01, 0xa2, 0x18,
-	0xcc, 0x83, 0x44, 0x2b, 0x1e, 0x1d, 0xcd, 0x71, 0x63, 0x62, 0x00, 0x88, 0xc2, 0xa9, 0x8e, 0x4b,
-	0x2d, 0x46, 0xda, 0x4d, 0x4b, 0x33, 0x27, 0x70, 0x33, 0x92, 0xfe, 0x37, 0x8e, 0x71, 0x9a, 0x9f,
-	0x3e, 0xb8, 0x9a, 0x55, 0xa3, 0xab, 0xcc, 0xd9, 0x5b, 0x7c, 0xad, 0xf4, 0xed, 0xef, 0x98, 0xb3,
-	0x1d, 0x97, 0xd6, 0x49, 0xbb, 0x59, 0x09, 0x81, 0xd1, 0xeb, 0x70, 0x3e, 0x22, 0xc4, 0xa3, 0xd6,
-	0xae, 0xd7, 0x76, 0x2c, 0x9f, 0x34, 0x2d, 0xdb, 0xeb, 0x51, 0x9e, 0x9f, 0x92, 0x34, 0x9e, 0x0b,
-	0x45, 0x36, 0xe8, 0x2d, 0xaf, 0xed, 0x98, 0xa4, 0xb9, 0x26, 0x96, 0xd1, 0x2b, 0x10, 0xb1, 0x61,
-	0xb9, 0x0e, 0xcb, 0x4f, 0x2f, 0x26, 0x97, 0x52, 0xe6, 0x54, 0x38, 0x59, 0x73, 0xd8, 0xea, 0xc4,
-	0xfb, 0xf7, 0x17, 0xc6, 0x3e, 0xbf, 0xbf, 0x30, 0x56, 0xbc, 0x09, 0x53, 0x3b, 0xb8, 0xad, 0x43,
-	0x8b, 0x30, 0x74, 0x1d, 0x26, 0x71, 0x30, 0xc8, 0x1b, 0x8b, 0xc9, 0x67, 0x86, 0x66, 0x24, 0x5a,
-	0xfc, 0x9d, 0x01, 0xe9, 0xca, 0xce, 0x26, 0x76, 0x7d, 0x54, 0x85, 0xd9, 0xc8, 0x57, 0x9f, 0x37,
-	0xca, 0x23, 0xf7, 0x0e, 0xc2, 0x7c, 0x1d, 0x66, 0xf7, 0x83, 0xc4, 0x11, 0xc2, 0xa8, 0x52, 0x73,
-	0xf1, 0xd1, 0x83, 0xab, 0x17, 0x34, 0x4c, 0x98, 0x5c, 0x0e, 0xe0, 0xed, 0x1f, 0x98, 0x8f, 0xd9,
-	0xfc, 0x16, 0x8c, 0xab, 0xad, 0x32, 0xf4, 0x5d, 0x78, 0xa9, 0x2b, 0x7e, 0x48, 0x53, 0x33, 0xd7,
-	0xe6, 0x47, 0xfa, 0xbc, 0x94, 0x8f, 0x7b, 0x88, 0xd2, 0x2b, 0xfe, 0x22, 0x01, 0x50, 0xd9, 0xd9,
-	0xd9, 0xf2, 0xdd, 0x6e, 0x9b, 0xf0, 0x2f, 0xcb, 0xf6, 0x6d, 0x38, 0x13, 0xd9, 0xce, 0x7c, 0xfb,
-	0xf8, 0xf6, 0x9f, 0x0a, 0xf5, 0xeb, 0xbe, 0x7d, 0x28, 0xac, 0xc3, 0x78, 0x08, 0x9b, 0x3c, 0x3e,
-	0x6c, 0x85, 0xf1, 0x61, 0x66, 0x7f, 0x00, 0x99, 0x88, 0x0c, 0x86, 0x6a, 0x30, 0xc1, 0xf5, 0x6f,
-	0x4d, 0x70, 0x71, 0x34, 0xc1, 0x81, 0x5a, 0x9c, 0xe4, 0x50, 0xbd, 0xf8, 0x6f, 0x03, 0x20, 0x16,
-	0x23, 0x5f, 0x4d, 0x1f, 0x43, 0x35, 0x48, 0xeb, 0xe4, 0x9c, 0x3c, 0x69, 0x72, 0xd6, 0x00, 0x31,
-	0x52, 0x7f, 0x99, 0x80, 0x53, 0xdb, 0x41, 0xf4, 0x7e, 0xf5, 0x39, 0xd8, 0x86, 0x71, 0x42, 0xb9,
-	0xef, 0x4a, 0x12, 0xc4, 0x99, 0xbf, 0x36, 0xea, 0xcc, 0x0f, 0x31, 0xaa, 0x4a, 0xb9, 0xdf, 0x8f,
-	0x7b, 0x40, 0x80, 0x15, 0xe3, 0xe3, 0xd7, 0x49, 0xc8, 0x8f, 0x52, 0x45, 0xaf, 0x42, 0xd6, 0xf6,
-	0x89, 0x9c, 0x08, 0xea, 0x8e, 0x21, 0x13, 0xe6, 0x4c, 0x30, 0xad, 0xcb, 0x8e, 0x09, 0xe2, 0xa2,
-	0x26, 0x9c, 0x4b, 0x88, 0x9e, 0xec, 0x66, 0x36, 0x13, 0x21, 0xc8, 0xc2, 0xb3, 0x05, 0x59, 0x97,
-	0xba, 0xdc, 0xc5, 0x6d, 0xab, 0x81, 0xdb, 0x98, 0xda, 0xc1, 0x0d, 0xf6, 0x58, 0x35, 0x7f, 0x46,
-	0x63, 0x94, 0x15, 0x04, 0xaa, 0xc2, 0x78, 0x80, 0x96, 0x3a, 0x3e, 0x5a, 0xa0, 0x8b, 0x2e, 0xc2,
-	0x54, 0xbc, 0x30, 0xc8, 0xdb, 0x48, 0xca, 0xcc, 0xc4, 0xea, 0xc2, 0x51, 0x95, 0x27, 0xfd, 0xcc,
-	0xca, 0xa3, 0x2f, 0x7c, 0xbf, 0x49, 0xc2, 0xac, 0x49, 0x9c, 0xff, 0xfd, 0x63, 0xd9, 0x04, 0x50,
-	0xa1, 0x2a, 0x32, 0xa9, 0x3e, 0x99, 0x13, 0xc4, 0xfb, 0xa4, 0x02, 0xa9, 0x30, 0xfe, 0xdf, 0x3a,
-	0xa1, 0xbf, 0x26, 0x60, 0x2a, 0x7e, 0x42, 0xff, 0x97, 0x45, 0x0b, 0xad, 0x47, 0x69, 0x2a, 0x25,
-	0xd3, 0xd4, 0xe5, 0x51, 0x69, 0x6a, 0xc8, 0x9b, 0x8f, 0xc8, 0x4f, 0x5f, 0x24, 0x21, 0xbd, 0x89,
-	0x7d, 0xdc, 0x61, 0x68, 0x63, 0xe8, 0x6e, 0x1b, 0x74, 0x05, 0x0e, 0x3a, 0x73, 0x45, 0x77, 0x41,
-	0x94, 0x2f, 0x7f, 0x38, 0xea, 0x6a, 0xfb, 0x35, 0x98, 0x11, 0x6f, 0xe4, 0xd0, 0x20, 0x45, 0xee,
-	0xb4, 0x7c, 0xea, 0x86, 0xd6, 0x33, 0xb4, 0x00, 0x19, 0x21, 0x16, 0xe5, 0x61, 0x21, 0x03, 0x1d,
-	0x7c, 0xa7, 0xaa, 0x66, 0xd0, 0x0a, 0xa0, 0xdd, 0xb0, 0x71, 0x61, 0x45, 0x44, 0x18, 0x4b, 0xd3,
-	0xe5, 0x44, 0xde, 0x30, 0x67, 0xa3, 0xd5, 0x40, 0xe5, 0x02, 0x80, 0xd8, 0x89, 0xe5, 0x10, 0xea,
-	0x75, 0xf4, 0x63, 0x6f, 0x52, 0xcc, 0x54, 0xc4, 0x04, 0xfa, 0xb9, 0xa1, 0xae, 0xc9, 0x07, 0x5e,
-	0xd3, 0xfa, 0x95, 0xb2, 0xf5, 0x1c, 0x81, 0xf1, 0xaf, 0xc7, 0x0b, 0x85, 0x3e, 0xee, 0xb4, 0x57,
-	0x8b, 0x87, 0xe0, 0x14, 0x0f, 0x7b, 0xe0, 0x8b, 0xcb, 0xf3, 0xe0, 0x6b, 0x1c, 0xd5, 0x20, 0xb7,
-	0x47, 0xfa, 0x96, 0xef, 0x71, 0x95, 0x6c, 0x9a, 0x84, 0xe8, 0xf7, 0xcc, 0x5c, 0x70, 0xbe, 0x0d,
-	0xcc, 0x48, 0xec, 0xfa, 0xef, 0xd2, 0x72, 0x4a, 0xec, 0xce, 0x9c, 0xd9, 0x23, 0x7d, 0x53, 0xeb,
-	0xdd, 0x24, 0x64, 0xf5, 0x92, 0x88, 0x96, 0xbb, 0x9f, 0x7d, 0x7c, 0xe5, 0x7c, 0x74, 0x69, 0x5f,
-	0xbe, 0x13, 0xf6, 0xc9, 0xd4, 0x11, 0x8b, 0x8b, 0x2f, 0x8a, 0x8a, 0x90, 0x49, 0x58, 0x57, 0xbc,
-	0x29, 0xc5, 0x1b, 0x24, 0xf6, 0x56, 0x30, 0x9e, 0xfd, 0x06, 0x89, 0xf4, 0x07, 0xde, 0x20, 0xb1,
-	0x10, 0x7d, 0x23, 0xaa, 0x01, 0x89, 0xa3, 0xac, 0x89, 0x7b, 0xa7, 0x56, 0x92, 0x91, 0x3f, 0x56,
-	0xfc, 0xb3, 0x01, 0x73, 0x43, 0xde, 0x1c, 0x6e, 0xd9, 0x06, 0xe4, 0xc7, 0x16, 0xa5, 0x57, 0xf4,
-	0xf5, 0xd6, 0x4f, 0x16, 0x1c, 0xb3, 0xfe, 0x50, 0x21, 0xf8, 0x72, 0x8a, 0x99, 0xce, 0x64, 0x7f,
-	0x34, 0xe0, 0x74, 0x7c, 0x03, 0xa1, 0x29, 0x75, 0x98, 0x8a, 0x7f, 0x5a, 0x1b, 0x71, 0xe9, 0x79,
-	0x8c, 0x88, 0xef, 0x7f, 0x00, 0x04, 0xed, 0x44, 0x19, 0x43, 0x75, 0xe7, 0x56, 0x9e, 0x9b, 0x94,
-	0x60, 0x63, 0x87, 0x66, 0x0e, 0x75, 0x36, 0x5f, 0x18, 0x90, 0xda, 0xf4, 0xbc, 0x36, 0xfa, 0x19,
-	0xcc, 0x52, 0x8f, 0x5b, 0x22, 0xb2, 0x88, 0x63, 0xe9, 0xd6, 0x81, 0xca, 0xc6, 0xd5, 0x67, 0x72,
-	0xf5, 0x8f, 0xc7, 0x0b, 0xc3, 0x9a, 0x83, 0x04, 0xea, 0x0e, 0x15, 0xf5, 0x78, 0x59, 0x0a, 0x6d,
-	0xa9, 0xee, 0x42, 0x13, 0xa6, 0x07, 0x3f, 0xa7, 0x32, 0xf6, 0x8d, 0xa3, 0x3e, 0x37, 0x7d, 0xe4,
-	0xa7, 0xa6, 0x1a, 0xb1, 0xef, 0xac, 0x4e, 0x88, 0x53, 0xfb, 0xa7, 0x38, 0xb9, 0xf7, 0x20, 0x17,
-	0xa6, 0xab, 0x6d, 0xd9, 0xde, 0x62, 0xe8, 0x26, 0x8c, 0xab, 0x4e, 0x57, 0xf0, 0x58, 0xb8, 0x18,
-	0xf5, 0x4e, 0x71, 0xc3, 0x76, 0x4b, 0xfb, 0xb1, 0xbe, 0xa7, 0x52, 0x1a, 0xe0, 0x53, 0x2b, 0xcb,
-	0xf6, 0xe7, 0xc3, 0x04, 0xcc, 0xad, 0x79, 0x94, 0xe9, 0x46, 0x8f, 0x8e, 0x6a, 0xd5, 0xab, 0xed,
-	0xa3, 0xcb, 0x23, 0xda, 0x50, 0x53, 0xc3, 0xcd, 0xa6, 0x1d, 0xc8, 0x8a, 0x12, 0x6b, 0x7b, 0xf4,
-	0x05, 0x7b, 0x4d, 0xd3, 0x5e, 0xdb, 0xd1, 0x3b, 0xda, 0x23, 0x7d, 0x81, 0x4b, 0xc9, 0xed, 0x01,
-	0xdc, 0xe4, 0xc9, 0x70, 0x29, 0xb9, 0x1d, 0xc3, 0x3d, 0x0b, 0x69, 0x7d, 0xbf, 0x4a, 0xc9, 0xdb,
-	0x83, 0x1e, 0xa1, 0xeb, 0x90, 0x14, 0xa9, 0xf0, 0xa5, 0x63, 0x24, 0x0f, 0xa1, 0x10, 0x2b, 0x6b,
-	0x75, 0x98, 0xd3, 0x9d, 0x02, 0xb6, 0xd1, 0x94, 0x8c, 0x12, 0x69, 0xd0, 0xdb, 0xa4, 0x7f, 0x48,
-	0xdb, 0x60, 0xea, 0xb9, 0xda, 0x06, 0x57, 0x7e, 0x6f, 0x00, 0x44, 0x3d, 0x33, 0xf4, 0x4d, 0x38,
-	0x57, 0xde, 0x58, 0xaf, 0x58, 0xf5, 0xad, 0x1b, 0x5b, 0xdb, 0x75, 0x6b, 0x7b, 0xbd, 0xbe, 0x59,
-	0x5d, 0xab, 0xdd, 0xac, 0x55, 0x2b, 0xb9, 0xb1, 0x42, 0xf6, 0xee, 0xbd, 0xc5, 0xcc, 0x36, 0x65,
-	0x5d, 0x62, 0xbb, 0x4d, 0x97, 0x38, 0xe8, 0xeb, 0x70, 0x7a, 0x50, 0x5a, 0x8c, 0xaa, 0x95, 0x9c,
-	0x51, 0x98, 0xba, 0x7b, 0x6f, 0x71, 0x42, 0xbd, 0x11, 0x88, 0x83, 0x96, 0xe0, 0xcc, 0xb0, 0x5c,
-	0x6d, 0xfd, 0xcd, 0x5c, 0xa2, 0x30, 0x7d, 0xf7, 0xde, 0xe2, 0x64, 0xf8, 0x98, 0x40, 0x45, 0x40,
-	0x71, 0x49, 0x8d, 0x97, 0x2c, 0xc0, 0xdd, 0x7b, 0x8b, 0x69, 0x15, 0x32, 0x85, 0xd4, 0xfb, 0xbf,
-	0x9d, 0x1f, 0xbb, 0xf2, 0x13, 0x80, 0x1a, 0x6d, 0xfa, 0xd8, 0x96, 0xa9, 0xa1, 0x00, 0x67, 0x6b,
-	0xeb, 0x37, 0xcd, 0x1b, 0x6b, 0x5b, 0xb5, 0x8d, 0xf5, 0xc1, 0x6d, 0x1f, 0x58, 0xab, 0x6c, 0x6c,
-	0x97, 0xdf, 0xa9, 0x5a, 0xf5, 0xda, 0x9b, 0xeb, 0x39, 0x03, 0x9d, 0x83, 0x53, 0x03, 0x6b, 0xdf,
-	0x5f, 0xdf, 0xaa, 0xbd, 0x5b, 0xcd, 0x25, 0xca, 0xd7, 0x3f, 0x79, 0x32, 0x6f, 0x3c, 0x7c, 0x32,
-	0x6f, 0xfc, 0xfd, 0xc9, 0xbc, 0xf1, 0xc1, 0xd3, 0xf9, 0xb1, 0x87, 0x4f, 0xe7, 0xc7, 0xfe, 0xf2,
-	0x74, 0x7e, 0xec, 0x47, 0x2f, 0x0f, 0x04, 0x63, 0x54, 0x8e, 0xe4, 0x7f, 0x17, 0x1a, 0x69, 0xe9,
-	0x35, 0xdf, 0xfa, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf4, 0xd1, 0x83, 0x1f, 0xd5, 0x19, 0x00,
+	// 2193 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x34, 0x25, 0x3e, 0x4a, 0xa2, 0x34, 0x76, 0x6c, 0x4a, 0xb6, 0x25, 0x99, 0x71,
+	0x1b, 0xc5, 0xad, 0xa8, 0xc8, 0x2d, 0x5c, 0x40, 0x08, 0x12, 0x98, 0xa2, 0x14, 0x33, 0x71, 0x24,
+	0x75, 0x29, 0xa9, 0x3f, 0x68, 0xb3, 0x18, 0xee, 0x0e, 0xa9, 0xad, 0xc8, 0x19, 0x7a, 0x67, 0x28,
+	0x9b, 0x3d, 0xf7, 0x10, 0xb8, 0x28, 0x90, 0x53, 0x10, 0xa0, 0x30, 0x6a, 0xa0, 0x97, 0xf4, 0x96,
+	0x83, 0xd1, 0x43, 0x6f, 0xbd, 0xa5, 0x05, 0x0a, 0x18, 0x3e, 0x15, 0x01, 0xea, 0x16, 0xf6, 0x21,
+	0x41, 0x7b, 0x29, 0x7a, 0xea, 0xb1, 0x98, 0xd9, 0xd9, 0x1f, 0x92, 0x92, 0x25, 0xd9, 0x41, 0x11,
+	0xb4, 0x17, 0x62, 0x67, 0xe6, 0xbd, 0x6f, 0x66, 0xbe, 0x79, 0xef, 0xcd, 0xbc, 0x47, 0xb8, 0x6c,
+	0x33, 0xde, 0x62, 0x7c, 0x91, 0x0b, 0xbc, 0xe7, 0xd2, 0xc6, 0xe2, 0xfe, 0x52, 0x8d, 0x08, 0xbc,
+	0x14, 0xb4, 0x8b, 0x6d, 0x8f, 0x09, 0x86, 0xce, 0xfa, 0x52, 0xc5, 0xa0, 0x57, 0x4b, 0x4d, 0x9f,
+	0x69, 0xb0, 0x06, 0x53, 0x22, 0x8b, 0xf2, 0xcb, 0x97, 0x9e, 0x9e, 0x6a, 0x30, 0xd6, 0x68, 0x92,
+	0x45, 0xd5, 0xaa, 0x75, 0xea, 0x8b, 0x98, 0x76, 0xf5, 0xd0, 0x4c, 0xff, 0x90, 0xd3, 0xf1, 0xb0,
+	0x70, 0x19, 0xd5, 0xe3, 0xb3, 0xfd, 0xe3, 0xc2, 0x6d, 0x11, 0x2e, 0x70, 0xab, 0x1d, 0x60, 0xfb,
+	0x2b, 0xb1, 0xfc, 0x49, 0xf5, 0xb2, 0x34, 0xb6, 0xde, 0x4a, 0x0d, 0x73, 0x12, 0xee, 0xc3, 0x66,
+	0x6e, 0x80, 0x3d, 0x89, 0x5b, 0x2e, 0x65, 0x8b, 0xea, 0x57, 0x77, 0x5d, 0xb4, 0x59, 0x8b, 0x88,
+	0x5a, 0x5d, 0x2c, 0x8a, 0x6e, 0x9b, 0xf0, 0xc5, 0xfd, 0x25, 0xff, 0x43, 0x0f, 0x5f, 0x08, 0x87,
+	0x71, 0xcd, 0x76, 0xfb, 0x46, 0x0b, 0x1f, 0x19, 0x30, 0x7e, 0xc3, 0xe5, 0x82, 0x79, 0xae, 0x8d,
+	0x9b, 0x15, 0x5a, 0x67, 0xe8, 0x75, 0x48, 0xef, 0x12, 0xec, 0x10, 0x2f, 0x6f, 0xcc, 0x19, 0xf3,
+	0xd9, 0xab, 0x53, 0xc5, 0x00, 0xa1, 0xe8, 0x6b, 0xee, 0x2f, 0x15, 0x6f, 0x28, 0x81, 0x52, 0xe6,
+	0xd3, 0xc7, 0xb3, 0x43, 0x1f, 0x7f, 0xfe, 0xc9, 0x15, 0xc3, 0xd4, 0x3a, 0xa8, 0x0c, 0xe9, 0x7d,
+	0xdc, 0xe4, 0x44, 0xe4, 0x13, 0x73, 0xc9, 0xf9, 0xec, 0xd5, 0x4b, 0xc5, 0x83, 0x69, 0x2f, 0xee,
+	0xe0, 0xa6, 0xeb, 0x60, 0xc1, 0x7a, 0x51, 0x7c, 0xdd, 0xe5, 0x44, 0xde, 0x28, 0x7c, 0x98, 0x80,
+	0xdc, 0x0a, 0x6b, 0xb5, 0x5c, 0xce, 0x5d, 0x46, 0x4d, 0x2c, 0x08, 0x47, 0x6f, 0x43, 0xca, 0xc3,
+	0x82, 0xa8, 0x95, 0x65, 0x4a, 0xd7, 0xa4, 0xe2, 0x67, 0x8f, 0x67, 0xcf, 0xfb, 0x53, 0x70, 0x67,
+	0xaf, 0xe8, 0xb2, 0xc5, 0x16, 0x16, 0xbb, 0xc5, 0x9b, 0xa4, 0x81, 0xed, 0x6e, 0x99, 0xd8, 0x8f,
+	0x1e, 0x2c, 0x80, 0x5e, 0x41, 0x99, 0xd8, 0xfe, 0x2c, 0x0a, 0x03, 0x7d, 0x17, 0x46, 0x5a, 0xf8,
+	0x8e, 0xa5, 0xf0, 0x12, 0x2f, 0x84, 0x37, 0xdc, 0xc2, 0x77, 0xe4, 0xfa, 0xd0, 0x7b, 0x90, 0x93,
+	0x90, 0xf6, 0x2e, 0xa6, 0x0d, 0xe2, 0x23, 0x27, 0x5f, 0x08, 0x79, 0xac, 0x85, 0xef, 0xac, 0x28,
+	0x34, 0x89, 0xbf, 0x9c, 0xfa, 0xe2, 0xfe, 0xac, 0x51, 0xf8, 0xbd, 0x01, 0x10, 0x11, 0x83, 0x30,
+	0x4c, 0xd8, 0x61, 0x4b, 0x4d, 0xca, 0xf5, 0xc9, 0xbd, 0x72, 0x18, 0xf7, 0x7d, 0xb4, 0x96, 0xc6,
+	0xe4, 0xf2, 0x1e, 0x3e, 0x9e, 0x35, 0xfc, 0x59, 0x73, 0xf6, 0x00, 0xed, 0xd9, 0x4e, 0xdb, 0xc1,
+	0x82, 0x58, 0xd2, 0x94, 0x15, 0x5b, 0xd9, 0xab, 0xd3, 0x45, 0xdf, 0xce, 0x8b, 0x81, 0x9d, 0x17,
+	0xb7, 0x02, 0x3b, 0xf7, 0x01, 0x3f, 0xf8, 0x6b, 0x00, 0x08, 0xbe, 0xb6, 0x1c, 0xd7, 0x7b, 0xf8,
+	0xd8, 0x80, 0x6c, 0x99, 0x70, 0xdb, 0x73, 0xdb, 0xd2, 0x73, 0x50, 0x1e, 0x86, 0x5b, 0x8c, 0xba,
+	0x7b, 0xda, 0xea, 0x32, 0x66, 0xd0, 0x44, 0xd3, 0x30, 0xe2, 0x3a, 0x84, 0x0a, 0x57, 0x74, 0xfd,
+	0x63, 0x32, 0xc3, 0xb6, 0xd4, 0xba, 0x4d, 0x6a, 0xdc, 0x0d, 0x78, 0x36, 0x83, 0x26, 0x7a, 0x15,
+	0x26, 0x38, 0xb1, 0x3b, 0x9e, 0x2b, 0xba, 0x96, 0xcd, 0xa8, 0xc0, 0xb6, 0xc8, 0xa7, 0x94, 0x48,
+	0x2e, 0xe8, 0x5f, 0xf1, 0xbb, 0x25, 0x88, 0x43, 0x04, 0x76, 0x9b, 0x3c, 0x7f, 0xca, 0x07, 0xd1,
+	0x4d, 0xbd, 0xd4, 0x7b, 0xc3, 0x90, 0x09, 0x8d, 0x15, 0xad, 0xc0, 0x04, 0x6b, 0x13, 0x4f, 0x7e,
+	0x5b, 0xd8, 0x71, 0x3c, 0xc2, 0xb9, 0xb6, 0xc6, 0xfc, 0xa3, 0x07, 0x0b, 0x67, 0x34, 0xe1, 0xd7,
+	0xfd, 0x91, 0xaa, 0xf0, 0x5c, 0xda, 0x30, 0x73, 0x81, 0x86, 0xee, 0x46, 0x3f, 0x90, 0x47, 0x46,
+	0x39, 0xa1, 0xbc, 0xc3, 0xad, 0x76, 0xa7, 0xb6, 0x47, 0xba, 0x9a, 0xd4, 0x33, 0x03, 0xa4, 0x5e,
+	0xa7, 0xdd, 0x52, 0xfe, 0x8f, 0x11, 0xb4, 0xed, 0x75, 0xdb, 0x82, 0x15, 0x37, 0x3b, 0xb5, 0x77,
+	0x48, 0xd7, 0xcc, 0x85, 0x38, 0x9b, 0x0a, 0x06, 0x9d, 0x85, 0xf4, 0x4f, 0xb0, 0xdb, 0x24, 0x8e,
+	0x62, 0x64, 0xc4, 0xd4, 0x2d, 0xb4, 0x0c, 0x69, 0x2e, 0xb0, 0xe8, 0x70, 0x45, 0xc3, 0xf8, 0xd5,
+	0xc2, 0x61, 0xb6, 0x51, 0x62, 0xd4, 0xa9, 0x2a, 0x49, 0x53, 0x6b, 0xa0, 0x15, 0x48, 0x0b, 0xb6,
+	0x47, 0xa8, 0x26, 0xa8, 0xf4, 0x0d, 0x6d, 0xcd, 0x2f, 0x0d, 0x5a, 0x73, 0x85, 0x8a, 0x98, 0x1d,
+	0x57, 0xa8, 0x30, 0xb5, 0x2a, 0xfa, 0x11, 0x4c, 0x38, 0xa4, 0x49, 0x1a, 0x8a, 0x39, 0xbe, 0x8b,
+	0x3d, 0xc2, 0xf3, 0x69, 0x05, 0xb7, 0x74, 0x62, 0xe7, 0x30, 0x73, 0x21, 0x54, 0x55, 0x21, 0xa1,
+	0x4d, 0xc8, 0x3a, 0x91, 0x39, 0xe5, 0x87, 0x15, 0x99, 0x2f, 0x1f, 0xb6, 0xc7, 0x98, 0xe5, 0xc5,
+	0xa3, 0x4f, 0x1c, 0x42, 0x5a, 0x50, 0x87, 0xd6, 0x18, 0x75, 0x5c, 0xda, 0xb0, 0x76, 0x89, 0xdb,
+	0xd8, 0x15, 0xf9, 0x91, 0x39, 0x63, 0x3e, 0x69, 0xe6, 0xc2, 0xfe, 0x1b, 0xaa, 0x1b, 0x6d, 0xc2,
+	0x78, 0x24, 0xaa, 0x3c, 0x24, 0x73, 0x52, 0x0f, 0x19, 0x0b, 0x01, 0xa4, 0x08, 0x7a, 0x17, 0x20,
+	0xf2, 0xc1, 0x3c, 0x28, 0xb4, 0xc2, 0xd1, 0xde, 0x1c, 0xdf, 0x4c, 0x0c, 0x00, 0x51, 0x38, 0xdd,
+	0x72, 0xa9, 0xc5, 0x49, 0xb3, 0x6e, 0x69, 0xe6, 0x24, 0x6e, 0x56, 0xd1, 0xff, 0xc6, 0x09, 0x4e,
+	0xf3, 0xb3, 0x07, 0x0b, 0x39, 0xbf, 0xb5, 0xc0, 0x9d, 0xbd, 0xb9, 0xd7, 0x8a, 0xdf, 0xfe, 0x8e,
+	0x39, 0xd9, 0x72, 0x69, 0x95, 0x34, 0xeb, 0xe5, 0x10, 0x18, 0xbd, 0x0e, 0xe7, 0x23, 0x42, 0x18,
+	0xb5, 0x76, 0x59, 0xd3, 0xb1, 0x3c, 0x52, 0xb7, 0x6c, 0xd6, 0xa1, 0x22, 0x3f, 0xaa, 0x68, 0x3c,
+	0x17, 0x8a, 0x6c, 0xd0, 0x1b, 0xac, 0xe9, 0x98, 0xa4, 0xbe, 0x22, 0x87, 0xd1, 0xcb, 0x10, 0xb1,
+	0x61, 0xb9, 0x0e, 0xcf, 0x8f, 0xcd, 0x25, 0xe7, 0x53, 0xe6, 0x68, 0xd8, 0x59, 0x71, 0xf8, 0xf2,
+	0xc8, 0xfb, 0xf7, 0x67, 0x87, 0xbe, 0xb8, 0x3f, 0x3b, 0x54, 0x58, 0x83, 0xd1, 0x1d, 0xdc, 0xd4,
+	0xae, 0x45, 0x38, 0xba, 0x06, 0x19, 0x1c, 0x34, 0xf2, 0xc6, 0x5c, 0xf2, 0x99, 0xae, 0x19, 0x89,
+	0x16, 0x7e, 0x63, 0x40, 0xba, 0xbc, 0xb3, 0x89, 0x5d, 0x0f, 0xad, 0xc2, 0x64, 0x64, 0xab, 0xc7,
+	0xf5, 0xf2, 0xc8, 0xbc, 0x03, 0x37, 0x5f, 0x87, 0xc9, 0xfd, 0x20, 0x70, 0x84, 0x30, 0xfe, 0x55,
+	0x73, 0xe9, 0xd1, 0x83, 0x85, 0x8b, 0x1a, 0x26, 0x0c, 0x2e, 0x7d, 0x78, 0xfb, 0x7d, 0xfd, 0xb1,
+	0x3d, 0xbf, 0x0d, 0xc3, 0xfe, 0x52, 0x39, 0x7a, 0x13, 0x4e, 0xb5, 0xe5, 0x87, 0xda, 0x6a, 0xf6,
+	0xea, 0xcc, 0xa1, 0x36, 0xaf, 0xe4, 0xe3, 0x16, 0xe2, 0xeb, 0x15, 0x7e, 0x9e, 0x00, 0x28, 0xef,
+	0xec, 0x6c, 0x79, 0x6e, 0xbb, 0x49, 0xc4, 0x97, 0xb5, 0xf7, 0x6d, 0x78, 0x29, 0xda, 0x3b, 0xf7,
+	0xec, 0x93, 0xef, 0xff, 0x74, 0xa8, 0x5f, 0xf5, 0xec, 0x03, 0x61, 0x1d, 0x2e, 0x42, 0xd8, 0xe4,
+	0xc9, 0x61, 0xcb, 0x5c, 0x0c, 0x32, 0xfb, 0x7d, 0xc8, 0x46, 0x64, 0x70, 0x54, 0x81, 0x11, 0xa1,
+	0xbf, 0x35, 0xc1, 0x85, 0xc3, 0x09, 0x0e, 0xd4, 0xe2, 0x24, 0x87, 0xea, 0x85, 0x7f, 0x1b, 0x00,
+	0x31, 0x1f, 0xf9, 0x6a, 0xda, 0x18, 0xaa, 0x40, 0x5a, 0x07, 0xe7, 0xe4, 0xf3, 0x06, 0x67, 0x0d,
+	0x10, 0x23, 0xf5, 0x17, 0x09, 0x38, 0xbd, 0x1d, 0x78, 0xef, 0x57, 0x9f, 0x83, 0x6d, 0x18, 0x26,
+	0x54, 0x78, 0xae, 0x22, 0x41, 0x9e, 0xf9, 0x6b, 0x87, 0x9d, 0xf9, 0x01, 0x9b, 0x5a, 0xa5, 0xc2,
+	0xeb, 0xc6, 0x2d, 0x20, 0xc0, 0x8a, 0xf1, 0xf1, 0xcb, 0x24, 0xe4, 0x0f, 0x53, 0x45, 0xaf, 0x40,
+	0xce, 0xf6, 0x88, 0xea, 0x08, 0xee, 0x1d, 0x43, 0x05, 0xcc, 0xf1, 0xa0, 0x5b, 0x5f, 0x3b, 0x26,
+	0xc8, 0x87, 0x9a, 0x34, 0x2e, 0x29, 0xfa, 0x7c, 0x2f, 0xb3, 0xf1, 0x08, 0x41, 0x5d, 0x3c, 0x5b,
+	0x90, 0x73, 0xa9, 0x2b, 0x5c, 0xdc, 0xb4, 0x6a, 0xb8, 0x89, 0xa9, 0x1d, 0xbc, 0x60, 0x4f, 0x74,
+	0xe7, 0x8f, 0x6b, 0x8c, 0x92, 0x0f, 0x81, 0x56, 0x61, 0x38, 0x40, 0x4b, 0x9d, 0x1c, 0x2d, 0xd0,
+	0x45, 0x97, 0x60, 0x34, 0x7e, 0x31, 0xa8, 0xd7, 0x48, 0xca, 0xcc, 0xc6, 0xee, 0x85, 0xa3, 0x6e,
+	0x9e, 0xf4, 0x33, 0x6f, 0x1e, 0xfd, 0xe0, 0xfb, 0x55, 0x12, 0x26, 0x4d, 0xe2, 0xfc, 0xef, 0x1f,
+	0xcb, 0x26, 0x80, 0xef, 0xaa, 0x32, 0x92, 0xe6, 0x53, 0xcf, 0xeb, 0xef, 0x19, 0x1f, 0xa4, 0xcc,
+	0xc5, 0x7f, 0xeb, 0x84, 0xfe, 0x92, 0x80, 0xd1, 0xf8, 0x09, 0xfd, 0x5f, 0x5e, 0x5a, 0x68, 0x3d,
+	0x0a, 0x53, 0x29, 0x15, 0xa6, 0x5e, 0x3d, 0x2c, 0x4c, 0x0d, 0x58, 0xf3, 0x11, 0xf1, 0xe9, 0x77,
+	0x69, 0x48, 0x6f, 0x62, 0x0f, 0xb7, 0x38, 0xda, 0x18, 0x78, 0xdb, 0x06, 0x55, 0x81, 0x7e, 0x63,
+	0x2e, 0xeb, 0x2a, 0x88, 0x6f, 0xcb, 0x1f, 0x1d, 0xf6, 0xb4, 0xfd, 0x1a, 0x8c, 0xcb, 0x1c, 0x39,
+	0xdc, 0x90, 0x4f, 0xee, 0x98, 0x4a, 0x75, 0xc3, 0xdd, 0x73, 0x34, 0x0b, 0x59, 0x29, 0x16, 0xc5,
+	0x61, 0x29, 0x03, 0x2d, 0x7c, 0x67, 0xd5, 0xef, 0x41, 0x4b, 0x80, 0x76, 0xc3, 0xc2, 0x85, 0x15,
+	0x11, 0x61, 0xcc, 0x8f, 0x95, 0x12, 0x79, 0xc3, 0x9c, 0x8c, 0x46, 0x03, 0x95, 0x8b, 0x00, 0x72,
+	0x25, 0x96, 0x43, 0x28, 0x6b, 0xe9, 0x64, 0x2f, 0x23, 0x7b, 0xca, 0xb2, 0x03, 0xfd, 0xcc, 0xf0,
+	0x9f, 0xc9, 0x7d, 0xd9, 0xb4, 0xce, 0x52, 0xb6, 0x8e, 0xe1, 0x18, 0xff, 0x7a, 0x3c, 0x3b, 0xdd,
+	0xc5, 0xad, 0xe6, 0x72, 0xe1, 0x00, 0x9c, 0xc2, 0x41, 0x09, 0xbe, 0x7c, 0x3c, 0xf7, 0x66, 0xe3,
+	0xa8, 0x02, 0x13, 0x7b, 0xa4, 0x6b, 0x79, 0x4c, 0xf8, 0xc1, 0xa6, 0x4e, 0x48, 0x7e, 0x38, 0xac,
+	0xc4, 0x28, 0x75, 0x59, 0x1d, 0x8a, 0x3d, 0xff, 0x5d, 0x5a, 0x4a, 0xc9, 0xd5, 0x99, 0xe3, 0x7b,
+	0xa4, 0x6b, 0x6a, 0xbd, 0x35, 0x42, 0xd0, 0x2d, 0x98, 0x6a, 0x34, 0x59, 0x0d, 0x37, 0xad, 0xa6,
+	0x7b, 0xab, 0xe3, 0x3a, 0x96, 0x36, 0x0c, 0xcb, 0xc6, 0xed, 0xfc, 0xc8, 0x0b, 0x55, 0x26, 0xce,
+	0xfa, 0xc0, 0x37, 0x15, 0x6e, 0xd5, 0x87, 0x5d, 0xc1, 0x6d, 0x74, 0x1b, 0x2e, 0x44, 0xb6, 0x7e,
+	0xc0, 0xac, 0x99, 0x17, 0x9a, 0x75, 0x2a, 0xc4, 0x1e, 0x98, 0x18, 0xc7, 0x9d, 0x4c, 0x1d, 0x73,
+	0x1d, 0xdb, 0x82, 0x79, 0x2a, 0x7b, 0xca, 0x94, 0x16, 0x4e, 0x16, 0xd3, 0x22, 0x87, 0x93, 0xf9,
+	0xf0, 0x9a, 0x42, 0x5a, 0xbe, 0x2c, 0x83, 0xcf, 0xdd, 0xcf, 0x3f, 0xb9, 0x72, 0x3e, 0xca, 0x81,
+	0x16, 0xef, 0x84, 0x65, 0x47, 0xdf, 0x63, 0x64, 0x1e, 0x81, 0xa2, 0x3b, 0xdd, 0x24, 0xbc, 0xcd,
+	0x28, 0x57, 0x29, 0x5d, 0x2c, 0xf5, 0x32, 0x9e, 0x9d, 0xd2, 0x45, 0xfa, 0x3d, 0x29, 0x5d, 0x2c,
+	0xe2, 0xbd, 0x11, 0x5d, 0xa9, 0x89, 0xa3, 0x8c, 0x23, 0xee, 0xec, 0x5a, 0x49, 0x05, 0xd2, 0xa1,
+	0xc2, 0x9f, 0x0c, 0x98, 0x1a, 0x08, 0x0e, 0xe1, 0x92, 0x6d, 0x40, 0x5e, 0x6c, 0x50, 0x39, 0x59,
+	0x57, 0x2f, 0xfd, 0xf9, 0x62, 0xcd, 0xa4, 0xd7, 0x3f, 0xfa, 0x25, 0xbd, 0x0d, 0xf4, 0xc5, 0xf0,
+	0x07, 0x03, 0xce, 0xc4, 0x17, 0x10, 0x6e, 0xa5, 0x0a, 0xa3, 0xf1, 0xa9, 0xf5, 0x26, 0x2e, 0x1f,
+	0x67, 0x13, 0xf1, 0xf5, 0xf7, 0x80, 0xa0, 0x9d, 0x28, 0x00, 0xfb, 0xc5, 0xce, 0xa5, 0x63, 0x93,
+	0x12, 0x2c, 0xec, 0xc0, 0x40, 0xec, 0x9f, 0xcd, 0x3f, 0x0c, 0x48, 0x6d, 0x32, 0xd6, 0x44, 0xb7,
+	0x60, 0x92, 0x32, 0xa1, 0x6c, 0x9a, 0x38, 0x96, 0xae, 0xc4, 0xf8, 0x97, 0xdb, 0xea, 0x33, 0xb9,
+	0xfa, 0xfb, 0xe3, 0xd9, 0x41, 0xcd, 0x5e, 0x02, 0x75, 0xc1, 0x8f, 0x32, 0x51, 0x52, 0x42, 0x5b,
+	0x4a, 0x06, 0xd5, 0x61, 0xac, 0x77, 0x3a, 0xff, 0x02, 0xbc, 0x7e, 0xd4, 0x74, 0x63, 0x47, 0x4e,
+	0x35, 0x5a, 0x8b, 0xcd, 0xb3, 0x3c, 0x22, 0x4f, 0xed, 0x9f, 0xf2, 0xe4, 0xde, 0x83, 0x89, 0x30,
+	0xfa, 0x6f, 0xab, 0x6a, 0x21, 0x47, 0x6b, 0x30, 0xec, 0x17, 0x0e, 0x83, 0xdc, 0xeb, 0x52, 0x54,
+	0x8a, 0x96, 0xc5, 0x6c, 0x59, 0x89, 0xee, 0x53, 0xea, 0xe1, 0x53, 0x2b, 0xab, 0x6a, 0xf2, 0xc3,
+	0x04, 0x4c, 0xad, 0x30, 0xca, 0x75, 0xdd, 0x4c, 0x07, 0x49, 0xbf, 0xf4, 0xdd, 0x95, 0xc5, 0x9e,
+	0x03, 0xab, 0x7a, 0xa3, 0x83, 0xb5, 0xbb, 0x1d, 0xc8, 0xc9, 0x17, 0x8b, 0xcd, 0xe8, 0x0b, 0x96,
+	0xee, 0xc6, 0x58, 0xd3, 0xd1, 0x2b, 0x92, 0x85, 0xbb, 0x1d, 0xc8, 0x51, 0x72, 0xbb, 0x07, 0x37,
+	0xf9, 0x7c, 0xb8, 0x94, 0xdc, 0x8e, 0xe1, 0x9e, 0x95, 0xe5, 0x7c, 0xf5, 0x5c, 0x4d, 0xa9, 0xc7,
+	0x98, 0x6e, 0xa1, 0x6b, 0x90, 0x94, 0x37, 0xcb, 0xa9, 0x13, 0x04, 0x0f, 0xa9, 0x10, 0x7b, 0x25,
+	0x54, 0x61, 0x4a, 0x17, 0x5e, 0xf8, 0x46, 0x5d, 0x31, 0x4a, 0xd4, 0x86, 0xde, 0x21, 0xdd, 0x03,
+	0xaa, 0x30, 0xa3, 0xc7, 0xab, 0xc2, 0x7c, 0x68, 0xc0, 0x69, 0x65, 0x1c, 0xee, 0x4f, 0x89, 0xaa,
+	0xed, 0x99, 0xc4, 0x66, 0x9e, 0x83, 0xc6, 0x21, 0xe1, 0x3a, 0xea, 0x4c, 0x52, 0x66, 0xc2, 0x75,
+	0x50, 0x11, 0x4e, 0xb1, 0xdb, 0x94, 0x78, 0xda, 0x32, 0x0f, 0xc7, 0xf6, 0xc5, 0xd0, 0x9b, 0x90,
+	0x09, 0x43, 0xfa, 0xf1, 0xdf, 0x5d, 0x91, 0xce, 0x95, 0xdf, 0x1a, 0x00, 0x51, 0x6d, 0x14, 0x7d,
+	0x13, 0xce, 0x95, 0x36, 0xd6, 0xcb, 0x56, 0x75, 0xeb, 0xfa, 0xd6, 0x76, 0xd5, 0xda, 0x5e, 0xaf,
+	0x6e, 0xae, 0xae, 0x54, 0xd6, 0x2a, 0xab, 0xe5, 0x89, 0xa1, 0xe9, 0xdc, 0xdd, 0x7b, 0x73, 0xd9,
+	0x6d, 0xca, 0xdb, 0xc4, 0x76, 0xeb, 0x2e, 0x71, 0xd0, 0xd7, 0xe1, 0x4c, 0xaf, 0xb4, 0x6c, 0xad,
+	0x96, 0x27, 0x8c, 0xe9, 0xd1, 0xbb, 0xf7, 0xe6, 0x46, 0xfc, 0x5c, 0x90, 0x38, 0x68, 0x1e, 0x5e,
+	0x1a, 0x94, 0xab, 0xac, 0xbf, 0x35, 0x91, 0x98, 0x1e, 0xbb, 0x7b, 0x6f, 0x2e, 0x13, 0x26, 0x8d,
+	0xa8, 0x00, 0x28, 0x2e, 0xa9, 0xf1, 0x92, 0xd3, 0x70, 0xf7, 0xde, 0x5c, 0xda, 0xf7, 0xe5, 0xe9,
+	0xd4, 0xfb, 0xbf, 0x9e, 0x19, 0xba, 0xf2, 0x63, 0x80, 0x0a, 0xad, 0x7b, 0xd8, 0x56, 0x31, 0x6b,
+	0x1a, 0xce, 0x56, 0xd6, 0xd7, 0xcc, 0xeb, 0x2b, 0x5b, 0x95, 0x8d, 0xf5, 0xde, 0x65, 0xf7, 0x8d,
+	0x95, 0x37, 0xb6, 0x4b, 0x37, 0x57, 0xad, 0x6a, 0xe5, 0xad, 0xf5, 0x09, 0x03, 0x9d, 0x83, 0xd3,
+	0x3d, 0x63, 0xdf, 0x5b, 0xdf, 0xaa, 0xbc, 0xbb, 0x3a, 0x91, 0x28, 0x5d, 0xfb, 0xf4, 0xc9, 0x8c,
+	0xf1, 0xf0, 0xc9, 0x8c, 0xf1, 0xb7, 0x27, 0x33, 0xc6, 0x07, 0x4f, 0x67, 0x86, 0x1e, 0x3e, 0x9d,
+	0x19, 0xfa, 0xf3, 0xd3, 0x99, 0xa1, 0x1f, 0x5e, 0xe8, 0x89, 0x12, 0xd1, 0x3d, 0xa9, 0xfe, 0x45,
+	0xaa, 0xa5, 0x95, 0x39, 0x7f, 0xeb, 0x3f, 0x03, 0x00, 0x08, 0xe9, 0x95, 0xc8, 0xbd, 0x1b, 0x00,
 	0x00,
 }
 
@@ -1523,743 +1607,811 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11763 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x7b, 0x90, 0x63, 0xd9,
-		0x59, 0xdf, 0x5c, 0x49, 0xad, 0x96, 0x3e, 0x49, 0xad, 0xdb, 0xa7, 0x7b, 0x66, 0x34, 0x3d, 0xbb,
-		0xd3, 0x3d, 0x77, 0x76, 0x77, 0x66, 0x67, 0x77, 0x7b, 0x76, 0x66, 0x67, 0x67, 0x77, 0xb5, 0x2f,
-		0x24, 0xb5, 0x7a, 0x46, 0xb3, 0xfd, 0xf2, 0x95, 0xba, 0xbd, 0xbb, 0x3c, 0x2e, 0xb7, 0xaf, 0x4e,
-		0x77, 0xdf, 0x1d, 0xe9, 0x5e, 0x59, 0xf7, 0x6a, 0xa6, 0x7b, 0x53, 0x95, 0x32, 0x01, 0x13, 0xb3,
-		0x3c, 0x62, 0x02, 0x01, 0x63, 0x7b, 0x8c, 0x81, 0x80, 0x6d, 0x08, 0x04, 0x62, 0x43, 0x20, 0x54,
-		0x1e, 0xa4, 0x12, 0x02, 0xa4, 0x42, 0x1c, 0xaa, 0x92, 0x50, 0x54, 0xb1, 0x01, 0x9b, 0x2a, 0x1c,
-		0x6c, 0x08, 0x10, 0x9b, 0xa2, 0xe2, 0x4a, 0x2a, 0x75, 0x5e, 0xf7, 0x21, 0x5d, 0xb5, 0xd4, 0xb3,
-		0xbb, 0xc6, 0x81, 0xfc, 0x33, 0xd3, 0xf7, 0x9c, 0xef, 0xfb, 0x9d, 0x73, 0xbe, 0xf3, 0x9d, 0xef,
-		0x7c, 0xe7, 0x3b, 0x0f, 0xc1, 0xef, 0x2f, 0xc3, 0xc2, 0xae, 0x6d, 0xef, 0xb6, 0xf0, 0xa5, 0x4e,
-		0xd7, 0x76, 0xed, 0xed, 0xde, 0xce, 0xa5, 0x26, 0x76, 0x8c, 0xae, 0xd9, 0x71, 0xed, 0xee, 0x22,
-		0x4d, 0x43, 0x79, 0x46, 0xb1, 0x28, 0x28, 0x94, 0x55, 0x98, 0x5e, 0x36, 0x5b, 0x78, 0xc9, 0x23,
-		0xac, 0x63, 0x17, 0x3d, 0x0d, 0x89, 0x1d, 0xb3, 0x85, 0x0b, 0xd2, 0x42, 0xfc, 0x42, 0xe6, 0xca,
-		0x03, 0x8b, 0x7d, 0x4c, 0x8b, 0x61, 0x8e, 0x0d, 0x92, 0xac, 0x52, 0x0e, 0xe5, 0xff, 0x24, 0x60,
-		0x26, 0x22, 0x17, 0x21, 0x48, 0x58, 0x7a, 0x9b, 0x20, 0x4a, 0x17, 0xd2, 0x2a, 0xfd, 0x1b, 0x15,
-		0x60, 0xb2, 0xa3, 0x1b, 0xb7, 0xf4, 0x5d, 0x5c, 0x88, 0xd1, 0x64, 0xf1, 0x89, 0xce, 0x00, 0x34,
-		0x71, 0x07, 0x5b, 0x4d, 0x6c, 0x19, 0x07, 0x85, 0xf8, 0x42, 0xfc, 0x42, 0x5a, 0x0d, 0xa4, 0xa0,
-		0x47, 0x60, 0xba, 0xd3, 0xdb, 0x6e, 0x99, 0x86, 0x16, 0x20, 0x83, 0x85, 0xf8, 0x85, 0x09, 0x55,
-		0x66, 0x19, 0x4b, 0x3e, 0xf1, 0x79, 0xc8, 0xdf, 0xc1, 0xfa, 0xad, 0x20, 0x69, 0x86, 0x92, 0x4e,
-		0x91, 0xe4, 0x00, 0x61, 0x05, 0xb2, 0x6d, 0xec, 0x38, 0xfa, 0x2e, 0xd6, 0xdc, 0x83, 0x0e, 0x2e,
-		0x24, 0x68, 0xeb, 0x17, 0x06, 0x5a, 0xdf, 0xdf, 0xf2, 0x0c, 0xe7, 0x6a, 0x1c, 0x74, 0x30, 0x2a,
-		0x41, 0x1a, 0x5b, 0xbd, 0x36, 0x43, 0x98, 0x18, 0x22, 0xbf, 0xaa, 0xd5, 0x6b, 0xf7, 0xa3, 0xa4,
-		0x08, 0x1b, 0x87, 0x98, 0x74, 0x70, 0xf7, 0xb6, 0x69, 0xe0, 0x42, 0x92, 0x02, 0x9c, 0x1f, 0x00,
-		0xa8, 0xb3, 0xfc, 0x7e, 0x0c, 0xc1, 0x87, 0x2a, 0x90, 0xc6, 0xfb, 0x2e, 0xb6, 0x1c, 0xd3, 0xb6,
-		0x0a, 0x93, 0x14, 0xe4, 0xc1, 0x88, 0x5e, 0xc4, 0xad, 0x66, 0x3f, 0x84, 0xcf, 0x87, 0xae, 0xc1,
-		0xa4, 0xdd, 0x71, 0x4d, 0xdb, 0x72, 0x0a, 0xa9, 0x05, 0xe9, 0x42, 0xe6, 0xca, 0x7d, 0x91, 0x8a,
-		0xb0, 0xce, 0x68, 0x54, 0x41, 0x8c, 0x6a, 0x20, 0x3b, 0x76, 0xaf, 0x6b, 0x60, 0xcd, 0xb0, 0x9b,
-		0x58, 0x33, 0xad, 0x1d, 0xbb, 0x90, 0xa6, 0x00, 0xf3, 0x83, 0x0d, 0xa1, 0x84, 0x15, 0xbb, 0x89,
-		0x6b, 0xd6, 0x8e, 0xad, 0x4e, 0x39, 0xa1, 0x6f, 0x74, 0x02, 0x92, 0xce, 0x81, 0xe5, 0xea, 0xfb,
-		0x85, 0x2c, 0xd5, 0x10, 0xfe, 0x45, 0x54, 0x07, 0x37, 0x4d, 0x52, 0x5c, 0x21, 0xc7, 0x54, 0x87,
-		0x7f, 0x2a, 0xbf, 0x94, 0x84, 0xfc, 0x38, 0xca, 0xf7, 0x2c, 0x4c, 0xec, 0x90, 0xf6, 0x17, 0x62,
-		0x47, 0x91, 0x0e, 0xe3, 0x09, 0x8b, 0x37, 0x79, 0x8f, 0xe2, 0x2d, 0x41, 0xc6, 0xc2, 0x8e, 0x8b,
-		0x9b, 0x4c, 0x57, 0xe2, 0x63, 0x6a, 0x1b, 0x30, 0xa6, 0x41, 0x65, 0x4b, 0xdc, 0x93, 0xb2, 0xbd,
-		0x0c, 0x79, 0xaf, 0x4a, 0x5a, 0x57, 0xb7, 0x76, 0x85, 0xd6, 0x5e, 0x1a, 0x55, 0x93, 0xc5, 0xaa,
-		0xe0, 0x53, 0x09, 0x9b, 0x3a, 0x85, 0x43, 0xdf, 0x68, 0x09, 0xc0, 0xb6, 0xb0, 0xbd, 0xa3, 0x35,
-		0xb1, 0xd1, 0x2a, 0xa4, 0x86, 0x48, 0x69, 0x9d, 0x90, 0x0c, 0x48, 0xc9, 0x66, 0xa9, 0x46, 0x0b,
-		0x3d, 0xe3, 0x2b, 0xe1, 0xe4, 0x10, 0x1d, 0x5a, 0x65, 0xc3, 0x6f, 0x40, 0x0f, 0x37, 0x61, 0xaa,
-		0x8b, 0xc9, 0x88, 0xc0, 0x4d, 0xde, 0xb2, 0x34, 0xad, 0xc4, 0xe2, 0xc8, 0x96, 0xa9, 0x9c, 0x8d,
-		0x35, 0x2c, 0xd7, 0x0d, 0x7e, 0xa2, 0x73, 0xe0, 0x25, 0x68, 0x54, 0xad, 0x80, 0xda, 0xa7, 0xac,
-		0x48, 0x5c, 0xd3, 0xdb, 0x78, 0xee, 0x75, 0x98, 0x0a, 0x8b, 0x07, 0xcd, 0xc2, 0x84, 0xe3, 0xea,
-		0x5d, 0x97, 0x6a, 0xe1, 0x84, 0xca, 0x3e, 0x90, 0x0c, 0x71, 0x6c, 0x35, 0xa9, 0xfd, 0x9b, 0x50,
-		0xc9, 0x9f, 0xe8, 0xeb, 0xfc, 0x06, 0xc7, 0x69, 0x83, 0x1f, 0x1a, 0xec, 0xd1, 0x10, 0x72, 0x7f,
-		0xbb, 0xe7, 0x9e, 0x82, 0x5c, 0xa8, 0x01, 0xe3, 0x16, 0xad, 0xfc, 0x74, 0x02, 0x8e, 0x47, 0x62,
-		0xa3, 0x97, 0x61, 0xb6, 0x67, 0x99, 0x96, 0x8b, 0xbb, 0x9d, 0x2e, 0x26, 0x2a, 0xcb, 0xca, 0x2a,
-		0xfc, 0xe1, 0xe4, 0x10, 0xa5, 0xdb, 0x0c, 0x52, 0x33, 0x14, 0x75, 0xa6, 0x37, 0x98, 0x88, 0x5e,
-		0x81, 0x0c, 0xd1, 0x0f, 0xbd, 0xab, 0x53, 0x40, 0x36, 0x1a, 0xaf, 0x8c, 0xd7, 0xe4, 0xc5, 0x25,
-		0x9f, 0xb3, 0x1c, 0x7f, 0xbf, 0x14, 0x53, 0x83, 0x58, 0x68, 0x0f, 0xb2, 0xb7, 0x71, 0xd7, 0xdc,
-		0x31, 0x0d, 0x86, 0x4d, 0xc4, 0x39, 0x75, 0xe5, 0xe9, 0x31, 0xb1, 0xb7, 0x02, 0xac, 0x75, 0x57,
-		0x77, 0x71, 0x11, 0x36, 0xd7, 0xb6, 0xaa, 0x6a, 0x6d, 0xb9, 0x56, 0x5d, 0x52, 0x43, 0xc8, 0x73,
-		0x9f, 0x92, 0x20, 0x13, 0xa8, 0x0b, 0x31, 0x5b, 0x56, 0xaf, 0xbd, 0x8d, 0xbb, 0x5c, 0xe2, 0xfc,
-		0x0b, 0x9d, 0x86, 0xf4, 0x4e, 0xaf, 0xd5, 0x62, 0x6a, 0xc3, 0xe6, 0xbc, 0x14, 0x49, 0x20, 0x2a,
-		0x43, 0xac, 0x14, 0x37, 0x04, 0xd4, 0x4a, 0x91, 0xbf, 0xd1, 0x39, 0xc8, 0x98, 0x8e, 0xd6, 0xc5,
-		0x1d, 0xac, 0xbb, 0xb8, 0x59, 0x48, 0x2c, 0x48, 0x17, 0x52, 0xe5, 0x58, 0x41, 0x52, 0xc1, 0x74,
-		0x54, 0x9e, 0x8a, 0xe6, 0x20, 0x25, 0x74, 0xaf, 0x30, 0x41, 0x28, 0x54, 0xef, 0x9b, 0xe5, 0x71,
-		0xee, 0xa4, 0xc8, 0x63, 0xdf, 0xca, 0x55, 0x98, 0x1e, 0x68, 0x24, 0xca, 0x43, 0x66, 0xa9, 0x5a,
-		0x59, 0x29, 0xa9, 0xa5, 0x46, 0x6d, 0x7d, 0x4d, 0x3e, 0x86, 0xa6, 0x20, 0xd0, 0x6e, 0x59, 0xba,
-		0x98, 0x4e, 0x7d, 0x7e, 0x52, 0x7e, 0xef, 0x7b, 0xdf, 0xfb, 0xde, 0x98, 0xf2, 0x2b, 0x49, 0x98,
-		0x8d, 0xb2, 0x72, 0x91, 0x06, 0xd7, 0x97, 0x49, 0x3c, 0x24, 0x93, 0x12, 0x4c, 0xb4, 0xf4, 0x6d,
-		0xdc, 0xa2, 0x8d, 0x9b, 0xba, 0xf2, 0xc8, 0x58, 0x76, 0x74, 0x71, 0x85, 0xb0, 0xa8, 0x8c, 0x13,
-		0xbd, 0xc0, 0x25, 0x37, 0x41, 0x11, 0x2e, 0x8e, 0x87, 0x40, 0xac, 0x1f, 0x97, 0xf2, 0x69, 0x48,
-		0x93, 0xff, 0x59, 0xb7, 0x24, 0x59, 0xb7, 0x90, 0x04, 0xda, 0x2d, 0x73, 0x90, 0xa2, 0x86, 0xad,
-		0x89, 0xbd, 0x2e, 0x13, 0xdf, 0xc4, 0x14, 0x34, 0xf1, 0x8e, 0xde, 0x6b, 0xb9, 0xda, 0x6d, 0xbd,
-		0xd5, 0xc3, 0xd4, 0x44, 0xa5, 0xd5, 0x2c, 0x4f, 0xdc, 0x22, 0x69, 0x68, 0x1e, 0x32, 0xcc, 0x0e,
-		0x9a, 0x56, 0x13, 0xef, 0xd3, 0x99, 0x70, 0x42, 0x65, 0xa6, 0xb1, 0x46, 0x52, 0x48, 0xf1, 0xaf,
-		0x39, 0xb6, 0x25, 0x8c, 0x09, 0x2d, 0x82, 0x24, 0xd0, 0xe2, 0x9f, 0xea, 0x9f, 0x84, 0xef, 0x8f,
-		0x6e, 0xde, 0x80, 0xf5, 0x3b, 0x0f, 0x79, 0x4a, 0xf1, 0x04, 0x1f, 0xab, 0x7a, 0xab, 0x30, 0x4d,
-		0x15, 0x60, 0x8a, 0x25, 0xaf, 0xf3, 0x54, 0xe5, 0x17, 0x62, 0x90, 0xa0, 0x53, 0x41, 0x1e, 0x32,
-		0x8d, 0x57, 0x36, 0xaa, 0xda, 0xd2, 0xfa, 0x66, 0x79, 0xa5, 0x2a, 0x4b, 0xa4, 0xeb, 0x69, 0xc2,
-		0xf2, 0xca, 0x7a, 0xa9, 0x21, 0xc7, 0xbc, 0xef, 0xda, 0x5a, 0xe3, 0xda, 0x55, 0x39, 0xee, 0x31,
-		0x6c, 0xb2, 0x84, 0x44, 0x90, 0xe0, 0x89, 0x2b, 0xf2, 0x04, 0x92, 0x21, 0xcb, 0x00, 0x6a, 0x2f,
-		0x57, 0x97, 0xae, 0x5d, 0x95, 0x93, 0xe1, 0x94, 0x27, 0xae, 0xc8, 0x93, 0x28, 0x07, 0x69, 0x9a,
-		0x52, 0x5e, 0x5f, 0x5f, 0x91, 0x53, 0x1e, 0x66, 0xbd, 0xa1, 0xd6, 0xd6, 0xae, 0xcb, 0x69, 0x0f,
-		0xf3, 0xba, 0xba, 0xbe, 0xb9, 0x21, 0x83, 0x87, 0xb0, 0x5a, 0xad, 0xd7, 0x4b, 0xd7, 0xab, 0x72,
-		0xc6, 0xa3, 0x28, 0xbf, 0xd2, 0xa8, 0xd6, 0xe5, 0x6c, 0xa8, 0x5a, 0x4f, 0x5c, 0x91, 0x73, 0x5e,
-		0x11, 0xd5, 0xb5, 0xcd, 0x55, 0x79, 0x0a, 0x4d, 0x43, 0x8e, 0x15, 0x21, 0x2a, 0x91, 0xef, 0x4b,
-		0xba, 0x76, 0x55, 0x96, 0xfd, 0x8a, 0x30, 0x94, 0xe9, 0x50, 0xc2, 0xb5, 0xab, 0x32, 0x52, 0x2a,
-		0x30, 0x41, 0xd5, 0x10, 0x21, 0x98, 0x5a, 0x29, 0x95, 0xab, 0x2b, 0xda, 0xfa, 0x06, 0x19, 0x34,
-		0xa5, 0x15, 0x59, 0xf2, 0xd3, 0xd4, 0xea, 0xbb, 0x36, 0x6b, 0x6a, 0x75, 0x49, 0x8e, 0x05, 0xd3,
-		0x36, 0xaa, 0xa5, 0x46, 0x75, 0x49, 0x8e, 0x2b, 0x06, 0xcc, 0x46, 0x4d, 0x81, 0x91, 0x43, 0x28,
-		0xa0, 0x0b, 0xb1, 0x21, 0xba, 0x40, 0xb1, 0xfa, 0x75, 0x41, 0xf9, 0x5c, 0x0c, 0x66, 0x22, 0xdc,
-		0x80, 0xc8, 0x42, 0x5e, 0x84, 0x09, 0xa6, 0xcb, 0xcc, 0x14, 0x3f, 0x1c, 0xe9, 0x4f, 0x50, 0xcd,
-		0x1e, 0x70, 0x8e, 0x28, 0x5f, 0xd0, 0x6d, 0x8c, 0x0f, 0x71, 0x1b, 0x09, 0xc4, 0x80, 0xc2, 0x7e,
-		0xe3, 0xc0, 0x74, 0xcd, 0x3c, 0x9a, 0x6b, 0xe3, 0x78, 0x34, 0x34, 0xed, 0x68, 0xd3, 0xf6, 0x44,
-		0xc4, 0xb4, 0xfd, 0x2c, 0x4c, 0x0f, 0x00, 0x8d, 0x3d, 0x7d, 0x7e, 0xab, 0x04, 0x85, 0x61, 0xc2,
-		0x19, 0x61, 0x12, 0x63, 0x21, 0x93, 0xf8, 0x6c, 0xbf, 0x04, 0xcf, 0x0e, 0xef, 0x84, 0x81, 0xbe,
-		0xfe, 0xb8, 0x04, 0x27, 0xa2, 0x97, 0x07, 0x91, 0x75, 0x78, 0x01, 0x92, 0x6d, 0xec, 0xee, 0xd9,
-		0xc2, 0x11, 0x7e, 0x28, 0xc2, 0xbd, 0x22, 0xd9, 0xfd, 0x9d, 0xcd, 0xb9, 0x82, 0xfe, 0x59, 0x7c,
-		0x98, 0x8f, 0xcf, 0x6a, 0x33, 0x50, 0xd3, 0xef, 0x88, 0xc1, 0xf1, 0x48, 0xf0, 0xc8, 0x8a, 0xde,
-		0x0f, 0x60, 0x5a, 0x9d, 0x9e, 0xcb, 0x9c, 0x5d, 0x66, 0x89, 0xd3, 0x34, 0x85, 0x1a, 0x2f, 0x62,
-		0x65, 0x7b, 0xae, 0x97, 0xcf, 0x26, 0x51, 0x60, 0x49, 0x94, 0xe0, 0x69, 0xbf, 0xa2, 0x09, 0x5a,
-		0xd1, 0x33, 0x43, 0x5a, 0x3a, 0xa0, 0x98, 0x8f, 0x83, 0x6c, 0xb4, 0x4c, 0x6c, 0xb9, 0x9a, 0xe3,
-		0x76, 0xb1, 0xde, 0x36, 0xad, 0x5d, 0x36, 0xcf, 0x16, 0x27, 0x76, 0xf4, 0x96, 0x83, 0xd5, 0x3c,
-		0xcb, 0xae, 0x8b, 0x5c, 0xc2, 0x41, 0x15, 0xa8, 0x1b, 0xe0, 0x48, 0x86, 0x38, 0x58, 0xb6, 0xc7,
-		0xa1, 0x7c, 0x6f, 0x1a, 0x32, 0x81, 0xc5, 0x14, 0x3a, 0x0b, 0xd9, 0xd7, 0xf4, 0xdb, 0xba, 0x26,
-		0x16, 0xc8, 0x4c, 0x12, 0x19, 0x92, 0xb6, 0xc1, 0x17, 0xc9, 0x8f, 0xc3, 0x2c, 0x25, 0xb1, 0x7b,
-		0x2e, 0xee, 0x6a, 0x46, 0x4b, 0x77, 0x1c, 0x2a, 0xb4, 0x14, 0x25, 0x45, 0x24, 0x6f, 0x9d, 0x64,
-		0x55, 0x44, 0x0e, 0x7a, 0x12, 0x66, 0x28, 0x47, 0xbb, 0xd7, 0x72, 0xcd, 0x4e, 0x0b, 0x6b, 0x64,
-		0xc9, 0xee, 0xd0, 0x29, 0xc7, 0xab, 0xd9, 0x34, 0xa1, 0x58, 0xe5, 0x04, 0xa4, 0x46, 0x0e, 0x5a,
-		0x82, 0xfb, 0x29, 0xdb, 0x2e, 0xb6, 0x70, 0x57, 0x77, 0xb1, 0x86, 0xdf, 0xd3, 0xd3, 0x5b, 0x8e,
-		0xa6, 0x5b, 0x4d, 0x6d, 0x4f, 0x77, 0xf6, 0x0a, 0xb3, 0x9e, 0x5b, 0x72, 0x8a, 0x10, 0x5e, 0xe7,
-		0x74, 0x55, 0x4a, 0x56, 0xb2, 0x9a, 0x37, 0x74, 0x67, 0x0f, 0x15, 0xe1, 0x04, 0x45, 0x71, 0xdc,
-		0xae, 0x69, 0xed, 0x6a, 0xc6, 0x1e, 0x36, 0x6e, 0x69, 0x3d, 0x77, 0xe7, 0xe9, 0xc2, 0xe9, 0x60,
-		0xf9, 0xb4, 0x86, 0x75, 0x4a, 0x53, 0x21, 0x24, 0x9b, 0xee, 0xce, 0xd3, 0xa8, 0x0e, 0x59, 0xd2,
-		0x19, 0x6d, 0xf3, 0x75, 0xac, 0xed, 0xd8, 0x5d, 0x3a, 0x87, 0x4e, 0x45, 0x98, 0xa6, 0x80, 0x04,
-		0x17, 0xd7, 0x39, 0xc3, 0xaa, 0xdd, 0xc4, 0xc5, 0x89, 0xfa, 0x46, 0xb5, 0xba, 0xa4, 0x66, 0x04,
-		0xca, 0xb2, 0xdd, 0x25, 0x0a, 0xb5, 0x6b, 0x7b, 0x02, 0xce, 0x30, 0x85, 0xda, 0xb5, 0x85, 0x78,
-		0x9f, 0x84, 0x19, 0xc3, 0x60, 0x6d, 0x36, 0x0d, 0x8d, 0x2f, 0xac, 0x9d, 0x82, 0x1c, 0x12, 0x96,
-		0x61, 0x5c, 0x67, 0x04, 0x5c, 0xc7, 0x1d, 0xf4, 0x0c, 0x1c, 0xf7, 0x85, 0x15, 0x64, 0x9c, 0x1e,
-		0x68, 0x65, 0x3f, 0xeb, 0x93, 0x30, 0xd3, 0x39, 0x18, 0x64, 0x44, 0xa1, 0x12, 0x3b, 0x07, 0xfd,
-		0x6c, 0x4f, 0xc1, 0x6c, 0x67, 0xaf, 0x33, 0xc8, 0x77, 0x31, 0xc8, 0x87, 0x3a, 0x7b, 0x9d, 0x7e,
-		0xc6, 0x07, 0x69, 0x94, 0xa5, 0x8b, 0x0d, 0xea, 0x1d, 0x9e, 0x0c, 0x92, 0x07, 0x32, 0xd0, 0x22,
-		0xc8, 0x86, 0xa1, 0x61, 0x4b, 0xdf, 0x6e, 0x61, 0x4d, 0xef, 0x62, 0x4b, 0x77, 0x0a, 0xf3, 0x94,
-		0x38, 0xe1, 0x76, 0x7b, 0x58, 0x9d, 0x32, 0x8c, 0x2a, 0xcd, 0x2c, 0xd1, 0x3c, 0x74, 0x11, 0xa6,
-		0xed, 0xed, 0xd7, 0x0c, 0xa6, 0x91, 0x5a, 0xa7, 0x8b, 0x77, 0xcc, 0xfd, 0xc2, 0x03, 0x54, 0xbc,
-		0x79, 0x92, 0x41, 0xf5, 0x71, 0x83, 0x26, 0xa3, 0x87, 0x41, 0x36, 0x9c, 0x3d, 0xbd, 0xdb, 0xa1,
-		0x26, 0xd9, 0xe9, 0xe8, 0x06, 0x2e, 0x3c, 0xc8, 0x48, 0x59, 0xfa, 0x9a, 0x48, 0x26, 0x23, 0xc2,
-		0xb9, 0x63, 0xee, 0xb8, 0x02, 0xf1, 0x3c, 0x1b, 0x11, 0x34, 0x8d, 0xa3, 0x5d, 0x00, 0x99, 0x48,
-		0x22, 0x54, 0xf0, 0x05, 0x4a, 0x36, 0xd5, 0xd9, 0xeb, 0x04, 0xcb, 0x3d, 0x07, 0x39, 0x42, 0xe9,
-		0x17, 0xfa, 0x30, 0x73, 0xdc, 0x3a, 0x7b, 0x81, 0x12, 0xaf, 0xc2, 0x09, 0x42, 0xd4, 0xc6, 0xae,
-		0xde, 0xd4, 0x5d, 0x3d, 0x40, 0xfd, 0x28, 0xa5, 0x26, 0x62, 0x5f, 0xe5, 0x99, 0xa1, 0x7a, 0x76,
-		0x7b, 0xdb, 0x07, 0x9e, 0x62, 0x3d, 0xc6, 0xea, 0x49, 0xd2, 0x84, 0x6a, 0xbd, 0x63, 0xab, 0x29,
-		0xa5, 0x08, 0xd9, 0xa0, 0xde, 0xa3, 0x34, 0x30, 0xcd, 0x97, 0x25, 0xe2, 0x04, 0x55, 0xd6, 0x97,
-		0x88, 0xfb, 0xf2, 0x6a, 0x55, 0x8e, 0x11, 0x37, 0x6a, 0xa5, 0xd6, 0xa8, 0x6a, 0xea, 0xe6, 0x5a,
-		0xa3, 0xb6, 0x5a, 0x95, 0xe3, 0x01, 0xc7, 0xfe, 0x66, 0x22, 0xf5, 0x90, 0x7c, 0x5e, 0xf9, 0xe5,
-		0x38, 0x4c, 0x85, 0xd7, 0xd6, 0xe8, 0x39, 0x38, 0x29, 0x42, 0x64, 0x0e, 0x76, 0xb5, 0x3b, 0x66,
-		0x97, 0x0e, 0xc8, 0xb6, 0xce, 0x26, 0x47, 0x4f, 0x7f, 0x66, 0x39, 0x55, 0x1d, 0xbb, 0xef, 0x36,
-		0xbb, 0x64, 0xb8, 0xb5, 0x75, 0x17, 0xad, 0xc0, 0xbc, 0x65, 0x6b, 0x8e, 0xab, 0x5b, 0x4d, 0xbd,
-		0xdb, 0xd4, 0xfc, 0xe0, 0xa4, 0xa6, 0x1b, 0x06, 0x76, 0x1c, 0x9b, 0x4d, 0x84, 0x1e, 0xca, 0x7d,
-		0x96, 0x5d, 0xe7, 0xc4, 0xfe, 0x0c, 0x51, 0xe2, 0xa4, 0x7d, 0xea, 0x1b, 0x1f, 0xa6, 0xbe, 0xa7,
-		0x21, 0xdd, 0xd6, 0x3b, 0x1a, 0xb6, 0xdc, 0xee, 0x01, 0xf5, 0xcf, 0x53, 0x6a, 0xaa, 0xad, 0x77,
-		0xaa, 0xe4, 0x1b, 0x6d, 0xc1, 0x43, 0x3e, 0xa9, 0xd6, 0xc2, 0xbb, 0xba, 0x71, 0xa0, 0x51, 0x67,
-		0x9c, 0x06, 0x7a, 0x34, 0xc3, 0xb6, 0x76, 0x5a, 0xa6, 0xe1, 0x3a, 0xd4, 0x3e, 0x30, 0x1b, 0xa7,
-		0xf8, 0x1c, 0x2b, 0x94, 0xe1, 0xa6, 0x63, 0x5b, 0xd4, 0x07, 0xaf, 0x08, 0xea, 0x77, 0xae, 0x87,
-		0xc3, 0xbd, 0x94, 0x90, 0x27, 0x6e, 0x26, 0x52, 0x13, 0x72, 0xf2, 0x66, 0x22, 0x95, 0x94, 0x27,
-		0x6f, 0x26, 0x52, 0x29, 0x39, 0x7d, 0x33, 0x91, 0x4a, 0xcb, 0xa0, 0xbc, 0x2f, 0x0d, 0xd9, 0xe0,
-		0xca, 0x80, 0x2c, 0xb4, 0x0c, 0x3a, 0x37, 0x4a, 0xd4, 0x7a, 0x9e, 0x3b, 0x74, 0x1d, 0xb1, 0x58,
-		0x21, 0x93, 0x66, 0x31, 0xc9, 0xdc, 0x70, 0x95, 0x71, 0x12, 0x87, 0x85, 0xa8, 0x35, 0x66, 0x6e,
-		0x4f, 0x4a, 0xe5, 0x5f, 0xe8, 0x3a, 0x24, 0x5f, 0x73, 0x28, 0x76, 0x92, 0x62, 0x3f, 0x70, 0x38,
-		0xf6, 0xcd, 0x3a, 0x05, 0x4f, 0xdf, 0xac, 0x6b, 0x6b, 0xeb, 0xea, 0x6a, 0x69, 0x45, 0xe5, 0xec,
-		0xe8, 0x14, 0x24, 0x5a, 0xfa, 0xeb, 0x07, 0xe1, 0xe9, 0x95, 0x26, 0xa1, 0x45, 0xc8, 0xf7, 0x2c,
-		0xb6, 0xea, 0x26, 0x5d, 0x45, 0xa8, 0xf2, 0x41, 0xaa, 0x29, 0x3f, 0x77, 0x85, 0xd0, 0x8f, 0xa9,
-		0x1e, 0xa7, 0x20, 0x71, 0x07, 0xeb, 0xb7, 0xc2, 0x93, 0x20, 0x4d, 0x42, 0x17, 0x20, 0xdb, 0xc4,
-		0xdb, 0xbd, 0x5d, 0xad, 0x8b, 0x9b, 0xba, 0xe1, 0x86, 0x4d, 0x7f, 0x86, 0x66, 0xa9, 0x34, 0x07,
-		0xbd, 0x04, 0x69, 0xd2, 0x47, 0x16, 0xed, 0xe3, 0x69, 0x2a, 0x82, 0xc7, 0x0e, 0x17, 0x01, 0xef,
-		0x62, 0xc1, 0xa4, 0xfa, 0xfc, 0xe8, 0x26, 0x24, 0x5d, 0xbd, 0xbb, 0x8b, 0x5d, 0x6a, 0xf9, 0xa7,
-		0x22, 0xc2, 0x55, 0x11, 0x48, 0x0d, 0xca, 0x41, 0xc4, 0x4a, 0x75, 0x94, 0x23, 0xa0, 0x1b, 0x30,
-		0xc9, 0xfe, 0x72, 0x0a, 0x33, 0x0b, 0xf1, 0xa3, 0x83, 0xa9, 0x82, 0xfd, 0x1d, 0xb4, 0x59, 0x97,
-		0x60, 0x82, 0x2a, 0x1b, 0x02, 0xe0, 0xea, 0x26, 0x1f, 0x43, 0x29, 0x48, 0x54, 0xd6, 0x55, 0x62,
-		0xb7, 0x64, 0xc8, 0xb2, 0x54, 0x6d, 0xa3, 0x56, 0xad, 0x54, 0xe5, 0x98, 0xf2, 0x24, 0x24, 0x99,
-		0x06, 0x11, 0x9b, 0xe6, 0xe9, 0x90, 0x7c, 0x8c, 0x7f, 0x72, 0x0c, 0x49, 0xe4, 0x6e, 0xae, 0x96,
-		0xab, 0xaa, 0x1c, 0x53, 0x36, 0x21, 0xdf, 0x27, 0x75, 0x74, 0x1c, 0xa6, 0xd5, 0x6a, 0xa3, 0xba,
-		0x46, 0x56, 0x6d, 0xda, 0xe6, 0xda, 0x4b, 0x6b, 0xeb, 0xef, 0x5e, 0x93, 0x8f, 0x85, 0x93, 0x85,
-		0x81, 0x94, 0xd0, 0x2c, 0xc8, 0x7e, 0x72, 0x7d, 0x7d, 0x53, 0xa5, 0xb5, 0xf9, 0xae, 0x18, 0xc8,
-		0xfd, 0x62, 0x43, 0x27, 0x61, 0xa6, 0x51, 0x52, 0xaf, 0x57, 0x1b, 0x1a, 0x5b, 0x89, 0x7a, 0xd0,
-		0xb3, 0x20, 0x07, 0x33, 0x96, 0x6b, 0x74, 0xa1, 0x3d, 0x0f, 0xa7, 0x83, 0xa9, 0xd5, 0x97, 0x1b,
-		0xd5, 0xb5, 0x3a, 0x2d, 0xbc, 0xb4, 0x76, 0x9d, 0x58, 0xeb, 0x3e, 0x3c, 0xb1, 0xf6, 0x8d, 0x93,
-		0xaa, 0x86, 0xf1, 0xaa, 0x2b, 0x4b, 0x72, 0xa2, 0x3f, 0x79, 0x7d, 0xad, 0xba, 0xbe, 0x2c, 0x4f,
-		0xf4, 0x97, 0x4e, 0xd7, 0xc3, 0x49, 0x34, 0x07, 0x27, 0xfa, 0x53, 0xb5, 0xea, 0x5a, 0x43, 0x7d,
-		0x45, 0x9e, 0xec, 0x2f, 0xb8, 0x5e, 0x55, 0xb7, 0x6a, 0x95, 0xaa, 0x9c, 0x42, 0x27, 0x00, 0x85,
-		0x6b, 0xd4, 0xb8, 0xb1, 0xbe, 0x24, 0xa7, 0x07, 0xec, 0x93, 0xe2, 0x40, 0x36, 0xb8, 0x28, 0xfd,
-		0xaa, 0x98, 0x46, 0xe5, 0x83, 0x31, 0xc8, 0x04, 0x16, 0x99, 0x64, 0x75, 0xa0, 0xb7, 0x5a, 0xf6,
-		0x1d, 0x4d, 0x6f, 0x99, 0xba, 0xc3, 0xad, 0x17, 0xd0, 0xa4, 0x12, 0x49, 0x19, 0xd7, 0x5a, 0x8c,
-		0x3f, 0x5f, 0x24, 0xbf, 0x16, 0xe7, 0x8b, 0x09, 0x39, 0xa9, 0x7c, 0x54, 0x02, 0xb9, 0x7f, 0xf5,
-		0xd8, 0xd7, 0x7c, 0x69, 0x58, 0xf3, 0xbf, 0x2a, 0x7d, 0xf7, 0x11, 0x09, 0xa6, 0xc2, 0x4b, 0xc6,
-		0xbe, 0xea, 0x9d, 0xfd, 0x2b, 0xad, 0xde, 0xef, 0xc5, 0x20, 0x17, 0x5a, 0x28, 0x8e, 0x5b, 0xbb,
-		0xf7, 0xc0, 0xb4, 0xd9, 0xc4, 0xed, 0x8e, 0xed, 0x62, 0xcb, 0x38, 0xd0, 0x5a, 0xf8, 0x36, 0x6e,
-		0x15, 0x14, 0x6a, 0xe2, 0x2f, 0x1d, 0xbe, 0x14, 0x5d, 0xac, 0xf9, 0x7c, 0x2b, 0x84, 0xad, 0x38,
-		0x53, 0x5b, 0xaa, 0xae, 0x6e, 0xac, 0x37, 0xaa, 0x6b, 0x95, 0x57, 0x84, 0x75, 0x51, 0x65, 0xb3,
-		0x8f, 0xec, 0x1d, 0x34, 0xda, 0x1b, 0x20, 0xf7, 0x57, 0x8a, 0xd8, 0x8a, 0x88, 0x6a, 0xc9, 0xc7,
-		0xd0, 0x0c, 0xe4, 0xd7, 0xd6, 0xb5, 0x7a, 0x6d, 0xa9, 0xaa, 0x55, 0x97, 0x97, 0xab, 0x95, 0x46,
-		0x9d, 0x05, 0x17, 0x3d, 0xea, 0x86, 0x1c, 0x0b, 0x8a, 0xf8, 0x43, 0x71, 0x98, 0x89, 0xa8, 0x09,
-		0x2a, 0xf1, 0xb0, 0x00, 0x8b, 0x54, 0x3c, 0x36, 0x4e, 0xed, 0x17, 0x89, 0x63, 0xbe, 0xa1, 0x77,
-		0x5d, 0x1e, 0x45, 0x78, 0x18, 0x88, 0x94, 0x2c, 0x97, 0xf8, 0x09, 0x5d, 0x1e, 0xb4, 0x65, 0xb1,
-		0x82, 0xbc, 0x9f, 0xce, 0xe2, 0xb6, 0x8f, 0x02, 0xea, 0xd8, 0x8e, 0xe9, 0x9a, 0xb7, 0xb1, 0x66,
-		0x5a, 0x22, 0xc2, 0x9b, 0x58, 0x90, 0x2e, 0x24, 0x54, 0x59, 0xe4, 0xd4, 0x2c, 0xd7, 0xa3, 0xb6,
-		0xf0, 0xae, 0xde, 0x47, 0x4d, 0xfc, 0x98, 0xb8, 0x2a, 0x8b, 0x1c, 0x8f, 0xfa, 0x2c, 0x64, 0x9b,
-		0x76, 0x8f, 0x2c, 0xa8, 0x18, 0x1d, 0xb1, 0x16, 0x92, 0x9a, 0x61, 0x69, 0x1e, 0x09, 0x5f, 0x2a,
-		0xfb, 0xa1, 0xe5, 0xac, 0x9a, 0x61, 0x69, 0x8c, 0xe4, 0x3c, 0xe4, 0xf5, 0xdd, 0xdd, 0x2e, 0x01,
-		0x17, 0x40, 0x6c, 0xf1, 0x3f, 0xe5, 0x25, 0x53, 0xc2, 0xb9, 0x9b, 0x90, 0x12, 0x72, 0x20, 0xfe,
-		0x30, 0x91, 0x84, 0xd6, 0x61, 0x11, 0xad, 0xd8, 0x85, 0xb4, 0x9a, 0xb2, 0x44, 0xe6, 0x59, 0xc8,
-		0x9a, 0x8e, 0xe6, 0xef, 0x6d, 0xc6, 0x16, 0x62, 0x17, 0x52, 0x6a, 0xc6, 0x74, 0xbc, 0x3d, 0x12,
-		0xe5, 0xe3, 0x31, 0x98, 0x0a, 0xef, 0xda, 0xa2, 0x25, 0x48, 0xb5, 0x6c, 0xbe, 0xc9, 0xc2, 0x8e,
-		0x0c, 0x5c, 0x18, 0xb1, 0xd1, 0xbb, 0xb8, 0xc2, 0xe9, 0x55, 0x8f, 0x73, 0xee, 0x37, 0x25, 0x48,
-		0x89, 0x64, 0x74, 0x02, 0x12, 0x1d, 0xdd, 0xdd, 0xa3, 0x70, 0x13, 0xe5, 0x98, 0x2c, 0xa9, 0xf4,
-		0x9b, 0xa4, 0x3b, 0x1d, 0x9d, 0xed, 0x13, 0xf1, 0x74, 0xf2, 0x4d, 0xfa, 0xb5, 0x85, 0xf5, 0x26,
-		0x8d, 0x2c, 0xd8, 0xed, 0x36, 0xb6, 0x5c, 0x47, 0xf4, 0x2b, 0x4f, 0xaf, 0xf0, 0x64, 0xf4, 0x08,
-		0x4c, 0xbb, 0x5d, 0xdd, 0x6c, 0x85, 0x68, 0x13, 0x94, 0x56, 0x16, 0x19, 0x1e, 0x71, 0x11, 0x4e,
-		0x09, 0xdc, 0x26, 0x76, 0x75, 0x63, 0x0f, 0x37, 0x7d, 0xa6, 0x24, 0x8d, 0x20, 0x9e, 0xe4, 0x04,
-		0x4b, 0x3c, 0x5f, 0xf0, 0x2a, 0x9f, 0x89, 0xc1, 0xb4, 0x88, 0x85, 0x34, 0x3d, 0x61, 0xad, 0x02,
-		0xe8, 0x96, 0x65, 0xbb, 0x41, 0x71, 0x0d, 0xaa, 0xf2, 0x00, 0xdf, 0x62, 0xc9, 0x63, 0x52, 0x03,
-		0x00, 0x73, 0x5f, 0x90, 0x00, 0xfc, 0xac, 0xa1, 0x72, 0x9b, 0x87, 0x0c, 0xdf, 0x93, 0xa7, 0x07,
-		0x3b, 0x58, 0xf8, 0x0c, 0x58, 0xd2, 0xb2, 0xd9, 0xa2, 0x41, 0xce, 0x6d, 0xbc, 0x6b, 0x5a, 0x7c,
-		0x77, 0x86, 0x7d, 0x88, 0x20, 0x67, 0xc2, 0xdf, 0x9e, 0x54, 0x21, 0xe5, 0xe0, 0xb6, 0x6e, 0xb9,
-		0xa6, 0xc1, 0xf7, 0x5b, 0xae, 0x1d, 0xa9, 0xf2, 0x8b, 0x75, 0xce, 0xad, 0x7a, 0x38, 0xca, 0x05,
-		0x48, 0x89, 0x54, 0xe2, 0xf8, 0xad, 0xad, 0xaf, 0x55, 0xe5, 0x63, 0x68, 0x12, 0xe2, 0xf5, 0x6a,
-		0x43, 0x96, 0xc8, 0x22, 0xb6, 0xb4, 0x52, 0x2b, 0xd5, 0xe5, 0x58, 0xf9, 0x6f, 0xc3, 0x8c, 0x61,
-		0xb7, 0xfb, 0x0b, 0x2c, 0xcb, 0x7d, 0x01, 0x44, 0xe7, 0x86, 0xf4, 0xea, 0x63, 0x9c, 0x68, 0xd7,
-		0x6e, 0xe9, 0xd6, 0xee, 0xa2, 0xdd, 0xdd, 0xf5, 0x8f, 0xc5, 0x90, 0xb5, 0x86, 0x13, 0x38, 0x1c,
-		0xd3, 0xd9, 0xfe, 0x4b, 0x49, 0xfa, 0xd1, 0x58, 0xfc, 0xfa, 0x46, 0xf9, 0x27, 0x63, 0x73, 0xd7,
-		0x19, 0xe3, 0x86, 0x68, 0x8e, 0x8a, 0x77, 0x5a, 0xd8, 0x20, 0x95, 0x87, 0x3f, 0x7e, 0x04, 0x66,
-		0x77, 0xed, 0x5d, 0x9b, 0x22, 0x5d, 0x22, 0x7f, 0xf1, 0x73, 0x35, 0x69, 0x2f, 0x75, 0x6e, 0xe4,
-		0x21, 0x9c, 0xe2, 0x1a, 0xcc, 0x70, 0x62, 0x8d, 0x6e, 0xdf, 0xb3, 0x50, 0x05, 0x3a, 0x34, 0x4e,
-		0x5e, 0xf8, 0xb9, 0x3f, 0xa0, 0x5e, 0x89, 0x3a, 0xcd, 0x59, 0x49, 0x1e, 0x8b, 0x66, 0x14, 0x55,
-		0x38, 0x1e, 0xc2, 0x63, 0x36, 0x02, 0x77, 0x47, 0x20, 0xfe, 0x5b, 0x8e, 0x38, 0x13, 0x40, 0xac,
-		0x73, 0xd6, 0x62, 0x05, 0x72, 0x47, 0xc1, 0xfa, 0x55, 0x8e, 0x95, 0xc5, 0x41, 0x90, 0xeb, 0x90,
-		0xa7, 0x20, 0x46, 0xcf, 0x71, 0xed, 0x36, 0x35, 0xc0, 0x87, 0xc3, 0xfc, 0xbb, 0x3f, 0x60, 0x83,
-		0x76, 0x8a, 0xb0, 0x55, 0x3c, 0xae, 0x62, 0x11, 0xe8, 0x89, 0x85, 0x26, 0x36, 0x5a, 0x23, 0x10,
-		0x7e, 0x8d, 0x57, 0xc4, 0xa3, 0x2f, 0x6e, 0xc1, 0x2c, 0xf9, 0x9b, 0xda, 0xc7, 0x60, 0x4d, 0x46,
-		0x07, 0xd5, 0x0b, 0xff, 0xe9, 0x5b, 0x99, 0x5d, 0x98, 0xf1, 0x00, 0x02, 0x75, 0x0a, 0xf4, 0xe2,
-		0x2e, 0x76, 0x5d, 0xdc, 0x75, 0x34, 0xbd, 0x15, 0x55, 0xbd, 0x40, 0x54, 0xb2, 0xf0, 0x43, 0x5f,
-		0x0c, 0xf7, 0xe2, 0x75, 0xc6, 0x59, 0x6a, 0xb5, 0x8a, 0x9b, 0x70, 0x32, 0x42, 0x2b, 0xc6, 0xc0,
-		0xfc, 0x10, 0xc7, 0x9c, 0x1d, 0xd0, 0x0c, 0x02, 0xbb, 0x01, 0x22, 0xdd, 0xeb, 0xcb, 0x31, 0x30,
-		0x3f, 0xcc, 0x31, 0x11, 0xe7, 0x15, 0x5d, 0x4a, 0x10, 0x6f, 0xc2, 0xf4, 0x6d, 0xdc, 0xdd, 0xb6,
-		0x1d, 0x1e, 0x09, 0x1e, 0x03, 0xee, 0x23, 0x1c, 0x2e, 0xcf, 0x19, 0x69, 0x68, 0x98, 0x60, 0x3d,
-		0x03, 0xa9, 0x1d, 0xdd, 0xc0, 0x63, 0x40, 0xdc, 0xe5, 0x10, 0x93, 0x84, 0x9e, 0xb0, 0x96, 0x20,
-		0xbb, 0x6b, 0xf3, 0x29, 0x72, 0x34, 0xfb, 0x47, 0x39, 0x7b, 0x46, 0xf0, 0x70, 0x88, 0x8e, 0xdd,
-		0xe9, 0xb5, 0xc8, 0xfc, 0x39, 0x1a, 0xe2, 0x87, 0x05, 0x84, 0xe0, 0xe1, 0x10, 0x47, 0x10, 0xeb,
-		0xc7, 0x04, 0x84, 0x13, 0x90, 0xe7, 0x8b, 0x90, 0xb1, 0xad, 0xd6, 0x81, 0x6d, 0x8d, 0x53, 0x89,
-		0x1f, 0xe1, 0x08, 0xc0, 0x59, 0x08, 0xc0, 0xb3, 0x90, 0x1e, 0xb7, 0x23, 0x7e, 0xfc, 0x8b, 0x62,
-		0x78, 0x88, 0x1e, 0xb8, 0x0e, 0x79, 0x61, 0xa0, 0x4c, 0xdb, 0x1a, 0x03, 0xe2, 0x27, 0x38, 0xc4,
-		0x54, 0x80, 0x8d, 0x37, 0xc3, 0xc5, 0x8e, 0xbb, 0x8b, 0xc7, 0x01, 0xf9, 0xb8, 0x68, 0x06, 0x67,
-		0xe1, 0xa2, 0xdc, 0xc6, 0x96, 0xb1, 0x37, 0x1e, 0xc2, 0x27, 0x84, 0x28, 0x05, 0x0f, 0x81, 0xa8,
-		0x40, 0xae, 0xad, 0x77, 0x9d, 0x3d, 0xbd, 0x35, 0x56, 0x77, 0x7c, 0x92, 0x63, 0x64, 0x3d, 0x26,
-		0x2e, 0x91, 0x9e, 0x75, 0x14, 0x98, 0x9f, 0x14, 0x12, 0x09, 0xb0, 0xf1, 0xa1, 0xe7, 0xb8, 0x34,
-		0x6c, 0x7e, 0x14, 0xb4, 0x9f, 0x12, 0x43, 0x8f, 0xf1, 0xae, 0x06, 0x11, 0x9f, 0x85, 0xb4, 0x63,
-		0xbe, 0x3e, 0x16, 0xcc, 0x3f, 0x12, 0x3d, 0x4d, 0x19, 0x08, 0xf3, 0x2b, 0x70, 0x2a, 0x72, 0x9a,
-		0x18, 0x03, 0xec, 0xa7, 0x39, 0xd8, 0x89, 0x88, 0xa9, 0x82, 0x9b, 0x84, 0xa3, 0x42, 0xfe, 0x8c,
-		0x30, 0x09, 0xb8, 0x0f, 0x6b, 0x83, 0x2c, 0x5a, 0x1c, 0x7d, 0xe7, 0x68, 0x52, 0xfb, 0xc7, 0x42,
-		0x6a, 0x8c, 0x37, 0x24, 0xb5, 0x06, 0x9c, 0xe0, 0x88, 0x47, 0xeb, 0xd7, 0x9f, 0x15, 0x86, 0x95,
-		0x71, 0x6f, 0x86, 0x7b, 0xf7, 0xeb, 0x61, 0xce, 0x13, 0xa7, 0xf0, 0x8e, 0x1d, 0xad, 0xad, 0x77,
-		0xc6, 0x40, 0xfe, 0x39, 0x8e, 0x2c, 0x2c, 0xbe, 0xe7, 0x5e, 0x3b, 0xab, 0x7a, 0x87, 0x80, 0xbf,
-		0x0c, 0x05, 0x01, 0xde, 0xb3, 0xba, 0xd8, 0xb0, 0x77, 0x2d, 0xf3, 0x75, 0xdc, 0x1c, 0x03, 0xfa,
-		0x9f, 0xf4, 0x75, 0xd5, 0x66, 0x80, 0x9d, 0x20, 0xd7, 0x40, 0xf6, 0x7c, 0x15, 0xcd, 0x6c, 0x77,
-		0xec, 0xae, 0x3b, 0x02, 0xf1, 0x53, 0xa2, 0xa7, 0x3c, 0xbe, 0x1a, 0x65, 0x2b, 0x56, 0x81, 0x9d,
-		0x25, 0x19, 0x57, 0x25, 0x3f, 0xcd, 0x81, 0x72, 0x3e, 0x17, 0x37, 0x1c, 0x86, 0xdd, 0xee, 0xe8,
-		0xdd, 0x71, 0xec, 0xdf, 0xcf, 0x0b, 0xc3, 0xc1, 0x59, 0xb8, 0xe1, 0x20, 0x1e, 0x1d, 0x99, 0xed,
-		0xc7, 0x40, 0xf8, 0x05, 0x61, 0x38, 0x04, 0x0f, 0x87, 0x10, 0x0e, 0xc3, 0x18, 0x10, 0xff, 0x54,
-		0x40, 0x08, 0x1e, 0x02, 0xf1, 0x2e, 0x7f, 0xa2, 0xed, 0xe2, 0x5d, 0xd3, 0x71, 0xf9, 0x61, 0xb0,
-		0xc3, 0xa1, 0x7e, 0xf1, 0x8b, 0x61, 0x27, 0x4c, 0x0d, 0xb0, 0x12, 0x4b, 0xc4, 0x37, 0x52, 0xe8,
-		0x92, 0x6d, 0x74, 0xc5, 0x7e, 0x49, 0x58, 0xa2, 0x00, 0x1b, 0xa9, 0x5b, 0xc0, 0x43, 0x24, 0x62,
-		0x37, 0xc8, 0x42, 0x65, 0x0c, 0xb8, 0x7f, 0xd6, 0x57, 0xb9, 0xba, 0xe0, 0x25, 0x98, 0x01, 0xff,
-		0xa7, 0x67, 0xdd, 0xc2, 0x07, 0x63, 0x69, 0xe7, 0x2f, 0xf7, 0xf9, 0x3f, 0x9b, 0x8c, 0x93, 0xd9,
-		0x90, 0x7c, 0x9f, 0x3f, 0x85, 0x46, 0x9d, 0xf5, 0x2c, 0x7c, 0xcb, 0x97, 0x78, 0x7b, 0xc3, 0xee,
-		0x54, 0x71, 0x85, 0x28, 0x79, 0xd8, 0xe9, 0x19, 0x0d, 0xf6, 0xad, 0x5f, 0xf2, 0xf4, 0x3c, 0xe4,
-		0xf3, 0x14, 0x97, 0x21, 0x17, 0x72, 0x78, 0x46, 0x43, 0x7d, 0x1b, 0x87, 0xca, 0x06, 0xfd, 0x9d,
-		0xe2, 0x93, 0x90, 0x20, 0xce, 0xcb, 0x68, 0xf6, 0xf7, 0x71, 0x76, 0x4a, 0x5e, 0x7c, 0x1e, 0x52,
-		0xc2, 0x69, 0x19, 0xcd, 0xfa, 0xed, 0x9c, 0xd5, 0x63, 0x21, 0xec, 0xc2, 0x61, 0x19, 0xcd, 0xfe,
-		0x77, 0x05, 0xbb, 0x60, 0x21, 0xec, 0xe3, 0x8b, 0xf0, 0x5f, 0x7d, 0x67, 0x82, 0x4f, 0x3a, 0x42,
-		0x76, 0xcf, 0xc2, 0x24, 0xf7, 0x54, 0x46, 0x73, 0x7f, 0x07, 0x2f, 0x5c, 0x70, 0x14, 0x9f, 0x82,
-		0x89, 0x31, 0x05, 0xfe, 0xdd, 0x9c, 0x95, 0xd1, 0x17, 0x2b, 0x90, 0x09, 0x78, 0x27, 0xa3, 0xd9,
-		0xbf, 0x87, 0xb3, 0x07, 0xb9, 0x48, 0xd5, 0xb9, 0x77, 0x32, 0x1a, 0xe0, 0xef, 0x89, 0xaa, 0x73,
-		0x0e, 0x22, 0x36, 0xe1, 0x98, 0x8c, 0xe6, 0xfe, 0x80, 0x90, 0xba, 0x60, 0x29, 0xbe, 0x08, 0x69,
-		0x6f, 0xb2, 0x19, 0xcd, 0xff, 0xbd, 0x9c, 0xdf, 0xe7, 0x21, 0x12, 0x08, 0x4c, 0x76, 0xa3, 0x21,
-		0xfe, 0xbe, 0x90, 0x40, 0x80, 0x8b, 0x0c, 0xa3, 0x7e, 0x07, 0x66, 0x34, 0xd2, 0xf7, 0x89, 0x61,
-		0xd4, 0xe7, 0xbf, 0x90, 0xde, 0xa4, 0x36, 0x7f, 0x34, 0xc4, 0xf7, 0x8b, 0xde, 0xa4, 0xf4, 0xa4,
-		0x1a, 0xfd, 0x1e, 0xc1, 0x68, 0x8c, 0x1f, 0x14, 0xd5, 0xe8, 0x73, 0x08, 0x8a, 0x1b, 0x80, 0x06,
-		0xbd, 0x81, 0xd1, 0x78, 0x1f, 0xe4, 0x78, 0xd3, 0x03, 0xce, 0x40, 0xf1, 0xdd, 0x70, 0x22, 0xda,
-		0x13, 0x18, 0x8d, 0xfa, 0x43, 0x5f, 0xea, 0x5b, 0xbb, 0x05, 0x1d, 0x81, 0x62, 0xc3, 0x9f, 0x52,
-		0x82, 0x5e, 0xc0, 0x68, 0xd8, 0x0f, 0x7d, 0x29, 0x6c, 0xb8, 0x83, 0x4e, 0x40, 0xb1, 0x04, 0xe0,
-		0x4f, 0xc0, 0xa3, 0xb1, 0x3e, 0xc2, 0xb1, 0x02, 0x4c, 0x64, 0x68, 0xf0, 0xf9, 0x77, 0x34, 0xff,
-		0x5d, 0x31, 0x34, 0x38, 0x07, 0x19, 0x1a, 0x62, 0xea, 0x1d, 0xcd, 0xfd, 0x51, 0x31, 0x34, 0x04,
-		0x0b, 0xd1, 0xec, 0xc0, 0xec, 0x36, 0x1a, 0xe1, 0x47, 0x84, 0x66, 0x07, 0xb8, 0x8a, 0x6b, 0x30,
-		0x3d, 0x30, 0x21, 0x8e, 0x86, 0xfa, 0x51, 0x0e, 0x25, 0xf7, 0xcf, 0x87, 0xc1, 0xc9, 0x8b, 0x4f,
-		0x86, 0xa3, 0xd1, 0x7e, 0xac, 0x6f, 0xf2, 0xe2, 0x73, 0x61, 0xf1, 0x59, 0x48, 0x59, 0xbd, 0x56,
-		0x8b, 0x0c, 0x1e, 0x74, 0xf8, 0x69, 0xdf, 0xc2, 0x7f, 0xff, 0x0a, 0x97, 0x8e, 0x60, 0x28, 0x3e,
-		0x09, 0x13, 0xb8, 0xbd, 0x8d, 0x9b, 0xa3, 0x38, 0xff, 0xe8, 0x2b, 0xc2, 0x60, 0x12, 0xea, 0xe2,
-		0x8b, 0x00, 0x2c, 0x34, 0x42, 0xb7, 0xe1, 0x47, 0xf0, 0x7e, 0xe1, 0x2b, 0xfc, 0x78, 0x9d, 0xcf,
-		0xe2, 0x03, 0xb0, 0xc3, 0x7a, 0x87, 0x03, 0x7c, 0x31, 0x0c, 0x40, 0x7b, 0xe4, 0x19, 0x98, 0x7c,
-		0xcd, 0xb1, 0x2d, 0x57, 0xdf, 0x1d, 0xc5, 0xfd, 0xc7, 0x9c, 0x5b, 0xd0, 0x13, 0x81, 0xb5, 0xed,
-		0x2e, 0x76, 0xf5, 0x5d, 0x67, 0x14, 0xef, 0x9f, 0x70, 0x5e, 0x8f, 0x81, 0x30, 0x1b, 0xba, 0xe3,
-		0x8e, 0xd3, 0xee, 0xff, 0x21, 0x98, 0x05, 0x03, 0xa9, 0x34, 0xf9, 0xfb, 0x16, 0x3e, 0x18, 0xc5,
-		0xfb, 0xa7, 0xa2, 0xd2, 0x9c, 0xbe, 0xf8, 0x3c, 0xa4, 0xc9, 0x9f, 0xec, 0xcc, 0xec, 0x08, 0xe6,
-		0x3f, 0xe3, 0xcc, 0x3e, 0x07, 0x29, 0xd9, 0x71, 0x9b, 0xae, 0x39, 0x5a, 0xd8, 0x7f, 0xce, 0x7b,
-		0x5a, 0xd0, 0x17, 0x4b, 0x90, 0x71, 0xdc, 0x66, 0xb3, 0xc7, 0xfd, 0xd3, 0x11, 0xec, 0xff, 0xf3,
-		0x2b, 0x5e, 0xc8, 0xc2, 0xe3, 0x21, 0xbd, 0x7d, 0xe7, 0x96, 0xdb, 0xb1, 0xe9, 0x7e, 0xcb, 0x28,
-		0x84, 0x2f, 0x71, 0x84, 0x00, 0x4b, 0xb1, 0x02, 0x59, 0xd2, 0x16, 0x71, 0x17, 0x61, 0x14, 0xc4,
-		0x97, 0xb9, 0x00, 0x42, 0x4c, 0xe5, 0x6f, 0xfe, 0xb5, 0xcf, 0x9e, 0x91, 0x3e, 0xf3, 0xd9, 0x33,
-		0xd2, 0xef, 0x7d, 0xf6, 0x8c, 0xf4, 0x81, 0xcf, 0x9d, 0x39, 0xf6, 0x99, 0xcf, 0x9d, 0x39, 0xf6,
-		0xdb, 0x9f, 0x3b, 0x73, 0x2c, 0x3a, 0x4a, 0x0c, 0xd7, 0xed, 0xeb, 0x36, 0x8b, 0x0f, 0xbf, 0xfa,
-		0xe0, 0xae, 0xe9, 0xee, 0xf5, 0xb6, 0x17, 0x0d, 0xbb, 0x7d, 0xc9, 0xb0, 0x9d, 0xb6, 0xed, 0x5c,
-		0x0a, 0xc7, 0x75, 0xe9, 0x5f, 0xf0, 0xbf, 0x25, 0xb2, 0x66, 0x0e, 0x87, 0x73, 0x75, 0xeb, 0x60,
-		0xd8, 0x65, 0xca, 0x6b, 0x10, 0x2f, 0x59, 0x07, 0xe8, 0x14, 0x33, 0x70, 0x5a, 0xaf, 0xdb, 0xe2,
-		0x07, 0x37, 0x27, 0xc9, 0xf7, 0x66, 0xb7, 0x85, 0x66, 0xfd, 0xd3, 0xd5, 0xd2, 0x85, 0x2c, 0x3f,
-		0x32, 0x5d, 0xfe, 0x1e, 0xe9, 0x68, 0x2d, 0x49, 0x95, 0xac, 0x03, 0xda, 0x90, 0x0d, 0xe9, 0xd5,
-		0x47, 0x47, 0xc6, 0xb9, 0x6f, 0x59, 0xf6, 0x1d, 0x8b, 0x54, 0xbb, 0xb3, 0x2d, 0x62, 0xdc, 0x67,
-		0xfa, 0x63, 0xdc, 0xef, 0xc6, 0xad, 0xd6, 0x4b, 0x84, 0xae, 0x41, 0x58, 0xb6, 0x93, 0xec, 0x8e,
-		0x00, 0x7c, 0x5f, 0x0c, 0xce, 0x0c, 0x84, 0xb3, 0xb9, 0x12, 0x0c, 0x13, 0x42, 0x11, 0x52, 0x4b,
-		0x42, 0xb7, 0x0a, 0x30, 0xe9, 0x60, 0xc3, 0xb6, 0x9a, 0x0e, 0x15, 0x44, 0x5c, 0x15, 0x9f, 0x44,
-		0x10, 0x96, 0x6e, 0xd9, 0x0e, 0x3f, 0xfa, 0xcc, 0x3e, 0xca, 0x1f, 0x3e, 0xa2, 0x20, 0x72, 0xa2,
-		0x24, 0x21, 0x8d, 0xcb, 0x63, 0x4a, 0x43, 0x34, 0x22, 0x14, 0xf9, 0x1f, 0x57, 0x2a, 0x3f, 0x18,
-		0x83, 0xf9, 0x7e, 0xa9, 0x90, 0x91, 0xe5, 0xb8, 0x7a, 0xbb, 0x33, 0x4c, 0x2c, 0xcf, 0x42, 0xba,
-		0x21, 0x68, 0x8e, 0x2c, 0x97, 0xbb, 0x47, 0x94, 0xcb, 0x94, 0x57, 0x94, 0x10, 0xcc, 0x95, 0x31,
-		0x05, 0xe3, 0xb5, 0xe3, 0x9e, 0x24, 0xf3, 0xe1, 0x34, 0x9c, 0x62, 0xc3, 0x49, 0x63, 0x43, 0x89,
-		0x7d, 0x70, 0x99, 0x64, 0x83, 0x59, 0xa3, 0xf7, 0x49, 0x94, 0x97, 0x60, 0xa6, 0x46, 0xac, 0x05,
-		0x59, 0x05, 0xf9, 0x3b, 0x3c, 0x91, 0xa7, 0xc3, 0x17, 0x42, 0x0e, 0x3f, 0xdf, 0xdf, 0x0a, 0x26,
-		0x29, 0xdf, 0x22, 0x81, 0x5c, 0x37, 0xf4, 0x96, 0xde, 0x7d, 0xab, 0x50, 0xe8, 0x29, 0x00, 0x76,
-		0xdc, 0xc3, 0xbb, 0xb8, 0x39, 0x75, 0xa5, 0xb0, 0x18, 0x6c, 0xdc, 0x22, 0x2b, 0x89, 0x1e, 0xa1,
-		0x4a, 0x53, 0x5a, 0xf2, 0xe7, 0xc5, 0x97, 0x01, 0xfc, 0x0c, 0x74, 0x1a, 0x4e, 0xd6, 0x2b, 0xa5,
-		0x95, 0x92, 0x2a, 0x0e, 0x09, 0xd5, 0x37, 0xaa, 0x15, 0x76, 0xcd, 0xea, 0x18, 0x3a, 0x01, 0x28,
-		0x98, 0xe9, 0x1d, 0x6a, 0x3a, 0x0e, 0xd3, 0xc1, 0x74, 0x76, 0xe7, 0x25, 0x56, 0xbc, 0x01, 0x79,
-		0x76, 0x20, 0x5f, 0xd3, 0x9b, 0x4d, 0xdc, 0xd4, 0x4c, 0x0b, 0x8d, 0x38, 0xdf, 0x5e, 0xf8, 0xf5,
-		0xff, 0x32, 0x41, 0x9b, 0x96, 0x63, 0x8c, 0x25, 0xc2, 0x57, 0xb3, 0x88, 0xcf, 0x69, 0xb6, 0x3b,
-		0x2d, 0x4c, 0xf7, 0x30, 0x35, 0x53, 0xc8, 0x7f, 0xb4, 0x3b, 0x43, 0xf0, 0xe2, 0x17, 0xd2, 0xea,
-		0x8c, 0xcf, 0xee, 0xf5, 0x5e, 0xf1, 0x25, 0x90, 0xc5, 0xc1, 0x51, 0xaf, 0x82, 0x23, 0x11, 0x7f,
-		0x83, 0xd7, 0x50, 0x44, 0x33, 0x44, 0x15, 0x57, 0x60, 0x5a, 0x37, 0x0c, 0xdc, 0x09, 0xd5, 0x6f,
-		0xc4, 0x0c, 0x22, 0x5a, 0x2b, 0x73, 0x4e, 0xbf, 0x6a, 0x4f, 0x41, 0xd2, 0xa1, 0x9d, 0x32, 0x0a,
-		0x42, 0x54, 0x87, 0x93, 0x17, 0xab, 0x30, 0xc5, 0xd4, 0xc0, 0x6b, 0xd1, 0x08, 0x80, 0x7f, 0xcf,
-		0x01, 0xb2, 0x94, 0x4d, 0xb4, 0xc6, 0x82, 0x69, 0x76, 0x6b, 0x11, 0x07, 0x5a, 0x73, 0x78, 0x14,
-		0xe5, 0x9f, 0x7f, 0xea, 0x71, 0xba, 0x6f, 0x7c, 0x36, 0xac, 0x74, 0x11, 0x83, 0x45, 0x95, 0x39,
-		0xb6, 0xdf, 0x5e, 0x0c, 0x53, 0xa2, 0x3c, 0xde, 0xee, 0xc3, 0x0b, 0xfb, 0x17, 0xbc, 0xb0, 0x33,
-		0x51, 0x1a, 0x1e, 0x28, 0x29, 0xc7, 0x51, 0x59, 0x46, 0xb1, 0x0c, 0xb9, 0x1d, 0xb3, 0x15, 0xe8,
-		0xee, 0xc3, 0x4b, 0xf9, 0x97, 0x9f, 0x7a, 0x9c, 0x0d, 0x34, 0xc2, 0xc4, 0x45, 0x53, 0xae, 0x0e,
-		0xb3, 0x7a, 0xaf, 0x3e, 0x32, 0x38, 0x7f, 0xb3, 0xff, 0x1e, 0xa3, 0xe8, 0xcf, 0x06, 0xab, 0xea,
-		0x5b, 0xa7, 0x04, 0x4c, 0xeb, 0x6d, 0xd3, 0xb2, 0x2f, 0xd1, 0x7f, 0xb9, 0x55, 0x9a, 0xa0, 0x1f,
-		0x63, 0x6c, 0xdb, 0x5e, 0x63, 0xc6, 0x62, 0xb4, 0xde, 0xfe, 0xd9, 0x77, 0xfd, 0xc4, 0x84, 0x6f,
-		0x50, 0x8a, 0xab, 0xbe, 0xee, 0x63, 0xcb, 0xb0, 0x9b, 0x63, 0xc5, 0x71, 0xfe, 0x5c, 0x60, 0x88,
-		0x08, 0x60, 0x95, 0xb3, 0x16, 0x9f, 0x83, 0x94, 0x07, 0x33, 0xca, 0x77, 0x13, 0x20, 0x1e, 0x07,
-		0xf1, 0xdc, 0x98, 0xd2, 0x8e, 0xe3, 0xa7, 0x7f, 0x49, 0xf0, 0x33, 0x1b, 0xb6, 0x46, 0x5a, 0x73,
-		0x1d, 0xa6, 0x9a, 0xb6, 0xe5, 0x6a, 0x76, 0xdb, 0x74, 0x71, 0xbb, 0xe3, 0x8e, 0xf4, 0x7c, 0xbf,
-		0xcc, 0x40, 0x52, 0x6a, 0x8e, 0xf0, 0xad, 0x0b, 0x36, 0x52, 0x13, 0x76, 0x2f, 0x72, 0x9c, 0x9a,
-		0xfc, 0x85, 0x57, 0x13, 0xca, 0x43, 0x6a, 0x72, 0x4f, 0xda, 0xe1, 0x34, 0x6f, 0xf1, 0xe9, 0xce,
-		0xdd, 0x67, 0x5a, 0xe0, 0x69, 0xc7, 0xc7, 0xe3, 0x70, 0x86, 0x13, 0x6f, 0xeb, 0x0e, 0xbe, 0x74,
-		0xfb, 0xf2, 0x36, 0x76, 0xf5, 0xcb, 0x97, 0x0c, 0xdb, 0x14, 0xbe, 0xce, 0x0c, 0x9f, 0xce, 0x48,
-		0xfe, 0x22, 0xcf, 0x9f, 0x8b, 0x3c, 0x10, 0x30, 0x37, 0x7c, 0x1a, 0x9c, 0x1b, 0xd4, 0x41, 0xa5,
-		0x05, 0x89, 0x8a, 0x6d, 0x5a, 0x64, 0xf6, 0x6f, 0x62, 0xcb, 0x6e, 0xf3, 0x09, 0x89, 0x7d, 0xa0,
-		0x1b, 0x90, 0xd4, 0xdb, 0x76, 0xcf, 0x72, 0xd9, 0x64, 0x54, 0x7e, 0xfc, 0xd7, 0xde, 0x9c, 0x3f,
-		0xf6, 0x3b, 0x6f, 0xce, 0x1f, 0x67, 0xb0, 0x4e, 0xf3, 0xd6, 0xa2, 0x69, 0x5f, 0x6a, 0xeb, 0xee,
-		0x1e, 0x31, 0x01, 0xbf, 0xf5, 0xe9, 0xc7, 0x80, 0x97, 0x57, 0xb3, 0xdc, 0x4f, 0xfc, 0xe1, 0xcf,
-		0x5e, 0x94, 0x54, 0xce, 0x5f, 0x4c, 0x7c, 0xfe, 0x63, 0xf3, 0x92, 0xd2, 0x81, 0xc9, 0x25, 0x6c,
-		0x1c, 0x52, 0x60, 0xad, 0xaf, 0xc0, 0xcb, 0xbc, 0xc0, 0xd3, 0x83, 0x05, 0xb2, 0x23, 0x8d, 0x4b,
-		0xd8, 0x08, 0x14, 0xbb, 0x84, 0x8d, 0x70, 0x89, 0xe5, 0xa5, 0xdf, 0xfe, 0xfd, 0x33, 0xc7, 0xde,
-		0xfb, 0xd9, 0x33, 0xc7, 0x86, 0x76, 0x99, 0x32, 0xba, 0xcb, 0xbc, 0x9e, 0xfa, 0x64, 0x82, 0xf4,
-		0x54, 0x1b, 0xbb, 0xdb, 0x3b, 0xee, 0x25, 0xa3, 0x7b, 0xd0, 0x71, 0xed, 0x4b, 0xb7, 0x2f, 0x93,
-		0x91, 0x6b, 0xef, 0xf0, 0x9e, 0x42, 0x22, 0x7f, 0x91, 0xe5, 0x2f, 0xde, 0x1e, 0xd2, 0x51, 0xca,
-		0x0e, 0x4c, 0x6c, 0x10, 0x46, 0x22, 0x0a, 0xd7, 0x76, 0xf5, 0x16, 0xf7, 0xc8, 0xd8, 0x07, 0x49,
-		0x65, 0xf7, 0x76, 0x63, 0x2c, 0xd5, 0x14, 0x57, 0x76, 0x5b, 0x58, 0xdf, 0x61, 0xd7, 0x9f, 0xe2,
-		0xd4, 0x95, 0x4f, 0x91, 0x04, 0x7a, 0xd3, 0x69, 0x16, 0x26, 0xf4, 0x1e, 0x3b, 0x54, 0x14, 0x27,
-		0x3e, 0x3e, 0xfd, 0x50, 0x56, 0x60, 0x92, 0x9f, 0x2d, 0x40, 0x32, 0xc4, 0x6f, 0xe1, 0x03, 0x5a,
-		0x4e, 0x56, 0x25, 0x7f, 0xa2, 0x4b, 0x30, 0x41, 0x6b, 0xcf, 0xef, 0x75, 0x9e, 0x5a, 0x1c, 0xac,
-		0xfe, 0x22, 0xad, 0xa5, 0xca, 0xe8, 0x94, 0x9b, 0x90, 0x5a, 0xb2, 0x89, 0x02, 0x85, 0xe1, 0xd2,
-		0x0c, 0x8e, 0x56, 0xba, 0xd3, 0xe3, 0xdd, 0xa7, 0xb2, 0x0f, 0x74, 0x02, 0x92, 0xec, 0x3e, 0x1c,
-		0x3f, 0x19, 0xc5, 0xbf, 0x94, 0x0a, 0x4c, 0x52, 0xec, 0xf5, 0x8e, 0x77, 0x07, 0x5d, 0x0a, 0xdc,
-		0x41, 0xe7, 0xf0, 0x31, 0xbf, 0xb6, 0x08, 0x12, 0x4d, 0xdd, 0xd5, 0x79, 0xc3, 0xe9, 0xdf, 0xca,
-		0x8b, 0x90, 0xe2, 0x20, 0x0e, 0x7a, 0x02, 0xe2, 0x76, 0xc7, 0xe1, 0x67, 0x9b, 0x4e, 0x0f, 0x6d,
-		0xcb, 0x7a, 0xa7, 0x9c, 0x20, 0x8a, 0xa5, 0x12, 0xea, 0xf2, 0xea, 0x50, 0xd5, 0x78, 0x22, 0xa4,
-		0x1a, 0xa2, 0xdb, 0xc5, 0x1f, 0x7a, 0xc7, 0xbc, 0x34, 0xa8, 0x0c, 0x9e, 0xae, 0xfc, 0x2f, 0x09,
-		0xee, 0x8f, 0xd0, 0x95, 0x5b, 0xf8, 0xc0, 0x39, 0xb2, 0xaa, 0xbc, 0x0c, 0xe9, 0x0d, 0xfa, 0xba,
-		0xcc, 0x4b, 0xf8, 0x00, 0xcd, 0xc1, 0x24, 0x6e, 0x5e, 0x79, 0xf2, 0xc9, 0xcb, 0xcf, 0xb0, 0x8e,
-		0xbc, 0x71, 0x4c, 0x15, 0x09, 0xe8, 0x0c, 0xa4, 0x1d, 0x6c, 0x74, 0xae, 0x3c, 0x79, 0xed, 0xd6,
-		0x65, 0x26, 0xb8, 0x1b, 0xc7, 0x54, 0x3f, 0xa9, 0x98, 0x22, 0x83, 0xe2, 0xf3, 0x3f, 0x32, 0x2f,
-		0x95, 0x27, 0x20, 0xee, 0xf4, 0xda, 0xef, 0x54, 0xe3, 0xff, 0x22, 0x09, 0x67, 0xbd, 0x6c, 0x66,
-		0xf6, 0x6e, 0x5f, 0xbe, 0x74, 0x5b, 0x6f, 0x99, 0x4d, 0xdd, 0x7f, 0x13, 0x68, 0xda, 0x13, 0x00,
-		0x25, 0x19, 0xda, 0xfe, 0xb9, 0xc3, 0x05, 0xa9, 0x7c, 0x5a, 0x82, 0xec, 0x96, 0xc0, 0xae, 0x63,
-		0x17, 0x3d, 0x07, 0xe0, 0x95, 0x25, 0xd4, 0xe1, 0xbe, 0xc5, 0x81, 0xd2, 0x16, 0x3d, 0x26, 0x35,
-		0x40, 0x8f, 0x9e, 0x86, 0x54, 0xa7, 0x6b, 0x77, 0x6c, 0x87, 0xdf, 0x8f, 0x1d, 0xc5, 0xeb, 0x51,
-		0xa3, 0x47, 0x01, 0xd1, 0xc1, 0xab, 0xdd, 0xb6, 0x5d, 0xd3, 0xda, 0xd5, 0x3a, 0xf6, 0x1d, 0xfe,
-		0xec, 0x40, 0x5c, 0x95, 0x69, 0xce, 0x16, 0xcd, 0xd8, 0x20, 0xe9, 0xca, 0xa7, 0x24, 0x48, 0x7b,
-		0x28, 0x64, 0x65, 0xa6, 0x37, 0x9b, 0x5d, 0xec, 0x38, 0x7c, 0x7c, 0x8a, 0x4f, 0xf4, 0x1c, 0x4c,
-		0x76, 0x7a, 0xdb, 0x9a, 0x18, 0x0b, 0x99, 0x2b, 0xf7, 0x47, 0x6a, 0xb6, 0x50, 0x10, 0xae, 0xdb,
-		0xc9, 0x4e, 0x6f, 0x9b, 0xa8, 0xcb, 0x59, 0xc8, 0x46, 0xd4, 0x26, 0x73, 0xdb, 0xaf, 0x08, 0x7d,
-		0xd5, 0x88, 0x37, 0x41, 0xeb, 0x74, 0x4d, 0xbb, 0x6b, 0xba, 0x07, 0xf4, 0xe8, 0x5d, 0x5c, 0x95,
-		0x45, 0xc6, 0x06, 0x4f, 0x57, 0x5a, 0x90, 0xaf, 0x53, 0x47, 0xdb, 0xaf, 0xfa, 0x35, 0xbf, 0x82,
-		0xd2, 0x18, 0x15, 0x1c, 0x5a, 0xb5, 0xd8, 0x40, 0xd5, 0x2e, 0xfe, 0x57, 0x09, 0x32, 0xe5, 0x96,
-		0x6d, 0xdc, 0xaa, 0x2d, 0x2d, 0xb7, 0xf4, 0x5d, 0x74, 0x19, 0x8e, 0x97, 0x57, 0xd6, 0x2b, 0x2f,
-		0x69, 0xb5, 0x25, 0x6d, 0x79, 0xa5, 0x74, 0xdd, 0x3f, 0xec, 0x3b, 0x77, 0xe2, 0x8d, 0xbb, 0x0b,
-		0x28, 0x40, 0xbb, 0x69, 0xd1, 0x85, 0x25, 0xba, 0x04, 0xb3, 0x61, 0x96, 0x52, 0xb9, 0x5e, 0x5d,
-		0x6b, 0xc8, 0xd2, 0xdc, 0xf1, 0x37, 0xee, 0x2e, 0x4c, 0x07, 0x38, 0x4a, 0xdb, 0x0e, 0xb6, 0xdc,
-		0x41, 0x86, 0xca, 0xfa, 0xea, 0x6a, 0xad, 0x21, 0xc7, 0x06, 0x18, 0x2a, 0x76, 0xbb, 0x6d, 0xba,
-		0xe8, 0x61, 0x98, 0x0e, 0x33, 0xac, 0xd5, 0x56, 0xe4, 0xf8, 0x1c, 0x7a, 0xe3, 0xee, 0xc2, 0x54,
-		0x80, 0x7a, 0xcd, 0x6c, 0xcd, 0xa5, 0xde, 0xff, 0x63, 0x67, 0x8e, 0x7d, 0xe2, 0x1f, 0x9e, 0x91,
-		0xca, 0x2b, 0x43, 0x47, 0xde, 0x95, 0xf1, 0x47, 0x9e, 0x18, 0x5a, 0xde, 0xc0, 0xfb, 0x68, 0x0c,
-		0xe6, 0xbd, 0xdc, 0xdb, 0xb8, 0xeb, 0x98, 0xb6, 0x45, 0x46, 0x0b, 0x53, 0x5b, 0xcf, 0x99, 0xe0,
-		0x9d, 0xc3, 0x09, 0x86, 0x1b, 0x9e, 0xe7, 0x21, 0x5e, 0xea, 0x74, 0xd0, 0x1c, 0x1d, 0x11, 0xae,
-		0x6d, 0xd8, 0x6c, 0x92, 0x4a, 0xa8, 0xde, 0x37, 0xc9, 0x73, 0xec, 0x1d, 0xf7, 0x8e, 0xde, 0xf5,
-		0x9e, 0xa9, 0x10, 0xdf, 0xca, 0x33, 0x90, 0xae, 0xd8, 0x96, 0x83, 0x2d, 0xa7, 0x47, 0x03, 0x0c,
-		0xdb, 0x44, 0x18, 0x1c, 0x81, 0x7d, 0x10, 0x23, 0xaf, 0x77, 0x3a, 0x94, 0x33, 0xa1, 0x92, 0x3f,
-		0xf9, 0xc4, 0xbd, 0x36, 0x54, 0x3c, 0x57, 0xc7, 0x17, 0x8f, 0x2f, 0x00, 0x4f, 0x40, 0xdf, 0x7f,
-		0x7f, 0xc0, 0x2c, 0x7b, 0x96, 0x29, 0x28, 0x9e, 0x08, 0xab, 0x34, 0x62, 0xd2, 0x9f, 0x1b, 0x6d,
-		0xeb, 0xe6, 0x46, 0xf5, 0xca, 0x10, 0xcb, 0x37, 0x2a, 0xdc, 0xa3, 0x3c, 0x03, 0xb9, 0x0d, 0xbd,
-		0xeb, 0xd6, 0xb1, 0x7b, 0x03, 0xeb, 0x4d, 0xdc, 0x0d, 0x7b, 0x13, 0x39, 0xe1, 0x4d, 0x20, 0x48,
-		0x50, 0x97, 0x81, 0x4d, 0xa6, 0xf4, 0x6f, 0xc5, 0x84, 0x04, 0x3d, 0x7b, 0xed, 0x79, 0x1a, 0x9c,
-		0x83, 0x79, 0x1a, 0xa4, 0xbb, 0x0e, 0x5c, 0xec, 0x88, 0x80, 0x21, 0xfd, 0x40, 0x4f, 0x0a, 0x7f,
-		0x21, 0x3e, 0xc2, 0x5f, 0xe0, 0x56, 0x88, 0x7b, 0x0d, 0x6d, 0x98, 0xe4, 0x03, 0xc1, 0xab, 0x89,
-		0xe4, 0xd7, 0x04, 0xad, 0x41, 0xbe, 0xa3, 0x77, 0x5d, 0x7a, 0xb5, 0x73, 0x8f, 0x36, 0x83, 0x5b,
-		0xba, 0x85, 0x08, 0xc3, 0x1b, 0x6a, 0x2e, 0x2f, 0x26, 0xd7, 0x09, 0x26, 0x2a, 0x9f, 0x4f, 0x40,
-		0x92, 0x8b, 0xe3, 0x05, 0x98, 0xe4, 0x02, 0xe7, 0xb6, 0xe9, 0xcc, 0x62, 0x84, 0xfa, 0x2f, 0x7a,
-		0x6a, 0xca, 0x01, 0x05, 0x13, 0x7a, 0x08, 0x52, 0xc6, 0x9e, 0x6e, 0x5a, 0x9a, 0xd9, 0xe4, 0x3e,
-		0x69, 0xe6, 0xb3, 0x6f, 0xce, 0x4f, 0x56, 0x48, 0x5a, 0x6d, 0x49, 0x9d, 0xa4, 0x99, 0xb5, 0x26,
-		0xf1, 0x71, 0xf6, 0xb0, 0xb9, 0xbb, 0xe7, 0x72, 0x03, 0xcb, 0xbf, 0xd0, 0xd3, 0x90, 0x20, 0x5d,
-		0xc6, 0xaf, 0xfe, 0xcf, 0x0d, 0x2c, 0x36, 0xbc, 0x78, 0x59, 0x39, 0x45, 0x0a, 0xfe, 0xc0, 0x7f,
-		0x9b, 0x97, 0x54, 0xca, 0x81, 0x96, 0x20, 0xd7, 0xd2, 0x1d, 0x57, 0xa3, 0xe3, 0x84, 0x14, 0x3f,
-		0xc1, 0x21, 0x06, 0x45, 0xc2, 0x65, 0xcb, 0xeb, 0x9e, 0x21, 0x6c, 0x2c, 0xa9, 0x89, 0x2e, 0x80,
-		0x4c, 0x51, 0x0c, 0x6a, 0xaa, 0x98, 0xdf, 0x98, 0xa4, 0xa2, 0x9f, 0x22, 0xe9, 0xcc, 0x82, 0x51,
-		0xef, 0xf1, 0x34, 0xa4, 0xe9, 0x6d, 0x63, 0x4a, 0xc2, 0x0e, 0xfd, 0xa7, 0x48, 0x02, 0xcd, 0x3c,
-		0x0f, 0x79, 0x7f, 0x86, 0x64, 0x24, 0x29, 0x86, 0xe2, 0x27, 0x53, 0xc2, 0xc7, 0x61, 0xd6, 0xc2,
-		0xfb, 0xf4, 0x1a, 0x42, 0x88, 0x3a, 0x4d, 0xa9, 0x11, 0xc9, 0xdb, 0x0a, 0x73, 0x3c, 0x08, 0x53,
-		0x86, 0x90, 0x3e, 0xa3, 0x05, 0x4a, 0x9b, 0xf3, 0x52, 0x29, 0xd9, 0x29, 0x48, 0xe9, 0x9d, 0x0e,
-		0x23, 0xc8, 0xf0, 0x09, 0xb2, 0xd3, 0xa1, 0x59, 0x17, 0x61, 0x9a, 0xb6, 0xb1, 0x8b, 0x9d, 0x5e,
-		0xcb, 0xe5, 0x20, 0x59, 0x4a, 0x93, 0x27, 0x19, 0x2a, 0x4b, 0xa7, 0xb4, 0xe7, 0x20, 0x87, 0x6f,
-		0x9b, 0x4d, 0x6c, 0x19, 0x98, 0xd1, 0xe5, 0x28, 0x5d, 0x56, 0x24, 0x52, 0xa2, 0x87, 0xc1, 0x9b,
-		0xf7, 0x34, 0x31, 0x29, 0x4f, 0x31, 0x3c, 0x91, 0x5e, 0x62, 0xc9, 0x4a, 0x01, 0x12, 0x4b, 0xba,
-		0xab, 0x13, 0x3b, 0xe6, 0xee, 0x33, 0x5f, 0x23, 0xab, 0x92, 0x3f, 0x95, 0x5f, 0x8a, 0x43, 0x62,
-		0xcb, 0x76, 0x31, 0xba, 0x1a, 0xf0, 0x6d, 0xa7, 0x22, 0x55, 0xba, 0x6e, 0xee, 0x5a, 0xb8, 0xb9,
-		0xea, 0xec, 0x06, 0xde, 0x06, 0xf2, 0x15, 0x2a, 0x16, 0x52, 0xa8, 0x59, 0x98, 0xe8, 0xda, 0x3d,
-		0xab, 0x29, 0xce, 0xcb, 0xd3, 0x0f, 0xb4, 0x0c, 0x29, 0x4f, 0x4f, 0x12, 0x23, 0xf5, 0x24, 0x4f,
-		0xf4, 0x84, 0xa8, 0x31, 0x4f, 0x50, 0x27, 0xb7, 0xb9, 0xba, 0x94, 0x21, 0xed, 0x59, 0x18, 0x4f,
-		0xe1, 0xc6, 0xd1, 0x59, 0x9f, 0x8d, 0xb8, 0x13, 0x5e, 0xef, 0x7b, 0xe2, 0x63, 0x3a, 0x27, 0x7b,
-		0x19, 0x5c, 0x7e, 0x21, 0xc5, 0xe2, 0x0f, 0x15, 0x4d, 0xd2, 0x86, 0xf9, 0x8a, 0xc5, 0x1e, 0x2b,
-		0xba, 0x0f, 0xd2, 0x8e, 0xb9, 0x6b, 0xe9, 0x6e, 0xaf, 0x8b, 0xb9, 0xee, 0xf9, 0x09, 0x24, 0xd7,
-		0xbf, 0x3c, 0xc2, 0x74, 0x2d, 0xf0, 0xe2, 0xdd, 0x25, 0x98, 0xf1, 0xdf, 0x9a, 0xf3, 0x51, 0x98,
-		0x9e, 0x21, 0x2f, 0xab, 0x2e, 0x72, 0x94, 0x7f, 0x2d, 0x41, 0x92, 0x4f, 0xee, 0x7e, 0x3f, 0x48,
-		0xd1, 0xfd, 0x10, 0x1b, 0xd6, 0x0f, 0xf1, 0xb7, 0xd4, 0x0f, 0xe0, 0xd5, 0xd3, 0xe1, 0xef, 0xd1,
-		0x44, 0x79, 0xa1, 0xac, 0x92, 0x75, 0x73, 0x97, 0x8f, 0xfd, 0x00, 0x97, 0xf2, 0xa6, 0x44, 0xa6,
-		0x5f, 0x9e, 0x8f, 0xca, 0x90, 0x13, 0x35, 0xd3, 0x76, 0x5a, 0xfa, 0x2e, 0x57, 0xc7, 0x33, 0xc3,
-		0xab, 0x47, 0x7c, 0x16, 0x35, 0xc3, 0x6b, 0x44, 0xbd, 0xaf, 0xc8, 0x9e, 0x8d, 0x0d, 0xe9, 0xd9,
-		0x90, 0x2a, 0xc5, 0xef, 0x4d, 0x95, 0x42, 0x9d, 0x9e, 0xe8, 0xeb, 0x74, 0xe5, 0x73, 0x12, 0x7f,
-		0xec, 0xae, 0xc9, 0x2e, 0xbf, 0xfc, 0x95, 0xf5, 0xd6, 0xd7, 0x73, 0xfd, 0x6a, 0xe2, 0xa6, 0x36,
-		0xd0, 0x6d, 0x0f, 0x44, 0x40, 0x86, 0x6b, 0xed, 0x77, 0x1f, 0x12, 0x30, 0x75, 0xbf, 0x1b, 0x7f,
-		0x3e, 0x06, 0xd3, 0x03, 0xf4, 0x7f, 0x0d, 0xbb, 0x33, 0x3c, 0x86, 0x27, 0xc6, 0x1c, 0xc3, 0xc9,
-		0xa1, 0x63, 0xf8, 0xe7, 0x63, 0x34, 0x32, 0xd0, 0xb1, 0x1d, 0xbd, 0xf5, 0x55, 0xb1, 0xc1, 0xa7,
-		0x21, 0xdd, 0xb1, 0x5b, 0x1a, 0xcb, 0x61, 0x37, 0x97, 0x52, 0x1d, 0xbb, 0xa5, 0x0e, 0xa8, 0xda,
-		0xc4, 0xdb, 0x65, 0xa0, 0x93, 0x6f, 0x43, 0x37, 0x4c, 0xf6, 0x8f, 0x2a, 0x17, 0xb2, 0x4c, 0x16,
-		0xdc, 0x83, 0xba, 0x4c, 0x84, 0x40, 0x7d, 0x32, 0xa9, 0xdf, 0xe7, 0xf3, 0xea, 0xcd, 0x48, 0x55,
-		0x4e, 0x48, 0x58, 0x98, 0xbf, 0x31, 0x18, 0x56, 0xea, 0xb3, 0x5c, 0x2a, 0x27, 0x54, 0x3e, 0x28,
-		0x01, 0xac, 0x10, 0xe1, 0xd2, 0x16, 0x13, 0xe7, 0xc7, 0xa1, 0x95, 0xd0, 0x42, 0x65, 0xcf, 0x0f,
-		0xed, 0x38, 0x5e, 0x83, 0xac, 0x13, 0xac, 0xfa, 0x12, 0xe4, 0x7c, 0x05, 0x77, 0xb0, 0xa8, 0xce,
-		0xfc, 0x61, 0xcb, 0xf9, 0x3a, 0x76, 0xd5, 0xec, 0xed, 0xc0, 0x97, 0xf2, 0x6f, 0x24, 0x48, 0xd3,
-		0x5a, 0xad, 0x62, 0x57, 0x0f, 0x75, 0xa4, 0xf4, 0x16, 0x3a, 0xf2, 0x7e, 0x00, 0x86, 0xe3, 0x98,
-		0xaf, 0x63, 0xae, 0x5f, 0x69, 0x9a, 0x52, 0x37, 0x5f, 0xc7, 0xe8, 0x29, 0x4f, 0xea, 0xf1, 0x11,
-		0x52, 0x17, 0xeb, 0x7d, 0x2e, 0xfb, 0x93, 0x30, 0x49, 0x5f, 0x66, 0xdd, 0x77, 0xf8, 0x12, 0x3e,
-		0x69, 0xf5, 0xda, 0x8d, 0x7d, 0x47, 0xb9, 0x05, 0x93, 0x8d, 0x7d, 0x16, 0x71, 0x3c, 0x0d, 0xe9,
-		0xae, 0x6d, 0x73, 0x6f, 0x90, 0x39, 0xe2, 0x29, 0x92, 0x40, 0x9d, 0x1f, 0x11, 0x64, 0x8b, 0xf9,
-		0x41, 0x36, 0x3f, 0x4c, 0x18, 0x1f, 0x2f, 0x4c, 0x48, 0xd6, 0xed, 0xb9, 0xd0, 0x88, 0x42, 0x8f,
-		0xc2, 0xc9, 0x7a, 0xed, 0xfa, 0x5a, 0x75, 0x49, 0x5b, 0xad, 0x5f, 0xef, 0x7b, 0x9d, 0x60, 0x2e,
-		0xff, 0xc6, 0xdd, 0x85, 0x0c, 0x5f, 0xb0, 0x0f, 0xa3, 0xde, 0x50, 0xab, 0x5b, 0xeb, 0x8d, 0xaa,
-		0x2c, 0x31, 0xea, 0x8d, 0x2e, 0xbe, 0x6d, 0xbb, 0xec, 0xed, 0xe3, 0xc7, 0xe1, 0x54, 0x04, 0xb5,
-		0xb7, 0x6c, 0x9f, 0x7e, 0xe3, 0xee, 0x42, 0x6e, 0xa3, 0x8b, 0x99, 0xaa, 0x51, 0x8e, 0x45, 0x28,
-		0x0c, 0x72, 0xac, 0x6f, 0xac, 0xd7, 0x4b, 0x2b, 0xf2, 0xc2, 0x9c, 0xfc, 0xc6, 0xdd, 0x85, 0xac,
-		0xb0, 0x1d, 0x84, 0xfe, 0x9d, 0x5f, 0xb7, 0x27, 0x06, 0xcf, 0x3b, 0xdc, 0xe9, 0xea, 0x9d, 0x0e,
-		0xee, 0x3a, 0xc3, 0x36, 0xf6, 0xcf, 0x41, 0x66, 0x29, 0x70, 0x6f, 0xd7, 0x3b, 0xe1, 0x21, 0xd1,
-		0x3b, 0xbd, 0xec, 0x43, 0x51, 0x00, 0x96, 0x5b, 0xb6, 0xee, 0x46, 0xd0, 0xc4, 0x02, 0x34, 0x35,
-		0xcb, 0xbd, 0x76, 0x35, 0x82, 0x26, 0x2e, 0x68, 0xce, 0x41, 0x66, 0x73, 0x18, 0x51, 0x22, 0x0c,
-		0xf4, 0xc4, 0x95, 0x08, 0x9a, 0x89, 0x3e, 0xa0, 0x48, 0xa2, 0x9c, 0x20, 0x3a, 0x0b, 0xe9, 0xb2,
-		0x6d, 0xb7, 0x22, 0x48, 0x52, 0x01, 0x9c, 0x7a, 0xe0, 0x4a, 0x72, 0x88, 0x28, 0x1d, 0xa8, 0x50,
-		0x99, 0xac, 0x5b, 0x23, 0x68, 0xbc, 0x33, 0x30, 0x47, 0x3e, 0xfa, 0xf1, 0x6e, 0xde, 0x2f, 0x47,
-		0x3d, 0xfa, 0x21, 0xfa, 0xf3, 0xde, 0x8e, 0x7e, 0x64, 0x03, 0x5b, 0x0f, 0x5e, 0x94, 0xa1, 0xa3,
-		0x77, 0xf5, 0xb6, 0x73, 0xd4, 0x70, 0xea, 0x88, 0x93, 0x35, 0x73, 0x23, 0x34, 0x91, 0xac, 0x6c,
-		0xf2, 0xde, 0x82, 0x79, 0x83, 0x56, 0x01, 0x5d, 0x0d, 0x46, 0x77, 0x32, 0xc3, 0xfd, 0x10, 0x46,
-		0x2e, 0xa2, 0x3f, 0xcf, 0x43, 0x4a, 0x2c, 0xbc, 0xb8, 0x6d, 0x3e, 0x1b, 0xe5, 0x2d, 0x71, 0x12,
-		0xce, 0xeb, 0xb1, 0xa0, 0xaf, 0x83, 0xb4, 0x67, 0xa9, 0xb9, 0x69, 0x52, 0x0e, 0xb3, 0xed, 0x1c,
-		0xc0, 0x67, 0x42, 0x45, 0x3f, 0x3c, 0x90, 0x18, 0x1a, 0x71, 0xd8, 0x62, 0x14, 0x9c, 0xdb, 0x0b,
-		0x0d, 0x3c, 0x09, 0x09, 0x7d, 0xdb, 0x30, 0xf9, 0x74, 0x7e, 0x7f, 0x04, 0x63, 0xa9, 0x5c, 0xa9,
-		0x31, 0x2e, 0xfa, 0x20, 0x07, 0x25, 0x27, 0x95, 0x76, 0x0e, 0x2c, 0x63, 0xaf, 0x6b, 0x5b, 0x07,
-		0x7c, 0x06, 0x8f, 0xaa, 0x74, 0x5d, 0xd0, 0x88, 0x4a, 0x7b, 0x4c, 0xa4, 0xd2, 0x3b, 0xd8, 0x9f,
-		0xbd, 0xa3, 0x2b, 0xbd, 0xcc, 0x28, 0x44, 0xa5, 0x39, 0x83, 0x52, 0xe3, 0xf1, 0x54, 0xde, 0x6d,
-		0xf4, 0x91, 0xaa, 0x7d, 0x8d, 0x45, 0x7a, 0xd8, 0x80, 0x4f, 0xb5, 0xf5, 0x7d, 0x3a, 0x68, 0xc8,
-		0x54, 0x42, 0x32, 0x77, 0xf9, 0xc3, 0x25, 0x71, 0x35, 0xd9, 0xd6, 0xf7, 0xaf, 0xeb, 0xce, 0xcd,
-		0x44, 0x2a, 0x2e, 0x27, 0x94, 0x4f, 0x12, 0xf7, 0x3b, 0xd4, 0x35, 0xe8, 0x11, 0x40, 0x84, 0x43,
-		0xdf, 0xc5, 0x1a, 0x99, 0x84, 0x68, 0x27, 0x0b, 0xdc, 0x7c, 0x5b, 0xdf, 0x2f, 0xed, 0xe2, 0xb5,
-		0x5e, 0x9b, 0x56, 0xc0, 0x41, 0xab, 0x20, 0x0b, 0x62, 0xa1, 0x80, 0x9e, 0xbf, 0x30, 0xf0, 0x50,
-		0x36, 0x27, 0x60, 0x0e, 0xcd, 0x07, 0x89, 0x43, 0x33, 0xc5, 0xf0, 0xbc, 0x23, 0x5f, 0xa1, 0xa6,
-		0xc4, 0xc3, 0x4d, 0x51, 0x5e, 0x84, 0x7c, 0x9f, 0x16, 0x20, 0x05, 0x72, 0x3c, 0x6a, 0x4d, 0x8f,
-		0xd3, 0xb0, 0xb5, 0x7b, 0x5a, 0xcd, 0xb0, 0xe0, 0x34, 0x1d, 0x7d, 0xc5, 0xd4, 0x2f, 0x7e, 0x6c,
-		0x5e, 0xa2, 0x5b, 0x97, 0x8f, 0x40, 0x2e, 0xa4, 0x06, 0x22, 0x70, 0x29, 0xf9, 0x81, 0x4b, 0x9f,
-		0xf8, 0x55, 0xc8, 0x92, 0xa9, 0x14, 0x37, 0x39, 0xed, 0x43, 0x90, 0x67, 0x73, 0x7d, 0xbf, 0xac,
-		0x99, 0x0f, 0xbf, 0x2a, 0x04, 0xae, 0x08, 0xa7, 0x3e, 0x2c, 0xf6, 0x8c, 0xa0, 0xba, 0xae, 0x3b,
-		0xca, 0x0f, 0x48, 0x90, 0xef, 0xd3, 0x0d, 0xf4, 0x3c, 0xa4, 0x3b, 0x5d, 0x6c, 0x98, 0x81, 0x30,
-		0xd7, 0x21, 0x22, 0x4c, 0x50, 0xf1, 0xf9, 0x1c, 0xc4, 0x4d, 0x12, 0xe7, 0x04, 0x9a, 0xb8, 0xa5,
-		0x1f, 0x8c, 0xee, 0x05, 0x06, 0x21, 0x7e, 0xb5, 0x60, 0x89, 0x30, 0x29, 0xbf, 0x2a, 0x41, 0x2e,
-		0xa4, 0x74, 0xa8, 0x09, 0xf7, 0x93, 0x29, 0x3a, 0x78, 0x36, 0x9d, 0xbf, 0xe6, 0x17, 0x58, 0xa3,
-		0x65, 0xae, 0x9c, 0x1e, 0x28, 0xc7, 0x9f, 0x68, 0xa8, 0x73, 0x23, 0xa9, 0x73, 0x04, 0xc7, 0x3f,
-		0xa2, 0xce, 0x9e, 0xfd, 0xbb, 0xc1, 0x9c, 0xf1, 0x75, 0x40, 0x9d, 0x6d, 0xb7, 0x1f, 0x3a, 0x36,
-		0x2e, 0xb4, 0x4c, 0x98, 0x83, 0x80, 0x4a, 0x1d, 0xc0, 0x1f, 0xb8, 0xa8, 0x34, 0x4e, 0x23, 0xe2,
-		0x87, 0xd5, 0xb0, 0x18, 0x2b, 0x48, 0xe5, 0x8d, 0x4f, 0x7c, 0xf6, 0x8c, 0xf4, 0x8e, 0xb8, 0x0e,
-		0xbf, 0x5b, 0x87, 0xfb, 0x7c, 0xd2, 0x6d, 0xc3, 0xec, 0x0f, 0x68, 0xcb, 0x9e, 0x71, 0x20, 0xb9,
-		0x64, 0x5a, 0x38, 0x7c, 0x3f, 0x6d, 0x64, 0xb8, 0x7b, 0xc4, 0x44, 0x34, 0x4e, 0x38, 0xfc, 0x1e,
-		0xa3, 0xdd, 0xff, 0x31, 0x0d, 0x93, 0x2a, 0x7e, 0x4f, 0x0f, 0x3b, 0x2e, 0x7a, 0x02, 0x12, 0xd8,
-		0xd8, 0xb3, 0x07, 0xb7, 0x9c, 0x78, 0x2b, 0x17, 0xab, 0xc6, 0x9e, 0xcd, 0x89, 0x6f, 0x1c, 0x53,
-		0x29, 0x31, 0xba, 0x06, 0x13, 0x3b, 0xad, 0x1e, 0x0f, 0x84, 0x87, 0xa6, 0x29, 0xc1, 0xb5, 0x4c,
-		0xb2, 0x7d, 0x36, 0x46, 0x4e, 0x0a, 0xa3, 0x3f, 0x27, 0x11, 0x1f, 0x56, 0x18, 0xfd, 0x15, 0x09,
-		0xbf, 0x30, 0x42, 0x8c, 0x2a, 0x00, 0xa6, 0x65, 0xba, 0x1a, 0x8d, 0x11, 0xf3, 0x69, 0x42, 0x89,
-		0x62, 0x35, 0x5d, 0x1a, 0x4f, 0xf6, 0xf9, 0xd3, 0xa6, 0x48, 0x23, 0x35, 0x7e, 0x4f, 0x0f, 0x77,
-		0xc5, 0x54, 0x11, 0x51, 0xe3, 0x77, 0x91, 0xec, 0x40, 0x8d, 0x29, 0x39, 0x99, 0x5a, 0xd9, 0x53,
-		0xa7, 0xee, 0x3e, 0x7f, 0xc0, 0x7b, 0x61, 0x90, 0x95, 0xbe, 0x74, 0xda, 0xd8, 0xf7, 0x99, 0x27,
-		0x0d, 0x96, 0x82, 0x9e, 0xf1, 0x96, 0x70, 0x99, 0xfe, 0x35, 0x93, 0xc7, 0xcc, 0x56, 0x70, 0x1e,
-		0x2f, 0x67, 0x40, 0xeb, 0x30, 0xd5, 0x32, 0x1d, 0x57, 0x73, 0x2c, 0xbd, 0xe3, 0xec, 0xd9, 0xae,
-		0x43, 0x63, 0xb1, 0x99, 0x2b, 0x0f, 0x0d, 0x42, 0xac, 0x98, 0x8e, 0x5b, 0x17, 0x64, 0x3e, 0x52,
-		0xae, 0x15, 0x4c, 0x27, 0x80, 0xf6, 0xce, 0x0e, 0xee, 0x7a, 0x88, 0x34, 0x68, 0x1b, 0x09, 0xb8,
-		0x4e, 0xe8, 0x04, 0x67, 0x00, 0xd0, 0x0e, 0xa6, 0xa3, 0x6f, 0x80, 0x99, 0x96, 0xad, 0x37, 0x3d,
-		0x3c, 0xcd, 0xd8, 0xeb, 0x59, 0xb7, 0x68, 0x88, 0x37, 0x73, 0xe5, 0x62, 0x44, 0x35, 0x6d, 0xbd,
-		0x29, 0x98, 0x2b, 0x84, 0xd4, 0x47, 0x9e, 0x6e, 0xf5, 0xe7, 0x21, 0x0d, 0x66, 0xf5, 0x4e, 0xa7,
-		0x75, 0xd0, 0x0f, 0x9f, 0xa7, 0xf0, 0x8f, 0x0c, 0xc2, 0x97, 0x08, 0xf5, 0x10, 0x7c, 0xa4, 0x0f,
-		0x64, 0xa2, 0x4d, 0x90, 0x3b, 0x5d, 0x4c, 0xef, 0xad, 0x76, 0xf8, 0x22, 0x85, 0xbe, 0x11, 0x98,
-		0xb9, 0x72, 0x61, 0x10, 0x7c, 0x83, 0x51, 0x8a, 0xd5, 0x8c, 0x8f, 0x9c, 0xef, 0x84, 0x73, 0x18,
-		0xac, 0x6d, 0x60, 0xfa, 0x86, 0x29, 0x87, 0x9d, 0x1e, 0x0e, 0x4b, 0x29, 0x23, 0x61, 0x43, 0x39,
-		0x68, 0x19, 0x32, 0x2c, 0xaa, 0xa5, 0x11, 0x13, 0x49, 0xdf, 0x16, 0xcc, 0x5c, 0x39, 0x17, 0x31,
-		0x5c, 0x29, 0xd1, 0x96, 0xed, 0x62, 0x1f, 0x0c, 0xb0, 0x97, 0x88, 0xb6, 0xe1, 0x38, 0x7d, 0x67,
-		0xf1, 0x40, 0x0b, 0xdb, 0xe3, 0xc2, 0x0c, 0x45, 0x7c, 0x74, 0x10, 0x91, 0xfe, 0xc8, 0xc0, 0xc1,
-		0x56, 0xd0, 0x30, 0xfb, 0xd0, 0x33, 0xb7, 0x07, 0x73, 0x89, 0xa6, 0xed, 0x98, 0x96, 0xde, 0x32,
-		0x5f, 0xc7, 0xcc, 0x79, 0xa1, 0x4f, 0x0c, 0x47, 0x6a, 0xda, 0x32, 0xa7, 0xa3, 0xce, 0x4c, 0x40,
-		0xd3, 0x76, 0x82, 0xe9, 0xe5, 0x49, 0xbe, 0xe4, 0xf0, 0xde, 0xcc, 0x9c, 0x94, 0x53, 0xec, 0x9d,
-		0xcc, 0x9b, 0x89, 0x14, 0xc8, 0x19, 0xe5, 0x3c, 0x64, 0x02, 0x76, 0x0a, 0x15, 0x60, 0x92, 0x4f,
-		0xaa, 0xe2, 0x00, 0x3f, 0xff, 0x54, 0xa6, 0x20, 0x1b, 0x34, 0x4d, 0xca, 0x07, 0x24, 0xc8, 0x04,
-		0x8c, 0x0e, 0xe1, 0x0c, 0x6e, 0x74, 0xa5, 0x7d, 0x3f, 0xf5, 0x9c, 0xf0, 0x2a, 0x44, 0x3e, 0xdb,
-		0x6c, 0xcd, 0xd2, 0x44, 0xee, 0xd4, 0xa0, 0x79, 0xc8, 0x74, 0xae, 0x74, 0x3c, 0x92, 0x38, 0x25,
-		0x81, 0xce, 0x95, 0x8e, 0x20, 0x38, 0x0b, 0x59, 0xd2, 0x74, 0x2d, 0xe8, 0x2e, 0xa7, 0xd5, 0x0c,
-		0x49, 0xe3, 0x24, 0xca, 0x6f, 0xc6, 0x40, 0xee, 0x37, 0x66, 0xde, 0x06, 0x98, 0x74, 0xe4, 0x0d,
-		0xb0, 0x53, 0xfd, 0x5b, 0x6f, 0xfe, 0x6e, 0xdb, 0x2a, 0xc8, 0xfe, 0x9e, 0x11, 0x9b, 0x7b, 0x0e,
-		0xf1, 0xff, 0xfb, 0xd6, 0x2a, 0x6a, 0xde, 0xe8, 0x5b, 0xbc, 0x5c, 0x0f, 0x9d, 0x17, 0x49, 0x78,
-		0x47, 0x5c, 0xfb, 0xf5, 0x49, 0xd0, 0x6c, 0x76, 0x9a, 0xba, 0x8b, 0x45, 0xc8, 0x3d, 0x70, 0x74,
-		0xe4, 0x21, 0xc8, 0xeb, 0x9d, 0x8e, 0xe6, 0xb8, 0xba, 0x8b, 0xb9, 0xa3, 0xc7, 0x02, 0x99, 0x39,
-		0xbd, 0xd3, 0xa1, 0xbf, 0x73, 0xc1, 0x1c, 0xbd, 0x07, 0x61, 0x8a, 0x58, 0x78, 0x53, 0x6f, 0x09,
-		0x2f, 0x22, 0xc9, 0xfc, 0x41, 0x9e, 0xca, 0x3d, 0x91, 0x26, 0x64, 0x83, 0xc6, 0xdd, 0x0b, 0xcd,
-		0x48, 0x81, 0xd0, 0x0c, 0xe2, 0x0f, 0x2f, 0x31, 0x09, 0x89, 0xc7, 0xaa, 0xa2, 0x37, 0x23, 0x67,
-		0x69, 0x18, 0xe7, 0x36, 0x8b, 0xbd, 0xa6, 0x54, 0xf6, 0xa1, 0xbc, 0x02, 0x53, 0xe1, 0x79, 0x00,
-		0x4d, 0x41, 0xcc, 0xdd, 0xe7, 0xa5, 0xc4, 0xdc, 0x7d, 0x74, 0x39, 0xf0, 0x0b, 0x21, 0x53, 0x51,
-		0xb3, 0x1f, 0xe7, 0xf7, 0x43, 0xa7, 0x37, 0x13, 0xa9, 0x98, 0x1c, 0x57, 0xf2, 0x90, 0x0b, 0xcd,
-		0x12, 0xca, 0x09, 0x98, 0x8d, 0xb2, 0xf9, 0x8a, 0x09, 0xb3, 0x51, 0xa6, 0x1b, 0x5d, 0x83, 0x94,
-		0x67, 0xf4, 0x07, 0xa2, 0x6d, 0xa2, 0x74, 0x8f, 0xc9, 0xa3, 0x0d, 0xed, 0x16, 0xc6, 0x42, 0xbb,
-		0x85, 0xca, 0x37, 0x43, 0x61, 0x98, 0x3d, 0xef, 0xdb, 0x3e, 0x48, 0x78, 0x82, 0x3b, 0x01, 0x49,
-		0xfe, 0xda, 0x70, 0x8c, 0x86, 0x29, 0xf8, 0x17, 0x11, 0x28, 0xb3, 0xed, 0x71, 0x16, 0xbd, 0xa0,
-		0x1f, 0x8a, 0x06, 0xa7, 0x86, 0x9a, 0xf4, 0xe1, 0xbb, 0xed, 0x0c, 0x88, 0xef, 0xb6, 0xd3, 0x0f,
-		0xfa, 0x2b, 0x54, 0xd8, 0x12, 0x41, 0xc0, 0xb4, 0xca, 0xbf, 0x94, 0x0f, 0xc5, 0xe1, 0x44, 0xb4,
-		0x5d, 0x47, 0x0b, 0x90, 0x25, 0x8b, 0x07, 0x37, 0xbc, 0xce, 0x80, 0xb6, 0xbe, 0xdf, 0xe0, 0x8b,
-		0x0c, 0xbe, 0x53, 0x19, 0xf3, 0x76, 0x2a, 0xd1, 0x16, 0x4c, 0xb7, 0x6c, 0x43, 0x6f, 0x69, 0x81,
-		0x9d, 0x62, 0x3e, 0x9c, 0x1e, 0x18, 0x66, 0xa7, 0xc5, 0x5e, 0x04, 0x31, 0x41, 0x7c, 0x20, 0xe4,
-		0x29, 0xc8, 0x8a, 0xb7, 0xab, 0x8c, 0xaa, 0x90, 0x69, 0x9b, 0xce, 0x36, 0xde, 0xd3, 0x6f, 0x9b,
-		0x76, 0x97, 0x8f, 0xab, 0x08, 0xed, 0x59, 0xf5, 0x89, 0xc4, 0x16, 0x76, 0x80, 0x2f, 0xd0, 0x29,
-		0x13, 0x91, 0x5b, 0xeb, 0xc9, 0x23, 0x5b, 0x96, 0x61, 0x9b, 0xd4, 0x93, 0x43, 0x37, 0xa9, 0xa3,
-		0x76, 0x84, 0x53, 0xd1, 0x3b, 0xc2, 0x6f, 0xd0, 0xce, 0x89, 0x9a, 0x1d, 0x07, 0x37, 0x89, 0x51,
-		0x03, 0x66, 0x39, 0x7f, 0x33, 0x24, 0xfd, 0x81, 0x73, 0x67, 0x61, 0xa7, 0x2b, 0x20, 0x75, 0x24,
-		0xf8, 0x87, 0x0b, 0x3e, 0x7e, 0x8f, 0x82, 0x17, 0x47, 0x35, 0x12, 0x81, 0xa3, 0x1a, 0xff, 0x8f,
-		0x75, 0xc6, 0xfb, 0xe2, 0x62, 0xf3, 0x2c, 0xe0, 0x58, 0x44, 0x9e, 0x41, 0x19, 0xb6, 0xd7, 0x23,
-		0x1a, 0x16, 0x3f, 0x72, 0xc3, 0x78, 0x6f, 0x27, 0x46, 0xf7, 0xf6, 0xc4, 0xdb, 0xd9, 0xdb, 0xc9,
-		0x7b, 0xec, 0xed, 0x77, 0xb4, 0x1f, 0x3e, 0x22, 0xc1, 0xdc, 0x70, 0x77, 0x2c, 0xb2, 0x43, 0x8e,
-		0xb4, 0x3b, 0x39, 0x6c, 0xc6, 0x7b, 0x10, 0xa6, 0xfa, 0xbc, 0x45, 0xa6, 0xcc, 0xb9, 0xd0, 0x72,
-		0x5d, 0xf9, 0xf6, 0x38, 0xcc, 0x46, 0x39, 0x74, 0x11, 0x23, 0x56, 0x85, 0x99, 0x26, 0x36, 0xcc,
-		0xe6, 0x3d, 0x0f, 0xd8, 0x69, 0xce, 0xfe, 0xff, 0xc7, 0x6b, 0x84, 0x9e, 0xfc, 0x38, 0x40, 0x4a,
-		0xc5, 0x4e, 0x87, 0x38, 0x68, 0xec, 0xd7, 0x0e, 0x0d, 0xdc, 0x71, 0xfd, 0xb0, 0x56, 0xe4, 0xba,
-		0x81, 0x93, 0x08, 0x3e, 0xb2, 0x7e, 0xf6, 0xf8, 0xd0, 0x55, 0x1e, 0x26, 0x18, 0xba, 0xe0, 0x67,
-		0xee, 0xb7, 0xc7, 0xca, 0xe2, 0x04, 0x4f, 0x89, 0x38, 0x41, 0x7c, 0xd8, 0xea, 0x97, 0x3b, 0xe3,
-		0x1e, 0x1f, 0x0f, 0x14, 0x5c, 0xe5, 0x81, 0x82, 0xc4, 0xb0, 0xe2, 0x98, 0xcf, 0xee, 0x17, 0x67,
-		0xb2, 0x87, 0x4c, 0x83, 0x91, 0x82, 0xe4, 0xb0, 0xa6, 0x06, 0x9c, 0x6b, 0xbf, 0xa9, 0x7e, 0xa8,
-		0xe0, 0x29, 0x11, 0x2a, 0x98, 0x1c, 0x56, 0x69, 0xee, 0x4d, 0xfa, 0x95, 0x66, 0xb1, 0x82, 0x17,
-		0x02, 0xb1, 0x82, 0x74, 0x7f, 0x18, 0x7e, 0x20, 0x56, 0xe0, 0x71, 0x7b, 0xc1, 0x82, 0xa2, 0x17,
-		0x2c, 0xc8, 0x0e, 0x8d, 0x34, 0x70, 0x37, 0xd0, 0x63, 0x16, 0xd1, 0x82, 0x8d, 0x81, 0x68, 0x01,
-		0x5b, 0xdc, 0x9f, 0x1f, 0x19, 0x2d, 0xf0, 0xa0, 0xfa, 0xc2, 0x05, 0x1b, 0x03, 0xe1, 0x82, 0xa9,
-		0x61, 0x88, 0x7d, 0x3e, 0xa7, 0x8f, 0x18, 0x8e, 0x17, 0x7c, 0x63, 0x74, 0xbc, 0x60, 0xe8, 0x82,
-		0x3e, 0xc2, 0xbf, 0xf4, 0xa0, 0x23, 0x02, 0x06, 0xdf, 0x3c, 0x24, 0x60, 0x20, 0x0f, 0x5b, 0xd8,
-		0x46, 0x79, 0x97, 0x5e, 0x01, 0x51, 0x11, 0x83, 0xad, 0x88, 0x88, 0x01, 0x5b, 0xda, 0x3f, 0x3c,
-		0x46, 0xc4, 0xc0, 0x83, 0x1e, 0x08, 0x19, 0x6c, 0x45, 0x84, 0x0c, 0xd0, 0x70, 0xdc, 0x3e, 0xa7,
-		0x28, 0x88, 0x1b, 0x8e, 0x19, 0x5c, 0x0f, 0xc7, 0x0c, 0x66, 0x0e, 0xf7, 0x45, 0xd9, 0xd4, 0xee,
-		0xa1, 0x05, 0x83, 0x06, 0xc6, 0xb0, 0xa0, 0x01, 0x5b, 0xd7, 0x3f, 0x36, 0x66, 0xd0, 0xc0, 0xc3,
-		0x8e, 0x8c, 0x1a, 0x6c, 0x0c, 0x44, 0x0d, 0x8e, 0x0f, 0x53, 0xb8, 0xbe, 0x49, 0xc6, 0x57, 0xb8,
-		0xa1, 0x61, 0x03, 0xf6, 0x23, 0x1b, 0xec, 0xe7, 0x35, 0x40, 0xce, 0xdc, 0x4c, 0xa4, 0x32, 0x72,
-		0x56, 0x79, 0x98, 0xb8, 0x35, 0x7d, 0x76, 0x8f, 0x2c, 0x22, 0x70, 0xb7, 0x6b, 0x77, 0xc5, 0x1e,
-		0x28, 0xfd, 0x50, 0x2e, 0x40, 0x36, 0x68, 0xe2, 0x0e, 0x09, 0x31, 0xe4, 0x21, 0x17, 0xb2, 0x6a,
-		0xca, 0x2f, 0x4a, 0x90, 0x0d, 0xda, 0xab, 0xd0, 0x02, 0x34, 0xcd, 0x17, 0xa0, 0x81, 0xc0, 0x43,
-		0x2c, 0x1c, 0x78, 0x98, 0x87, 0x0c, 0x59, 0x84, 0xf5, 0xc5, 0x14, 0xf4, 0x8e, 0x17, 0x53, 0x10,
-		0x07, 0x37, 0x59, 0x78, 0x82, 0xcf, 0x53, 0xec, 0xd4, 0x42, 0xde, 0x3b, 0xc4, 0xca, 0xc3, 0xfc,
-		0x8f, 0xc1, 0x4c, 0x80, 0xd6, 0x5b, 0xdc, 0xb1, 0xe5, 0xb5, 0xec, 0x51, 0x97, 0xf8, 0x2a, 0xef,
-		0x57, 0x25, 0x98, 0x1e, 0x30, 0x97, 0x91, 0x71, 0x03, 0xe9, 0xed, 0x8a, 0x1b, 0xc4, 0xee, 0x3d,
-		0x6e, 0x10, 0x5c, 0xae, 0xc6, 0xc3, 0xcb, 0xd5, 0xbf, 0x94, 0x20, 0x17, 0x32, 0xdb, 0xa4, 0x13,
-		0x0c, 0xbb, 0x29, 0x76, 0xcc, 0xe9, 0xdf, 0xc4, 0x4f, 0x69, 0xd9, 0xbb, 0x7c, 0x99, 0x48, 0xfe,
-		0x24, 0x54, 0xde, 0x44, 0x94, 0xe6, 0xd3, 0x8c, 0xb7, 0xf6, 0x9c, 0x08, 0xde, 0x29, 0xe3, 0xf7,
-		0xac, 0x92, 0xfe, 0x3d, 0x2b, 0x6f, 0xa3, 0x7c, 0x32, 0xb0, 0x51, 0x8e, 0x9e, 0x81, 0x34, 0xdd,
-		0x05, 0xd0, 0xec, 0x8e, 0xff, 0xc3, 0xcc, 0xc3, 0xef, 0x58, 0x39, 0xf4, 0x92, 0x00, 0xbb, 0x98,
-		0xe5, 0x7b, 0x21, 0xe9, 0x90, 0x17, 0x72, 0x1f, 0xa4, 0x49, 0xf5, 0xd9, 0x8f, 0x1b, 0x01, 0x7f,
-		0x6a, 0x44, 0x24, 0x28, 0x3f, 0x15, 0x83, 0x7c, 0xdf, 0xac, 0x13, 0xd9, 0xf8, 0xa8, 0x13, 0x2b,
-		0xe3, 0x09, 0xe4, 0x0c, 0xc0, 0xae, 0xee, 0x68, 0x77, 0x74, 0xcb, 0xe5, 0xbf, 0x61, 0x1a, 0x57,
-		0x03, 0x29, 0x68, 0x0e, 0x52, 0xe4, 0xab, 0xe7, 0xf0, 0x5f, 0x31, 0x8d, 0xab, 0xde, 0x37, 0xaa,
-		0x41, 0x12, 0xdf, 0xa6, 0xcf, 0x71, 0xb3, 0x47, 0xed, 0x4f, 0x46, 0x98, 0x27, 0x92, 0x5f, 0x2e,
-		0x90, 0xee, 0xfe, 0xa3, 0x37, 0xe7, 0x65, 0x46, 0xfe, 0xa8, 0x77, 0x81, 0x55, 0xe5, 0x00, 0x61,
-		0x31, 0xa4, 0xfa, 0xc4, 0x40, 0xc3, 0x85, 0x59, 0xb1, 0xf6, 0x27, 0x42, 0x65, 0x37, 0x71, 0xd4,
-		0x5c, 0x1b, 0xb7, 0x3b, 0xb6, 0xdd, 0xd2, 0xd8, 0x38, 0x2f, 0xc1, 0x54, 0x78, 0x92, 0x65, 0xbf,
-		0x3c, 0xe8, 0xea, 0xa6, 0xa5, 0x85, 0x7c, 0xe3, 0x2c, 0x4b, 0x64, 0xe3, 0xea, 0x66, 0x22, 0x25,
-		0xc9, 0x31, 0x1e, 0xae, 0x79, 0x17, 0x1c, 0x8f, 0x9c, 0x63, 0xd1, 0xd3, 0x90, 0xf6, 0xe7, 0x67,
-		0x76, 0x9f, 0xea, 0xb0, 0x38, 0x8c, 0x4f, 0xac, 0x6c, 0xc1, 0xf1, 0xc8, 0x49, 0x16, 0x3d, 0x0f,
-		0x49, 0x76, 0x5e, 0x9b, 0x9f, 0xc9, 0x7b, 0x70, 0xf4, 0xec, 0xdc, 0x6b, 0xb9, 0x2a, 0x67, 0x52,
-		0x2e, 0xc3, 0xa9, 0xa1, 0xb3, 0xac, 0x1f, 0x4d, 0x91, 0x02, 0xd1, 0x14, 0xe5, 0x67, 0x24, 0x98,
-		0x1b, 0x3e, 0x73, 0xa2, 0x72, 0x5f, 0x85, 0x2e, 0x8e, 0x39, 0xef, 0x06, 0x6a, 0x45, 0x96, 0x1b,
-		0x5d, 0xbc, 0x83, 0x5d, 0x63, 0x8f, 0x4d, 0xe1, 0xcc, 0x28, 0xe4, 0xd4, 0x1c, 0x4f, 0xa5, 0x3c,
-		0x0e, 0x23, 0x7b, 0x0d, 0x1b, 0xae, 0xc6, 0x3a, 0xd5, 0xe1, 0x3f, 0x35, 0x9f, 0x63, 0xa9, 0x75,
-		0x96, 0xa8, 0x3c, 0x02, 0x27, 0x87, 0xcc, 0xc5, 0x11, 0xc7, 0xcd, 0x5f, 0x25, 0xc4, 0x91, 0x13,
-		0x2c, 0x7a, 0x11, 0x92, 0x8e, 0xab, 0xbb, 0x3d, 0x87, 0xb7, 0xec, 0xfc, 0xc8, 0xb9, 0xb9, 0x4e,
-		0xc9, 0x55, 0xce, 0xa6, 0x3c, 0x0b, 0x68, 0x70, 0xa6, 0x8d, 0x58, 0x5b, 0x49, 0x51, 0x6b, 0xab,
-		0x6d, 0x38, 0x7d, 0xc8, 0x9c, 0x8a, 0x2a, 0x7d, 0x95, 0x7b, 0x64, 0xac, 0x29, 0xb9, 0xaf, 0x82,
-		0x7f, 0x12, 0x83, 0xe3, 0x91, 0x53, 0x6b, 0x60, 0x94, 0x4a, 0x6f, 0x75, 0x94, 0x3e, 0x0f, 0xe0,
-		0xee, 0x8b, 0x4b, 0x06, 0xdc, 0xda, 0x47, 0xad, 0x27, 0xf6, 0xb1, 0x41, 0x0d, 0x16, 0x51, 0x8c,
-		0xb4, 0xcb, 0xff, 0x22, 0x8b, 0xff, 0xc0, 0x7a, 0xb6, 0x47, 0x67, 0x02, 0x87, 0x2f, 0xf5, 0xc6,
-		0x9e, 0x33, 0xfc, 0x85, 0x2f, 0x4b, 0x76, 0xd0, 0xab, 0x70, 0xb2, 0x6f, 0x46, 0xf3, 0xb0, 0x13,
-		0x63, 0x4f, 0x6c, 0xc7, 0xc3, 0x13, 0x9b, 0xc0, 0x0e, 0xce, 0x4a, 0x13, 0xe1, 0x59, 0xe9, 0x55,
-		0x00, 0x7f, 0x61, 0xeb, 0x9f, 0x87, 0x95, 0x82, 0xe7, 0x61, 0xaf, 0xc1, 0x04, 0xd1, 0x04, 0x21,
-		0xaa, 0x08, 0x83, 0x41, 0xba, 0x34, 0xb0, 0x32, 0x66, 0xe4, 0xca, 0x6b, 0x42, 0xdb, 0x82, 0x31,
-		0xc6, 0x21, 0x65, 0xbc, 0x10, 0x2e, 0x43, 0x19, 0x1e, 0xae, 0x8c, 0x2e, 0xeb, 0x6f, 0xc1, 0x04,
-		0xed, 0xfe, 0xc8, 0x0b, 0xc8, 0xdf, 0x04, 0xa0, 0xbb, 0x6e, 0xd7, 0xdc, 0xee, 0xf9, 0x25, 0x2c,
-		0x0c, 0xd1, 0x9f, 0x92, 0x20, 0x2c, 0xdf, 0xc7, 0x15, 0x69, 0xd6, 0xe7, 0x0d, 0x28, 0x53, 0x00,
-		0x51, 0x59, 0x83, 0xa9, 0x30, 0x6f, 0xf4, 0x8d, 0x6a, 0xff, 0xdd, 0x26, 0x71, 0xae, 0xcd, 0x9f,
-		0xc8, 0xf9, 0x5b, 0x6a, 0xf4, 0x43, 0xf9, 0x96, 0x18, 0x64, 0x83, 0xda, 0xf7, 0x37, 0x70, 0xb2,
-		0x54, 0xbe, 0x5d, 0x82, 0x94, 0xd7, 0xfe, 0x43, 0x6e, 0x03, 0xf8, 0x77, 0xeb, 0xbd, 0x18, 0x3c,
-		0xdb, 0xf5, 0x88, 0x7b, 0xbb, 0x1e, 0xcf, 0x79, 0x13, 0xc2, 0xd0, 0xc5, 0x7c, 0x50, 0xda, 0xe2,
-		0x1c, 0x2e, 0x9f, 0xa0, 0x9e, 0x1d, 0xef, 0x72, 0xef, 0x2c, 0x4c, 0x04, 0xef, 0xe5, 0xb2, 0x0f,
-		0x05, 0x07, 0x8e, 0x2b, 0xb1, 0xd1, 0x18, 0xbc, 0x05, 0x2c, 0x1d, 0xfd, 0x16, 0xb0, 0x57, 0x4c,
-		0x2c, 0x58, 0xcc, 0x3f, 0x90, 0x20, 0x25, 0xc6, 0x05, 0x7a, 0x31, 0x78, 0x98, 0x4e, 0x9c, 0xcc,
-		0x19, 0x6e, 0x97, 0x78, 0x01, 0x81, 0xb3, 0x74, 0x03, 0x57, 0x12, 0xe2, 0x47, 0xbe, 0x92, 0xc0,
-		0xfd, 0x90, 0x2f, 0x4b, 0x20, 0xf7, 0x8f, 0xdb, 0xb7, 0x5e, 0xbf, 0xc1, 0xf9, 0x2a, 0x1e, 0x31,
-		0x5f, 0x0d, 0xbb, 0x68, 0x90, 0x18, 0x76, 0xd1, 0x60, 0xb0, 0xdd, 0x13, 0xf7, 0xda, 0xee, 0xf7,
-		0xc5, 0x20, 0x13, 0x88, 0xf1, 0xa1, 0x27, 0x43, 0xb7, 0x16, 0xce, 0x1e, 0x1a, 0x10, 0x0c, 0x5c,
-		0x5b, 0x08, 0x49, 0x2a, 0x76, 0x0f, 0x92, 0x7a, 0xfb, 0x2f, 0x33, 0x46, 0xdf, 0x8c, 0x9f, 0x18,
-		0x72, 0x33, 0xfe, 0xef, 0x48, 0x90, 0xf2, 0x82, 0x2f, 0x47, 0xdd, 0x93, 0x3b, 0x01, 0x49, 0xee,
-		0x7b, 0xb1, 0x4d, 0x39, 0xfe, 0x15, 0x19, 0x1d, 0x9d, 0x83, 0x94, 0xf8, 0x95, 0x55, 0x3e, 0xc3,
-		0x79, 0xdf, 0x17, 0xb7, 0x21, 0x13, 0xd8, 0xd6, 0x44, 0xa7, 0xe0, 0x78, 0xe5, 0x46, 0xb5, 0xf2,
-		0x92, 0xd6, 0x78, 0xb9, 0xff, 0xb7, 0xf5, 0x06, 0xb2, 0xd4, 0x2a, 0xfd, 0x96, 0x25, 0x74, 0x12,
-		0x66, 0xc2, 0x59, 0x2c, 0x23, 0x36, 0x97, 0x78, 0xff, 0x8f, 0x9d, 0x39, 0x76, 0xf1, 0xcb, 0x12,
-		0xcc, 0x44, 0x78, 0xb9, 0xe8, 0x2c, 0xdc, 0xbf, 0xbe, 0xbc, 0x5c, 0x55, 0xb5, 0xfa, 0x5a, 0x69,
-		0xa3, 0x7e, 0x63, 0xbd, 0xa1, 0xa9, 0xd5, 0xfa, 0xe6, 0x4a, 0x23, 0x50, 0xe8, 0x02, 0xdc, 0x17,
-		0x4d, 0x52, 0xaa, 0x54, 0xaa, 0x1b, 0x0d, 0xf6, 0xe3, 0x7e, 0x43, 0x28, 0xca, 0xeb, 0x6a, 0x43,
-		0x8e, 0x0d, 0x87, 0x50, 0xab, 0x37, 0xab, 0x95, 0x86, 0x1c, 0x47, 0xe7, 0xe1, 0xdc, 0x61, 0x14,
-		0xda, 0xf2, 0xba, 0xba, 0x5a, 0x6a, 0xc8, 0x89, 0x91, 0x84, 0xf5, 0xea, 0xda, 0x52, 0x55, 0x95,
-		0x27, 0x78, 0xbb, 0x3f, 0x16, 0x83, 0xc2, 0x30, 0x67, 0x9a, 0x60, 0x95, 0x36, 0x36, 0x56, 0x5e,
-		0xf1, 0xb1, 0x2a, 0x37, 0x36, 0xd7, 0x5e, 0x1a, 0x14, 0xc1, 0x43, 0xa0, 0x1c, 0x46, 0xe8, 0x09,
-		0xe2, 0x41, 0x38, 0x7b, 0x28, 0x1d, 0x17, 0xc7, 0x08, 0x32, 0xb5, 0xda, 0x50, 0x5f, 0x91, 0xe3,
-		0x68, 0x11, 0x2e, 0x8e, 0x24, 0xf3, 0xf2, 0xe4, 0x04, 0xba, 0x04, 0x8f, 0x1c, 0x4e, 0xcf, 0x04,
-		0x24, 0x18, 0x84, 0x88, 0xde, 0x90, 0xe0, 0x78, 0xa4, 0x57, 0x8e, 0xce, 0xc1, 0xfc, 0x86, 0xba,
-		0x5e, 0xa9, 0xd6, 0xeb, 0xde, 0x9d, 0x05, 0xad, 0xde, 0x28, 0x35, 0x36, 0xeb, 0x01, 0xd9, 0x28,
-		0x70, 0x66, 0x18, 0x91, 0x27, 0x97, 0x43, 0x68, 0xb8, 0x06, 0x08, 0x3d, 0xbd, 0x2b, 0xc1, 0xa9,
-		0xa1, 0x5e, 0x38, 0xba, 0x00, 0x0f, 0x6c, 0x55, 0xd5, 0xda, 0xf2, 0x2b, 0xda, 0xd6, 0x7a, 0x23,
-		0xf8, 0x2b, 0x92, 0x03, 0xb5, 0x3a, 0x0f, 0xe7, 0x0e, 0xa5, 0xf4, 0xaa, 0x36, 0x8a, 0xb0, 0xaf,
-		0x7e, 0xdf, 0x26, 0x41, 0xbe, 0xcf, 0x16, 0xa2, 0xfb, 0xa0, 0xb0, 0x5a, 0xab, 0x97, 0xab, 0x37,
-		0x4a, 0x5b, 0xb5, 0x75, 0xb5, 0x7f, 0xcc, 0x9e, 0x83, 0xf9, 0x81, 0xdc, 0xa5, 0xcd, 0x8d, 0x95,
-		0x5a, 0xa5, 0xd4, 0xa8, 0x6a, 0xec, 0xa2, 0x09, 0x69, 0xd8, 0x00, 0xd1, 0x4a, 0xed, 0xfa, 0x8d,
-		0x86, 0x56, 0x59, 0xa9, 0x55, 0xd7, 0x1a, 0x5a, 0xa9, 0xd1, 0x28, 0xf9, 0xc3, 0xb9, 0xfc, 0xd2,
-		0xd0, 0x03, 0x9e, 0x97, 0xc7, 0x3f, 0xe0, 0xc9, 0x8f, 0x70, 0x7a, 0xe7, 0x3b, 0xff, 0xf3, 0x13,
-		0xf0, 0x00, 0x7f, 0x98, 0xc8, 0x71, 0xf5, 0x5b, 0xa6, 0xb5, 0xeb, 0xbd, 0x10, 0xc5, 0xbf, 0xf9,
-		0x39, 0xcf, 0x13, 0xfc, 0x15, 0x24, 0x91, 0x3a, 0xe2, 0x9d, 0xa8, 0xa1, 0xcf, 0x8b, 0x8e, 0xbc,
-		0x1f, 0x30, 0xea, 0x98, 0xe6, 0x61, 0x6f, 0x50, 0x8d, 0x78, 0xe9, 0x2a, 0xe2, 0x8d, 0xaa, 0xb9,
-		0xc3, 0xdf, 0x6b, 0x98, 0x3b, 0xf4, 0xf0, 0xab, 0xf2, 0x41, 0x09, 0xa6, 0x6e, 0x98, 0x8e, 0x6b,
-		0x77, 0x4d, 0x43, 0x6f, 0x51, 0x47, 0xe2, 0xb9, 0xb1, 0x2f, 0xb4, 0x95, 0xd3, 0x64, 0x1a, 0xe3,
-		0x2f, 0x59, 0xed, 0x89, 0x3b, 0x65, 0xc9, 0xdb, 0x7a, 0x8b, 0x5d, 0x26, 0x0b, 0x3e, 0x85, 0xd7,
-		0x2f, 0xf6, 0xc0, 0xfc, 0x1a, 0x44, 0x61, 0xbc, 0xc5, 0x58, 0x41, 0x52, 0x7e, 0x20, 0x06, 0x79,
-		0xba, 0xc0, 0x71, 0xe8, 0x82, 0x98, 0x2e, 0xb9, 0x6e, 0x42, 0xa2, 0xab, 0xbb, 0x7c, 0x19, 0x52,
-		0xbe, 0x76, 0xe4, 0xe7, 0xaf, 0x58, 0x29, 0x14, 0x03, 0xbd, 0x0b, 0x52, 0x6d, 0x7d, 0x5f, 0xa3,
-		0x78, 0xb1, 0xb7, 0x84, 0x37, 0xd9, 0xd6, 0xf7, 0x49, 0xfd, 0xd0, 0x37, 0x41, 0x9e, 0x40, 0x1a,
-		0x7b, 0xba, 0xb5, 0x8b, 0x19, 0x72, 0xfc, 0x2d, 0x21, 0xe7, 0xda, 0xfa, 0x7e, 0x85, 0xa2, 0x11,
-		0x7c, 0xfe, 0x4c, 0xd8, 0xaf, 0x48, 0x7c, 0x75, 0x49, 0x05, 0x83, 0x74, 0x90, 0x0d, 0xef, 0x8b,
-		0x16, 0x2a, 0x82, 0xb6, 0xe7, 0x87, 0xc9, 0xbe, 0x4f, 0xac, 0xe5, 0x1c, 0xa9, 0xde, 0x67, 0xde,
-		0x9c, 0x97, 0x58, 0xa9, 0x79, 0x63, 0x40, 0xec, 0x19, 0xb6, 0x6a, 0xd6, 0xa8, 0x7f, 0x13, 0x1b,
-		0xe9, 0xdf, 0xe4, 0x84, 0x7f, 0xc3, 0x00, 0x81, 0x71, 0x93, 0x7c, 0xde, 0x86, 0x4f, 0x48, 0x90,
-		0x59, 0x0a, 0x3c, 0xdd, 0x59, 0x80, 0xc9, 0xb6, 0x6d, 0x99, 0xb7, 0x70, 0xd7, 0x8b, 0xba, 0xb3,
-		0x4f, 0xe2, 0x83, 0xb0, 0x5f, 0x80, 0x74, 0x0f, 0xc4, 0x03, 0x2a, 0xe2, 0x9b, 0x70, 0xdd, 0xc1,
-		0xdb, 0x8e, 0x29, 0xe4, 0xac, 0x8a, 0x4f, 0xf4, 0x30, 0xc8, 0x0e, 0x36, 0x7a, 0x5d, 0xd3, 0x3d,
-		0xd0, 0x0c, 0xdb, 0x72, 0x75, 0xc3, 0xe5, 0x8b, 0xb5, 0xbc, 0x48, 0xaf, 0xb0, 0x64, 0x02, 0xd2,
-		0xc4, 0xae, 0x6e, 0xb6, 0xd8, 0x61, 0xb4, 0xb4, 0x2a, 0x3e, 0x79, 0x55, 0xef, 0x4e, 0x06, 0x97,
-		0x2a, 0x15, 0x90, 0xed, 0x0e, 0xee, 0x86, 0x76, 0xdd, 0x99, 0x36, 0x16, 0x7e, 0xeb, 0xd3, 0x8f,
-		0xcd, 0x72, 0x81, 0xf3, 0xfd, 0x5a, 0x76, 0x03, 0x4b, 0xcd, 0x0b, 0x0e, 0xb1, 0x1d, 0xff, 0x4a,
-		0x28, 0xce, 0xde, 0xdb, 0xf6, 0xdf, 0x2e, 0x9a, 0x1d, 0x10, 0x6a, 0xc9, 0x3a, 0x28, 0x17, 0x7e,
-		0xc3, 0x87, 0xe6, 0x8b, 0x99, 0x0d, 0xba, 0x70, 0x09, 0xc6, 0xdc, 0x29, 0x0c, 0x71, 0xef, 0x5e,
-		0xd3, 0xcd, 0x96, 0xf8, 0xb1, 0x5c, 0x95, 0x7f, 0xa1, 0xa2, 0x17, 0x47, 0x4a, 0x50, 0x6f, 0x59,
-		0x19, 0xa6, 0x1b, 0x65, 0xdb, 0x6a, 0x86, 0xc3, 0x47, 0xa8, 0x02, 0x49, 0xd7, 0xbe, 0x85, 0x2d,
-		0x2e, 0xa0, 0xf2, 0x23, 0x47, 0x78, 0xe7, 0x4e, 0xe5, 0xac, 0xe8, 0x1b, 0x40, 0x6e, 0xe2, 0x16,
-		0xde, 0x65, 0x97, 0x4d, 0xf7, 0xf4, 0x2e, 0x66, 0xaf, 0x1e, 0xdc, 0xd3, 0x2b, 0x76, 0x79, 0x0f,
-		0xaa, 0x4e, 0x91, 0xd0, 0x46, 0xf8, 0x71, 0xd8, 0x49, 0x6f, 0x8b, 0x38, 0xb2, 0x8d, 0x01, 0xcd,
-		0x0b, 0x5a, 0x9f, 0xd0, 0x63, 0xb2, 0x0f, 0x83, 0xdc, 0xb3, 0xb6, 0x6d, 0x8b, 0xfe, 0xc6, 0x24,
-		0xf7, 0xb0, 0x53, 0x6c, 0xef, 0xc5, 0x4b, 0xe7, 0x7b, 0x2f, 0x1b, 0x30, 0xe5, 0x93, 0xd2, 0x11,
-		0x92, 0x3e, 0xea, 0x08, 0xc9, 0x79, 0x00, 0x84, 0x04, 0xad, 0x02, 0xf8, 0x63, 0x90, 0x46, 0xfe,
-		0x33, 0xc3, 0x7b, 0xcc, 0x1f, 0xcd, 0xc1, 0xc6, 0x04, 0x00, 0x90, 0x05, 0x33, 0x6d, 0xd3, 0xd2,
-		0x1c, 0xdc, 0xda, 0xd1, 0xb8, 0xe4, 0x08, 0x6e, 0x86, 0x8a, 0xff, 0x85, 0x23, 0xf4, 0xe6, 0xef,
-		0x7c, 0xfa, 0xb1, 0xbc, 0xff, 0xfc, 0xdf, 0xc2, 0xe3, 0x8b, 0x57, 0x9f, 0x52, 0xa7, 0xdb, 0xa6,
-		0x55, 0xc7, 0xad, 0x9d, 0x25, 0x0f, 0x18, 0x3d, 0x07, 0xa7, 0x7d, 0x81, 0xd8, 0x96, 0xb6, 0x67,
-		0xb7, 0x9a, 0x5a, 0x17, 0xef, 0x68, 0x06, 0x7d, 0xbc, 0x30, 0x4b, 0xc5, 0x78, 0xd2, 0x23, 0x59,
-		0xb7, 0x6e, 0xd8, 0xad, 0xa6, 0x8a, 0x77, 0x2a, 0x24, 0x1b, 0x9d, 0x03, 0x5f, 0x1a, 0x9a, 0xd9,
-		0x74, 0x0a, 0xb9, 0x85, 0xf8, 0x85, 0x84, 0x9a, 0xf5, 0x12, 0x6b, 0x4d, 0xa7, 0x98, 0x7a, 0xff,
-		0xc7, 0xe6, 0x8f, 0x7d, 0xfe, 0x63, 0xf3, 0xc7, 0x94, 0x65, 0xfa, 0xba, 0x19, 0x1f, 0x5a, 0xd8,
-		0x41, 0xd7, 0x20, 0xad, 0x8b, 0x0f, 0x76, 0x69, 0xe9, 0x90, 0xa1, 0xe9, 0x93, 0x2a, 0x9f, 0x94,
-		0x20, 0xb9, 0xb4, 0xb5, 0xa1, 0x9b, 0x5d, 0x54, 0x85, 0x69, 0x5f, 0x57, 0xc7, 0x1d, 0xe5, 0xbe,
-		0x7a, 0x8b, 0x61, 0xbe, 0x36, 0xec, 0x88, 0x4e, 0xba, 0x7c, 0xf6, 0xb7, 0x3e, 0xfd, 0xd8, 0xfd,
-		0x1c, 0x66, 0xab, 0xef, 0xb4, 0x8e, 0xc0, 0xeb, 0x3f, 0xc5, 0x13, 0x68, 0xf3, 0x4d, 0x98, 0x64,
-		0x55, 0x75, 0xd0, 0x8b, 0x30, 0xd1, 0x21, 0x7f, 0xf0, 0x00, 0xee, 0x99, 0xa1, 0x3a, 0x4f, 0xe9,
-		0x83, 0x1a, 0xc2, 0xf8, 0x94, 0xef, 0x8c, 0x01, 0x2c, 0x6d, 0x6d, 0x35, 0xba, 0x66, 0xa7, 0x85,
-		0xdd, 0xb7, 0xab, 0xed, 0x9b, 0x70, 0x3c, 0x70, 0xb7, 0xbc, 0x6b, 0x1c, 0xbd, 0xfd, 0x33, 0xfe,
-		0x2d, 0xf3, 0xae, 0x11, 0x09, 0xdb, 0x74, 0x5c, 0x0f, 0x36, 0x7e, 0x74, 0xd8, 0x25, 0xc7, 0x1d,
-		0x94, 0xec, 0xcb, 0x90, 0xf1, 0x85, 0xe1, 0xa0, 0x1a, 0xa4, 0x5c, 0xfe, 0x37, 0x17, 0xb0, 0x32,
-		0x5c, 0xc0, 0x82, 0x2d, 0x28, 0x64, 0x8f, 0x5d, 0xf9, 0x4b, 0x09, 0x20, 0x30, 0x46, 0xbe, 0x36,
-		0x75, 0x0c, 0xd5, 0x20, 0xc9, 0x8d, 0x73, 0xfc, 0x9e, 0x9f, 0x18, 0x65, 0x00, 0x01, 0xa1, 0x7e,
-		0x77, 0x0c, 0x66, 0x36, 0xc5, 0xe8, 0xfd, 0xda, 0x97, 0xc1, 0x26, 0x4c, 0x62, 0xcb, 0xed, 0x9a,
-		0xde, 0x06, 0xc4, 0xe3, 0xc3, 0xfa, 0x3c, 0xa2, 0x51, 0x55, 0xcb, 0xed, 0x1e, 0x04, 0x35, 0x40,
-		0x60, 0x05, 0xe4, 0xf1, 0xe1, 0x38, 0x14, 0x86, 0xb1, 0xa2, 0xf3, 0x90, 0x37, 0xba, 0x98, 0x26,
-		0x84, 0xef, 0xd0, 0x4d, 0x89, 0x64, 0x3e, 0xed, 0xa8, 0x40, 0x1c, 0x35, 0xa2, 0x5c, 0x84, 0xf4,
-		0xde, 0x3c, 0xb3, 0x29, 0x1f, 0x81, 0x4e, 0x3c, 0x0d, 0xc8, 0x8b, 0x93, 0xf7, 0xdb, 0x7a, 0x4b,
-		0xb7, 0x0c, 0xe1, 0xc1, 0x1e, 0x69, 0xce, 0x17, 0xa7, 0xf7, 0xcb, 0x0c, 0x02, 0x55, 0x61, 0x52,
-		0xa0, 0x25, 0x8e, 0x8e, 0x26, 0x78, 0xd1, 0x59, 0xc8, 0x06, 0x27, 0x06, 0xea, 0x8d, 0x24, 0xd4,
-		0x4c, 0x60, 0x5e, 0x18, 0x35, 0xf3, 0x24, 0x0f, 0x9d, 0x79, 0xb8, 0xc3, 0xf7, 0xc3, 0x71, 0x98,
-		0x56, 0x71, 0xf3, 0xaf, 0x7f, 0xb7, 0x6c, 0x00, 0xb0, 0xa1, 0x4a, 0x2c, 0x29, 0xef, 0x99, 0x7b,
-		0x18, 0xef, 0x69, 0x06, 0xb2, 0xe4, 0xb8, 0x5f, 0xad, 0x1e, 0xfa, 0xdd, 0x18, 0x64, 0x83, 0x3d,
-		0xf4, 0x37, 0x72, 0xd2, 0x42, 0x6b, 0xbe, 0x99, 0x62, 0x77, 0x07, 0x1e, 0x1e, 0x66, 0xa6, 0x06,
-		0xb4, 0x79, 0x84, 0x7d, 0xfa, 0x42, 0x1c, 0x92, 0xfc, 0x0c, 0xcf, 0xfa, 0x80, 0x6f, 0x3b, 0xf2,
-		0x02, 0x75, 0x4e, 0xdc, 0x41, 0x8f, 0x74, 0x6d, 0x1f, 0x84, 0x29, 0xb2, 0x46, 0x0e, 0x1d, 0x0c,
-		0x92, 0x2e, 0xe4, 0xe8, 0x52, 0xd7, 0x3f, 0x18, 0x8b, 0xe6, 0x21, 0x43, 0xc8, 0x7c, 0x3b, 0x4c,
-		0x68, 0xa0, 0xad, 0xef, 0x57, 0x59, 0x0a, 0xba, 0x0c, 0x68, 0xcf, 0x0b, 0x5c, 0x68, 0xbe, 0x20,
-		0xa4, 0x0b, 0x39, 0xfa, 0x9a, 0xc0, 0xb4, 0x9f, 0x2b, 0x58, 0xee, 0x07, 0x20, 0x35, 0xd1, 0xd8,
-		0xcb, 0xda, 0xfc, 0xdd, 0x72, 0x92, 0xb2, 0x44, 0x5f, 0xd7, 0xfe, 0x36, 0x89, 0xb9, 0xc9, 0x7d,
-		0xab, 0x69, 0xbe, 0x4a, 0x69, 0x8c, 0x31, 0x30, 0xfe, 0xfc, 0xcd, 0xf9, 0xb9, 0x03, 0xbd, 0xdd,
-		0x2a, 0x2a, 0x11, 0x38, 0x4a, 0xd4, 0x02, 0x9f, 0x38, 0xcf, 0xe1, 0xd5, 0x38, 0xaa, 0x81, 0x7c,
-		0x0b, 0x1f, 0x68, 0x5d, 0xfe, 0xc3, 0xec, 0xda, 0x0e, 0x16, 0xef, 0x18, 0x9c, 0x5a, 0x8c, 0x78,
-		0xe7, 0x7c, 0xb1, 0x62, 0x9b, 0x16, 0xdf, 0xa3, 0x98, 0xba, 0x85, 0x0f, 0x54, 0xce, 0xb7, 0x8c,
-		0x71, 0xf1, 0x01, 0x32, 0x5a, 0xde, 0xf8, 0xc3, 0x9f, 0xbd, 0x78, 0x3a, 0xf0, 0x66, 0xf7, 0xbe,
-		0x17, 0x27, 0x63, 0x5d, 0x4c, 0x1c, 0x5f, 0xe4, 0x4f, 0x42, 0x81, 0xc3, 0x60, 0x10, 0x58, 0x2b,
-		0x48, 0x87, 0xaf, 0x41, 0x7c, 0xfe, 0xd0, 0x1a, 0x24, 0x30, 0x44, 0x5f, 0xf0, 0xe7, 0x80, 0xd8,
-		0xa8, 0xd6, 0x04, 0xb5, 0x93, 0x33, 0xd1, 0x91, 0x7f, 0x4c, 0xf9, 0x0f, 0x12, 0x9c, 0x1a, 0xd0,
-		0x66, 0xaf, 0xca, 0x06, 0xa0, 0x6e, 0x20, 0x93, 0x6a, 0x85, 0xd8, 0x0f, 0xbc, 0xb7, 0xc1, 0x31,
-		0xdd, 0x1d, 0x98, 0x08, 0xde, 0x9e, 0xc9, 0x8c, 0x5b, 0xb2, 0x5f, 0x97, 0x60, 0x36, 0x58, 0x01,
-		0xaf, 0x29, 0x75, 0xc8, 0x06, 0x8b, 0xe6, 0x8d, 0x78, 0x60, 0x9c, 0x46, 0x04, 0xeb, 0x1f, 0x02,
-		0x41, 0x5b, 0xbe, 0xc5, 0x60, 0xd1, 0xb9, 0xcb, 0x63, 0x0b, 0x45, 0x54, 0x2c, 0xd2, 0x72, 0xb0,
-		0xbe, 0xf9, 0x82, 0x04, 0x89, 0x0d, 0xdb, 0x6e, 0xa1, 0xf7, 0xc0, 0xb4, 0x65, 0xbb, 0x1a, 0x19,
-		0x59, 0xb8, 0xa9, 0xf1, 0xd0, 0x01, 0xb3, 0xc6, 0xd5, 0x43, 0x65, 0xf5, 0x47, 0x6f, 0xce, 0x0f,
-		0x72, 0x46, 0xbd, 0x9b, 0x9f, 0xb7, 0x6c, 0xb7, 0x4c, 0x89, 0x1a, 0x2c, 0xba, 0xb0, 0x03, 0xb9,
-		0x70, 0x71, 0xcc, 0x62, 0x97, 0x46, 0x15, 0x97, 0x1b, 0x59, 0x54, 0x76, 0x3b, 0x50, 0x0e, 0x7b,
-		0x21, 0xfc, 0x4f, 0x49, 0xcf, 0x7d, 0x13, 0xc8, 0x5b, 0xfd, 0xa7, 0x4d, 0x96, 0x61, 0x52, 0x9c,
-		0x2e, 0x91, 0xc6, 0x3d, 0xb9, 0x12, 0x94, 0x27, 0x67, 0xa6, 0xe1, 0xcf, 0xcf, 0xc4, 0xe0, 0x54,
-		0xc5, 0xb6, 0x1c, 0x1e, 0xe8, 0xe1, 0xa3, 0x9a, 0xc5, 0x6a, 0x0f, 0xd0, 0xc3, 0x43, 0xc2, 0x50,
-		0xd9, 0xc1, 0x60, 0xd3, 0x16, 0xe4, 0xc9, 0x14, 0x6b, 0xd8, 0xd6, 0x5b, 0x8c, 0x35, 0xe5, 0xec,
-		0x56, 0x93, 0xd7, 0xe8, 0x16, 0x3e, 0x20, 0xb8, 0x16, 0xbe, 0x13, 0xc2, 0x8d, 0xdf, 0x1b, 0xae,
-		0x85, 0xef, 0x04, 0x70, 0xfd, 0x0d, 0xcd, 0x44, 0x68, 0x43, 0xf3, 0x1a, 0xc4, 0x89, 0x29, 0x9c,
-		0x38, 0x82, 0xf1, 0x20, 0x0c, 0x81, 0x69, 0xad, 0x0e, 0xa7, 0x78, 0xa4, 0xc0, 0x59, 0xdf, 0xa1,
-		0x12, 0xc5, 0xb4, 0x41, 0x2f, 0xe1, 0x83, 0x88, 0xb0, 0x41, 0x76, 0xac, 0xb0, 0xc1, 0xc5, 0x5f,
-		0x90, 0x00, 0xfc, 0x98, 0x19, 0x7a, 0x14, 0x4e, 0x96, 0xd7, 0xd7, 0x96, 0xfc, 0xbd, 0x9d, 0xc0,
-		0x8f, 0x07, 0x89, 0x77, 0xbc, 0x9c, 0x0e, 0x36, 0xcc, 0x1d, 0x13, 0x37, 0xd1, 0x43, 0x30, 0x1b,
-		0xa6, 0x26, 0x5f, 0xd5, 0x25, 0x59, 0x9a, 0xcb, 0xbe, 0x71, 0x77, 0x21, 0xc5, 0xd6, 0x08, 0xb8,
-		0x89, 0x2e, 0xc0, 0xf1, 0x41, 0xba, 0xda, 0xda, 0x75, 0x39, 0x36, 0x97, 0x7b, 0xe3, 0xee, 0x42,
-		0xda, 0x5b, 0x4c, 0x20, 0x05, 0x50, 0x90, 0x92, 0xe3, 0xc5, 0xe7, 0xe0, 0x8d, 0xbb, 0x0b, 0x49,
-		0x36, 0x64, 0xf8, 0xa6, 0xd0, 0x37, 0x02, 0xd4, 0xac, 0x9d, 0xae, 0x6e, 0x50, 0xd3, 0x30, 0x07,
-		0x27, 0x6a, 0x6b, 0xcb, 0x6a, 0xa9, 0xd2, 0xa8, 0xad, 0xaf, 0xf5, 0xfd, 0xe6, 0x51, 0x38, 0x6f,
-		0x69, 0x7d, 0xb3, 0xbc, 0x52, 0xd5, 0xea, 0xb5, 0xeb, 0x6b, 0x6c, 0x07, 0x37, 0x94, 0xf7, 0xee,
-		0xb5, 0x46, 0x6d, 0xb5, 0x2a, 0xc7, 0xca, 0xd7, 0x86, 0x6e, 0xf6, 0xdc, 0x17, 0x1a, 0x8c, 0xfe,
-		0x74, 0x14, 0xfa, 0x31, 0x89, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xae, 0x10, 0xc3, 0x37,
-		0xa7, 0x00, 0x00,
+		// 12859 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x90, 0x24, 0x57,
+		0x56, 0x18, 0x3c, 0x59, 0xef, 0x3a, 0xf5, 0xca, 0xbe, 0xdd, 0x33, 0x53, 0xd3, 0x23, 0xcd, 0x23,
+		0xf5, 0x1a, 0x8d, 0x56, 0x3d, 0x9a, 0x91, 0x34, 0xd2, 0xb4, 0x56, 0x12, 0x55, 0x5d, 0x35, 0x33,
+		0x35, 0xea, 0xd7, 0x66, 0x55, 0xb7, 0x1e, 0x3c, 0x92, 0xec, 0xaa, 0xec, 0xee, 0xd2, 0x54, 0x65,
+		0x96, 0x2a, 0xb3, 0x66, 0xa6, 0xf7, 0x8b, 0x20, 0x16, 0x58, 0xf8, 0x16, 0x2d, 0xcb, 0xb7, 0x7c,
+		0xf0, 0x2d, 0x82, 0x65, 0x96, 0x05, 0x3e, 0xd8, 0xe5, 0x69, 0x1e, 0x0b, 0x06, 0x1c, 0xd8, 0xc6,
+		0x61, 0x63, 0xc0, 0x36, 0x5e, 0x88, 0xb0, 0x4d, 0x10, 0x61, 0xd9, 0xde, 0x25, 0x90, 0x80, 0xe5,
+		0x69, 0xad, 0xbd, 0x11, 0x1b, 0x8e, 0x75, 0x9c, 0xfb, 0xc8, 0x47, 0x55, 0x56, 0x57, 0xf5, 0x48,
+		0x8b, 0x65, 0xf0, 0x9f, 0xee, 0xba, 0xf7, 0x9e, 0x73, 0xee, 0xbd, 0xe7, 0x9e, 0x7b, 0xce, 0xb9,
+		0xe7, 0x3e, 0x12, 0xbe, 0xa0, 0xc1, 0xa9, 0x1d, 0xcb, 0xda, 0xe9, 0x18, 0xe7, 0x7a, 0x7d, 0xcb,
+		0xb1, 0xb6, 0x06, 0xdb, 0xe7, 0x5a, 0x86, 0xdd, 0xec, 0xb7, 0x7b, 0x8e, 0xd5, 0x5f, 0xa0, 0x79,
+		0xa4, 0xc0, 0x20, 0x16, 0x04, 0x84, 0xb2, 0x02, 0x33, 0x97, 0xdb, 0x1d, 0xa3, 0xe2, 0x02, 0xd6,
+		0x0d, 0x87, 0x3c, 0x09, 0xb1, 0xed, 0x76, 0xc7, 0x28, 0x4a, 0xa7, 0xa2, 0x67, 0x32, 0x17, 0xee,
+		0x5d, 0x18, 0x42, 0x5a, 0x08, 0x62, 0xac, 0x63, 0xb6, 0x4a, 0x31, 0x94, 0xd7, 0xe2, 0x30, 0x1b,
+		0x52, 0x4a, 0x08, 0xc4, 0x4c, 0xbd, 0x8b, 0x14, 0xa5, 0x33, 0x69, 0x95, 0xfe, 0x26, 0x45, 0x48,
+		0xf6, 0xf4, 0xe6, 0x75, 0x7d, 0xc7, 0x28, 0x46, 0x68, 0xb6, 0x48, 0x92, 0x13, 0x00, 0x2d, 0xa3,
+		0x67, 0x98, 0x2d, 0xc3, 0x6c, 0xee, 0x15, 0xa3, 0xa7, 0xa2, 0x67, 0xd2, 0xaa, 0x2f, 0x87, 0x3c,
+		0x04, 0x33, 0xbd, 0xc1, 0x56, 0xa7, 0xdd, 0xd4, 0x7c, 0x60, 0x70, 0x2a, 0x7a, 0x26, 0xae, 0xca,
+		0xac, 0xa0, 0xe2, 0x01, 0x3f, 0x00, 0x85, 0x9b, 0x86, 0x7e, 0xdd, 0x0f, 0x9a, 0xa1, 0xa0, 0x79,
+		0xcc, 0xf6, 0x01, 0x2e, 0x41, 0xb6, 0x6b, 0xd8, 0xb6, 0xbe, 0x63, 0x68, 0xce, 0x5e, 0xcf, 0x28,
+		0xc6, 0x68, 0xef, 0x4f, 0x8d, 0xf4, 0x7e, 0xb8, 0xe7, 0x19, 0x8e, 0xd5, 0xd8, 0xeb, 0x19, 0xa4,
+		0x04, 0x69, 0xc3, 0x1c, 0x74, 0x19, 0x85, 0xf8, 0x18, 0xfe, 0x55, 0xcd, 0x41, 0x77, 0x98, 0x4a,
+		0x0a, 0xd1, 0x38, 0x89, 0xa4, 0x6d, 0xf4, 0x6f, 0xb4, 0x9b, 0x46, 0x31, 0x41, 0x09, 0x3c, 0x30,
+		0x42, 0xa0, 0xce, 0xca, 0x87, 0x69, 0x08, 0x3c, 0xb2, 0x04, 0x69, 0xe3, 0x96, 0x63, 0x98, 0x76,
+		0xdb, 0x32, 0x8b, 0x49, 0x4a, 0xe4, 0xbe, 0x90, 0x51, 0x34, 0x3a, 0xad, 0x61, 0x12, 0x1e, 0x1e,
+		0xb9, 0x08, 0x49, 0xab, 0xe7, 0xb4, 0x2d, 0xd3, 0x2e, 0xa6, 0x4e, 0x49, 0x67, 0x32, 0x17, 0xee,
+		0x0a, 0x15, 0x84, 0x35, 0x06, 0xa3, 0x0a, 0x60, 0x52, 0x03, 0xd9, 0xb6, 0x06, 0xfd, 0xa6, 0xa1,
+		0x35, 0xad, 0x96, 0xa1, 0xb5, 0xcd, 0x6d, 0xab, 0x98, 0xa6, 0x04, 0x4e, 0x8e, 0x76, 0x84, 0x02,
+		0x2e, 0x59, 0x2d, 0xa3, 0x66, 0x6e, 0x5b, 0x6a, 0xde, 0x0e, 0xa4, 0xc9, 0x11, 0x48, 0xd8, 0x7b,
+		0xa6, 0xa3, 0xdf, 0x2a, 0x66, 0xa9, 0x84, 0xf0, 0x14, 0xb9, 0x00, 0x49, 0xa3, 0xd5, 0xc6, 0xea,
+		0x8a, 0xf9, 0x53, 0xd2, 0x99, 0xfc, 0x85, 0xe2, 0x28, 0x8f, 0x59, 0xb9, 0x2a, 0x00, 0x95, 0x5f,
+		0x4d, 0x40, 0x61, 0x1a, 0xb1, 0x7c, 0x0a, 0xe2, 0xdb, 0xc8, 0x99, 0x62, 0xe4, 0x20, 0x7c, 0x63,
+		0x38, 0x41, 0xc6, 0x27, 0xee, 0x90, 0xf1, 0x25, 0xc8, 0x98, 0x86, 0xed, 0x18, 0x2d, 0x26, 0x45,
+		0xd1, 0x29, 0xe5, 0x10, 0x18, 0xd2, 0xa8, 0x18, 0xc6, 0xee, 0x48, 0x0c, 0x5f, 0x80, 0x82, 0xdb,
+		0x24, 0xad, 0xaf, 0x9b, 0x3b, 0x42, 0x9e, 0xcf, 0x4d, 0x6a, 0xc9, 0x42, 0x55, 0xe0, 0xa9, 0x88,
+		0xa6, 0xe6, 0x8d, 0x40, 0x9a, 0x54, 0x00, 0x2c, 0xd3, 0xb0, 0xb6, 0xb5, 0x96, 0xd1, 0xec, 0x14,
+		0x53, 0x63, 0xb8, 0xb4, 0x86, 0x20, 0x23, 0x5c, 0xb2, 0x58, 0x6e, 0xb3, 0x43, 0x2e, 0x79, 0xe2,
+		0x99, 0x1c, 0x23, 0x5d, 0x2b, 0x6c, 0x62, 0x8e, 0x48, 0xe8, 0x06, 0xe4, 0xfb, 0x06, 0xce, 0x15,
+		0xa3, 0xc5, 0x7b, 0x96, 0xa6, 0x8d, 0x58, 0x98, 0xd8, 0x33, 0x95, 0xa3, 0xb1, 0x8e, 0xe5, 0xfa,
+		0xfe, 0x24, 0xb9, 0x07, 0xdc, 0x0c, 0x8d, 0x8a, 0x15, 0x50, 0xcd, 0x95, 0x15, 0x99, 0xab, 0x7a,
+		0xd7, 0x98, 0x7f, 0x3f, 0xe4, 0x83, 0xec, 0x21, 0x73, 0x10, 0xb7, 0x1d, 0xbd, 0xef, 0x50, 0x29,
+		0x8c, 0xab, 0x2c, 0x41, 0x64, 0x88, 0x1a, 0x66, 0x8b, 0x6a, 0xc6, 0xb8, 0x8a, 0x3f, 0xc9, 0xd7,
+		0x78, 0x1d, 0x8e, 0xd2, 0x0e, 0xdf, 0x3f, 0x3a, 0xa2, 0x01, 0xca, 0xc3, 0xfd, 0x9e, 0x7f, 0x02,
+		0x72, 0x81, 0x0e, 0x4c, 0x5b, 0xb5, 0xf2, 0xaf, 0x63, 0x70, 0x38, 0x94, 0x36, 0x79, 0x01, 0xe6,
+		0x06, 0x66, 0xdb, 0x74, 0x8c, 0x7e, 0xaf, 0x6f, 0xa0, 0xc8, 0xb2, 0xba, 0x8a, 0x6f, 0x24, 0xc7,
+		0x08, 0xdd, 0x86, 0x1f, 0x9a, 0x51, 0x51, 0x67, 0x07, 0xa3, 0x99, 0xe4, 0x45, 0xc8, 0xa0, 0x7c,
+		0xe8, 0x7d, 0x9d, 0x12, 0x64, 0xb3, 0xf1, 0xc2, 0x74, 0x5d, 0x5e, 0xa8, 0x78, 0x98, 0xe5, 0xe8,
+		0x87, 0xa4, 0x88, 0xea, 0xa7, 0x45, 0x9e, 0x80, 0xd4, 0xb6, 0xa1, 0x3b, 0x83, 0xbe, 0x61, 0x17,
+		0x2f, 0x50, 0x56, 0x1e, 0x1f, 0x9d, 0xa4, 0x0c, 0xa0, 0x6e, 0x38, 0xaa, 0x0b, 0x4c, 0xba, 0x90,
+		0xbd, 0x61, 0xf4, 0xdb, 0xdb, 0xed, 0x26, 0x6b, 0x54, 0x94, 0x2a, 0x9f, 0x27, 0xa7, 0x6c, 0xd4,
+		0xa6, 0x0f, 0xb5, 0xee, 0xe8, 0x8e, 0xb1, 0x08, 0x1b, 0xab, 0x9b, 0x55, 0xb5, 0x76, 0xb9, 0x56,
+		0xad, 0xb0, 0x66, 0x06, 0xc8, 0xcf, 0x7f, 0xaf, 0x04, 0x19, 0x5f, 0x4f, 0x50, 0x1d, 0x9a, 0x83,
+		0xee, 0x96, 0xd1, 0xe7, 0xe3, 0xc5, 0x53, 0xe4, 0x38, 0xa4, 0xb7, 0x07, 0x9d, 0x0e, 0x13, 0x3a,
+		0x66, 0x4b, 0x53, 0x98, 0x81, 0x02, 0x87, 0x3a, 0x8e, 0xab, 0x11, 0xaa, 0xe3, 0xf0, 0x37, 0x99,
+		0x87, 0x94, 0x10, 0xca, 0x62, 0xfc, 0x94, 0x74, 0x26, 0xa5, 0xba, 0x69, 0x56, 0xd6, 0x33, 0x74,
+		0xc7, 0x68, 0x15, 0x13, 0xa2, 0x8c, 0xa5, 0xaf, 0xc5, 0x52, 0x31, 0x39, 0xae, 0x3c, 0x06, 0x33,
+		0x23, 0x5d, 0x21, 0x05, 0xc8, 0x54, 0xaa, 0x4b, 0xcb, 0x25, 0xb5, 0xd4, 0xa8, 0xad, 0xad, 0xca,
+		0x87, 0x48, 0x1e, 0x7c, 0xbd, 0x93, 0xa5, 0xb3, 0xe9, 0xd4, 0x9b, 0x49, 0xf9, 0x03, 0x1f, 0xf8,
+		0xc0, 0x07, 0x22, 0xca, 0x6f, 0x24, 0x60, 0x2e, 0x4c, 0x09, 0x86, 0xea, 0x63, 0xaf, 0xd3, 0xd1,
+		0x40, 0xa7, 0x4b, 0x10, 0xef, 0xe8, 0x5b, 0x46, 0xa7, 0x18, 0xa3, 0x83, 0xf0, 0xd0, 0x54, 0x6a,
+		0x76, 0x61, 0x19, 0x51, 0x54, 0x86, 0x49, 0x9e, 0xe1, 0xac, 0x89, 0x53, 0x0a, 0x67, 0xa7, 0xa3,
+		0x80, 0xca, 0x91, 0xb3, 0xf1, 0x38, 0xa4, 0xf1, 0x3f, 0xe3, 0x7b, 0x82, 0xf1, 0x1d, 0x33, 0x28,
+		0xdf, 0xe7, 0x21, 0x45, 0xf5, 0x5e, 0xcb, 0x70, 0xc7, 0x44, 0xa4, 0x51, 0x53, 0xb4, 0x8c, 0x6d,
+		0x7d, 0xd0, 0x71, 0xb4, 0x1b, 0x7a, 0x67, 0x60, 0x50, 0x0d, 0x96, 0x56, 0xb3, 0x3c, 0x73, 0x13,
+		0xf3, 0xc8, 0x49, 0xc8, 0x30, 0x35, 0xd9, 0x36, 0x5b, 0xc6, 0x2d, 0x6a, 0x42, 0xe3, 0x2a, 0xd3,
+		0x9c, 0x35, 0xcc, 0xc1, 0xea, 0x5f, 0xb6, 0x2d, 0x53, 0xe8, 0x1a, 0x5a, 0x05, 0x66, 0xd0, 0xea,
+		0x9f, 0x18, 0xb6, 0xde, 0x77, 0x87, 0x77, 0x6f, 0x44, 0x39, 0x3e, 0x00, 0x05, 0x0a, 0xf1, 0x28,
+		0x9f, 0xca, 0x7a, 0xa7, 0x38, 0x43, 0xc5, 0x20, 0xcf, 0xb2, 0xd7, 0x78, 0xae, 0xf2, 0xcb, 0x11,
+		0x88, 0x51, 0x4b, 0x51, 0x80, 0x4c, 0xe3, 0xc5, 0xf5, 0xaa, 0x56, 0x59, 0xdb, 0x28, 0x2f, 0x57,
+		0x65, 0x09, 0x87, 0x9e, 0x66, 0x5c, 0x5e, 0x5e, 0x2b, 0x35, 0xe4, 0x88, 0x9b, 0xae, 0xad, 0x36,
+		0x2e, 0x3e, 0x26, 0x47, 0x5d, 0x84, 0x0d, 0x96, 0x11, 0xf3, 0x03, 0x3c, 0x7a, 0x41, 0x8e, 0x13,
+		0x19, 0xb2, 0x8c, 0x40, 0xed, 0x85, 0x6a, 0xe5, 0xe2, 0x63, 0x72, 0x22, 0x98, 0xf3, 0xe8, 0x05,
+		0x39, 0x49, 0x72, 0x90, 0xa6, 0x39, 0xe5, 0xb5, 0xb5, 0x65, 0x39, 0xe5, 0xd2, 0xac, 0x37, 0xd4,
+		0xda, 0xea, 0x15, 0x39, 0xed, 0xd2, 0xbc, 0xa2, 0xae, 0x6d, 0xac, 0xcb, 0xe0, 0x52, 0x58, 0xa9,
+		0xd6, 0xeb, 0xa5, 0x2b, 0x55, 0x39, 0xe3, 0x42, 0x94, 0x5f, 0x6c, 0x54, 0xeb, 0x72, 0x36, 0xd0,
+		0xac, 0x47, 0x2f, 0xc8, 0x39, 0xb7, 0x8a, 0xea, 0xea, 0xc6, 0x8a, 0x9c, 0x27, 0x33, 0x90, 0x63,
+		0x55, 0x88, 0x46, 0x14, 0x86, 0xb2, 0x2e, 0x3e, 0x26, 0xcb, 0x5e, 0x43, 0x18, 0x95, 0x99, 0x40,
+		0xc6, 0xc5, 0xc7, 0x64, 0xa2, 0x2c, 0x41, 0x9c, 0x8a, 0x21, 0x21, 0x90, 0x5f, 0x2e, 0x95, 0xab,
+		0xcb, 0xda, 0xda, 0x3a, 0x4e, 0x9a, 0xd2, 0xb2, 0x2c, 0x79, 0x79, 0x6a, 0x75, 0xbd, 0x5a, 0x6a,
+		0x54, 0x2b, 0x72, 0xd4, 0x9f, 0xf7, 0xbe, 0x8d, 0x9a, 0x5a, 0xad, 0xc8, 0x11, 0xa5, 0x09, 0x73,
+		0x61, 0x16, 0x32, 0x74, 0x0a, 0xf9, 0x64, 0x21, 0x32, 0x46, 0x16, 0x28, 0xad, 0x61, 0x59, 0x50,
+		0x3e, 0x1f, 0x81, 0xd9, 0x10, 0x2f, 0x21, 0xb4, 0x92, 0x67, 0x21, 0xce, 0x64, 0x99, 0x69, 0xea,
+		0x07, 0x43, 0xdd, 0x0d, 0x2a, 0xd9, 0x23, 0xbe, 0x13, 0xc5, 0xf3, 0xfb, 0x9b, 0xd1, 0x31, 0xfe,
+		0x26, 0x92, 0x18, 0x11, 0xd8, 0xaf, 0x1f, 0xb1, 0xe6, 0xcc, 0xe1, 0xb9, 0x38, 0x8d, 0xc3, 0x43,
+		0xf3, 0x0e, 0x66, 0xd5, 0xe3, 0x21, 0x56, 0xfd, 0x29, 0x98, 0x19, 0x21, 0x34, 0xb5, 0x75, 0xfd,
+		0x56, 0x09, 0x8a, 0xe3, 0x98, 0x33, 0x41, 0x25, 0x46, 0x02, 0x2a, 0xf1, 0xa9, 0x61, 0x0e, 0x9e,
+		0x1e, 0x3f, 0x08, 0x23, 0x63, 0xfd, 0x29, 0x09, 0x8e, 0x84, 0xaf, 0x2b, 0x42, 0xdb, 0xf0, 0x0c,
+		0x24, 0xba, 0x86, 0xb3, 0x6b, 0x09, 0x3f, 0xf9, 0xfe, 0x10, 0xef, 0x0b, 0x8b, 0x87, 0x07, 0x9b,
+		0x63, 0x91, 0x4b, 0xc3, 0x6d, 0x3d, 0x39, 0x6e, 0x95, 0x33, 0xd2, 0xd2, 0xef, 0x88, 0xc0, 0xe1,
+		0x50, 0xe2, 0xa1, 0x0d, 0xbd, 0x1b, 0xa0, 0x6d, 0xf6, 0x06, 0x0e, 0xf3, 0x85, 0x99, 0x26, 0x4e,
+		0xd3, 0x1c, 0xaa, 0xbc, 0x50, 0xcb, 0x0e, 0x1c, 0xb7, 0x9c, 0x59, 0x49, 0x60, 0x59, 0x14, 0xe0,
+		0x49, 0xaf, 0xa1, 0x31, 0xda, 0xd0, 0x13, 0x63, 0x7a, 0x3a, 0x22, 0x98, 0x8f, 0x80, 0xdc, 0xec,
+		0xb4, 0x0d, 0xd3, 0xd1, 0x6c, 0xa7, 0x6f, 0xe8, 0xdd, 0xb6, 0xb9, 0xc3, 0xac, 0xed, 0x62, 0x7c,
+		0x5b, 0xef, 0xd8, 0x86, 0x5a, 0x60, 0xc5, 0x75, 0x51, 0x8a, 0x18, 0x54, 0x80, 0xfa, 0x3e, 0x8c,
+		0x44, 0x00, 0x83, 0x15, 0xbb, 0x18, 0xca, 0xcf, 0xa4, 0x21, 0xe3, 0x5b, 0x85, 0x91, 0xd3, 0x90,
+		0x7d, 0x59, 0xbf, 0xa1, 0x6b, 0x62, 0x65, 0xcd, 0x38, 0x91, 0xc1, 0xbc, 0x75, 0x96, 0x45, 0x1e,
+		0x81, 0x39, 0x0a, 0x62, 0x0d, 0x1c, 0xa3, 0xaf, 0x35, 0x3b, 0xba, 0x6d, 0x53, 0xa6, 0xa5, 0x28,
+		0x28, 0xc1, 0xb2, 0x35, 0x2c, 0x5a, 0x12, 0x25, 0xe4, 0x71, 0x98, 0xa5, 0x18, 0xdd, 0x41, 0xc7,
+		0x69, 0xf7, 0x3a, 0x86, 0x86, 0x6b, 0x7d, 0xbb, 0x08, 0xfe, 0x96, 0xcd, 0x20, 0xc4, 0x0a, 0x07,
+		0xc0, 0x16, 0xd9, 0xa4, 0x02, 0x77, 0x53, 0xb4, 0x1d, 0xc3, 0x34, 0xfa, 0xba, 0x63, 0x68, 0xc6,
+		0x2b, 0x03, 0xbd, 0x63, 0x6b, 0xba, 0xd9, 0xd2, 0x76, 0x75, 0x7b, 0xb7, 0x38, 0x87, 0x04, 0xca,
+		0x91, 0xa2, 0xa4, 0x1e, 0x43, 0xc0, 0x2b, 0x1c, 0xae, 0x4a, 0xc1, 0x4a, 0x66, 0xeb, 0xaa, 0x6e,
+		0xef, 0x92, 0x45, 0x38, 0x42, 0xa9, 0xd8, 0x4e, 0xbf, 0x6d, 0xee, 0x68, 0xcd, 0x5d, 0xa3, 0x79,
+		0x5d, 0x1b, 0x38, 0xdb, 0x4f, 0x16, 0x8f, 0xfb, 0xeb, 0xa7, 0x2d, 0xac, 0x53, 0x98, 0x25, 0x04,
+		0xd9, 0x70, 0xb6, 0x9f, 0x24, 0x75, 0xc8, 0xe2, 0x60, 0x74, 0xdb, 0xef, 0x37, 0xb4, 0x6d, 0xab,
+		0x4f, 0x6d, 0x68, 0x3e, 0x44, 0x35, 0xf9, 0x38, 0xb8, 0xb0, 0xc6, 0x11, 0x56, 0xac, 0x96, 0xb1,
+		0x18, 0xaf, 0xaf, 0x57, 0xab, 0x15, 0x35, 0x23, 0xa8, 0x5c, 0xb6, 0xfa, 0x28, 0x50, 0x3b, 0x96,
+		0xcb, 0xe0, 0x0c, 0x13, 0xa8, 0x1d, 0x4b, 0xb0, 0xf7, 0x71, 0x98, 0x6d, 0x36, 0x59, 0x9f, 0xdb,
+		0x4d, 0x8d, 0xaf, 0xc8, 0xed, 0xa2, 0x1c, 0x60, 0x56, 0xb3, 0x79, 0x85, 0x01, 0x70, 0x19, 0xb7,
+		0xc9, 0x25, 0x38, 0xec, 0x31, 0xcb, 0x8f, 0x38, 0x33, 0xd2, 0xcb, 0x61, 0xd4, 0xc7, 0x61, 0xb6,
+		0xb7, 0x37, 0x8a, 0x48, 0x02, 0x35, 0xf6, 0xf6, 0x86, 0xd1, 0xee, 0xa3, 0x51, 0x96, 0xbe, 0xd1,
+		0xa4, 0xae, 0xde, 0x51, 0x3f, 0xb4, 0xaf, 0x80, 0x2c, 0x80, 0xdc, 0x6c, 0x6a, 0x86, 0xa9, 0x6f,
+		0x75, 0x0c, 0x4d, 0xef, 0x1b, 0xa6, 0x6e, 0x17, 0x4f, 0x52, 0xe0, 0x98, 0xd3, 0x1f, 0x18, 0x6a,
+		0xbe, 0xd9, 0xac, 0xd2, 0xc2, 0x12, 0x2d, 0x23, 0x67, 0x61, 0xc6, 0xda, 0x7a, 0xb9, 0xc9, 0x04,
+		0x4b, 0xeb, 0xf5, 0x8d, 0xed, 0xf6, 0xad, 0xe2, 0xbd, 0x94, 0x4b, 0x05, 0x2c, 0xa0, 0x62, 0xb5,
+		0x4e, 0xb3, 0xc9, 0x83, 0x20, 0x37, 0xed, 0x5d, 0xbd, 0xdf, 0xa3, 0x9a, 0xd5, 0xee, 0xe9, 0x4d,
+		0xa3, 0x78, 0x1f, 0x03, 0x65, 0xf9, 0xab, 0x22, 0x1b, 0x05, 0xdb, 0xbe, 0xd9, 0xde, 0x76, 0x04,
+		0xc5, 0x07, 0x98, 0x60, 0xd3, 0x3c, 0x4e, 0xed, 0x0c, 0xc8, 0xbd, 0xdd, 0x5e, 0xb0, 0xe2, 0x33,
+		0x14, 0x2c, 0xdf, 0xdb, 0xed, 0xf9, 0xeb, 0xbd, 0x07, 0x72, 0xbd, 0x5d, 0x7f, 0xa5, 0x0f, 0x32,
+		0xff, 0xab, 0xb7, 0xeb, 0xab, 0xf1, 0x31, 0x38, 0x82, 0x40, 0x5d, 0xc3, 0xd1, 0x5b, 0xba, 0xa3,
+		0xfb, 0xa0, 0xdf, 0x43, 0xa1, 0xe7, 0x7a, 0xbb, 0xbd, 0x15, 0x5e, 0x18, 0x68, 0x67, 0x7f, 0xb0,
+		0xb5, 0xe7, 0xca, 0xc7, 0xc3, 0xac, 0x9d, 0x98, 0x27, 0x24, 0xe4, 0x8e, 0x97, 0x1f, 0x5f, 0xb5,
+		0xc5, 0x96, 0xb2, 0x08, 0x59, 0xbf, 0xdc, 0x93, 0x34, 0x30, 0xc9, 0x97, 0x25, 0x74, 0x82, 0x96,
+		0xd6, 0x2a, 0xe8, 0xbe, 0xbc, 0x54, 0x95, 0x23, 0xe8, 0x46, 0x2d, 0xd7, 0x1a, 0x55, 0x4d, 0xdd,
+		0x58, 0x6d, 0xd4, 0x56, 0xaa, 0x72, 0xd4, 0xe7, 0xd8, 0x5f, 0x8b, 0xa5, 0xce, 0xca, 0x0f, 0x5d,
+		0x8b, 0xa5, 0xee, 0x97, 0x1f, 0xa0, 0xec, 0x19, 0x11, 0x4a, 0xe5, 0xad, 0x28, 0xe4, 0x83, 0xcb,
+		0x72, 0xf2, 0x5e, 0x38, 0x2a, 0xe2, 0x6e, 0xb6, 0xe1, 0x68, 0x37, 0xdb, 0x7d, 0x3a, 0x59, 0xbb,
+		0x3a, 0x33, 0x9c, 0xae, 0x50, 0xce, 0x71, 0xa8, 0xba, 0xe1, 0x3c, 0xdf, 0xee, 0xe3, 0x54, 0xec,
+		0xea, 0x0e, 0x59, 0x86, 0x93, 0xa6, 0xa5, 0xd9, 0x8e, 0x6e, 0xb6, 0xf4, 0x7e, 0x4b, 0xf3, 0x22,
+		0x9e, 0x9a, 0xde, 0x6c, 0x1a, 0xb6, 0x6d, 0x31, 0x23, 0xe9, 0x52, 0xb9, 0xcb, 0xb4, 0xea, 0x1c,
+		0xd8, 0xb3, 0x1e, 0x25, 0x0e, 0x3a, 0x34, 0x27, 0xa2, 0xe3, 0xe6, 0xc4, 0x71, 0x48, 0x77, 0xf5,
+		0x9e, 0x66, 0x98, 0x4e, 0x7f, 0x8f, 0xfa, 0xee, 0x29, 0x35, 0xd5, 0xd5, 0x7b, 0x55, 0x4c, 0x93,
+		0x4d, 0xb8, 0xdf, 0x03, 0xd5, 0x3a, 0xc6, 0x8e, 0xde, 0xdc, 0xd3, 0xa8, 0xa3, 0x4e, 0x63, 0x44,
+		0x5a, 0xd3, 0x32, 0xb7, 0x3b, 0xed, 0xa6, 0x63, 0x17, 0x33, 0xae, 0xfe, 0x53, 0x3c, 0x8c, 0x65,
+		0x8a, 0x70, 0xcd, 0xb6, 0x4c, 0xea, 0x9f, 0x2f, 0x09, 0xe8, 0x80, 0xd8, 0x64, 0xdf, 0x15, 0x62,
+		0x13, 0x1c, 0xfa, 0x98, 0x1c, 0xbf, 0x16, 0x4b, 0xc5, 0xe5, 0xc4, 0xb5, 0x58, 0x2a, 0x21, 0x27,
+		0xaf, 0xc5, 0x52, 0x29, 0x39, 0x7d, 0x2d, 0x96, 0x4a, 0xcb, 0xa0, 0xdc, 0xce, 0x41, 0xd6, 0xbf,
+		0xdc, 0xc0, 0xd5, 0x5b, 0x93, 0x1a, 0x5c, 0x89, 0xaa, 0xe4, 0x7b, 0xf6, 0x5d, 0x9c, 0x2c, 0x2c,
+		0xa1, 0x25, 0x5e, 0x4c, 0x30, 0xdf, 0x5e, 0x65, 0x98, 0xe8, 0x05, 0xe1, 0x24, 0x33, 0x98, 0x2f,
+		0x95, 0x52, 0x79, 0x8a, 0x5c, 0x81, 0xc4, 0xcb, 0x36, 0xa5, 0x9d, 0xa0, 0xb4, 0xef, 0xdd, 0x9f,
+		0xf6, 0xb5, 0x3a, 0x25, 0x9e, 0xbe, 0x56, 0xd7, 0x56, 0xd7, 0xd4, 0x95, 0xd2, 0xb2, 0xca, 0xd1,
+		0xc9, 0x31, 0x88, 0x75, 0xf4, 0xf7, 0xef, 0x05, 0x6d, 0x36, 0xcd, 0x22, 0x0b, 0x50, 0x18, 0x98,
+		0x6c, 0xad, 0x8e, 0x63, 0x8c, 0x50, 0x05, 0x3f, 0x54, 0xde, 0x2b, 0x5d, 0x46, 0xf8, 0x29, 0xe5,
+		0xea, 0x18, 0xc4, 0x30, 0x28, 0x1d, 0xb4, 0xac, 0x34, 0x8b, 0x9c, 0x81, 0x6c, 0xcb, 0xd8, 0x1a,
+		0xec, 0x68, 0x7d, 0xa3, 0xa5, 0x37, 0x9d, 0xa0, 0x3d, 0xc9, 0xd0, 0x22, 0x95, 0x96, 0x90, 0xe7,
+		0x20, 0x8d, 0x63, 0x64, 0xd2, 0x31, 0x9e, 0xa1, 0x2c, 0x78, 0x78, 0x7f, 0x16, 0xf0, 0x21, 0x16,
+		0x48, 0xaa, 0x87, 0x4f, 0xae, 0x42, 0xd2, 0xd1, 0xfb, 0x3b, 0x86, 0x63, 0x17, 0x67, 0x4f, 0x45,
+		0xcf, 0xe4, 0x2f, 0x2c, 0x4c, 0x43, 0xaa, 0x41, 0x51, 0xe8, 0x4a, 0x59, 0xa0, 0x93, 0xe7, 0x41,
+		0xe6, 0xa1, 0x58, 0x8d, 0x2f, 0x73, 0xed, 0xe2, 0x1c, 0x15, 0xc0, 0xf7, 0xec, 0x4f, 0x92, 0x47,
+		0x72, 0x2b, 0x0c, 0x49, 0x2d, 0x18, 0x81, 0x74, 0x70, 0x5e, 0x1c, 0x3e, 0xc8, 0xbc, 0xd8, 0x80,
+		0x02, 0xff, 0xad, 0xd9, 0x83, 0x5e, 0xcf, 0xea, 0x3b, 0xc5, 0x23, 0xa7, 0xa4, 0xc9, 0x0d, 0x12,
+		0xc4, 0x18, 0x8e, 0x9a, 0xdf, 0x0e, 0xa4, 0xbf, 0x7a, 0xd3, 0x6d, 0xfe, 0x25, 0xc8, 0x07, 0x99,
+		0xe1, 0x0f, 0x84, 0x47, 0xa7, 0x0c, 0x84, 0xe3, 0xb2, 0x44, 0x2c, 0xd4, 0xd0, 0x34, 0xb1, 0xc4,
+		0xfc, 0xc7, 0x22, 0x90, 0x0f, 0x76, 0x8c, 0x5c, 0x01, 0x22, 0x46, 0xac, 0x6d, 0x3a, 0x7d, 0xab,
+		0x35, 0x68, 0x1a, 0xad, 0xa2, 0x34, 0xa1, 0x9e, 0x19, 0x8e, 0x53, 0x73, 0x51, 0xfc, 0x84, 0x7c,
+		0xb3, 0x20, 0x32, 0x25, 0xa1, 0x8a, 0x37, 0x3f, 0xce, 0xc1, 0xac, 0x20, 0x80, 0xc4, 0x6e, 0xea,
+		0x7d, 0x13, 0x5d, 0x64, 0xe6, 0xb4, 0x13, 0x5f, 0xd1, 0xf3, 0xac, 0x84, 0x94, 0x40, 0x88, 0x8b,
+		0xd6, 0x37, 0xba, 0x16, 0xc6, 0xbb, 0x62, 0x13, 0xaa, 0xcd, 0x73, 0x04, 0x95, 0xc1, 0x2b, 0xe7,
+		0x20, 0x4e, 0xd5, 0x0f, 0x01, 0xe0, 0x0a, 0x48, 0x3e, 0x44, 0x52, 0x10, 0x5b, 0x5a, 0x53, 0xd1,
+		0x3c, 0xca, 0x90, 0x65, 0xb9, 0xda, 0x7a, 0xad, 0xba, 0x54, 0x95, 0x23, 0xca, 0xe3, 0x90, 0x60,
+		0x3a, 0x05, 0x4d, 0xa7, 0xab, 0x55, 0xe4, 0x43, 0x3c, 0xc9, 0x69, 0x48, 0xa2, 0x74, 0x63, 0xa5,
+		0x5c, 0x55, 0xe5, 0x88, 0xb2, 0x01, 0x85, 0xa1, 0x79, 0x48, 0x0e, 0xc3, 0x8c, 0x5a, 0x6d, 0x54,
+		0x57, 0x31, 0x38, 0xa0, 0x6d, 0xac, 0x3e, 0xb7, 0xba, 0xf6, 0x3c, 0x46, 0xd6, 0x02, 0xd9, 0xc2,
+		0x0e, 0x4b, 0x64, 0x0e, 0x64, 0x2f, 0xbb, 0xbe, 0xb6, 0xa1, 0xd2, 0xd6, 0x7c, 0x67, 0x04, 0xe4,
+		0xe1, 0x49, 0x49, 0x8e, 0xc2, 0x6c, 0xa3, 0xa4, 0x5e, 0xa9, 0x36, 0x34, 0x16, 0xf0, 0x70, 0x49,
+		0xcf, 0x81, 0xec, 0x2f, 0xb8, 0x5c, 0xa3, 0xf1, 0x9c, 0x93, 0x70, 0xdc, 0x9f, 0x5b, 0x7d, 0xa1,
+		0x51, 0x5d, 0xad, 0xd3, 0xca, 0x4b, 0xab, 0x57, 0xd0, 0x29, 0x18, 0xa2, 0x27, 0x42, 0x2c, 0x51,
+		0x6c, 0x6a, 0x90, 0x5e, 0x75, 0xb9, 0x22, 0xc7, 0x86, 0xb3, 0xd7, 0x56, 0xab, 0x6b, 0x97, 0xe5,
+		0xf8, 0x70, 0xed, 0x34, 0xec, 0x92, 0x20, 0xf3, 0x70, 0x64, 0x38, 0x57, 0xab, 0xae, 0x36, 0xd4,
+		0x17, 0xe5, 0xe4, 0x70, 0xc5, 0xf5, 0xaa, 0xba, 0x59, 0x5b, 0xaa, 0xca, 0x29, 0x72, 0x04, 0x48,
+		0xb0, 0x45, 0x8d, 0xab, 0x6b, 0x15, 0x39, 0x1d, 0x66, 0xb1, 0x88, 0x3c, 0xab, 0xfc, 0xb4, 0x04,
+		0x59, 0x7f, 0x08, 0x24, 0xa0, 0x54, 0xa4, 0x77, 0x9b, 0xb1, 0x55, 0x7e, 0x2f, 0x02, 0x19, 0x5f,
+		0x2c, 0x04, 0x17, 0xb1, 0x7a, 0xa7, 0x63, 0xdd, 0xd4, 0xf4, 0x4e, 0x5b, 0xb7, 0xb9, 0x3d, 0x04,
+		0x9a, 0x55, 0xc2, 0x9c, 0x69, 0xed, 0xcf, 0xf4, 0xae, 0x4b, 0xe2, 0x8e, 0x5d, 0x97, 0xe4, 0xbb,
+		0xd0, 0x75, 0x89, 0xcb, 0x09, 0xe5, 0x0f, 0x22, 0x20, 0x0f, 0x47, 0x47, 0x86, 0xf8, 0x26, 0x8d,
+		0xe3, 0x9b, 0xbf, 0x7f, 0x91, 0x83, 0xf4, 0x6f, 0xd8, 0xaa, 0x47, 0xc7, 0x5a, 0xf5, 0x10, 0x63,
+		0x15, 0x7b, 0x37, 0x1b, 0x2b, 0xbf, 0xb8, 0xfe, 0x3b, 0x09, 0xf2, 0xc1, 0x60, 0x4e, 0x80, 0x63,
+		0xca, 0x41, 0x38, 0x16, 0x1c, 0x91, 0xd3, 0xe3, 0x46, 0xe4, 0x6f, 0xa5, 0x5f, 0xdf, 0x1f, 0x85,
+		0x5c, 0x20, 0xf6, 0x33, 0x6d, 0xeb, 0x5e, 0x81, 0x99, 0x76, 0xcb, 0xe8, 0xf6, 0x2c, 0x07, 0x4f,
+		0x1e, 0x68, 0x1d, 0xe3, 0x86, 0xd1, 0xa1, 0x6c, 0xc8, 0x87, 0xec, 0xae, 0x06, 0x6a, 0x58, 0xa8,
+		0x79, 0x78, 0xcb, 0x88, 0xb6, 0x38, 0x5b, 0xab, 0x54, 0x57, 0xd6, 0xd7, 0x1a, 0xd5, 0xd5, 0xa5,
+		0x17, 0x85, 0x26, 0x57, 0xe5, 0xf6, 0x10, 0x58, 0x80, 0xe1, 0xf7, 0xbc, 0x3b, 0x16, 0x9d, 0xeb,
+		0x20, 0x0f, 0xf7, 0x06, 0x15, 0x7a, 0x48, 0x7f, 0xe4, 0x43, 0x64, 0x16, 0x0a, 0xab, 0x6b, 0x5a,
+		0xbd, 0x56, 0xa9, 0x6a, 0xd5, 0xcb, 0x97, 0xab, 0x4b, 0x8d, 0x3a, 0xdb, 0x68, 0x70, 0xa1, 0x1b,
+		0x72, 0xc4, 0x3f, 0x36, 0x3f, 0x10, 0x85, 0xd9, 0x90, 0x96, 0x90, 0x12, 0x0f, 0x11, 0xb2, 0xa8,
+		0xe5, 0xc3, 0xd3, 0xb4, 0x7e, 0x01, 0x57, 0xf7, 0xeb, 0x7a, 0xdf, 0xe1, 0x11, 0xc5, 0x07, 0x01,
+		0xd9, 0x6b, 0x3a, 0xe8, 0xde, 0xf7, 0xf9, 0x06, 0x0e, 0x73, 0x41, 0x0a, 0x5e, 0x3e, 0xdb, 0xc3,
+		0x79, 0x0f, 0x90, 0x9e, 0x65, 0xb7, 0x9d, 0xf6, 0x0d, 0x3c, 0x08, 0x21, 0x76, 0x7b, 0x70, 0xe2,
+		0xc6, 0x54, 0x59, 0x94, 0xd4, 0x4c, 0xc7, 0x85, 0x36, 0x8d, 0x1d, 0x7d, 0x08, 0x1a, 0x97, 0x1f,
+		0x51, 0x55, 0x16, 0x25, 0x2e, 0xf4, 0x69, 0xc8, 0xb6, 0xac, 0x01, 0x46, 0x65, 0x18, 0x1c, 0xaa,
+		0x64, 0x49, 0xcd, 0xb0, 0x3c, 0x17, 0x84, 0x87, 0xcd, 0xbc, 0x6d, 0xa6, 0xac, 0x9a, 0x61, 0x79,
+		0x0c, 0xe4, 0x01, 0x28, 0xe8, 0x3b, 0x3b, 0x7d, 0x24, 0x2e, 0x08, 0xb1, 0x40, 0x60, 0xde, 0xcd,
+		0xa6, 0x80, 0xf3, 0xd7, 0x20, 0x25, 0xf8, 0x80, 0xeb, 0x5f, 0xe4, 0x84, 0xd6, 0x63, 0xd1, 0xed,
+		0x08, 0xee, 0x3c, 0x99, 0xa2, 0xf0, 0x34, 0x64, 0xdb, 0xb6, 0xe6, 0x1d, 0x83, 0x88, 0x9c, 0x8a,
+		0x9c, 0x49, 0xa9, 0x99, 0xb6, 0xed, 0xee, 0x8a, 0x2a, 0x9f, 0x02, 0x00, 0x4f, 0xd8, 0xc8, 0x77,
+		0x4b, 0x90, 0x67, 0x06, 0xa6, 0xd7, 0x37, 0x6c, 0xc3, 0x6c, 0x8a, 0x65, 0xe1, 0x83, 0xfb, 0x88,
+		0x28, 0x53, 0x73, 0xeb, 0x1c, 0xa1, 0xfc, 0xec, 0x87, 0x24, 0xe9, 0x35, 0x29, 0xf6, 0x9a, 0x24,
+		0xfd, 0x88, 0x94, 0x23, 0xa9, 0xea, 0x0b, 0xeb, 0xcb, 0xb5, 0xa5, 0x5a, 0xa3, 0xf8, 0xc7, 0x49,
+		0x9a, 0xae, 0xad, 0xf0, 0xf4, 0x1b, 0xc9, 0x60, 0xf9, 0x9b, 0xc9, 0x5f, 0x90, 0xa2, 0xa9, 0x37,
+		0x93, 0x6a, 0x6e, 0xdb, 0x4f, 0x8f, 0x74, 0xfc, 0x27, 0x28, 0x22, 0xe3, 0x16, 0x92, 0x5e, 0x6b,
+		0xaa, 0xfc, 0xdc, 0x44, 0xf9, 0x41, 0xda, 0x90, 0x04, 0x6d, 0x48, 0x86, 0x24, 0x96, 0x96, 0xd7,
+		0xea, 0xd5, 0x0a, 0x6d, 0x46, 0x9a, 0xc4, 0xd6, 0xd6, 0xab, 0xab, 0xc5, 0x37, 0x44, 0x95, 0xde,
+		0x61, 0x8b, 0xd7, 0x24, 0x38, 0x2a, 0x76, 0x59, 0xb9, 0xad, 0x35, 0xcc, 0xa6, 0xd5, 0x12, 0xde,
+		0x6d, 0xfe, 0xc2, 0xf9, 0xfd, 0x2a, 0x57, 0x39, 0x2a, 0x65, 0x49, 0x95, 0x23, 0x96, 0x1f, 0x1e,
+		0x61, 0x49, 0x69, 0xb5, 0xc2, 0xdb, 0x92, 0x21, 0x89, 0xf5, 0xd2, 0xd2, 0x73, 0xd5, 0x8a, 0xd7,
+		0x9a, 0xc3, 0xfd, 0x30, 0x2a, 0xe4, 0x9b, 0xa0, 0x80, 0xd1, 0x56, 0x94, 0x8d, 0x76, 0x8b, 0x6d,
+		0x7b, 0xc7, 0xc6, 0xed, 0x97, 0x7a, 0x2d, 0xc2, 0xf0, 0xeb, 0xa6, 0x8b, 0x51, 0x7e, 0xd0, 0xd7,
+		0x94, 0x34, 0x89, 0xad, 0xae, 0xad, 0x56, 0x45, 0x33, 0xe8, 0x16, 0xf1, 0x8b, 0x5e, 0x33, 0xf2,
+		0x83, 0x00, 0x2a, 0xf9, 0x26, 0x90, 0x45, 0x78, 0xc8, 0x65, 0x49, 0x7c, 0xdc, 0x96, 0xaf, 0xd7,
+		0x00, 0x1e, 0x64, 0x72, 0x99, 0x71, 0xbf, 0xaf, 0x05, 0x73, 0xa4, 0xb0, 0x5c, 0x5d, 0xbd, 0xd2,
+		0xb8, 0xaa, 0xad, 0xab, 0x55, 0xba, 0x73, 0x57, 0xfc, 0x63, 0x51, 0x7d, 0xa1, 0x1b, 0x44, 0x24,
+		0xdf, 0x22, 0x41, 0x86, 0xb9, 0x40, 0x2c, 0x26, 0xc5, 0x82, 0x0a, 0xf7, 0xef, 0x57, 0x37, 0xf5,
+		0x80, 0x28, 0x74, 0xf9, 0x12, 0xad, 0x36, 0x2a, 0x04, 0xe2, 0x28, 0x21, 0xcb, 0xd5, 0x2b, 0xa5,
+		0xa5, 0x17, 0xb5, 0x72, 0xb5, 0xde, 0x40, 0x4d, 0xb6, 0xa6, 0x32, 0x19, 0x05, 0x12, 0x2f, 0x2d,
+		0x2f, 0xaf, 0x3d, 0xef, 0x31, 0x02, 0x5e, 0x76, 0xc9, 0x28, 0x5f, 0x07, 0xb9, 0x80, 0xb8, 0xa3,
+		0x53, 0x4c, 0x9d, 0x69, 0xec, 0x41, 0xbd, 0xba, 0xba, 0xe4, 0x77, 0xe2, 0xb3, 0xe0, 0x8a, 0xb7,
+		0x2c, 0x61, 0x4a, 0x08, 0xbf, 0x1c, 0x41, 0x35, 0xca, 0x1b, 0xe0, 0xee, 0x25, 0x46, 0x95, 0x27,
+		0x20, 0x25, 0xc4, 0x17, 0x5d, 0x73, 0xea, 0x61, 0x0f, 0x2d, 0x0c, 0x52, 0x40, 0x65, 0x57, 0x96,
+		0x70, 0x19, 0xc4, 0x64, 0x5a, 0x8e, 0x28, 0x9b, 0x70, 0x38, 0x54, 0xf4, 0xc8, 0x3d, 0x70, 0x52,
+		0xec, 0x5f, 0x32, 0xa7, 0x5f, 0xab, 0xae, 0x2e, 0xad, 0x55, 0x70, 0x99, 0xe4, 0xd1, 0x04, 0xe0,
+		0x32, 0xc8, 0x5a, 0x29, 0xe4, 0x53, 0x8e, 0x28, 0x35, 0xc8, 0x07, 0x05, 0x88, 0x1c, 0x87, 0xa3,
+		0x1b, 0x8d, 0xcb, 0x4f, 0x6a, 0x9b, 0xa5, 0xe5, 0x5a, 0xa5, 0x34, 0xb4, 0x20, 0x02, 0xe0, 0x52,
+		0x24, 0x47, 0xb0, 0xa1, 0x28, 0x5d, 0x72, 0x54, 0x89, 0xa5, 0x24, 0x59, 0x52, 0xea, 0x50, 0x18,
+		0x12, 0x05, 0x72, 0x17, 0x14, 0xf9, 0x0a, 0x25, 0xac, 0x55, 0xb3, 0x30, 0x2c, 0x1c, 0x6c, 0xad,
+		0x56, 0xa9, 0x2e, 0xd7, 0x56, 0x6a, 0x0d, 0xda, 0xbe, 0xab, 0x00, 0xde, 0x18, 0xa3, 0xcd, 0xba,
+		0x56, 0x5f, 0x5b, 0xd5, 0x2e, 0xe3, 0x42, 0xaf, 0xe1, 0x23, 0x95, 0x06, 0x36, 0xa6, 0xb2, 0x84,
+		0xeb, 0x91, 0xd1, 0x81, 0x97, 0x23, 0x67, 0x13, 0x68, 0xb1, 0x3e, 0xbc, 0x7a, 0x36, 0x91, 0xfa,
+		0xf0, 0xaa, 0xfc, 0x51, 0xfc, 0xff, 0xd1, 0x55, 0xf9, 0xbb, 0x57, 0xaf, 0x25, 0x52, 0x6f, 0x24,
+		0xe5, 0x37, 0x93, 0xca, 0x5f, 0x46, 0x81, 0x78, 0x92, 0xe5, 0xc6, 0x3c, 0x5e, 0x80, 0x94, 0x1b,
+		0x44, 0x61, 0xa7, 0x34, 0xdf, 0xbb, 0x8f, 0x40, 0x0a, 0x34, 0x5f, 0xd6, 0x50, 0x50, 0xc5, 0xa5,
+		0x86, 0x2b, 0xe6, 0x6e, 0xdb, 0x6c, 0x77, 0x07, 0x5d, 0x4d, 0x44, 0x16, 0x26, 0xae, 0x98, 0x39,
+		0x02, 0x4f, 0x53, 0x12, 0xfa, 0xad, 0x00, 0x89, 0xf8, 0x44, 0x12, 0x0c, 0x81, 0xa7, 0xe7, 0xbf,
+		0x24, 0x41, 0x71, 0x5c, 0x63, 0xef, 0x28, 0xe8, 0xb1, 0x0a, 0x73, 0xd6, 0x0d, 0xa3, 0xdf, 0x6f,
+		0xb7, 0xe8, 0x3e, 0x86, 0xeb, 0x0a, 0xc5, 0x26, 0xbb, 0x42, 0xb3, 0x3e, 0x44, 0x9e, 0x6d, 0x93,
+		0x32, 0x5a, 0xac, 0x5b, 0xa8, 0xac, 0x05, 0xa5, 0xf8, 0x64, 0x4a, 0x39, 0x8a, 0x22, 0x68, 0x5c,
+		0x43, 0x01, 0xc5, 0xd5, 0x47, 0x44, 0x8e, 0x7a, 0xfe, 0x96, 0xf2, 0xa9, 0x08, 0xe4, 0x83, 0xc7,
+		0x22, 0x49, 0x05, 0x52, 0x1d, 0x8b, 0x1f, 0x39, 0x62, 0xa3, 0x7d, 0x66, 0xc2, 0x49, 0xca, 0x85,
+		0x65, 0x0e, 0xaf, 0xba, 0x98, 0xf3, 0xbf, 0x2b, 0x41, 0x4a, 0x64, 0x93, 0x23, 0x10, 0xeb, 0xe9,
+		0xce, 0x2e, 0x25, 0x17, 0x2f, 0x47, 0x64, 0x49, 0xa5, 0x69, 0xcc, 0xb7, 0x7b, 0x3a, 0x3b, 0x6e,
+		0xc5, 0xf3, 0x31, 0x8d, 0x3e, 0x4f, 0xc7, 0xd0, 0x5b, 0x74, 0x07, 0xce, 0xea, 0x76, 0x0d, 0xd3,
+		0xb1, 0x85, 0xcf, 0xc3, 0xf3, 0x97, 0x78, 0x36, 0x9e, 0xce, 0x75, 0xfa, 0x7a, 0xbb, 0x13, 0x80,
+		0x8d, 0x51, 0x58, 0x59, 0x14, 0xb8, 0xc0, 0x8b, 0x70, 0x4c, 0xd0, 0x6d, 0x19, 0x8e, 0xde, 0xdc,
+		0x35, 0x5a, 0x1e, 0x52, 0x82, 0xee, 0xb4, 0x1f, 0xe5, 0x00, 0x15, 0x5e, 0x2e, 0x70, 0x95, 0xcf,
+		0x46, 0x60, 0x46, 0xec, 0x19, 0xb6, 0x5c, 0x66, 0xad, 0x00, 0xe8, 0xa6, 0x69, 0x39, 0x7e, 0x76,
+		0x8d, 0xba, 0x79, 0x23, 0x78, 0x0b, 0x25, 0x17, 0x49, 0xf5, 0x11, 0x98, 0xff, 0x33, 0x09, 0xc0,
+		0x2b, 0x1a, 0xcb, 0xb7, 0x93, 0x90, 0xe1, 0x87, 0x5e, 0xe9, 0xc9, 0x69, 0x16, 0x5a, 0x03, 0x96,
+		0x85, 0xbb, 0x8b, 0x18, 0x75, 0xdb, 0x32, 0x76, 0xda, 0x26, 0x3f, 0xc5, 0xc4, 0x12, 0xe2, 0x30,
+		0x40, 0xcc, 0x3b, 0xe5, 0xa7, 0x42, 0xca, 0x36, 0xba, 0xba, 0xe9, 0xb4, 0x9b, 0x7c, 0xd6, 0x5c,
+		0x3c, 0x50, 0xe3, 0x17, 0xea, 0x1c, 0x5b, 0x75, 0xe9, 0x28, 0x67, 0x20, 0x25, 0x72, 0x5d, 0xfd,
+		0x78, 0x88, 0x24, 0x21, 0x5a, 0xaf, 0xa2, 0x85, 0xa0, 0x6a, 0xaa, 0x56, 0xaa, 0xcb, 0x91, 0xb3,
+		0x9f, 0x8a, 0x40, 0x52, 0x4c, 0xe3, 0x59, 0x28, 0x54, 0x2b, 0xb5, 0x21, 0x55, 0x3b, 0x0b, 0x79,
+		0x91, 0xc9, 0xf4, 0x99, 0xfc, 0xc1, 0xa4, 0x3f, 0x73, 0x5d, 0x5d, 0x6b, 0xac, 0x5d, 0x90, 0xff,
+		0x78, 0x34, 0xf3, 0x51, 0xf9, 0x8d, 0x24, 0x99, 0x81, 0xac, 0xc8, 0xbc, 0xf0, 0xc8, 0x85, 0x47,
+		0xe5, 0x37, 0x87, 0xb3, 0x1e, 0x93, 0xff, 0x84, 0x46, 0x75, 0x44, 0xd6, 0x79, 0xad, 0x81, 0xfa,
+		0x72, 0x6d, 0x75, 0xf9, 0x45, 0x59, 0xf2, 0x17, 0x5c, 0xf0, 0x15, 0x44, 0xc8, 0xdd, 0x70, 0x54,
+		0x14, 0x5c, 0xba, 0x74, 0xe9, 0xd2, 0x13, 0xbe, 0xc2, 0xdb, 0x1f, 0x49, 0x0c, 0x17, 0x3f, 0xe9,
+		0x2b, 0xfe, 0xc4, 0x68, 0xf1, 0x25, 0x5f, 0xf1, 0x0f, 0x7d, 0x24, 0x41, 0x66, 0x21, 0x23, 0x8a,
+		0x57, 0x4a, 0x2f, 0xc8, 0x5f, 0xf9, 0xca, 0x57, 0xbe, 0x92, 0x2c, 0x7f, 0x13, 0xcc, 0x36, 0xad,
+		0xee, 0xf0, 0xd0, 0x94, 0xe5, 0xa1, 0x23, 0x09, 0xf6, 0x55, 0xe9, 0xa5, 0x87, 0x39, 0xd0, 0x8e,
+		0xd5, 0xd1, 0xcd, 0x9d, 0x05, 0xab, 0xbf, 0xe3, 0x9d, 0xd0, 0x47, 0xf7, 0xd2, 0xf6, 0x9d, 0xd3,
+		0xef, 0x6d, 0x7d, 0x49, 0x92, 0x7e, 0x24, 0x12, 0xbd, 0xb2, 0x5e, 0xfe, 0xc9, 0xc8, 0xfc, 0x15,
+		0x86, 0xb8, 0x2e, 0x06, 0x5e, 0x35, 0xb6, 0x3b, 0x46, 0x13, 0x47, 0x07, 0xfe, 0xfc, 0x21, 0x98,
+		0xdb, 0xb1, 0x76, 0x2c, 0x4a, 0xe9, 0x1c, 0xfe, 0x62, 0x8d, 0x20, 0x69, 0x37, 0x77, 0x7e, 0xe2,
+		0x7d, 0x80, 0xc5, 0x55, 0x98, 0xe5, 0xc0, 0x1a, 0xf5, 0x76, 0xd9, 0xae, 0x29, 0xd9, 0xf7, 0xe4,
+		0x4d, 0xf1, 0xe7, 0xff, 0x88, 0x86, 0x29, 0xd4, 0x19, 0x8e, 0x8a, 0x65, 0x6c, 0x63, 0x75, 0x51,
+		0x85, 0xc3, 0x01, 0x7a, 0x6c, 0xa5, 0x61, 0xf4, 0x27, 0x50, 0xfc, 0x17, 0x9c, 0xe2, 0xac, 0x8f,
+		0x62, 0x9d, 0xa3, 0x2e, 0x2e, 0x41, 0xee, 0x20, 0xb4, 0x7e, 0x93, 0xd3, 0xca, 0x1a, 0x7e, 0x22,
+		0x57, 0xa0, 0x40, 0x89, 0x34, 0x07, 0xb6, 0x63, 0x75, 0xe9, 0x32, 0x6e, 0x7f, 0x32, 0xff, 0xf2,
+		0x8f, 0x98, 0x7a, 0xcb, 0x23, 0xda, 0x92, 0x8b, 0xb5, 0xb8, 0x08, 0xd4, 0x6b, 0xc7, 0xe3, 0xa4,
+		0x13, 0x28, 0xfc, 0x16, 0x6f, 0x88, 0x0b, 0xbf, 0xb8, 0x09, 0x73, 0xf8, 0x9b, 0xae, 0xb2, 0xfc,
+		0x2d, 0x99, 0x7c, 0x4c, 0xa7, 0xf8, 0x7b, 0xdf, 0xca, 0x34, 0xe8, 0xac, 0x4b, 0xc0, 0xd7, 0x26,
+		0xdf, 0x28, 0xee, 0x18, 0x8e, 0x63, 0xf4, 0x6d, 0x4d, 0xef, 0x84, 0x35, 0xcf, 0x77, 0xce, 0xa1,
+		0xf8, 0xfd, 0x5f, 0x08, 0x8e, 0xe2, 0x15, 0x86, 0x59, 0xea, 0x74, 0x16, 0x37, 0xe0, 0x68, 0x88,
+		0x54, 0x4c, 0x41, 0xf3, 0x07, 0x38, 0xcd, 0xb9, 0x11, 0xc9, 0x40, 0xb2, 0xeb, 0x20, 0xf2, 0xdd,
+		0xb1, 0x9c, 0x82, 0xe6, 0xc7, 0x39, 0x4d, 0xc2, 0x71, 0xc5, 0x90, 0x22, 0xc5, 0x6b, 0x30, 0x73,
+		0xc3, 0xe8, 0x6f, 0x59, 0x36, 0x3f, 0x5b, 0x32, 0x05, 0xb9, 0x1f, 0xe4, 0xe4, 0x0a, 0x1c, 0x91,
+		0x1e, 0x36, 0x41, 0x5a, 0x97, 0x20, 0xb5, 0xad, 0x37, 0x8d, 0x29, 0x48, 0xdc, 0xe6, 0x24, 0x92,
+		0x08, 0x8f, 0xa8, 0x25, 0xc8, 0xee, 0x58, 0x7c, 0xa1, 0x3d, 0x19, 0xfd, 0x13, 0x1c, 0x3d, 0x23,
+		0x70, 0x38, 0x89, 0x9e, 0xd5, 0x1b, 0x74, 0x70, 0x15, 0x3e, 0x99, 0xc4, 0x0f, 0x09, 0x12, 0x02,
+		0x87, 0x93, 0x38, 0x00, 0x5b, 0x3f, 0x29, 0x48, 0xd8, 0x3e, 0x7e, 0x3e, 0x8b, 0x47, 0x4e, 0x3b,
+		0x7b, 0x96, 0x39, 0x4d, 0x23, 0x7e, 0x98, 0x53, 0x00, 0x8e, 0x82, 0x04, 0x9e, 0x82, 0xf4, 0xb4,
+		0x03, 0xf1, 0x63, 0x5f, 0x10, 0xd3, 0x43, 0x8c, 0xc0, 0x15, 0x28, 0x08, 0x05, 0x85, 0x1b, 0x36,
+		0x93, 0x49, 0xfc, 0x38, 0x27, 0x91, 0xf7, 0xa1, 0xf1, 0x6e, 0x38, 0x86, 0xed, 0xec, 0x18, 0xd3,
+		0x10, 0xf9, 0x94, 0xe8, 0x06, 0x47, 0xe1, 0xac, 0xdc, 0x32, 0xcc, 0xe6, 0xee, 0x74, 0x14, 0x3e,
+		0x2d, 0x58, 0x29, 0x70, 0x90, 0xc4, 0x12, 0xe4, 0xba, 0x7a, 0xdf, 0xde, 0xd5, 0x3b, 0x53, 0x0d,
+		0xc7, 0x4f, 0x70, 0x1a, 0x59, 0x17, 0x89, 0x73, 0x64, 0x60, 0x1e, 0x84, 0xcc, 0x4f, 0x0a, 0x8e,
+		0x0c, 0xcc, 0x00, 0xa1, 0x75, 0x98, 0xb3, 0x1d, 0xea, 0xf9, 0x1e, 0x84, 0xda, 0x4f, 0x89, 0xa9,
+		0xc7, 0x70, 0x57, 0xfc, 0x14, 0x9f, 0x82, 0xb4, 0xdd, 0x7e, 0xff, 0x54, 0x64, 0x7e, 0x5a, 0x8c,
+		0x34, 0x45, 0x40, 0xe4, 0x17, 0xe1, 0x58, 0xa8, 0x99, 0x98, 0x82, 0xd8, 0xcf, 0x70, 0x62, 0x47,
+		0x42, 0x4c, 0x05, 0x57, 0x09, 0x07, 0x25, 0xf9, 0xb3, 0x42, 0x25, 0x18, 0x43, 0xb4, 0xd6, 0x31,
+		0xf4, 0x69, 0xeb, 0xdb, 0x07, 0xe3, 0xda, 0x3f, 0x10, 0x5c, 0x63, 0xb8, 0x01, 0xae, 0x35, 0xe0,
+		0x08, 0xa7, 0x78, 0xb0, 0x71, 0xfd, 0x39, 0xa1, 0x58, 0x19, 0xf6, 0x46, 0x70, 0x74, 0xbf, 0x16,
+		0xe6, 0x5d, 0x76, 0x8a, 0x18, 0x9b, 0xad, 0xe1, 0x09, 0x95, 0xc9, 0x94, 0x7f, 0x9e, 0x53, 0x16,
+		0x1a, 0xdf, 0x0d, 0xd2, 0xd9, 0x2b, 0x7a, 0x0f, 0x89, 0xbf, 0x00, 0x45, 0x41, 0x7c, 0x60, 0xf6,
+		0x8d, 0xa6, 0xb5, 0x63, 0xb6, 0xdf, 0x6f, 0xb4, 0xa6, 0x20, 0xfd, 0x0b, 0x43, 0x43, 0xb5, 0xe1,
+		0x43, 0x47, 0xca, 0x35, 0x90, 0x5d, 0x5f, 0x45, 0x6b, 0x77, 0xe9, 0x7e, 0xc4, 0xfe, 0x14, 0x7f,
+		0x51, 0x8c, 0x94, 0x8b, 0x57, 0xa3, 0x68, 0x8b, 0x55, 0x60, 0xa7, 0xd3, 0xa7, 0x15, 0xc9, 0xcf,
+		0x70, 0x42, 0x39, 0x0f, 0x8b, 0x2b, 0x8e, 0xa6, 0xd5, 0xed, 0xe9, 0xfd, 0x69, 0xf4, 0xdf, 0x2f,
+		0x09, 0xc5, 0xc1, 0x51, 0xb8, 0xe2, 0x40, 0x8f, 0x0e, 0xad, 0xfd, 0x14, 0x14, 0x7e, 0x59, 0x28,
+		0x0e, 0x81, 0xc3, 0x49, 0x08, 0x87, 0x61, 0x0a, 0x12, 0xff, 0x50, 0x90, 0x10, 0x38, 0x48, 0xe2,
+		0x7d, 0x9e, 0xa1, 0xed, 0x1b, 0x3b, 0x6d, 0xdb, 0xe1, 0xf7, 0x47, 0xf6, 0x27, 0xf5, 0x2b, 0x5f,
+		0x08, 0x3a, 0x61, 0xaa, 0x0f, 0x15, 0x35, 0x11, 0x0f, 0x8c, 0xd1, 0xc0, 0xef, 0xe4, 0x86, 0xfd,
+		0xaa, 0xd0, 0x44, 0x3e, 0x34, 0x6c, 0x9b, 0xcf, 0x43, 0x44, 0xb6, 0x37, 0x71, 0x49, 0x37, 0x05,
+		0xb9, 0x5f, 0x1b, 0x6a, 0x5c, 0x5d, 0xe0, 0x22, 0x4d, 0x9f, 0xff, 0x33, 0x30, 0xaf, 0x1b, 0x7b,
+		0x53, 0x49, 0xe7, 0x3f, 0x1a, 0xf2, 0x7f, 0x36, 0x18, 0x26, 0xd3, 0x21, 0x85, 0x21, 0x7f, 0x8a,
+		0x4c, 0xba, 0x5c, 0x56, 0xfc, 0xe6, 0xb7, 0x78, 0x7f, 0x83, 0xee, 0xd4, 0xe2, 0x32, 0xc8, 0x3c,
+		0xc7, 0x73, 0x60, 0x27, 0x12, 0xfb, 0xd6, 0xb7, 0x5c, 0x39, 0x0f, 0xf8, 0x3c, 0x8b, 0x97, 0x21,
+		0x17, 0x70, 0x78, 0x26, 0x93, 0xfa, 0x20, 0x27, 0x95, 0xf5, 0xfb, 0x3b, 0x8b, 0x8f, 0x43, 0x0c,
+		0x9d, 0x97, 0xc9, 0xe8, 0xdf, 0xc6, 0xd1, 0x29, 0xf8, 0xe2, 0xd3, 0x90, 0x12, 0x4e, 0xcb, 0x64,
+		0xd4, 0x6f, 0xe7, 0xa8, 0x2e, 0x0a, 0xa2, 0x0b, 0x87, 0x65, 0x32, 0xfa, 0xff, 0x2d, 0xd0, 0x05,
+		0x0a, 0xa2, 0x4f, 0xcf, 0xc2, 0x7f, 0xfa, 0xe1, 0x18, 0x43, 0x17, 0x28, 0x8b, 0x78, 0x3a, 0x9e,
+		0x79, 0x2a, 0x93, 0xb1, 0xbf, 0x83, 0x57, 0x2e, 0x30, 0x16, 0x9f, 0x80, 0xf8, 0x94, 0x0c, 0xff,
+		0x08, 0x47, 0x65, 0xf0, 0x8b, 0x4b, 0x90, 0xf1, 0x79, 0x27, 0x93, 0xd1, 0xbf, 0x8b, 0xa3, 0xfb,
+		0xb1, 0xb0, 0xe9, 0xdc, 0x3b, 0x99, 0x4c, 0xe0, 0xff, 0x11, 0x4d, 0xe7, 0x18, 0xc8, 0x36, 0xe1,
+		0x98, 0x4c, 0xc6, 0xfe, 0xa8, 0xe0, 0xba, 0x40, 0x59, 0x7c, 0x16, 0xd2, 0xae, 0xb1, 0x99, 0x8c,
+		0xff, 0xdd, 0x1c, 0xdf, 0xc3, 0x41, 0x0e, 0x0c, 0xcc, 0x03, 0x90, 0xf8, 0x7f, 0x05, 0x07, 0x7c,
+		0x58, 0x38, 0x8d, 0x86, 0x1d, 0x98, 0xc9, 0x94, 0xbe, 0x47, 0x4c, 0xa3, 0x21, 0xff, 0x05, 0x47,
+		0x93, 0xea, 0xfc, 0xc9, 0x24, 0xbe, 0x57, 0x8c, 0x26, 0x85, 0xc7, 0x66, 0x0c, 0x7b, 0x04, 0x93,
+		0x69, 0x7c, 0x9f, 0x68, 0xc6, 0x90, 0x43, 0xb0, 0xb8, 0x0e, 0x64, 0xd4, 0x1b, 0x98, 0x4c, 0xef,
+		0x35, 0x4e, 0x6f, 0x66, 0xc4, 0x19, 0x58, 0x7c, 0x1e, 0x8e, 0x84, 0x7b, 0x02, 0x93, 0xa9, 0x7e,
+		0xff, 0x5b, 0x43, 0x6b, 0x37, 0xbf, 0x23, 0xb0, 0xd8, 0x80, 0xb9, 0x30, 0x2f, 0x60, 0x32, 0xd9,
+		0x1f, 0x78, 0x2b, 0xa8, 0xb8, 0xfd, 0x4e, 0xc0, 0x62, 0x09, 0xc0, 0x33, 0xc0, 0x93, 0x69, 0xfd,
+		0x20, 0xa7, 0xe5, 0x43, 0xc2, 0xa9, 0xc1, 0xed, 0xef, 0x64, 0xfc, 0xdb, 0x62, 0x6a, 0x70, 0x0c,
+		0x9c, 0x1a, 0xc2, 0xf4, 0x4e, 0xc6, 0xfe, 0x84, 0x98, 0x1a, 0x02, 0x05, 0x25, 0xdb, 0x67, 0xdd,
+		0x26, 0x53, 0xf8, 0x61, 0x21, 0xd9, 0x3e, 0xac, 0xc5, 0x55, 0x98, 0x19, 0x31, 0x88, 0x93, 0x49,
+		0xfd, 0x08, 0x27, 0x25, 0x0f, 0xdb, 0x43, 0xbf, 0xf1, 0xe2, 0xc6, 0x70, 0x32, 0xb5, 0x1f, 0x1d,
+		0x32, 0x5e, 0xdc, 0x16, 0x2e, 0x3e, 0x05, 0x29, 0x73, 0xd0, 0xe9, 0xe0, 0xe4, 0x21, 0xfb, 0xdf,
+		0x1f, 0x2c, 0xfe, 0xc9, 0x97, 0x39, 0x77, 0x04, 0xc2, 0xe2, 0xe3, 0x10, 0x37, 0xba, 0x5b, 0x46,
+		0x6b, 0x12, 0xe6, 0x9f, 0x7e, 0x59, 0x28, 0x4c, 0x84, 0x5e, 0x7c, 0x16, 0x80, 0x85, 0x46, 0xe8,
+		0x19, 0xdc, 0x09, 0xb8, 0x7f, 0xf6, 0x65, 0x7e, 0x61, 0xc7, 0x43, 0xf1, 0x08, 0xb0, 0xeb, 0x3f,
+		0xfb, 0x13, 0xf8, 0x42, 0x90, 0x00, 0x1d, 0x91, 0x4b, 0x90, 0xc4, 0xad, 0x37, 0x47, 0xdf, 0x99,
+		0x84, 0xfd, 0xe7, 0x1c, 0x5b, 0xc0, 0x23, 0xc3, 0xba, 0x56, 0xdf, 0x70, 0xf4, 0x1d, 0x7b, 0x12,
+		0xee, 0x5f, 0x70, 0x5c, 0x17, 0x01, 0x91, 0x9b, 0xba, 0xed, 0x4c, 0xd3, 0xef, 0xbf, 0x14, 0xc8,
+		0x02, 0x01, 0x1b, 0x8d, 0xbf, 0xaf, 0x1b, 0x7b, 0x93, 0x70, 0xff, 0x4a, 0x34, 0x9a, 0xc3, 0x2f,
+		0x3e, 0x0d, 0x69, 0xfc, 0xc9, 0x6e, 0xe1, 0x4d, 0x40, 0xfe, 0x6b, 0x8e, 0xec, 0x61, 0x60, 0xcd,
+		0xb6, 0xd3, 0x72, 0xda, 0x93, 0x99, 0xfd, 0x37, 0x7c, 0xa4, 0x05, 0xfc, 0x62, 0x09, 0x32, 0xb6,
+		0xd3, 0x6a, 0x0d, 0xb8, 0x7f, 0x3a, 0x01, 0xfd, 0xbf, 0x7e, 0xd9, 0x0d, 0x59, 0xb8, 0x38, 0x38,
+		0xda, 0x37, 0xaf, 0x3b, 0x3d, 0x8b, 0x9e, 0xda, 0x98, 0x44, 0xe1, 0x2d, 0x4e, 0xc1, 0x87, 0xb2,
+		0xb8, 0x04, 0x59, 0xec, 0x8b, 0xd8, 0xfc, 0x9e, 0x44, 0xe2, 0x8b, 0x9c, 0x01, 0x01, 0xa4, 0xf2,
+		0x37, 0xfe, 0xd6, 0xe7, 0x4e, 0x48, 0x9f, 0xfd, 0xdc, 0x09, 0xe9, 0x3f, 0x7f, 0xee, 0x84, 0xf4,
+		0xd1, 0xcf, 0x9f, 0x38, 0xf4, 0xd9, 0xcf, 0x9f, 0x38, 0xf4, 0x07, 0x9f, 0x3f, 0x71, 0x28, 0x3c,
+		0x4a, 0x0c, 0x57, 0xac, 0x2b, 0x16, 0x8b, 0x0f, 0xbf, 0x74, 0xdf, 0x4e, 0xdb, 0xd9, 0x1d, 0x6c,
+		0x2d, 0x34, 0xad, 0xee, 0xb9, 0xa6, 0x65, 0x77, 0x2d, 0xfb, 0x5c, 0x30, 0xae, 0x4b, 0x7f, 0xc1,
+		0xff, 0x90, 0xe0, 0x18, 0x23, 0xe3, 0x85, 0x73, 0x75, 0x73, 0x6f, 0xdc, 0xbb, 0x2e, 0x17, 0x21,
+		0x5a, 0x32, 0xf7, 0xc8, 0x31, 0xa6, 0xe0, 0xb4, 0x41, 0xbf, 0xc3, 0xaf, 0x82, 0x25, 0x31, 0xbd,
+		0xd1, 0xef, 0x04, 0x8f, 0x01, 0x67, 0xf9, 0x31, 0xe0, 0xf2, 0x77, 0x49, 0x07, 0xeb, 0x49, 0xaa,
+		0x64, 0xee, 0xd1, 0x8e, 0xac, 0x4b, 0x2f, 0xbd, 0x67, 0x62, 0x9c, 0xfb, 0xba, 0x69, 0xdd, 0x34,
+		0xb1, 0xd9, 0xbd, 0x2d, 0x11, 0xe3, 0x3e, 0x31, 0x1c, 0xe3, 0x7e, 0xde, 0xe8, 0x74, 0x9e, 0x43,
+		0x38, 0xdc, 0x8e, 0xb6, 0xb7, 0x12, 0xec, 0xd6, 0x31, 0x7c, 0x4f, 0x04, 0x4e, 0x8c, 0x84, 0xb3,
+		0xb9, 0x10, 0x8c, 0x63, 0xc2, 0x22, 0xa4, 0x2a, 0x42, 0xb6, 0x8a, 0xf8, 0xaa, 0x4a, 0xd3, 0x32,
+		0x5b, 0xec, 0x3c, 0x67, 0x54, 0x15, 0x49, 0x64, 0x84, 0xa9, 0x9b, 0x96, 0xcd, 0x2f, 0x53, 0xb2,
+		0x44, 0xf9, 0xe3, 0x07, 0x64, 0x44, 0x4e, 0xd4, 0x24, 0xb8, 0x71, 0x7e, 0x4a, 0x6e, 0x88, 0x4e,
+		0x04, 0x22, 0xff, 0xd3, 0x72, 0xe5, 0xfb, 0x22, 0x70, 0x72, 0x98, 0x2b, 0x38, 0xb3, 0x6c, 0x47,
+		0xef, 0xf6, 0xc6, 0xb1, 0xe5, 0x29, 0x48, 0x37, 0x04, 0xcc, 0x81, 0xf9, 0x72, 0xfb, 0x80, 0x7c,
+		0xc9, 0xbb, 0x55, 0x09, 0xc6, 0x5c, 0x98, 0x92, 0x31, 0x6e, 0x3f, 0xee, 0x88, 0x33, 0x1f, 0x4f,
+		0xc3, 0x31, 0x36, 0x9d, 0x34, 0x36, 0x95, 0x58, 0x82, 0xf3, 0x24, 0xeb, 0x2f, 0x9a, 0xbc, 0x4f,
+		0xa2, 0x3c, 0x07, 0xb3, 0x35, 0xd4, 0x16, 0xb8, 0x0a, 0xf2, 0x76, 0x78, 0x42, 0xef, 0x9b, 0x9e,
+		0x0a, 0x38, 0xfc, 0x7c, 0x27, 0xd0, 0x9f, 0xa5, 0x7c, 0xb3, 0x04, 0x72, 0xbd, 0xa9, 0x77, 0xf4,
+		0xfe, 0xdb, 0x25, 0x45, 0x9e, 0x00, 0x60, 0xa7, 0x85, 0xdc, 0x97, 0x62, 0x70, 0x37, 0xdc, 0xdf,
+		0xb9, 0x05, 0x56, 0x13, 0xbd, 0x8b, 0x91, 0xa6, 0xb0, 0xf8, 0xf3, 0xec, 0x0b, 0x00, 0x5e, 0x01,
+		0x9e, 0xae, 0xa8, 0x2f, 0x95, 0x96, 0x4b, 0xaa, 0x38, 0xf6, 0x51, 0x5f, 0xaf, 0x2e, 0xb1, 0x87,
+		0x1b, 0x0e, 0xe1, 0xd1, 0x05, 0x7f, 0xa1, 0x7b, 0x7e, 0xfd, 0x30, 0xcc, 0xf8, 0xf3, 0xd9, 0x2d,
+		0xfa, 0xc8, 0xe2, 0x55, 0x8c, 0x14, 0xe0, 0x89, 0x45, 0x4d, 0x6f, 0xb5, 0x8c, 0x96, 0xd6, 0x36,
+		0xc9, 0x84, 0x1b, 0xb3, 0xc5, 0xdf, 0xfe, 0xf7, 0x71, 0xda, 0xb5, 0x1c, 0x43, 0x2c, 0x21, 0x5e,
+		0xcd, 0x44, 0x9f, 0xb3, 0xdd, 0xed, 0x75, 0x0c, 0xba, 0xdb, 0xab, 0xb5, 0x05, 0xff, 0x27, 0xbb,
+		0x33, 0x48, 0x0f, 0x77, 0x8e, 0x67, 0x3d, 0x74, 0x77, 0xf4, 0x16, 0x9f, 0xf3, 0xce, 0x13, 0xb9,
+		0x0d, 0x9c, 0x48, 0xf1, 0x77, 0x78, 0x0b, 0x45, 0x34, 0x43, 0x34, 0x71, 0x19, 0x66, 0xf0, 0x9a,
+		0x59, 0x2f, 0xd0, 0xbe, 0x09, 0x16, 0x44, 0xf4, 0x56, 0xe6, 0x98, 0x5e, 0xd3, 0x9e, 0x80, 0x84,
+		0x4d, 0x07, 0x65, 0x12, 0x09, 0xd1, 0x1c, 0x0e, 0x8e, 0xe1, 0x2f, 0x26, 0x06, 0x6e, 0x8f, 0x26,
+		0x10, 0xf8, 0x57, 0x9c, 0x40, 0x96, 0xa2, 0x89, 0xde, 0x98, 0x30, 0xc3, 0x9e, 0x49, 0x31, 0x7c,
+		0xbd, 0xd9, 0x3f, 0x8a, 0xf2, 0xeb, 0xbf, 0xf8, 0x08, 0xdd, 0x61, 0x3f, 0x1d, 0x14, 0xba, 0x90,
+		0xc9, 0xa2, 0xca, 0x9c, 0xb6, 0xd7, 0x5f, 0x03, 0xf2, 0xa2, 0x3e, 0xde, 0xef, 0xfd, 0x2b, 0xfb,
+		0xc7, 0xbc, 0xb2, 0x13, 0x61, 0x12, 0xee, 0xab, 0x29, 0xc7, 0xa9, 0xb2, 0x82, 0xc5, 0x32, 0xe4,
+		0x70, 0x53, 0xde, 0x63, 0xce, 0xfe, 0xb5, 0xfc, 0x93, 0x5f, 0x7c, 0x84, 0x4d, 0x34, 0x44, 0xe2,
+		0xac, 0x29, 0x57, 0xc7, 0x69, 0xbd, 0x97, 0x1e, 0x1a, 0xb5, 0xdf, 0xec, 0xdf, 0xc3, 0x94, 0xfa,
+		0x53, 0xfe, 0xa6, 0x7a, 0xda, 0x29, 0x06, 0x33, 0x78, 0x81, 0xdb, 0x3a, 0x47, 0xff, 0x72, 0xad,
+		0x14, 0xa7, 0x89, 0x29, 0xb6, 0x6d, 0x2f, 0x32, 0x65, 0x31, 0x59, 0x6e, 0xff, 0xfa, 0x3b, 0x7f,
+		0x3c, 0xee, 0x29, 0x94, 0xc5, 0x95, 0xd1, 0xb3, 0x74, 0x93, 0x69, 0xfc, 0x8d, 0xa0, 0x31, 0x7c,
+		0x34, 0x6e, 0xf1, 0xbd, 0xb8, 0x17, 0xca, 0xc9, 0x4c, 0xf2, 0xdd, 0x04, 0x11, 0x17, 0x03, 0x3d,
+		0x37, 0x26, 0xb4, 0xd3, 0xf8, 0xe9, 0x6f, 0x09, 0x7c, 0xa6, 0xc3, 0xf0, 0x24, 0xea, 0xe2, 0x15,
+		0xc8, 0xb7, 0x2c, 0xd3, 0xd1, 0xac, 0x6e, 0xdb, 0x31, 0xba, 0x3d, 0x67, 0xa2, 0xe7, 0xfb, 0x45,
+		0x46, 0x24, 0xa5, 0xe6, 0x10, 0x6f, 0x4d, 0xa0, 0x61, 0x4b, 0xd8, 0x4b, 0x2b, 0xd3, 0xb4, 0xe4,
+		0xbf, 0xb9, 0x2d, 0xa1, 0x38, 0xd8, 0x92, 0x3b, 0x92, 0x0e, 0xbb, 0x75, 0x9d, 0x9b, 0x3b, 0xe7,
+		0x16, 0x93, 0x02, 0x57, 0x3a, 0x3e, 0x15, 0x85, 0x13, 0x1c, 0x78, 0x4b, 0xb7, 0x8d, 0x73, 0x37,
+		0xce, 0x6f, 0x19, 0x8e, 0x7e, 0xfe, 0x5c, 0xd3, 0x6a, 0x0b, 0x5f, 0x67, 0x96, 0x95, 0x2f, 0x60,
+		0xf9, 0x02, 0x2f, 0x9f, 0x0f, 0x3d, 0x10, 0x30, 0x3f, 0xde, 0x0c, 0xce, 0x8f, 0xca, 0xa0, 0xd2,
+		0x81, 0xd8, 0x92, 0xd5, 0xa6, 0xb7, 0xc4, 0x5a, 0x86, 0x69, 0x75, 0xb9, 0x41, 0x62, 0x09, 0x72,
+		0x15, 0x12, 0x7a, 0xd7, 0x1a, 0x98, 0x0e, 0x33, 0x46, 0xe5, 0x47, 0x7e, 0xeb, 0xf5, 0x93, 0x87,
+		0xfe, 0xf0, 0xf5, 0x93, 0x87, 0x19, 0x59, 0xbb, 0x75, 0x7d, 0xa1, 0x6d, 0x9d, 0xeb, 0xea, 0xce,
+		0x2e, 0xaa, 0x80, 0xdf, 0xff, 0xcc, 0xc3, 0xc0, 0xeb, 0xab, 0x99, 0xce, 0xa7, 0xdf, 0xf8, 0xb9,
+		0xb3, 0x92, 0xca, 0xf1, 0x17, 0x63, 0x6f, 0x7e, 0xf2, 0xa4, 0xa4, 0xf4, 0x20, 0x59, 0x31, 0x9a,
+		0xfb, 0x54, 0x58, 0x1b, 0xaa, 0xf0, 0x3c, 0xaf, 0xf0, 0xf8, 0x68, 0x85, 0xec, 0xf6, 0x49, 0xc5,
+		0x68, 0xfa, 0xaa, 0xad, 0x18, 0xcd, 0x60, 0x8d, 0xe5, 0xca, 0x1f, 0xfc, 0x97, 0x13, 0x87, 0x3e,
+		0xf0, 0xb9, 0x13, 0x87, 0xc6, 0x0e, 0x99, 0x32, 0x79, 0xc8, 0xdc, 0x91, 0xfa, 0x89, 0x18, 0x8e,
+		0x54, 0xd7, 0x70, 0xb6, 0xb6, 0x9d, 0x73, 0xcd, 0xfe, 0x5e, 0xcf, 0xb1, 0xce, 0xdd, 0x38, 0x8f,
+		0x33, 0xd7, 0xda, 0xe6, 0x23, 0x45, 0x44, 0xf9, 0x02, 0x2b, 0x5f, 0xb8, 0x31, 0x66, 0xa0, 0x94,
+		0x6d, 0x88, 0xaf, 0x23, 0x22, 0xb2, 0xc2, 0xb1, 0x1c, 0xbd, 0xc3, 0x3d, 0x32, 0x96, 0xc0, 0x5c,
+		0xf6, 0x12, 0x50, 0x84, 0xe5, 0xb6, 0xc5, 0x23, 0x40, 0x1d, 0x43, 0xdf, 0x66, 0x0f, 0x2a, 0x44,
+		0xa9, 0x2b, 0x9f, 0xc2, 0x0c, 0xfa, 0x76, 0xc2, 0x1c, 0xc4, 0xf5, 0x01, 0x3b, 0x7e, 0x15, 0x45,
+		0x1f, 0x9f, 0x26, 0x94, 0x65, 0x48, 0xf2, 0xb3, 0x05, 0x78, 0xfe, 0xe8, 0xba, 0xb1, 0x47, 0xeb,
+		0xc9, 0xaa, 0xf8, 0x93, 0x9c, 0x83, 0x38, 0x6d, 0x3d, 0xbf, 0xc7, 0x72, 0x6c, 0x61, 0xb4, 0xf9,
+		0x0b, 0xb4, 0x95, 0x2a, 0x83, 0x53, 0xae, 0x41, 0xaa, 0x62, 0xa1, 0x00, 0x05, 0xc9, 0xa5, 0x19,
+		0x39, 0xda, 0xe8, 0xde, 0xc0, 0x11, 0x97, 0x0d, 0x69, 0x02, 0xaf, 0xee, 0xb2, 0x17, 0x36, 0xf8,
+		0x19, 0x32, 0x9e, 0x52, 0x96, 0x20, 0x49, 0x69, 0xaf, 0xf5, 0xdc, 0x67, 0xab, 0x24, 0xdf, 0xb3,
+		0x55, 0x9c, 0x7c, 0xc4, 0x6b, 0x2d, 0x81, 0x18, 0x5e, 0xbf, 0xe7, 0x1d, 0xa7, 0xbf, 0x95, 0x67,
+		0x21, 0xc5, 0x89, 0xd8, 0xe4, 0x51, 0x88, 0x5a, 0x3d, 0x71, 0x44, 0xf2, 0xf8, 0xd8, 0xbe, 0xac,
+		0xf5, 0xca, 0x31, 0x14, 0x2c, 0x15, 0xa1, 0xcb, 0x2b, 0x63, 0x45, 0xe3, 0xd1, 0x80, 0x68, 0x88,
+		0x61, 0x17, 0x3f, 0xf4, 0x5e, 0xfb, 0xdc, 0xa8, 0x30, 0xb8, 0xb2, 0xf2, 0x5a, 0x04, 0xee, 0x0e,
+		0x91, 0x95, 0xeb, 0xc6, 0x9e, 0x7d, 0x60, 0x51, 0xb9, 0x01, 0xe9, 0x75, 0xfa, 0xd0, 0xe5, 0x73,
+		0xc6, 0x1e, 0x99, 0xc7, 0xf3, 0x90, 0x17, 0x1e, 0x7f, 0xfc, 0xfc, 0x25, 0x36, 0x90, 0x57, 0x0f,
+		0xa9, 0x22, 0x83, 0x9c, 0x80, 0xb4, 0x6d, 0x34, 0x7b, 0x17, 0x1e, 0xbf, 0x78, 0xfd, 0x3c, 0x63,
+		0xdc, 0xd5, 0x43, 0xaa, 0x97, 0x45, 0xee, 0x82, 0xd4, 0x56, 0xc7, 0x3e, 0x7f, 0xe1, 0xd1, 0x27,
+		0xcf, 0x33, 0x26, 0x5e, 0x3d, 0xa4, 0xba, 0x39, 0x8b, 0x29, 0x9c, 0x32, 0x6f, 0xfe, 0xf0, 0x49,
+		0xa9, 0x1c, 0x87, 0xa8, 0x3d, 0xe8, 0x7e, 0xb5, 0x58, 0xf3, 0x6b, 0x49, 0x38, 0xed, 0x16, 0x33,
+		0xa5, 0x78, 0xe3, 0xfc, 0x39, 0x7e, 0xe2, 0xdc, 0x7d, 0xbc, 0x74, 0xc6, 0x65, 0x0f, 0x05, 0x41,
+		0xee, 0xec, 0xcf, 0xd0, 0x31, 0xcc, 0xfb, 0x8c, 0x04, 0xd9, 0x4d, 0x41, 0x1b, 0xef, 0x1f, 0xbc,
+		0x17, 0xc0, 0xad, 0x4b, 0x08, 0xcb, 0x5d, 0x0b, 0x23, 0xb5, 0x2d, 0xb8, 0x48, 0xaa, 0x0f, 0x9e,
+		0x3c, 0x09, 0xa9, 0x5e, 0xdf, 0xea, 0x59, 0x36, 0x7f, 0x8f, 0x67, 0x12, 0xae, 0x0b, 0x8d, 0xf7,
+		0x3d, 0xe8, 0xd4, 0xd6, 0x6e, 0x58, 0x0e, 0x9e, 0x1d, 0xe9, 0x59, 0x37, 0xf9, 0x33, 0x67, 0x51,
+		0x55, 0xa6, 0x25, 0x9b, 0xb4, 0x60, 0x1d, 0xf3, 0x95, 0xff, 0x2e, 0x41, 0xda, 0xa5, 0x82, 0xeb,
+		0x36, 0xbd, 0xd5, 0xea, 0x1b, 0xb6, 0xcd, 0x67, 0xaf, 0x48, 0x92, 0x45, 0x48, 0xf6, 0x06, 0x5b,
+		0x9a, 0x98, 0x29, 0xf8, 0xda, 0x53, 0x98, 0xdc, 0x0b, 0xf1, 0xa1, 0x97, 0xf8, 0x12, 0xbd, 0xc1,
+		0x16, 0x8a, 0xd2, 0x69, 0xc8, 0x86, 0xb4, 0x25, 0x73, 0xc3, 0x6b, 0x06, 0x7d, 0x7c, 0x95, 0x77,
+		0x40, 0xeb, 0xf5, 0xdb, 0x56, 0xbf, 0xed, 0xec, 0xd1, 0x03, 0x8c, 0x51, 0x55, 0x16, 0x05, 0xeb,
+		0x3c, 0x9f, 0x28, 0x90, 0xe3, 0x6d, 0xd1, 0xb6, 0xf6, 0x1c, 0x7e, 0x4a, 0x36, 0xab, 0x66, 0x58,
+		0x75, 0x65, 0xcc, 0x22, 0xa7, 0x20, 0x2b, 0x60, 0xdc, 0x5b, 0xfb, 0x69, 0x15, 0x18, 0x08, 0x2e,
+		0x4f, 0x94, 0x0e, 0x14, 0xea, 0xd4, 0x95, 0xf7, 0xba, 0x7f, 0xd1, 0xeb, 0xa4, 0x34, 0x45, 0x27,
+		0xc7, 0x76, 0x30, 0x32, 0xd2, 0xc1, 0xb3, 0xff, 0x41, 0x82, 0x4c, 0xb9, 0x63, 0x35, 0xaf, 0xd7,
+		0x2a, 0x97, 0x3b, 0xfa, 0x0e, 0x39, 0x0f, 0x87, 0xcb, 0xcb, 0x6b, 0x4b, 0xcf, 0x69, 0xb5, 0x8a,
+		0x76, 0x79, 0xb9, 0xe4, 0x3b, 0x2b, 0x3e, 0x7f, 0xe4, 0xd5, 0xdb, 0xa7, 0x88, 0x0f, 0x76, 0xc3,
+		0xa4, 0x4b, 0x57, 0x72, 0x0e, 0xe6, 0x82, 0x28, 0xa5, 0x72, 0x1d, 0x6f, 0x28, 0x49, 0xf3, 0x87,
+		0x5f, 0xbd, 0x7d, 0x6a, 0xc6, 0x87, 0x51, 0xda, 0xb2, 0x0d, 0xd3, 0x19, 0x45, 0x58, 0x5a, 0x5b,
+		0x59, 0xc1, 0x03, 0xfb, 0x23, 0x08, 0x78, 0xfa, 0xb5, 0xed, 0x90, 0x07, 0x61, 0x26, 0x88, 0xb0,
+		0x5a, 0x5b, 0x96, 0xa3, 0xf3, 0xe4, 0xd5, 0xdb, 0xa7, 0xf2, 0x3e, 0xe8, 0xd5, 0x76, 0x67, 0x3e,
+		0xf5, 0xa1, 0x1f, 0x3d, 0x71, 0xe8, 0xd3, 0xff, 0xff, 0x09, 0xa9, 0xbc, 0x3c, 0x76, 0xf6, 0x5e,
+		0x98, 0x7e, 0xf6, 0x8a, 0xe9, 0xe9, 0x4e, 0xde, 0x4f, 0x44, 0xe0, 0xa4, 0x5b, 0x7a, 0xc3, 0xe8,
+		0xe3, 0x9e, 0x01, 0xce, 0x43, 0x26, 0xfa, 0xae, 0xbb, 0xc2, 0x07, 0x87, 0x03, 0x8c, 0x57, 0x6d,
+		0x4f, 0x43, 0xb4, 0xd4, 0xeb, 0xe1, 0xf3, 0x79, 0x34, 0xdd, 0xb4, 0x98, 0x19, 0x8c, 0xa9, 0x6e,
+		0x1a, 0xcb, 0x6c, 0x6b, 0xdb, 0xb9, 0xa9, 0xf7, 0xdd, 0xa7, 0xf5, 0x44, 0x5a, 0xb9, 0x04, 0xe9,
+		0x25, 0xcb, 0xb4, 0x0d, 0xd3, 0x1e, 0xd0, 0x10, 0xc6, 0x16, 0x32, 0x83, 0x53, 0x60, 0x09, 0x34,
+		0x23, 0x7a, 0xaf, 0x47, 0x31, 0x63, 0x2a, 0xfe, 0xe4, 0xae, 0xc1, 0xea, 0x58, 0xf6, 0x3c, 0x36,
+		0x3d, 0x7b, 0x3c, 0x06, 0xb8, 0x0c, 0xfa, 0xde, 0xbb, 0x7d, 0x8a, 0xdf, 0xd5, 0x6e, 0x7e, 0xf6,
+		0x84, 0x68, 0xb6, 0x09, 0x6e, 0xc5, 0xfc, 0x64, 0x7d, 0x39, 0x3f, 0x69, 0x54, 0xc6, 0xf8, 0x8b,
+		0x93, 0x02, 0x4a, 0xca, 0x25, 0xc8, 0xe1, 0x45, 0xaf, 0xba, 0xe1, 0x5c, 0x35, 0xf4, 0x96, 0xd1,
+		0x0f, 0xfa, 0x2b, 0x39, 0xe1, 0xaf, 0x10, 0x88, 0x51, 0xa7, 0x84, 0x99, 0x6b, 0xfa, 0x5b, 0x69,
+		0x43, 0x0c, 0x51, 0x3d, 0x5f, 0x86, 0x63, 0xd0, 0x04, 0x1d, 0x2e, 0xaa, 0x25, 0x18, 0x0a, 0x4b,
+		0x90, 0xc7, 0x85, 0x47, 0x12, 0x9d, 0xe0, 0x91, 0x70, 0x1b, 0xce, 0xfd, 0x92, 0x2e, 0x24, 0xf9,
+		0x44, 0x70, 0x5b, 0x22, 0x79, 0x2d, 0x21, 0xab, 0x50, 0xe8, 0xe9, 0x7d, 0x87, 0x3e, 0x39, 0xb3,
+		0x4b, 0xbb, 0xc1, 0xb5, 0xe5, 0xa9, 0x10, 0xe5, 0x1d, 0xe8, 0x2e, 0xaf, 0x26, 0xd7, 0xf3, 0x67,
+		0x2a, 0x6f, 0xc6, 0x20, 0xc1, 0xd9, 0xf1, 0x0c, 0x24, 0x39, 0xc3, 0xb9, 0x6e, 0x3a, 0xb1, 0x10,
+		0x22, 0xfe, 0x0b, 0xae, 0x98, 0x72, 0x82, 0x02, 0x89, 0xdc, 0x0f, 0xa9, 0xe6, 0xae, 0xde, 0x36,
+		0xb5, 0x76, 0x8b, 0x7b, 0xbd, 0x99, 0xcf, 0xbd, 0x7e, 0x32, 0xb9, 0x84, 0x79, 0xb5, 0x8a, 0x9a,
+		0xa4, 0x85, 0xb5, 0x16, 0x7a, 0x51, 0xbb, 0x46, 0x7b, 0x67, 0xd7, 0xe1, 0x6a, 0x9a, 0xa7, 0xf0,
+		0xf9, 0x6e, 0x1c, 0x32, 0x7e, 0xb7, 0x61, 0x7e, 0x64, 0x39, 0xe3, 0x46, 0xe4, 0xca, 0x29, 0xac,
+		0xf8, 0xa3, 0xff, 0xe9, 0xa4, 0xa4, 0x52, 0x0c, 0x52, 0x81, 0x5c, 0x47, 0xb7, 0x1d, 0x8d, 0xce,
+		0x13, 0xac, 0x3e, 0xce, 0x49, 0x8c, 0xb2, 0x84, 0xf3, 0x96, 0xb7, 0x3d, 0x83, 0x68, 0x2c, 0xab,
+		0x85, 0xef, 0x30, 0x51, 0x2a, 0x4d, 0xaa, 0xaa, 0x98, 0x67, 0x9a, 0xa0, 0xac, 0xcf, 0x63, 0x3e,
+		0xd3, 0x60, 0xd4, 0x3f, 0x3d, 0x0e, 0x69, 0xfa, 0xb4, 0x12, 0x05, 0x61, 0x97, 0x13, 0x53, 0x98,
+		0x41, 0x0b, 0x1f, 0x80, 0x82, 0x67, 0x65, 0x19, 0x48, 0x8a, 0x51, 0xf1, 0xb2, 0x29, 0xe0, 0x23,
+		0x30, 0x67, 0x1a, 0xb7, 0x1c, 0x6d, 0x18, 0x3a, 0x4d, 0xa1, 0x09, 0x96, 0x6d, 0x06, 0x31, 0xee,
+		0x83, 0x7c, 0x53, 0x70, 0x9f, 0xc1, 0x02, 0x85, 0xcd, 0xb9, 0xb9, 0x14, 0xec, 0x18, 0xa4, 0xf4,
+		0x5e, 0x8f, 0x01, 0x64, 0xb8, 0x91, 0xed, 0xf5, 0x68, 0xd1, 0x59, 0x98, 0xa1, 0x7d, 0xec, 0x1b,
+		0x36, 0x5e, 0x9b, 0x61, 0x30, 0x59, 0x0a, 0x53, 0xc0, 0x02, 0x95, 0xe5, 0x53, 0xd8, 0x7b, 0x20,
+		0x67, 0xdc, 0xc0, 0x9b, 0xa1, 0x4d, 0x83, 0xc1, 0xe5, 0x28, 0x5c, 0x56, 0x64, 0x52, 0xa0, 0x07,
+		0xc1, 0xb5, 0x9e, 0x9a, 0x30, 0xec, 0x79, 0x46, 0x4f, 0xe4, 0x97, 0x58, 0xb6, 0x52, 0x84, 0x58,
+		0x45, 0x77, 0x74, 0xd4, 0x63, 0xce, 0x2d, 0xe6, 0xaf, 0x64, 0x55, 0xfc, 0xa9, 0xfc, 0x6a, 0x14,
+		0x62, 0x9b, 0x96, 0x83, 0x6f, 0x57, 0xc5, 0x7c, 0xaf, 0xeb, 0x84, 0x89, 0x74, 0xbd, 0xbd, 0x63,
+		0x1a, 0xad, 0x15, 0x7b, 0xc7, 0xf7, 0x9e, 0xa9, 0x27, 0x50, 0x91, 0x80, 0x40, 0xcd, 0x41, 0xbc,
+		0x6f, 0x0d, 0xcc, 0x96, 0xb8, 0xbb, 0x40, 0x13, 0xe4, 0x32, 0xa4, 0x5c, 0x39, 0x89, 0x4d, 0x94,
+		0x93, 0x02, 0xca, 0x09, 0x8a, 0x31, 0xcf, 0x50, 0x93, 0x5b, 0x5c, 0x5c, 0xca, 0x90, 0x76, 0x35,
+		0x4c, 0x31, 0x7e, 0x00, 0x99, 0xf5, 0xd0, 0xd0, 0x29, 0x71, 0x47, 0xdf, 0x65, 0x1f, 0x93, 0x39,
+		0xd9, 0x2d, 0xe0, 0xfc, 0x0b, 0x08, 0x16, 0x7f, 0x5c, 0x35, 0x49, 0x3b, 0xe6, 0x09, 0x16, 0x7b,
+		0x60, 0xf5, 0x2e, 0x3c, 0xe3, 0xb8, 0x63, 0xd2, 0x8b, 0x39, 0x5c, 0xf6, 0xbc, 0x0c, 0x2c, 0xf5,
+		0x2e, 0xb9, 0x32, 0x59, 0xf3, 0x32, 0xf0, 0xa9, 0x12, 0x37, 0xa1, 0x79, 0x54, 0x98, 0x9c, 0x11,
+		0xb7, 0xa8, 0x2e, 0x4a, 0x94, 0x7f, 0x26, 0x41, 0x82, 0x1b, 0x77, 0x6f, 0x1c, 0xa4, 0xf0, 0x71,
+		0x88, 0x8c, 0x1b, 0x87, 0xe8, 0xdb, 0x1a, 0x07, 0x70, 0xdb, 0x69, 0xf3, 0x37, 0x34, 0xc3, 0x3c,
+		0x59, 0xd6, 0xc8, 0x7a, 0x7b, 0x87, 0xcf, 0x7d, 0x1f, 0x96, 0xf2, 0xba, 0x04, 0x69, 0xb7, 0x9c,
+		0x94, 0x21, 0x27, 0x5a, 0xa6, 0x6d, 0x77, 0xf4, 0x1d, 0x2e, 0x8e, 0x27, 0xc6, 0x37, 0x0f, 0x7d,
+		0x16, 0x35, 0xc3, 0x5b, 0x84, 0x89, 0xf0, 0x91, 0x8d, 0x8c, 0x19, 0xd9, 0x80, 0x28, 0x45, 0xef,
+		0x4c, 0x94, 0x02, 0x83, 0x1e, 0x1b, 0x1a, 0x74, 0xe5, 0xf3, 0x12, 0x7f, 0xbf, 0xbb, 0x65, 0xb4,
+		0xfe, 0x97, 0x8e, 0xd6, 0xd7, 0x72, 0xf9, 0xc2, 0x90, 0xe6, 0xc8, 0xb0, 0xdd, 0x1b, 0x42, 0x32,
+		0xd8, 0x6a, 0x6f, 0xf8, 0x88, 0x20, 0x53, 0xf7, 0x86, 0xf1, 0x97, 0x22, 0x30, 0x33, 0x02, 0xff,
+		0x77, 0x70, 0x38, 0x83, 0x73, 0x38, 0x3e, 0xe5, 0x1c, 0x4e, 0x8c, 0x9d, 0xc3, 0xbf, 0x14, 0xa1,
+		0xb1, 0x87, 0x9e, 0x65, 0xeb, 0x9d, 0xbf, 0x15, 0x1d, 0x7c, 0x1c, 0xd2, 0x3d, 0xab, 0xa3, 0xb1,
+		0x12, 0x76, 0x8b, 0x2c, 0xd5, 0xb3, 0x3a, 0xea, 0x88, 0xa8, 0xc5, 0xdf, 0x29, 0x05, 0x9d, 0x78,
+		0x07, 0x86, 0x21, 0x39, 0x3c, 0xab, 0x1c, 0xc8, 0x32, 0x5e, 0x70, 0x0f, 0xea, 0x3c, 0x32, 0x01,
+		0x7f, 0x15, 0xa5, 0x61, 0x9f, 0xcf, 0x6d, 0x37, 0x03, 0x55, 0x13, 0xbb, 0x2e, 0x0a, 0xf3, 0x37,
+		0x8a, 0x91, 0xb1, 0x28, 0x4c, 0x94, 0x55, 0x0e, 0xa8, 0xbc, 0x26, 0x01, 0x2c, 0x23, 0x73, 0x69,
+		0x8f, 0xd1, 0xf9, 0xb1, 0x69, 0x23, 0xb4, 0x40, 0xdd, 0x27, 0xc7, 0x0e, 0x1c, 0x6f, 0x41, 0xd6,
+		0xf6, 0x37, 0xbd, 0x02, 0x39, 0x4f, 0xc0, 0x6d, 0x43, 0x34, 0xe7, 0xe4, 0x7e, 0x21, 0x01, 0xbc,
+		0x1b, 0x9a, 0xbd, 0xe1, 0x4b, 0x29, 0xff, 0x5c, 0x82, 0x34, 0x6d, 0x15, 0x3e, 0x30, 0x19, 0x18,
+		0x48, 0xe9, 0x6d, 0x0c, 0xe4, 0xdd, 0x00, 0x8c, 0x0e, 0x1e, 0x34, 0xe2, 0xf2, 0x95, 0xa6, 0x39,
+		0x78, 0x7c, 0x88, 0x3c, 0xe1, 0x72, 0x3d, 0x3a, 0x81, 0xeb, 0x5c, 0x75, 0x08, 0xde, 0x1f, 0x85,
+		0x24, 0x7d, 0x2a, 0xe1, 0x96, 0xcd, 0x03, 0x01, 0xf8, 0x20, 0x71, 0xe3, 0x96, 0xad, 0x5c, 0x87,
+		0x64, 0xe3, 0x16, 0x8b, 0x69, 0x1e, 0x87, 0x74, 0xdf, 0xb2, 0xb8, 0x37, 0xc8, 0x1c, 0xf1, 0x14,
+		0x66, 0x50, 0xe7, 0x47, 0x84, 0xf1, 0x22, 0x5e, 0x18, 0xcf, 0x0b, 0x44, 0x46, 0xa7, 0x0b, 0x44,
+		0xe2, 0xba, 0x3d, 0x17, 0x98, 0x51, 0xe4, 0x3d, 0x70, 0xb4, 0x5e, 0xbb, 0xb2, 0x5a, 0xad, 0x68,
+		0x2b, 0xf5, 0x2b, 0x43, 0x37, 0xda, 0xe7, 0x0b, 0xaf, 0xde, 0x3e, 0x95, 0xe1, 0x0b, 0xf6, 0x71,
+		0xd0, 0xeb, 0x6a, 0x75, 0x73, 0xad, 0x51, 0x95, 0x25, 0x06, 0xbd, 0xde, 0x37, 0x6e, 0x58, 0x0e,
+		0xfb, 0xd0, 0xcb, 0x23, 0x70, 0x2c, 0x04, 0xda, 0x5d, 0xb6, 0xcf, 0xbc, 0x7a, 0xfb, 0x54, 0x6e,
+		0xbd, 0x6f, 0x30, 0x51, 0xa3, 0x18, 0x0b, 0x50, 0x1c, 0xc5, 0x58, 0x5b, 0x5f, 0xab, 0x97, 0x96,
+		0xe5, 0x53, 0xf3, 0xf2, 0xab, 0xb7, 0x4f, 0x65, 0x85, 0xee, 0x40, 0xf8, 0xaf, 0xfe, 0xba, 0x3d,
+		0x36, 0x7a, 0xa2, 0xe2, 0x66, 0x5f, 0xef, 0xf5, 0x8c, 0xbe, 0x3d, 0xee, 0xe8, 0xc0, 0x3d, 0x90,
+		0xa9, 0xf8, 0xde, 0x17, 0x71, 0xcf, 0x90, 0x48, 0xf4, 0xed, 0x11, 0x96, 0x50, 0x14, 0x80, 0xcb,
+		0x1d, 0x4b, 0x77, 0x42, 0x60, 0x22, 0x3e, 0x98, 0x9a, 0xe9, 0x5c, 0x7c, 0x2c, 0x04, 0x26, 0x2a,
+		0x60, 0xee, 0x81, 0xcc, 0xc6, 0x38, 0xa0, 0x58, 0x90, 0xd0, 0xa3, 0x17, 0x42, 0x60, 0xe2, 0x43,
+		0x84, 0x42, 0x81, 0x72, 0x02, 0xe8, 0x34, 0xa4, 0xcb, 0x96, 0xd5, 0x09, 0x01, 0x49, 0xf9, 0xe8,
+		0xd4, 0x7d, 0x4f, 0xa7, 0x04, 0x80, 0xd2, 0xbe, 0x06, 0xd1, 0xb8, 0x56, 0x08, 0x8c, 0x7b, 0xca,
+		0xe6, 0xc0, 0x87, 0x4b, 0x9e, 0xe7, 0xe3, 0x72, 0xd0, 0xc3, 0x25, 0x62, 0x3c, 0xef, 0xec, 0x70,
+		0x49, 0xd6, 0xb7, 0xb9, 0xe1, 0x46, 0x19, 0x7a, 0x7a, 0x5f, 0xef, 0xee, 0x13, 0xb8, 0x08, 0x0f,
+		0x2a, 0x4c, 0x38, 0xbb, 0x33, 0x3f, 0x41, 0x12, 0x71, 0x65, 0x53, 0x70, 0x17, 0xcc, 0xeb, 0xb4,
+		0x09, 0xe4, 0x31, 0x7f, 0x74, 0x27, 0x33, 0xde, 0x0f, 0x61, 0xe0, 0x22, 0xfa, 0xf3, 0x34, 0xa4,
+		0xc4, 0xc2, 0x8b, 0xeb, 0xe6, 0xd3, 0x61, 0xde, 0x12, 0x07, 0xe1, 0xb8, 0x2e, 0x0a, 0xf9, 0x1a,
+		0x48, 0xbb, 0x9a, 0x9a, 0xab, 0x26, 0x65, 0x3f, 0xdd, 0xce, 0x09, 0x78, 0x48, 0x18, 0x9f, 0x15,
+		0xe1, 0x81, 0xd8, 0xd8, 0x88, 0xc3, 0x26, 0x83, 0xe0, 0xd8, 0x02, 0x81, 0x3c, 0x0e, 0x31, 0x7d,
+		0xab, 0xd9, 0x2e, 0xc6, 0x87, 0x63, 0x9e, 0x2e, 0x62, 0xa9, 0xbc, 0x54, 0x63, 0x58, 0x34, 0xb0,
+		0x4b, 0xc1, 0xb1, 0xd1, 0xf6, 0x9e, 0xd9, 0xdc, 0xed, 0x5b, 0xe6, 0x5e, 0x31, 0x31, 0xb6, 0xd1,
+		0x75, 0x01, 0x23, 0x1a, 0xed, 0x22, 0x61, 0xa3, 0xb7, 0x0d, 0xcf, 0x7a, 0x87, 0x37, 0x9a, 0xbf,
+		0x6c, 0x20, 0x1a, 0xcd, 0x11, 0x94, 0x1a, 0x8f, 0xa7, 0xf2, 0x61, 0xa3, 0x8f, 0xe7, 0xde, 0xe2,
+		0xf1, 0x60, 0x36, 0xe1, 0x53, 0x5d, 0xfd, 0x16, 0x0b, 0x06, 0x1f, 0x85, 0x24, 0x16, 0xee, 0xf0,
+		0x57, 0xec, 0xa2, 0x6a, 0xa2, 0xab, 0xdf, 0xba, 0xa2, 0xe3, 0x63, 0x09, 0x51, 0x39, 0xa6, 0xfc,
+		0x04, 0xba, 0xdf, 0x81, 0xa1, 0x21, 0x0f, 0x01, 0x41, 0x0c, 0xdc, 0xd3, 0x46, 0x23, 0x44, 0x07,
+		0x59, 0xd0, 0xc5, 0x77, 0x28, 0x4a, 0x3b, 0xc6, 0xea, 0xa0, 0x4b, 0x1b, 0x60, 0x13, 0xdc, 0x00,
+		0xe7, 0xc0, 0x42, 0x00, 0x5d, 0x7f, 0x61, 0xe4, 0xdb, 0x3f, 0x1c, 0x80, 0x39, 0x34, 0xaf, 0xa1,
+		0x43, 0x93, 0x67, 0xf4, 0x44, 0x49, 0xb0, 0x2b, 0xd1, 0x60, 0x57, 0x94, 0x67, 0xa1, 0x30, 0x24,
+		0x05, 0xfe, 0x70, 0x38, 0x65, 0x1a, 0x5d, 0xbb, 0xa7, 0x45, 0x38, 0x9c, 0xce, 0xbe, 0xc5, 0xd4,
+		0xaf, 0xe0, 0x9e, 0x0b, 0x6e, 0x8e, 0x3e, 0x04, 0xb9, 0x80, 0x18, 0x88, 0xc0, 0xa5, 0xe4, 0x05,
+		0x2e, 0x3d, 0xe0, 0x97, 0x20, 0x8b, 0xa6, 0xd4, 0x68, 0x71, 0xd8, 0xfb, 0xa1, 0xc0, 0x6c, 0xfd,
+		0x30, 0xaf, 0x99, 0x0f, 0xbf, 0x22, 0x18, 0xae, 0x40, 0xce, 0x83, 0xf3, 0xd8, 0x9e, 0x11, 0x50,
+		0x57, 0x74, 0x5b, 0xf9, 0x98, 0x04, 0x85, 0x21, 0xd9, 0x20, 0x4f, 0x43, 0xba, 0xd7, 0x37, 0x9a,
+		0x6d, 0x5f, 0x98, 0x6b, 0x1f, 0x16, 0xc6, 0x28, 0xfb, 0x3c, 0x0c, 0x74, 0x93, 0xc4, 0x49, 0x84,
+		0x96, 0xd1, 0xd1, 0xf7, 0x8a, 0x91, 0xe9, 0x48, 0x88, 0x4f, 0xb4, 0x55, 0x10, 0x49, 0xf9, 0x4d,
+		0x09, 0x72, 0x01, 0xa1, 0x23, 0x2d, 0xb8, 0x1b, 0x4d, 0xb4, 0xff, 0xf4, 0x3b, 0x7f, 0xba, 0xdc,
+		0xb7, 0x46, 0x0b, 0x7b, 0xa6, 0xc3, 0x33, 0x34, 0xd4, 0xb9, 0x91, 0xd4, 0x79, 0xa4, 0xe3, 0x1d,
+		0x82, 0x67, 0x6f, 0x9c, 0x5f, 0x65, 0xce, 0xf8, 0x1a, 0x90, 0xde, 0x96, 0x33, 0x4c, 0x3a, 0x32,
+		0x2d, 0x69, 0x19, 0x91, 0xfd, 0x04, 0x95, 0x3a, 0x80, 0x37, 0x71, 0x49, 0x69, 0x9a, 0x4e, 0x44,
+		0xf7, 0x6b, 0xe1, 0x62, 0xa4, 0x28, 0x95, 0xd7, 0x3f, 0xfd, 0xb9, 0x13, 0xd2, 0x57, 0xc5, 0x75,
+		0xf8, 0x52, 0x1d, 0xee, 0xf2, 0x40, 0xb7, 0x9a, 0xed, 0xe1, 0x80, 0xb6, 0x2c, 0x4a, 0x17, 0xb0,
+		0x74, 0x9a, 0x78, 0xf6, 0x04, 0x4b, 0x33, 0x4d, 0xbc, 0xfb, 0x0e, 0xc3, 0xd9, 0xff, 0x36, 0x0d,
+		0x49, 0xd5, 0x78, 0x65, 0x60, 0xd8, 0x0e, 0x79, 0x14, 0x62, 0x46, 0x73, 0xd7, 0x1a, 0xdd, 0x53,
+		0xe2, 0xdd, 0x58, 0xa8, 0x36, 0x77, 0x2d, 0x0e, 0x7c, 0xf5, 0x90, 0x4a, 0x81, 0xc9, 0x45, 0x88,
+		0x6f, 0x77, 0x06, 0x3c, 0xd2, 0x1d, 0xb0, 0x43, 0x02, 0xeb, 0x32, 0x16, 0x7b, 0x68, 0x0c, 0x1c,
+		0x2b, 0xa3, 0x1f, 0xc7, 0x8b, 0x8e, 0xab, 0x8c, 0x7e, 0x13, 0xcf, 0xab, 0x0c, 0x81, 0xc9, 0x12,
+		0x40, 0xdb, 0x6c, 0x3b, 0x1a, 0x0d, 0x02, 0x17, 0xe3, 0xc3, 0xba, 0xdc, 0x43, 0x6d, 0x3b, 0x34,
+		0x60, 0xec, 0xe1, 0xa7, 0xdb, 0x22, 0x0f, 0x5b, 0xfc, 0xca, 0xc0, 0xe8, 0x0b, 0x5b, 0x10, 0xd2,
+		0xe2, 0xf7, 0x61, 0xb1, 0xaf, 0xc5, 0x14, 0x1c, 0x6d, 0x27, 0xfb, 0xfe, 0x82, 0x73, 0xab, 0x98,
+		0x1a, 0x36, 0x03, 0x02, 0x95, 0x7e, 0x7e, 0xa1, 0x71, 0xcb, 0x43, 0x4e, 0x36, 0x59, 0x0e, 0xb9,
+		0xe4, 0xae, 0xd1, 0x32, 0xc3, 0x8b, 0x22, 0x17, 0x99, 0x96, 0x7b, 0xb8, 0x1c, 0x81, 0xac, 0x41,
+		0xbe, 0xd3, 0xb6, 0x1d, 0xcd, 0x36, 0xf5, 0x9e, 0xbd, 0x6b, 0x39, 0xe2, 0x09, 0xf4, 0xfb, 0x47,
+		0x49, 0x2c, 0xb7, 0x6d, 0xa7, 0x2e, 0xc0, 0x3c, 0x4a, 0xb9, 0x8e, 0x3f, 0x1f, 0x09, 0x5a, 0xdb,
+		0xdb, 0x46, 0xdf, 0xa5, 0x58, 0xcc, 0x8d, 0x23, 0xb8, 0x86, 0x70, 0x02, 0xd3, 0x47, 0xd0, 0xf2,
+		0xe7, 0x93, 0xaf, 0x83, 0xd9, 0x8e, 0xa5, 0xb7, 0x5c, 0x7a, 0x5a, 0x73, 0x77, 0x60, 0x5e, 0xa7,
+		0x31, 0xdc, 0xcc, 0x85, 0xb3, 0x21, 0xcd, 0xb4, 0xf4, 0x96, 0x40, 0x5e, 0x42, 0x50, 0x8f, 0xf2,
+		0x4c, 0x67, 0xb8, 0x8c, 0x68, 0x30, 0xa7, 0xf7, 0x7a, 0x9d, 0xbd, 0x61, 0xf2, 0x05, 0x4a, 0xfe,
+		0xa1, 0x51, 0xf2, 0x25, 0x84, 0x1e, 0x43, 0x9f, 0xe8, 0x23, 0x85, 0x64, 0x03, 0xe3, 0xcf, 0x06,
+		0xbd, 0xfa, 0xda, 0xe3, 0xab, 0x10, 0xfa, 0xc6, 0x38, 0xbe, 0x35, 0x34, 0x42, 0x7c, 0x9d, 0x41,
+		0x8a, 0xe5, 0x8a, 0x47, 0xb9, 0xd0, 0x0b, 0x96, 0x30, 0xb2, 0x56, 0xd3, 0xa0, 0x5f, 0x64, 0xe0,
+		0x64, 0x67, 0xc6, 0x93, 0xa5, 0x90, 0xa1, 0x64, 0x03, 0x25, 0xe4, 0x32, 0x64, 0x58, 0xd8, 0x0a,
+		0xb7, 0xce, 0x0d, 0xfa, 0xa9, 0x8b, 0xcc, 0x85, 0x7b, 0x42, 0xa6, 0x2b, 0x05, 0xc2, 0x90, 0xb8,
+		0x47, 0x0c, 0x0c, 0x37, 0x93, 0x6c, 0xc1, 0x61, 0xfa, 0x4e, 0xfb, 0x9e, 0x16, 0x54, 0xb8, 0xc5,
+		0x59, 0xfe, 0xb6, 0xea, 0x08, 0x45, 0xfa, 0xe5, 0xb3, 0xbd, 0x4d, 0xbf, 0xe6, 0xf5, 0x48, 0xcf,
+		0xde, 0x18, 0x2d, 0x45, 0x49, 0xdb, 0x6e, 0x9b, 0x7a, 0x07, 0x3f, 0x42, 0xc2, 0xfc, 0xd5, 0xb9,
+		0x71, 0x92, 0x76, 0x99, 0xc3, 0x51, 0x6f, 0xc5, 0x27, 0x69, 0xdb, 0xfe, 0xfc, 0x72, 0x92, 0xaf,
+		0x29, 0xdc, 0x17, 0x8c, 0x93, 0x72, 0x8a, 0xbd, 0xb3, 0x7f, 0x2d, 0x96, 0x02, 0x39, 0xa3, 0x3c,
+		0x00, 0x19, 0x9f, 0x9e, 0xc2, 0xa3, 0x02, 0xdc, 0x6a, 0x8a, 0x3b, 0x00, 0x3c, 0xa9, 0xe4, 0x21,
+		0xeb, 0x57, 0x4d, 0xca, 0x47, 0x25, 0xc8, 0xf8, 0x94, 0x0e, 0x62, 0xfa, 0x77, 0xb2, 0xd2, 0x9e,
+		0x23, 0x7a, 0x8f, 0x70, 0x1b, 0x44, 0x39, 0xdb, 0x4d, 0xcd, 0xd2, 0x4c, 0xee, 0xb5, 0xe0, 0xa3,
+		0x48, 0xbd, 0x0b, 0x3d, 0x17, 0x24, 0x4a, 0x41, 0xa0, 0x77, 0xa1, 0x27, 0x00, 0x4e, 0x43, 0x16,
+		0xbb, 0xae, 0xf9, 0xfd, 0xe1, 0xb4, 0x9a, 0xc1, 0x3c, 0x0e, 0xa2, 0xfc, 0x6e, 0x04, 0xe4, 0x61,
+		0x65, 0xe6, 0xee, 0x70, 0x49, 0x07, 0xde, 0xe1, 0x3a, 0x36, 0xbc, 0xb7, 0xe6, 0x6d, 0xa7, 0xad,
+		0x80, 0xec, 0x6d, 0x0a, 0x31, 0xdb, 0xb3, 0x8f, 0x83, 0x3f, 0xb4, 0x18, 0x51, 0x0b, 0xcd, 0x60,
+		0x06, 0xb9, 0x12, 0x38, 0x54, 0x12, 0x73, 0x4f, 0xc9, 0x0e, 0xcb, 0x93, 0x80, 0xd9, 0xe8, 0xb5,
+		0x74, 0xc7, 0x10, 0x31, 0x75, 0x0f, 0x15, 0x3d, 0x39, 0xdc, 0x85, 0xb2, 0x1d, 0xdd, 0x31, 0x02,
+		0xa7, 0x28, 0x72, 0x7a, 0xaf, 0x47, 0x3f, 0xbe, 0xc7, 0x3c, 0xb9, 0xfb, 0x20, 0x8f, 0x1a, 0xbe,
+		0xad, 0x77, 0x84, 0x9b, 0x90, 0x60, 0x0e, 0x1f, 0xcf, 0xe5, 0xae, 0x46, 0x0b, 0xb2, 0x7e, 0xe5,
+		0xee, 0xc6, 0x5e, 0x24, 0x5f, 0xec, 0x85, 0xf0, 0x57, 0xae, 0x18, 0x87, 0xc4, 0xcb, 0x60, 0xe1,
+		0xbb, 0x8d, 0x73, 0x34, 0x4e, 0x73, 0x83, 0x05, 0x57, 0x53, 0x2a, 0x4b, 0x28, 0x2f, 0x42, 0x3e,
+		0x68, 0x07, 0x48, 0x1e, 0x22, 0xce, 0x2d, 0x5e, 0x4b, 0xc4, 0xb9, 0x45, 0xce, 0xfb, 0xbe, 0x4b,
+		0x98, 0x0f, 0xb3, 0x7e, 0x1c, 0xdf, 0x8b, 0x8d, 0xb2, 0xa7, 0xd2, 0x94, 0x02, 0xe4, 0x02, 0x56,
+		0x42, 0x39, 0x02, 0x73, 0x61, 0x3a, 0x5f, 0x69, 0xc3, 0x5c, 0x98, 0xea, 0x26, 0x17, 0x21, 0xe5,
+		0x2a, 0xfd, 0x91, 0x70, 0x9a, 0xa8, 0xdd, 0x45, 0x72, 0x61, 0x03, 0xdb, 0x81, 0x91, 0xc0, 0x76,
+		0xa0, 0xf2, 0x8d, 0x50, 0x1c, 0xa7, 0xcf, 0x87, 0xf6, 0x07, 0x62, 0x2e, 0xe3, 0x8e, 0x40, 0x82,
+		0x3f, 0x29, 0x19, 0xa1, 0x71, 0x08, 0x9e, 0x42, 0x86, 0x32, 0xdd, 0x1e, 0xa5, 0xd9, 0x2c, 0xa1,
+		0x68, 0x70, 0x6c, 0xac, 0x4a, 0x1f, 0xbf, 0x9d, 0xce, 0x08, 0xf1, 0xed, 0x74, 0x9a, 0xc0, 0x6a,
+		0x6d, 0x0c, 0xec, 0xf7, 0xc5, 0xd9, 0x3b, 0x96, 0xc2, 0x07, 0x73, 0x8f, 0x84, 0xeb, 0x75, 0x3c,
+		0xa1, 0x83, 0xab, 0x03, 0x27, 0xb8, 0x90, 0x80, 0xae, 0x7e, 0xab, 0xc1, 0x57, 0x11, 0x7c, 0x2b,
+		0x32, 0xe2, 0x6e, 0x45, 0x92, 0x4d, 0x98, 0xe9, 0x58, 0x4d, 0xbd, 0xa3, 0xf9, 0xb6, 0x82, 0xf9,
+		0x74, 0xba, 0x77, 0x9c, 0x9e, 0x16, 0x9b, 0x0d, 0xa8, 0x82, 0xf8, 0x44, 0x28, 0x50, 0x22, 0xcb,
+		0xee, 0xb6, 0x31, 0xa9, 0x42, 0xa6, 0xdb, 0xb6, 0xb7, 0x8c, 0x5d, 0xfd, 0x46, 0xdb, 0xea, 0xf3,
+		0x79, 0x15, 0x22, 0x3d, 0x2b, 0x1e, 0x90, 0xd8, 0xa3, 0xf6, 0xe1, 0xf9, 0x06, 0x25, 0x1e, 0xba,
+		0x77, 0x9e, 0x38, 0xb0, 0x66, 0x19, 0xb7, 0x0b, 0x9d, 0x1c, 0xbb, 0x0b, 0x1d, 0xb6, 0xe5, 0x9b,
+		0x0a, 0xdf, 0xf2, 0x7d, 0x95, 0x0e, 0x4e, 0x98, 0x75, 0x1c, 0xdd, 0x05, 0x26, 0x0d, 0x98, 0xe3,
+		0xf8, 0xad, 0x00, 0xf7, 0x47, 0x0e, 0xa7, 0x05, 0x9d, 0x2e, 0x1f, 0xd7, 0x89, 0xc0, 0x1f, 0xcf,
+		0xf8, 0xe8, 0x1d, 0x32, 0x5e, 0x9c, 0xc5, 0x88, 0xf9, 0xce, 0x62, 0xfc, 0x6f, 0x36, 0x18, 0xdf,
+		0x16, 0x15, 0xbb, 0x63, 0x3e, 0xc7, 0x22, 0xf4, 0x90, 0xc9, 0xb8, 0xcd, 0x1c, 0xd1, 0xb1, 0xe8,
+		0x81, 0x3b, 0xc6, 0x47, 0x3b, 0x36, 0x79, 0xb4, 0xe3, 0xef, 0xe4, 0x68, 0x27, 0xee, 0x70, 0xb4,
+		0xbf, 0xaa, 0xe3, 0xf0, 0x83, 0x12, 0xcc, 0x8f, 0x77, 0xc7, 0x42, 0x07, 0xe4, 0x40, 0xdb, 0x8f,
+		0xe3, 0x2c, 0xde, 0x7d, 0x90, 0x1f, 0xf2, 0x16, 0x99, 0x30, 0xe7, 0x02, 0xeb, 0x71, 0xe5, 0x17,
+		0xa2, 0xf8, 0x95, 0xdb, 0x51, 0x87, 0x2e, 0x64, 0xc6, 0xaa, 0xf8, 0x5d, 0x93, 0x66, 0xbb, 0x75,
+		0xc7, 0x13, 0x76, 0x86, 0xa3, 0xff, 0x9f, 0xf9, 0x3a, 0x2a, 0x27, 0x78, 0x56, 0x07, 0x03, 0x99,
+		0x78, 0xe8, 0xd3, 0xb1, 0x84, 0x6f, 0x94, 0x66, 0x01, 0x42, 0x5e, 0xd0, 0xb0, 0xb8, 0x77, 0xf4,
+		0x63, 0x00, 0x29, 0xd5, 0xb0, 0x7b, 0xe8, 0xcc, 0xb1, 0xaf, 0xb9, 0x37, 0x8d, 0x9e, 0xe3, 0xc5,
+		0xb8, 0x42, 0xd7, 0x18, 0x1c, 0x44, 0xe0, 0xe1, 0x5a, 0xdb, 0xc5, 0xc3, 0x6d, 0x60, 0x1a, 0x52,
+		0x18, 0x1b, 0x1c, 0x60, 0xae, 0xba, 0x8b, 0x4a, 0xa1, 0xc9, 0x13, 0x22, 0xa6, 0x10, 0x1d, 0xb7,
+		0x52, 0xe6, 0x8e, 0xbb, 0x8b, 0xc7, 0xe0, 0xb1, 0x3a, 0x1a, 0x54, 0x88, 0x8d, 0xab, 0x8e, 0xf9,
+		0xf7, 0x5e, 0x75, 0x6d, 0xf6, 0xc2, 0xac, 0x3f, 0xaa, 0x90, 0x18, 0xd7, 0x55, 0x9f, 0x23, 0xee,
+		0x75, 0xd5, 0x0b, 0x2b, 0x3c, 0x21, 0xc2, 0x0a, 0xc9, 0x71, 0x8d, 0xe6, 0x9e, 0xa7, 0xd7, 0x68,
+		0x0a, 0x4f, 0x9e, 0xf1, 0xc5, 0x15, 0xd2, 0xc3, 0x31, 0xf9, 0x91, 0xb8, 0x82, 0x8b, 0xed, 0x06,
+		0x16, 0x16, 0xdd, 0xc0, 0x42, 0x76, 0x6c, 0x54, 0x82, 0xbb, 0x8c, 0x2e, 0x32, 0xc7, 0x20, 0xeb,
+		0x23, 0x91, 0x05, 0x16, 0x08, 0x78, 0x60, 0x62, 0x64, 0xc1, 0x25, 0x35, 0x14, 0x5a, 0x58, 0x1f,
+		0x09, 0x2d, 0xe4, 0xc7, 0x51, 0x1c, 0xf2, 0x4f, 0x3d, 0x8a, 0xc1, 0xd8, 0xc2, 0xd7, 0x87, 0xc7,
+		0x16, 0xc6, 0x2e, 0xfe, 0x43, 0x7c, 0x51, 0x97, 0x74, 0x48, 0x70, 0xe1, 0x1b, 0xc7, 0x04, 0x17,
+		0xe4, 0x71, 0x8b, 0xe0, 0x30, 0x4f, 0xd4, 0xad, 0x20, 0x2c, 0xba, 0xb0, 0x19, 0x12, 0x5d, 0x60,
+		0x61, 0x80, 0x07, 0xa7, 0x88, 0x2e, 0xb8, 0xa4, 0x47, 0xc2, 0x0b, 0x9b, 0x21, 0xe1, 0x05, 0x32,
+		0x9e, 0xee, 0x90, 0x03, 0xe5, 0xa7, 0x1b, 0x28, 0x22, 0x57, 0x82, 0xf1, 0x85, 0xd9, 0xfd, 0xfd,
+		0x56, 0xe6, 0x06, 0xb8, 0xd4, 0xfc, 0x01, 0x86, 0xe6, 0xb8, 0x00, 0x03, 0x8b, 0x01, 0x3c, 0x3c,
+		0x65, 0x80, 0xc1, 0xa5, 0x1d, 0x1a, 0x61, 0x58, 0x1f, 0x89, 0x30, 0x1c, 0x1e, 0x27, 0x70, 0x43,
+		0x06, 0xc9, 0x13, 0xb8, 0xb1, 0x21, 0x06, 0xf6, 0x41, 0x3f, 0xf6, 0x29, 0x3f, 0x90, 0x33, 0xd7,
+		0x62, 0xa9, 0x8c, 0x9c, 0x55, 0x1e, 0x44, 0x17, 0x68, 0x48, 0xef, 0xe1, 0x82, 0xc3, 0xe8, 0xf7,
+		0xad, 0xbe, 0xd8, 0x10, 0xa5, 0x09, 0xe5, 0x0c, 0x64, 0xfd, 0x2a, 0x6e, 0x9f, 0x70, 0x44, 0x01,
+		0x72, 0x01, 0xad, 0xa6, 0xfc, 0x8a, 0x04, 0x59, 0xbf, 0xbe, 0x0a, 0x2c, 0x56, 0xd3, 0x7c, 0xb1,
+		0xea, 0x0b, 0x52, 0x44, 0x82, 0x41, 0x0a, 0xfc, 0x2c, 0x52, 0x6f, 0x24, 0xfe, 0xa0, 0xf7, 0xdc,
+		0xf8, 0x83, 0x38, 0xc5, 0xc9, 0x42, 0x19, 0xdc, 0x32, 0xb0, 0x23, 0x0c, 0x05, 0xf7, 0x44, 0x2b,
+		0x8f, 0xf9, 0x3f, 0x0c, 0xb3, 0x3e, 0x58, 0x77, 0x21, 0xc8, 0x96, 0xe2, 0xb2, 0x0b, 0x5d, 0xe2,
+		0x2b, 0xc2, 0xdf, 0x94, 0x60, 0x66, 0x44, 0x5d, 0x86, 0xc6, 0x18, 0xa4, 0x77, 0x2a, 0xc6, 0x10,
+		0xb9, 0xf3, 0x18, 0x83, 0x7f, 0x69, 0x1b, 0x0d, 0x2e, 0x6d, 0xbf, 0x24, 0x41, 0x2e, 0xa0, 0xb6,
+		0x71, 0x10, 0x9a, 0x56, 0x4b, 0x6c, 0x9f, 0xd3, 0xdf, 0xe8, 0xd3, 0x74, 0x2c, 0xf1, 0x25, 0x36,
+		0xfc, 0x89, 0x50, 0xae, 0x21, 0x4a, 0x73, 0x33, 0xe3, 0xae, 0x53, 0xe3, 0xfe, 0x2b, 0x6c, 0xfc,
+		0x5a, 0x57, 0xc2, 0xbb, 0xd6, 0xe5, 0xee, 0x9a, 0x27, 0x7d, 0xbb, 0xe6, 0xe4, 0x12, 0x6e, 0x39,
+		0x59, 0xd6, 0xb6, 0x66, 0xf5, 0x98, 0xad, 0x0f, 0xf8, 0x46, 0x23, 0x57, 0xba, 0x6c, 0x7a, 0x63,
+		0x80, 0xfe, 0xf2, 0x79, 0x2c, 0xe9, 0x80, 0xc7, 0x72, 0x17, 0xa4, 0xb1, 0xf9, 0xec, 0xb3, 0xae,
+		0xc0, 0x5f, 0x36, 0x11, 0x19, 0xca, 0x4f, 0x45, 0xa0, 0x30, 0x64, 0x75, 0x42, 0x3b, 0x1f, 0x76,
+		0x7c, 0x65, 0x3a, 0x86, 0x9c, 0x00, 0xd8, 0xd1, 0x6d, 0xed, 0xa6, 0x6e, 0x3a, 0x46, 0x8b, 0x73,
+		0xc5, 0x97, 0x83, 0x37, 0x1d, 0x30, 0x35, 0xb0, 0x8d, 0x16, 0x8f, 0xe6, 0xb8, 0x69, 0xbc, 0x1a,
+		0x69, 0xdc, 0xa0, 0xef, 0xa4, 0xb3, 0x2f, 0xf1, 0x1c, 0x0d, 0x51, 0x4f, 0x58, 0x5e, 0x2e, 0xe2,
+		0x70, 0xff, 0xe9, 0xeb, 0x27, 0x65, 0x06, 0xfe, 0x1e, 0xf7, 0xbe, 0xac, 0xca, 0x09, 0x04, 0xd9,
+		0x90, 0x1a, 0x62, 0x03, 0x0d, 0x2d, 0x66, 0x45, 0x9c, 0x00, 0x99, 0xca, 0x2e, 0xf7, 0xe0, 0x75,
+		0xfd, 0x6e, 0xcf, 0xb2, 0x3a, 0x1a, 0x9b, 0xe7, 0x25, 0xc8, 0x07, 0x8d, 0x2c, 0xfb, 0x74, 0xba,
+		0x83, 0xd1, 0xb6, 0x80, 0x1f, 0x9d, 0x65, 0x99, 0x6c, 0x5e, 0xf9, 0x5f, 0xc1, 0x57, 0xde, 0x07,
+		0x87, 0x43, 0x6d, 0x2c, 0x79, 0x12, 0xd2, 0x9e, 0x7d, 0x66, 0x17, 0xb4, 0xf6, 0x8b, 0xd9, 0x78,
+		0xc0, 0xf8, 0x89, 0x8a, 0x50, 0x23, 0x4b, 0x9e, 0x86, 0x04, 0x3b, 0xbc, 0xcd, 0x0f, 0xe8, 0xdd,
+		0x37, 0xd9, 0x3a, 0xe3, 0x97, 0x12, 0x38, 0x92, 0x72, 0x1e, 0x8e, 0x8d, 0xb5, 0xb2, 0x5e, 0xe4,
+		0x45, 0xf2, 0x45, 0x5e, 0x94, 0x9f, 0x95, 0x60, 0x7e, 0xbc, 0xe5, 0x24, 0xe5, 0xa1, 0x06, 0x9d,
+		0x9d, 0xd2, 0xee, 0xfa, 0x5a, 0x85, 0x4b, 0x93, 0xbe, 0xb1, 0x6d, 0x38, 0xcd, 0x5d, 0x66, 0xc2,
+		0x99, 0x52, 0xc8, 0xa9, 0x39, 0x9e, 0x4b, 0x71, 0x6c, 0x06, 0xf6, 0xb2, 0xd1, 0x74, 0x34, 0x36,
+		0xa8, 0x36, 0x5d, 0x1e, 0xa4, 0xd5, 0x1c, 0xcb, 0xad, 0xb3, 0x4c, 0xe5, 0x21, 0x38, 0x3a, 0xc6,
+		0x16, 0x87, 0x9c, 0x3d, 0x7f, 0x09, 0x81, 0x43, 0x0d, 0x2c, 0x79, 0x16, 0x12, 0xb6, 0xa3, 0x3b,
+		0x03, 0x9b, 0xf7, 0xec, 0x81, 0x89, 0xb6, 0xb9, 0x4e, 0xc1, 0x55, 0x8e, 0xa6, 0x3c, 0x05, 0x64,
+		0xd4, 0xd2, 0x86, 0xac, 0xc3, 0xa4, 0xb0, 0x75, 0xd8, 0x16, 0x1c, 0xdf, 0xc7, 0xa6, 0x92, 0xa5,
+		0xa1, 0xc6, 0x3d, 0x34, 0x95, 0x49, 0x1e, 0x6a, 0xe0, 0x5f, 0x44, 0xe0, 0x70, 0xa8, 0x69, 0xf5,
+		0xcd, 0x52, 0xe9, 0xed, 0xce, 0xd2, 0xa7, 0x01, 0x9c, 0x5b, 0xe2, 0xc6, 0x01, 0xd7, 0xf6, 0x61,
+		0xeb, 0x89, 0x5b, 0x46, 0xb3, 0x71, 0x8b, 0x0b, 0x46, 0xda, 0xe1, 0xbf, 0x30, 0x50, 0xe0, 0x5b,
+		0xfb, 0x0e, 0xa8, 0x25, 0xb0, 0x8b, 0xd1, 0x83, 0xd9, 0x0c, 0xf9, 0x46, 0x30, 0xdb, 0x26, 0x2f,
+		0xc1, 0xd1, 0x21, 0x8b, 0xe6, 0xd2, 0x8e, 0x4d, 0x6d, 0xd8, 0x0e, 0x07, 0x0d, 0x9b, 0xa0, 0xed,
+		0xb7, 0x4a, 0xf1, 0xa0, 0x55, 0x7a, 0x09, 0xc0, 0x5b, 0x04, 0x7b, 0x87, 0x63, 0x25, 0xff, 0xe1,
+		0xd8, 0x8b, 0x10, 0x47, 0x49, 0x10, 0xac, 0x0a, 0x51, 0x18, 0x38, 0xa4, 0xbe, 0x55, 0x34, 0x03,
+		0x57, 0x5e, 0x06, 0x32, 0x1a, 0x8f, 0x1c, 0x53, 0xc7, 0x33, 0xc1, 0x3a, 0x94, 0xf1, 0xa1, 0xcd,
+		0xf0, 0xba, 0xfe, 0x2f, 0x88, 0xd3, 0xe1, 0x0f, 0xbd, 0xef, 0xfc, 0x0d, 0x00, 0xba, 0xe3, 0xf4,
+		0xdb, 0x5b, 0x03, 0xaf, 0x86, 0x53, 0x63, 0xe4, 0xa7, 0x24, 0x00, 0xcb, 0x77, 0x71, 0x41, 0x9a,
+		0xf3, 0x70, 0x7d, 0xc2, 0xe4, 0xa3, 0xa8, 0xac, 0x42, 0x3e, 0x88, 0x1b, 0x7e, 0x81, 0x7b, 0xf4,
+		0x6b, 0xb1, 0x9e, 0x21, 0xe7, 0x4f, 0xb7, 0xd1, 0x84, 0xf2, 0xcd, 0x11, 0xc8, 0xfa, 0xa5, 0xef,
+		0xef, 0xa1, 0xb1, 0x54, 0xbe, 0x5d, 0x82, 0x94, 0xdb, 0xff, 0x7d, 0xae, 0x06, 0x78, 0x57, 0xf9,
+		0xdd, 0x78, 0x3d, 0xdb, 0x21, 0x89, 0xba, 0x3b, 0x24, 0xef, 0x75, 0x0d, 0xc2, 0xd8, 0xc5, 0xbc,
+		0x9f, 0xdb, 0xe2, 0x50, 0x2e, 0x37, 0x50, 0x4f, 0x4d, 0x77, 0x5b, 0x18, 0xb7, 0x6f, 0x7c, 0x57,
+		0x7d, 0x59, 0x42, 0xb1, 0x7d, 0x67, 0x97, 0xd8, 0x6c, 0xf4, 0x00, 0x23, 0x3e, 0xc0, 0xd1, 0x0b,
+		0xbe, 0xd1, 0xc9, 0x17, 0x7c, 0x63, 0xc3, 0x17, 0x7c, 0x99, 0x0f, 0xa0, 0xfc, 0x7f, 0x12, 0xa4,
+		0xc4, 0x2c, 0x21, 0xcf, 0xfa, 0xcf, 0xd9, 0x89, 0x43, 0x3b, 0xe3, 0xb5, 0x14, 0xef, 0xbc, 0x87,
+		0x33, 0x7a, 0x5b, 0x21, 0x7a, 0xe0, 0xdb, 0x0a, 0xdc, 0x2b, 0xf9, 0xa2, 0x04, 0xf2, 0xf0, 0x2c,
+		0x7e, 0xfb, 0xed, 0x1b, 0xb5, 0x5e, 0xd1, 0x10, 0xeb, 0x35, 0xee, 0x0e, 0x42, 0x6c, 0xdc, 0x1d,
+		0x84, 0xd1, 0x7e, 0xc7, 0xef, 0xb4, 0xdf, 0xdf, 0x16, 0x81, 0x8c, 0x2f, 0x3a, 0x88, 0x87, 0x0f,
+		0x7d, 0x17, 0x1a, 0x4e, 0xef, 0x1b, 0x4a, 0xf4, 0xdd, 0x68, 0x08, 0x70, 0x2a, 0x72, 0x07, 0x9c,
+		0x7a, 0xe7, 0xef, 0x39, 0x86, 0x5f, 0xbc, 0x8f, 0x8f, 0xb9, 0x78, 0xff, 0x2d, 0x12, 0xa4, 0xdc,
+		0x50, 0xcc, 0x41, 0x77, 0xf3, 0x8e, 0x40, 0x82, 0x7b, 0x62, 0x6c, 0x3b, 0x8f, 0xa7, 0x42, 0xe3,
+		0xaa, 0xf3, 0x90, 0xea, 0x1a, 0x8e, 0x4e, 0xd5, 0x23, 0xb3, 0x77, 0x6e, 0xfa, 0xec, 0x16, 0x64,
+		0x7c, 0x1b, 0xa2, 0xe4, 0x18, 0x1c, 0x5e, 0xba, 0x5a, 0x5d, 0x7a, 0x4e, 0x6b, 0xbc, 0x30, 0xfc,
+		0xa9, 0xb6, 0x91, 0x22, 0xb5, 0x4a, 0xd3, 0xec, 0xfb, 0x39, 0xc1, 0x22, 0x56, 0x10, 0x99, 0x8f,
+		0xe1, 0x59, 0xf3, 0xb3, 0x5f, 0x94, 0x60, 0x36, 0xc4, 0xe7, 0x25, 0xa7, 0xe1, 0xee, 0xb5, 0xcb,
+		0x97, 0xab, 0xaa, 0x56, 0x5f, 0x2d, 0xad, 0xd7, 0xaf, 0xae, 0x35, 0x34, 0xb5, 0x5a, 0xdf, 0x58,
+		0xf6, 0x7f, 0xea, 0xec, 0x14, 0xdc, 0x15, 0x0e, 0x52, 0x5a, 0x5a, 0xaa, 0xae, 0x37, 0xd8, 0x47,
+		0xa4, 0xc7, 0x40, 0x94, 0xe9, 0xa7, 0xd0, 0xc6, 0x93, 0x50, 0xab, 0xd7, 0xaa, 0x4b, 0x0d, 0x39,
+		0x4a, 0x1e, 0x80, 0x7b, 0xf6, 0x83, 0xe0, 0x1f, 0x60, 0x93, 0x63, 0x13, 0x01, 0xeb, 0xd5, 0xd5,
+		0x4a, 0x55, 0x95, 0xe3, 0xbc, 0xdf, 0x9f, 0x8c, 0x40, 0x71, 0x9c, 0x6b, 0x8d, 0xb4, 0x4a, 0xeb,
+		0xeb, 0xcb, 0x2f, 0x7a, 0xb4, 0x96, 0xae, 0x6e, 0xac, 0x3e, 0x37, 0xca, 0x82, 0xfb, 0x41, 0xd9,
+		0x0f, 0xd0, 0x65, 0xc4, 0x7d, 0x70, 0x7a, 0x5f, 0x38, 0xce, 0x8e, 0x09, 0x60, 0x6a, 0x15, 0xbf,
+		0x80, 0x1d, 0x25, 0x0b, 0x70, 0x76, 0x22, 0x98, 0x5b, 0x26, 0xc7, 0xc8, 0x39, 0x78, 0x68, 0x7f,
+		0x78, 0xc6, 0x20, 0x81, 0x20, 0x58, 0xf4, 0xaa, 0x04, 0x87, 0x43, 0x7d, 0x74, 0xfc, 0xd4, 0xdf,
+		0xba, 0xba, 0xb6, 0x54, 0xad, 0xd7, 0xdd, 0xeb, 0x0c, 0x5a, 0xbd, 0x51, 0x6a, 0x6c, 0xd4, 0x7d,
+		0xbc, 0x51, 0xe0, 0xc4, 0x38, 0x20, 0x97, 0x2f, 0xfb, 0xc0, 0x70, 0x09, 0x10, 0x72, 0x7a, 0x5b,
+		0x82, 0x63, 0x63, 0x7d, 0x72, 0x72, 0x06, 0xee, 0x65, 0x5f, 0x03, 0xd4, 0xf0, 0xee, 0x86, 0xef,
+		0x6b, 0xe5, 0x23, 0xad, 0x7a, 0x00, 0xee, 0xd9, 0x17, 0xd2, 0x6d, 0xda, 0x24, 0xc0, 0xa1, 0xf6,
+		0x7d, 0x50, 0x82, 0xc2, 0x90, 0x2e, 0xa4, 0x1f, 0x1d, 0xac, 0xd5, 0xcb, 0xd5, 0xab, 0xa5, 0xcd,
+		0xda, 0x9a, 0x3a, 0x3c, 0x67, 0xef, 0x81, 0x93, 0x23, 0xa5, 0x95, 0x0d, 0xfc, 0x6a, 0x63, 0xa9,
+		0x51, 0xd5, 0xd8, 0x1d, 0x14, 0xec, 0xd8, 0x08, 0xd0, 0x72, 0xed, 0xca, 0xd5, 0x86, 0xb6, 0xb4,
+		0x5c, 0xab, 0xae, 0x36, 0xb4, 0x52, 0xa3, 0x51, 0xf2, 0xa6, 0x73, 0xf9, 0xb9, 0xb1, 0x67, 0x3f,
+		0xcf, 0x4f, 0x7f, 0xf6, 0x93, 0x9f, 0xee, 0x74, 0x8f, 0x7e, 0xfe, 0xfa, 0x13, 0x70, 0x2f, 0x7f,
+		0x15, 0xc9, 0x76, 0xf4, 0xeb, 0x6d, 0x73, 0xc7, 0x7d, 0x9e, 0x8a, 0xa7, 0xf9, 0x11, 0xd0, 0x23,
+		0x0c, 0x6a, 0x41, 0xe4, 0x4e, 0x78, 0xa4, 0x6a, 0xec, 0xdb, 0xa6, 0x13, 0xaf, 0x0e, 0x4c, 0x3a,
+		0xe0, 0xb9, 0xdf, 0x03, 0x58, 0x13, 0x9e, 0xd9, 0x0a, 0x79, 0x20, 0x6b, 0x7e, 0xff, 0xa7, 0x1c,
+		0xe6, 0xf7, 0x3d, 0x17, 0x8b, 0x57, 0xce, 0xf2, 0x57, 0xdb, 0xb6, 0x63, 0xf5, 0xdb, 0x4d, 0xbd,
+		0x43, 0x1d, 0x89, 0xf7, 0x4e, 0x7d, 0xd7, 0xad, 0x9c, 0x46, 0x33, 0xc6, 0x9f, 0xd1, 0xda, 0x15,
+		0xd7, 0xcd, 0x12, 0x37, 0xf4, 0x0e, 0xbb, 0x67, 0xe6, 0x7f, 0x87, 0x6f, 0x98, 0xed, 0x3e, 0xfb,
+		0xea, 0xa7, 0xc2, 0x70, 0xf1, 0xb4, 0xb0, 0xf2, 0x31, 0x0c, 0x76, 0xe1, 0x72, 0xc7, 0xa6, 0xcb,
+		0x63, 0xba, 0x00, 0xbb, 0x06, 0xb1, 0xbe, 0xee, 0xf0, 0x45, 0x49, 0xf9, 0xe2, 0x81, 0xdf, 0xde,
+		0x62, 0xb5, 0x50, 0x1a, 0xe4, 0x7d, 0x80, 0x47, 0xe3, 0x35, 0x4a, 0x2f, 0xf2, 0xb6, 0xe8, 0xe1,
+		0x0d, 0x01, 0x6c, 0x1f, 0xf9, 0x06, 0xfa, 0xa1, 0x49, 0xdc, 0xb4, 0x32, 0x77, 0x0c, 0x46, 0x39,
+		0xfa, 0xb6, 0x28, 0xe7, 0xba, 0xfa, 0xad, 0x25, 0x4a, 0x0d, 0xe9, 0xf3, 0x37, 0xca, 0x7e, 0x43,
+		0x02, 0xf0, 0x18, 0x43, 0x74, 0x0c, 0xe1, 0x8a, 0x14, 0xad, 0x54, 0x84, 0x70, 0x1f, 0x18, 0xc7,
+		0xfb, 0x21, 0xb6, 0x96, 0x73, 0xd8, 0xbc, 0xcf, 0xbe, 0x7e, 0x52, 0x62, 0xb5, 0x16, 0x9a, 0x23,
+		0x6c, 0xcf, 0xb0, 0x35, 0xb4, 0x46, 0xfd, 0x9b, 0xc8, 0x44, 0xff, 0x26, 0x27, 0xfc, 0x1b, 0x46,
+		0x10, 0x18, 0x36, 0x96, 0xf3, 0x3e, 0x7c, 0x5a, 0x82, 0x4c, 0xc5, 0xf7, 0x6e, 0x28, 0xc6, 0xe0,
+		0x2d, 0xb3, 0x7d, 0xdd, 0xe8, 0xbb, 0x31, 0x78, 0x96, 0x44, 0x1f, 0x84, 0x7d, 0xc4, 0xda, 0xd9,
+		0x13, 0x6f, 0xab, 0x88, 0x34, 0x62, 0xdd, 0x34, 0xb6, 0xec, 0xb6, 0xe0, 0xb3, 0x2a, 0x92, 0xb8,
+		0x1b, 0x6b, 0x1b, 0xcd, 0x01, 0x86, 0x05, 0xb5, 0xa6, 0x65, 0x3a, 0xf8, 0x2d, 0x7b, 0xe6, 0xe6,
+		0x17, 0x44, 0xfe, 0x12, 0xcb, 0x46, 0x22, 0x2d, 0xc3, 0xd1, 0xdb, 0x1d, 0x76, 0x8c, 0x2d, 0xad,
+		0x8a, 0x24, 0x6f, 0xea, 0xed, 0xa4, 0x7f, 0xe1, 0xb2, 0x04, 0xb2, 0xd5, 0x33, 0xfa, 0x81, 0xfd,
+		0x7a, 0x26, 0x8d, 0xc5, 0xdf, 0xff, 0xcc, 0xc3, 0x73, 0x9c, 0xe1, 0x7c, 0xa7, 0x97, 0x5d, 0xce,
+		0x52, 0x0b, 0x02, 0x83, 0x67, 0x93, 0x17, 0x03, 0x51, 0xf7, 0xc1, 0x96, 0xf7, 0x34, 0xd2, 0xdc,
+		0x08, 0x53, 0x4b, 0xe6, 0x5e, 0xb9, 0xf8, 0x3b, 0x1e, 0x69, 0x1e, 0x56, 0x5e, 0xa7, 0x8b, 0x15,
+		0x7f, 0x04, 0x9e, 0x92, 0x41, 0xf7, 0xee, 0x65, 0xbd, 0xdd, 0x31, 0x5a, 0x7c, 0x25, 0xcc, 0x53,
+		0xb8, 0x23, 0xc9, 0xa3, 0x4a, 0xec, 0xeb, 0xa9, 0xca, 0x38, 0xd9, 0x28, 0x5b, 0x66, 0x2b, 0x18,
+		0x4c, 0xc2, 0x88, 0x94, 0x63, 0x5d, 0x37, 0x4c, 0xce, 0xa0, 0xf2, 0x43, 0x07, 0x78, 0x64, 0x4f,
+		0xe5, 0xa8, 0xe4, 0xeb, 0x40, 0x6e, 0x19, 0x1d, 0x63, 0x87, 0x72, 0xce, 0xde, 0xd5, 0xf1, 0x72,
+		0x78, 0xe2, 0x4e, 0x9f, 0xd0, 0x2b, 0xb8, 0xa4, 0xea, 0x94, 0x12, 0x59, 0x0f, 0xbe, 0x4c, 0x9b,
+		0x74, 0x37, 0x8c, 0x43, 0xfb, 0xe8, 0x93, 0x3c, 0xbf, 0xf6, 0xf1, 0x93, 0x40, 0x09, 0x1a, 0x98,
+		0x5b, 0x96, 0x49, 0x3f, 0x05, 0xca, 0x3d, 0xec, 0x14, 0xdb, 0x89, 0x71, 0xf3, 0xf9, 0x4e, 0xcc,
+		0x3a, 0xe4, 0x3d, 0x50, 0x3a, 0x43, 0xd2, 0x07, 0x9d, 0x21, 0x39, 0x97, 0x00, 0x82, 0xe0, 0x37,
+		0x43, 0xbd, 0x39, 0x48, 0xf7, 0x01, 0x32, 0x17, 0x94, 0xc9, 0xb3, 0xd9, 0xdf, 0x19, 0x1f, 0x01,
+		0x62, 0xc2, 0x6c, 0xb7, 0x6d, 0x6a, 0xb6, 0xd1, 0xd9, 0xd6, 0x38, 0xe7, 0x90, 0x6e, 0x86, 0xb2,
+		0xff, 0x99, 0x03, 0x8c, 0xe6, 0x1f, 0x7e, 0xe6, 0xe1, 0x82, 0xf7, 0xf6, 0xe0, 0xa9, 0x47, 0x16,
+		0x1e, 0x7b, 0x42, 0x9d, 0xe9, 0xb6, 0xcd, 0xba, 0xd1, 0xd9, 0xae, 0xb8, 0x84, 0xc9, 0x7b, 0xe1,
+		0xb8, 0xc7, 0x10, 0xcb, 0xd4, 0x76, 0xad, 0x4e, 0x4b, 0xeb, 0x1b, 0xdb, 0x5a, 0x93, 0xbe, 0x9c,
+		0x98, 0xa5, 0x6c, 0x3c, 0xea, 0x82, 0xac, 0x99, 0x57, 0xad, 0x4e, 0x4b, 0x35, 0xb6, 0x97, 0xb0,
+		0x18, 0xa3, 0xf4, 0x1e, 0x76, 0xbb, 0x85, 0xfb, 0xdf, 0x51, 0x3c, 0xca, 0xeb, 0x66, 0xd6, 0x5a,
+		0xf6, 0x62, 0xea, 0x43, 0x9f, 0x3c, 0x79, 0xe8, 0xcd, 0x4f, 0x9e, 0x3c, 0xa4, 0x5c, 0xa6, 0x8f,
+		0xa7, 0xf1, 0xa9, 0x65, 0xd8, 0xe4, 0x22, 0xa4, 0x75, 0x91, 0x60, 0xf7, 0x99, 0xf6, 0x99, 0x9a,
+		0x1e, 0x28, 0x5e, 0xe5, 0x4a, 0x54, 0x36, 0xd7, 0xf5, 0x76, 0x9f, 0x54, 0xf1, 0xdd, 0x59, 0x21,
+		0xab, 0xd3, 0xce, 0x72, 0x4f, 0xbc, 0xc5, 0x34, 0x5f, 0x1d, 0x77, 0xb8, 0x27, 0x5d, 0x3e, 0xfd,
+		0xfb, 0x9f, 0x79, 0xf8, 0x6e, 0x4e, 0x66, 0x73, 0xe8, 0x9c, 0x8f, 0xa0, 0x37, 0x7c, 0xfe, 0xc7,
+		0xd7, 0xe7, 0x6b, 0x90, 0x64, 0x4d, 0xb5, 0xc9, 0xb3, 0x10, 0xef, 0xe1, 0x0f, 0x1e, 0xce, 0x3d,
+		0x31, 0x56, 0xe6, 0x29, 0xbc, 0x5f, 0x42, 0x18, 0x9e, 0xf2, 0xe1, 0x08, 0x40, 0x65, 0x73, 0xb3,
+		0xd1, 0x6f, 0xf7, 0x3a, 0x86, 0xf3, 0x4e, 0xf5, 0x7d, 0x03, 0x0e, 0x7b, 0x7d, 0xb7, 0xfb, 0xcd,
+		0x83, 0xf7, 0x7f, 0xd6, 0xbb, 0x80, 0xde, 0x6f, 0x86, 0x92, 0x6d, 0xd9, 0x8e, 0x4b, 0x36, 0x7a,
+		0x70, 0xb2, 0x15, 0xdb, 0x19, 0xe5, 0xec, 0x0b, 0x90, 0xf1, 0x98, 0x61, 0x93, 0x1a, 0xa4, 0x1c,
+		0xfe, 0x9b, 0x33, 0x58, 0x19, 0xcf, 0x60, 0x81, 0xe6, 0x67, 0xb2, 0x8b, 0x8e, 0xfb, 0x96, 0xe0,
+		0x9b, 0x23, 0xef, 0x4e, 0x19, 0xc3, 0xb8, 0x24, 0x57, 0xce, 0xd1, 0x3b, 0x7e, 0xdf, 0x94, 0x11,
+		0xf0, 0x31, 0xf5, 0x23, 0x11, 0x98, 0xdd, 0x10, 0xb3, 0xf7, 0xdd, 0xcf, 0x83, 0x0d, 0x48, 0x1a,
+		0xa6, 0xd3, 0x6f, 0xbb, 0xdb, 0x11, 0x8f, 0x8c, 0x1b, 0xf3, 0x90, 0x4e, 0x55, 0x4d, 0xa7, 0xbf,
+		0xe7, 0x97, 0x00, 0x41, 0xcb, 0xc7, 0x8f, 0x8f, 0x47, 0xa1, 0x38, 0x0e, 0x15, 0x5f, 0x03, 0x6a,
+		0xf6, 0x0d, 0x9a, 0x11, 0xbc, 0x5e, 0x97, 0x17, 0xd9, 0xdc, 0xec, 0xa8, 0x80, 0x8e, 0x1a, 0x0a,
+		0x17, 0x82, 0xde, 0x99, 0x67, 0x96, 0xf7, 0x28, 0x50, 0xc3, 0xd3, 0x80, 0x82, 0x38, 0xb3, 0xbf,
+		0xa5, 0x77, 0x74, 0xbc, 0x93, 0x1c, 0x3d, 0xb8, 0xcd, 0x17, 0xe7, 0xfe, 0xcb, 0x8c, 0x04, 0xa9,
+		0x42, 0x52, 0x50, 0x8b, 0x1d, 0x9c, 0x9a, 0xc0, 0xc5, 0xdb, 0x19, 0x7e, 0xc3, 0x40, 0xbd, 0x91,
+		0x98, 0x9a, 0x71, 0xf3, 0x6a, 0xad, 0x49, 0x96, 0x27, 0xb1, 0xaf, 0xe5, 0xe1, 0x0e, 0xdf, 0x0f,
+		0x45, 0x61, 0x46, 0x35, 0x5a, 0x7f, 0xf7, 0x87, 0x65, 0x1d, 0x80, 0x4d, 0x55, 0xd4, 0xa4, 0xc5,
+		0xd8, 0x9d, 0xce, 0xf7, 0x34, 0x23, 0x52, 0xb1, 0x9d, 0xbf, 0xad, 0x11, 0xfa, 0x8f, 0x11, 0xc8,
+		0xfa, 0x47, 0xe8, 0xef, 0xa5, 0xd1, 0x22, 0xab, 0x9e, 0x9a, 0x62, 0xb7, 0x0e, 0x1e, 0x1c, 0xa7,
+		0xa6, 0x46, 0xa4, 0x79, 0x82, 0x7e, 0xfa, 0xb5, 0x04, 0x24, 0xf8, 0x89, 0x9e, 0xb5, 0x11, 0xdf,
+		0x76, 0xe2, 0xdd, 0xea, 0x9c, 0xb8, 0x9e, 0x1e, 0xea, 0xda, 0xde, 0x07, 0x78, 0x69, 0x5d, 0x0b,
+		0x1c, 0x13, 0xc2, 0x38, 0x34, 0x2e, 0x75, 0xdd, 0xde, 0xdb, 0x78, 0x54, 0x0a, 0xc1, 0x3c, 0x3d,
+		0x8c, 0x30, 0x78, 0xc3, 0xa3, 0xca, 0x72, 0xc8, 0x79, 0x20, 0xbb, 0x6e, 0xe0, 0x42, 0xf3, 0x18,
+		0x21, 0x9d, 0xc9, 0xd1, 0x87, 0x06, 0x66, 0xbc, 0x52, 0x81, 0x82, 0xcf, 0xcd, 0x58, 0x66, 0x4b,
+		0x63, 0xcf, 0x7a, 0xf3, 0x47, 0xd3, 0x31, 0xa7, 0x82, 0x19, 0xe4, 0x83, 0x12, 0x73, 0x93, 0x87,
+		0x56, 0xd3, 0x7c, 0x95, 0xd2, 0x98, 0x62, 0x62, 0xfc, 0xcd, 0xeb, 0x27, 0xe7, 0xf7, 0xf4, 0x6e,
+		0x67, 0x51, 0x09, 0xa1, 0xa3, 0x84, 0x2d, 0xf0, 0xd1, 0x79, 0x0e, 0xae, 0xc6, 0x49, 0x0d, 0x64,
+		0xdc, 0x99, 0xea, 0xf3, 0xef, 0xe7, 0x6b, 0xdb, 0x86, 0x78, 0xe2, 0xe0, 0xd8, 0x42, 0xc8, 0x23,
+		0xeb, 0x0b, 0xf8, 0x58, 0x39, 0xdf, 0xa3, 0xc8, 0x5f, 0x37, 0xf6, 0x54, 0x8e, 0x77, 0xd9, 0x30,
+		0xc8, 0x2b, 0x70, 0x6c, 0xa7, 0x63, 0x6d, 0xe1, 0xa5, 0x97, 0xf6, 0x2b, 0x83, 0x76, 0x4b, 0xe3,
+		0x82, 0xa1, 0x35, 0xf5, 0x5e, 0x31, 0xf5, 0xb6, 0x22, 0x13, 0x47, 0x18, 0xe1, 0x65, 0x4a, 0xb7,
+		0xce, 0xc8, 0x2e, 0xe9, 0x3d, 0x72, 0x13, 0xee, 0xf2, 0x64, 0x3d, 0xa4, 0xd6, 0xf4, 0xdb, 0xaa,
+		0xf5, 0x98, 0x4b, 0x7b, 0xa4, 0x62, 0xdd, 0x3f, 0xc9, 0xe8, 0x30, 0x6f, 0xeb, 0x4d, 0xdc, 0xe1,
+		0xa1, 0xa7, 0xa8, 0xca, 0x0f, 0x1f, 0x4c, 0xa7, 0x79, 0x13, 0x0e, 0xd7, 0xc3, 0x97, 0x29, 0xa5,
+		0xc5, 0x7b, 0x51, 0xf9, 0xbc, 0xfa, 0xc6, 0xcf, 0x9d, 0x3d, 0xee, 0x7b, 0x7f, 0xfd, 0x96, 0x1b,
+		0x76, 0x64, 0x33, 0x06, 0xd7, 0x11, 0xc4, 0xb3, 0xe9, 0xbe, 0x93, 0x76, 0xe0, 0x5b, 0x7a, 0x49,
+		0xfb, 0x2f, 0xe9, 0x3c, 0xfc, 0xc0, 0x92, 0xce, 0xa7, 0xf1, 0x9e, 0xf1, 0x4c, 0x6a, 0x64, 0x92,
+		0x70, 0xf8, 0x27, 0x3b, 0x47, 0xa2, 0x8a, 0xf4, 0x90, 0xf2, 0x6f, 0x24, 0x38, 0x36, 0xa2, 0x1c,
+		0xdc, 0x26, 0x37, 0x81, 0xf4, 0x7d, 0x85, 0x74, 0x92, 0x89, 0xe7, 0x8d, 0xef, 0x4c, 0xd7, 0xcc,
+		0xf4, 0x87, 0x4b, 0xdf, 0x21, 0xdf, 0x80, 0x1b, 0x86, 0xdf, 0x96, 0x60, 0xce, 0xdf, 0x00, 0xb7,
+		0x2b, 0x75, 0xc8, 0xfa, 0xab, 0x2e, 0x4a, 0xee, 0x01, 0xda, 0x89, 0x9d, 0xf0, 0xb7, 0x3f, 0x40,
+		0x84, 0x6c, 0x7a, 0x0a, 0x98, 0x05, 0x3b, 0xcf, 0x4f, 0xcd, 0x14, 0xd1, 0xb0, 0x50, 0x45, 0xcc,
+		0xc6, 0xe6, 0xcf, 0x24, 0x88, 0xad, 0x5b, 0x56, 0x87, 0xbc, 0x02, 0x33, 0xa6, 0xe5, 0x50, 0x99,
+		0x36, 0x5a, 0x1a, 0x8f, 0xc4, 0x30, 0xe3, 0x56, 0xdd, 0x97, 0x57, 0x7f, 0xfa, 0xfa, 0xc9, 0x51,
+		0xcc, 0xb0, 0x6f, 0x20, 0x14, 0x4c, 0xcb, 0x29, 0x53, 0xa0, 0x06, 0x85, 0x21, 0xdb, 0x90, 0x0b,
+		0x56, 0xc7, 0x0c, 0x60, 0x69, 0x52, 0x75, 0xb9, 0x89, 0x55, 0x65, 0xb7, 0x7c, 0xf5, 0xb0, 0xf7,
+		0xdc, 0xff, 0x0a, 0x47, 0xee, 0x1b, 0x40, 0xde, 0x1c, 0x3e, 0xca, 0x73, 0x19, 0x92, 0xe2, 0xe8,
+		0x8e, 0x34, 0xed, 0xb1, 0x20, 0x3f, 0x3f, 0x39, 0x32, 0x8d, 0x26, 0x7f, 0x36, 0x02, 0xc7, 0xf0,
+		0x94, 0x0f, 0x8f, 0x9b, 0x71, 0x25, 0xc9, 0x42, 0xdf, 0x7b, 0x18, 0xec, 0x09, 0x8d, 0xea, 0x65,
+		0x47, 0x63, 0x77, 0x9b, 0x50, 0x40, 0x8f, 0x05, 0xe3, 0x6e, 0x6f, 0x2f, 0x74, 0x97, 0xb3, 0x3a,
+		0x2d, 0xde, 0x22, 0x0c, 0xdc, 0x6d, 0x42, 0xc1, 0x34, 0x6e, 0x06, 0xe8, 0x46, 0xef, 0x8c, 0xae,
+		0x69, 0xdc, 0xf4, 0xd1, 0xf5, 0xf6, 0x87, 0x63, 0x81, 0xfd, 0xe1, 0x8b, 0x10, 0x45, 0xcb, 0x12,
+		0x3f, 0x80, 0xf2, 0x40, 0x04, 0x9f, 0x97, 0x50, 0x87, 0x63, 0x3c, 0xf0, 0x62, 0xaf, 0x6d, 0x53,
+		0x8e, 0x1a, 0xb4, 0x43, 0xcf, 0x19, 0x7b, 0x21, 0x51, 0x98, 0xec, 0x74, 0x51, 0x98, 0x8f, 0x49,
+		0x30, 0x4b, 0x85, 0xa3, 0xfd, 0x7e, 0x83, 0xc6, 0xf6, 0x54, 0xa3, 0x69, 0xf5, 0x5b, 0x78, 0x16,
+		0x85, 0x3f, 0x36, 0x17, 0x53, 0x23, 0xed, 0x16, 0x59, 0x80, 0xb8, 0x75, 0xd3, 0xe4, 0xa7, 0x3f,
+		0xf6, 0xf3, 0xf2, 0x18, 0x58, 0x70, 0xd3, 0x7f, 0x6a, 0xbf, 0xcb, 0xc3, 0x39, 0xfb, 0xcb, 0x12,
+		0x80, 0x17, 0x1b, 0xc5, 0xc7, 0xd9, 0xca, 0x6b, 0xab, 0x15, 0x6f, 0x0f, 0xcf, 0xf7, 0x85, 0x2a,
+		0xf1, 0x94, 0x9b, 0xdd, 0x33, 0x9a, 0xed, 0xed, 0xb6, 0xd1, 0x22, 0xf7, 0xc3, 0x5c, 0x10, 0x1a,
+		0x53, 0xd5, 0x8a, 0x2c, 0xcd, 0x67, 0x5f, 0xbd, 0x7d, 0x2a, 0xc5, 0xd6, 0x82, 0x06, 0xbe, 0x54,
+		0x7c, 0x78, 0x14, 0x0e, 0xbf, 0x6e, 0x15, 0x99, 0xcf, 0xbd, 0x7a, 0xfb, 0x54, 0xda, 0x5d, 0x34,
+		0x12, 0x05, 0x88, 0x1f, 0x92, 0xd3, 0x8b, 0xce, 0xc3, 0xab, 0xb7, 0x4f, 0x25, 0xd8, 0x5c, 0xe6,
+		0x9b, 0x7f, 0x5f, 0x8f, 0x0f, 0x92, 0x6d, 0xf7, 0xf5, 0x26, 0xd5, 0x59, 0xf3, 0x70, 0xa4, 0xb6,
+		0x7a, 0x59, 0x2d, 0x2d, 0x35, 0x70, 0xbb, 0x30, 0xd0, 0xec, 0xa1, 0xb2, 0xca, 0xda, 0x46, 0x79,
+		0xb9, 0xaa, 0xe1, 0x13, 0x71, 0x6c, 0xa7, 0x3e, 0x50, 0xf6, 0xfc, 0x6a, 0xa3, 0xb6, 0x52, 0x95,
+		0x23, 0xe5, 0x8b, 0x63, 0x37, 0xf5, 0xee, 0x0a, 0x68, 0x09, 0xcf, 0x4e, 0x06, 0xbe, 0x58, 0xf2,
+		0x3f, 0x07, 0x00, 0x22, 0xfa, 0x57, 0xbd, 0x27, 0xb6, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2486,6 +2638,19 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	if that1.ValidatorBondFactor == nil {
+		if this.ValidatorBondFactor != nil {
+			return false
+		}
+	} else if !this.ValidatorBondFactor.Equal(*that1.ValidatorBondFactor) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3379,6 +3544,38 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValidatorBondFactor != nil {
+		{
+			size := m.ValidatorBondFactor.Size()
+			i -= size
+			if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3746,6 +3943,48 @@ func (m *ValAddrsOfRotatedConsKeys) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -4104,6 +4343,14 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.ValidatorBondFactor != nil {
+		l = m.ValidatorBondFactor.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
@@ -4219,6 +4466,26 @@ func (m *ValAddrsOfRotatedConsKeys) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6912,6 +7179,110 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.ValidatorBondFactor = &v
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -7775,6 +8146,139 @@ func (m *ValAddrsOfRotatedConsKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// tokenizeShareModulePrefix is the prefix of the derivation key of the record addresses.
+const tokenizeShareModulePrefix = "tokenizeshare_"
+
+// GetModuleAddress returns the address holding the tokenized delegation of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return address.Module(ModuleName, []byte(fmt.Sprintf("%s%d", tokenizeShareModulePrefix, r.Id)))
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return ShareTokenDenom(r.Validator, r.Id)
+}
+
+// ShareTokenDenom returns the denom of the share tokens of a record,
+// in the form {validator address}/{record id}.
+func ShareTokenDenom(validator string, recordID uint64) string {
+	return fmt.Sprintf("%s/%d", validator, recordID)
+}
+
+// ParseShareTokenDenom returns the validator address and the record id of
+// a share token denom.
+func ParseShareTokenDenom(denom string) (validator string, recordID uint64, err error) {
+	validator, id, found := strings.Cut(denom, "/")
+	if !found || validator == "" {
+		return "", 0, ErrNotTokenizeShareDenom.Wrap(denom)
+	}
+
+	recordID, err = strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return "", 0, ErrNotTokenizeShareDenom.Wrap(denom)
+	}
+
+	return validator, recordID, nil
+}